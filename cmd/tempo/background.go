@@ -0,0 +1,90 @@
+package main
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// backgroundQueryTimeout bounds the wait for a terminal to answer the OSC 11
+// query; terminals that don't support it never answer.
+const backgroundQueryTimeout = 150 * time.Millisecond
+
+// oscBackground matches an OSC 11 reply, e.g. "\x1b]11;rgb:1a1a/1b1b/2626\x07".
+var oscBackground = regexp.MustCompile(`\]11;rgb:([0-9a-fA-F]{1,4})/([0-9a-fA-F]{1,4})/([0-9a-fA-F]{1,4})`)
+
+// detectLightBackground reports whether the terminal background is light,
+// asking the terminal with an OSC 11 query and falling back to $COLORFGBG. ok
+// is false when neither gives an answer.
+func detectLightBackground() (light, ok bool) {
+	if light, ok := queryBackground(); ok {
+		return light, true
+	}
+	return colorFGBGBackground(os.Getenv("COLORFGBG"))
+}
+
+// queryBackground asks the terminal for its background color with OSC 11.
+func queryBackground() (light, ok bool) {
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return false, false
+	}
+	defer tty.Close()
+
+	state, err := term.MakeRaw(int(tty.Fd()))
+	if err != nil {
+		return false, false
+	}
+	defer term.Restore(int(tty.Fd()), state)
+
+	if err := tty.SetReadDeadline(time.Now().Add(backgroundQueryTimeout)); err != nil {
+		return false, false // Can't bound the wait for a silent terminal
+	}
+	if _, err := tty.WriteString("\x1b]11;?\x1b\\"); err != nil {
+		return false, false
+	}
+
+	var reply []byte
+	buf := make([]byte, 64)
+	for len(reply) < 256 {
+		n, err := tty.Read(buf)
+		reply = append(reply, buf[:n]...)
+		if m := oscBackground.FindSubmatch(reply); m != nil {
+			return isLight(hexComponent(m[1]), hexComponent(m[2]), hexComponent(m[3])), true
+		}
+		if err != nil {
+			break
+		}
+	}
+	return false, false
+}
+
+// hexComponent scales a 1-4 digit hex color component to 0-1.
+func hexComponent(digits []byte) float64 {
+	v, _ := strconv.ParseUint(string(digits), 16, 16)
+	return float64(v) / float64(uint64(1)<<(4*len(digits))-1)
+}
+
+// isLight reports whether a color, with components from 0 to 1, is light.
+func isLight(r, g, b float64) bool {
+	return 0.2126*r+0.7152*g+0.0722*b > 0.5
+}
+
+// colorFGBGBackground reads the background from $COLORFGBG ("fg;bg" or
+// "fg;default;bg"), set by rxvt, Konsole and others. ANSI colors 7 and 9-15
+// are light.
+func colorFGBGBackground(value string) (light, ok bool) {
+	if value == "" {
+		return false, false
+	}
+	fields := strings.Split(value, ";")
+	bg, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil {
+		return false, false
+	}
+	return bg == 7 || (bg >= 9 && bg <= 15), true
+}
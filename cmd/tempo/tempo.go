@@ -29,9 +29,20 @@ var (
 	tlsCA         = flag.String("tls-ca", "", "Path to CA certificate (overrides profile)")
 	tlsServerName = flag.String("tls-server-name", "", "Server name for TLS verification (overrides profile)")
 	tlsSkipVerify = flag.Bool("tls-skip-verify", false, "Skip TLS verification (insecure)")
+	apiKey        = flag.String("api-key", "", "Temporal Cloud API key (overrides profile)")
+	proxyURL      = flag.String("proxy", "", "HTTP CONNECT or SOCKS5 proxy URL, e.g. socks5://host:1080 (overrides profile)")
+	cloudRegion   = flag.String("cloud-region", "", "Temporal Cloud region, e.g. aws-us-east-1 (sets the address)")
+	backend       = flag.String("backend", "", "Backend: grpc, mock, file or record (overrides profile)")
+	backendPath   = flag.String("backend-path", "", "Snapshot file for the file and record backends (overrides profile)")
+	workflowID    = flag.String("workflow-id", "", "Open this workflow's detail view on launch")
+	runID         = flag.String("run-id", "", "Run ID of --workflow-id (defaults to the latest run)")
+	query         = flag.String("query", "", "Open the workflow list filtered by this visibility query")
 	themeNameFlag = flag.String("theme", "", "Theme name (overrides config file)")
+	noColorFlag   = flag.Bool("no-color", false, "Plain text without colors or box drawing, for screen readers and basic terminals")
 	devMode       = flag.Bool("dev", false, "Development mode: test splash screen with theme cycling")
 	versionFlag   = flag.Bool("version", false, "Print version information and exit")
+	migrateFlag   = flag.Bool("migrate-secrets", false, "Move plaintext API keys, TLS key passphrases and codec auth from the config into the OS keychain and exit")
+	importTheme   = flag.String("import-theme", "", "Convert a base16, Gogh or alacritty color scheme file into a custom theme and exit")
 )
 
 const (
@@ -49,8 +60,26 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *runID != "" && *workflowID == "" {
+		fmt.Fprintln(os.Stderr, "Error: --run-id requires --workflow-id")
+		os.Exit(1)
+	}
+
+	if *importTheme != "" {
+		importThemeFile(*importTheme)
+		return
+	}
+
 	// Load configuration from file
 	cfg, err := config.Load()
+	if *migrateFlag {
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to load config: %v\n", err)
+			os.Exit(1)
+		}
+		migrateSecrets(cfg)
+		return
+	}
 	if err != nil {
 		// Config load error is non-fatal, use defaults
 		cfg = config.DefaultConfig()
@@ -61,13 +90,23 @@ func main() {
 	if *themeNameFlag != "" {
 		themeName = *themeNameFlag
 	}
+	if themeName == config.AutoTheme || themeName == "" {
+		// Match the terminal background; assume dark when it can't be detected
+		light, _ := detectLightBackground()
+		themeName = cfg.PickAutoTheme(light)
+	}
 
 	// Initialize theme system before any UI using jig's built-in themes
-	selectedTheme := themes.Get(themeName)
+	selectedTheme := config.ResolveTheme(themeName)
 	if selectedTheme == nil {
 		fmt.Fprintf(os.Stderr, "Warning: theme %q not found, using tokyonight-night\n", themeName)
 		selectedTheme = themes.Default()
 	}
+	if *noColorFlag || cfg.NoColorEnabled() {
+		// tcell drops every color it draws when NO_COLOR is set
+		os.Setenv("NO_COLOR", "1")
+		selectedTheme = config.MonochromeTheme()
+	}
 	theme.SetProvider(selectedTheme)
 
 	// Register Temporal-specific statuses with jig's theme system
@@ -88,22 +127,28 @@ func main() {
 
 	// Get the profile's connection config
 	profileConfig, _ := cfg.GetProfile(activeProfileName)
+	profileConfig, err = profileConfig.Resolve()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: profile %q: %v\n", activeProfileName, err)
+		os.Exit(1)
+	}
 
 	// Build temporal connection config from profile
-	connConfig := temporal.ConnectionConfig{
-		Address:       profileConfig.Address,
-		Namespace:     profileConfig.Namespace,
-		TLSCertPath:   profileConfig.TLS.Cert,
-		TLSKeyPath:    profileConfig.TLS.Key,
-		TLSCAPath:     profileConfig.TLS.CA,
-		TLSServerName: profileConfig.TLS.ServerName,
-		TLSSkipVerify: profileConfig.TLS.SkipVerify,
-	}
+	connConfig := temporal.ConnectionConfigFromProfile(profileConfig)
+	connConfig.MaxPayloadSize = cfg.History.GetMaxPayloadSize()
 
 	// CLI flags override profile settings
 	if *address != "" {
 		connConfig.Address = *address
 	}
+	if *cloudRegion != "" {
+		regionAddress, err := temporal.CloudRegionAddress(*cloudRegion)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		connConfig.Address = regionAddress
+	}
 	if *namespace != "" {
 		connConfig.Namespace = *namespace
 	}
@@ -122,6 +167,18 @@ func main() {
 	if *tlsSkipVerify {
 		connConfig.TLSSkipVerify = true
 	}
+	if *apiKey != "" {
+		connConfig.APIKey = *apiKey
+	}
+	if *proxyURL != "" {
+		connConfig.ProxyURL = *proxyURL
+	}
+	if *backend != "" {
+		connConfig.Backend = *backend
+	}
+	if *backendPath != "" {
+		connConfig.BackendPath = *backendPath
+	}
 
 	// Run connection with UI
 	provider, err := connectWithUI(connConfig)
@@ -129,17 +186,58 @@ func main() {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
-	defer provider.Close()
 
-	// Launch main application with config for profile management
+	// Launch main application with config for profile management.
+	// The app owns the connection from here and closes it on exit.
 	app := view.NewAppWithProvider(provider, connConfig.Namespace, cfg, activeProfileName)
 	app.SetDevMode(*devMode)
+	if *workflowID != "" || *query != "" {
+		app.OpenDeepLink(*workflowID, *runID, *query)
+	}
 	if err := app.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 }
 
+// migrateSecrets moves plaintext secrets of every profile into the OS keychain,
+// replacing them with keyring: references in the config file.
+func migrateSecrets(cfg *config.Config) {
+	migrated, err := cfg.MigrateSecrets()
+	if migrated > 0 {
+		if saveErr := cfg.Save(); saveErr != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to save config: %v\n", saveErr)
+			os.Exit(1)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Moved secrets of %d profile(s) to the keychain\n", migrated)
+}
+
+func importThemeFile(path string) {
+	t, err := config.ImportTheme(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	key := config.ThemeKey(t.Name)
+	if key == "" {
+		key = "imported"
+	}
+	if _, builtin := config.BuiltinThemes[key]; builtin {
+		key += "-imported" // Built-in themes take precedence over custom ones
+	}
+	saved, err := config.SaveCustomTheme(key, t)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Imported %s theme %q to %s\nSelect it with --theme %s, :theme %s or the theme selector (T)\n", t.Type, t.Name, saved, key, key)
+}
+
 const splashLogo = `
 ░▒▓████████▓▒░▒▓████████▓▒░▒▓██████████████▓▒░░▒▓███████▓▒░ ░▒▓██████▓▒░  
    ░▒▓█▓▒░   ░▒▓█▓▒░      ░▒▓█▓▒░░▒▓█▓▒░░▒▓█▓▒░▒▓█▓▒░░▒▓█▓▒░▒▓█▓▒░░▒▓█▓▒░ 
@@ -213,9 +311,13 @@ func connectWithUI(config temporal.ConnectionConfig) (temporal.Provider, error)
 	logoText.SetBackgroundColor(theme.Bg())
 
 	// Apply gradient effect to logo using theme colors
-	gradientColors := util.DefaultGradientColors()
-	gradientLogo := util.ApplyDiagonalGradient(splashLogo, gradientColors)
-	logoText.SetText(gradientLogo)
+	if os.Getenv("NO_COLOR") != "" {
+		logoText.SetText(splashLogo)
+	} else {
+		gradientColors := util.DefaultGradientColors()
+		gradientLogo := util.ApplyDiagonalGradient(splashLogo, gradientColors)
+		logoText.SetText(gradientLogo)
+	}
 
 	// Create spacer boxes with background color
 	leftSpacer := tview.NewBox().SetBackgroundColor(theme.Bg())
@@ -323,7 +425,7 @@ func connectWithUI(config temporal.ConnectionConfig) (temporal.Provider, error)
 			updateStatus(fmt.Sprintf("Connecting to %s... (attempt %d/%d)", config.Address, attempt, maxRetries), false)
 
 			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-			client, err := temporal.NewClient(ctx, config)
+			client, err := temporal.NewProvider(ctx, config)
 			cancel()
 
 			if err == nil {
@@ -4,26 +4,110 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 // TLSConfig holds TLS connection settings.
 type TLSConfig struct {
-	Cert       string `yaml:"cert,omitempty"`
-	Key        string `yaml:"key,omitempty"`
-	CA         string `yaml:"ca,omitempty"`
-	ServerName string `yaml:"server_name,omitempty"`
-	SkipVerify bool   `yaml:"skip_verify,omitempty"`
+	Cert          string `yaml:"cert,omitempty"`
+	Key           string `yaml:"key,omitempty"`
+	KeyPassphrase string `yaml:"key_passphrase,omitempty"` // For encrypted keys; usually a keyring: reference
+	CA            string `yaml:"ca,omitempty"`
+	ServerName    string `yaml:"server_name,omitempty"`
+	SkipVerify    bool   `yaml:"skip_verify,omitempty"`
+}
+
+// CodecConfig holds payload decoding settings: a remote codec server and/or
+// an external decoder plugin executable.
+type CodecConfig struct {
+	Endpoint      string   `yaml:"endpoint,omitempty"`
+	Auth          string   `yaml:"auth,omitempty"` // Sent verbatim as the Authorization header
+	PassNamespace bool     `yaml:"pass_namespace,omitempty"`
+	Plugin        string   `yaml:"plugin,omitempty"`
+	PluginArgs    []string `yaml:"plugin_args,omitempty"`
+}
+
+// RPCConfig holds gRPC call settings. Durations are strings like "30s";
+// invalid ones fall back to the defaults.
+type RPCConfig struct {
+	Timeout      string            `yaml:"timeout,omitempty"`       // Per attempt of a call (default 10s, longer for list and history calls)
+	Timeouts     map[string]string `yaml:"timeouts,omitempty"`      // Per API method, e.g. ListWorkflowExecutions: 1m
+	Retries      int               `yaml:"retries,omitempty"`       // Retries when unavailable or timed out (default 2, -1 disables)
+	RetryBackoff string            `yaml:"retry_backoff,omitempty"` // First retry delay, doubled per retry (default 250ms)
+	RateLimit    float64           `yaml:"rate_limit,omitempty"`    // Calls per second to the server (default: no limit)
+	RateBurst    int               `yaml:"rate_burst,omitempty"`    // Calls sent at once before rate_limit applies
+	Headers      map[string]string `yaml:"headers,omitempty"`       // gRPC metadata sent with every call
+	Interceptors []string          `yaml:"interceptors,omitempty"`  // Interceptors compiled into the binary, by name
+}
+
+// Validate reports settings that can't be applied.
+func (r RPCConfig) Validate() error {
+	if r.RateLimit < 0 {
+		return fmt.Errorf("rpc.rate_limit must not be negative")
+	}
+	if r.RateBurst < 0 {
+		return fmt.Errorf("rpc.rate_burst must not be negative")
+	}
+	return nil
+}
+
+// GetTimeout returns the per-attempt timeout, or zero for the default.
+func (r RPCConfig) GetTimeout() time.Duration {
+	return parseDuration(r.Timeout)
+}
+
+// GetTimeouts returns the valid per-method timeouts.
+func (r RPCConfig) GetTimeouts() map[string]time.Duration {
+	if len(r.Timeouts) == 0 {
+		return nil
+	}
+	timeouts := make(map[string]time.Duration, len(r.Timeouts))
+	for method, value := range r.Timeouts {
+		if d := parseDuration(value); d > 0 {
+			timeouts[method] = d
+		}
+	}
+	return timeouts
+}
+
+// GetRetryBackoff returns the first retry delay, or zero for the default.
+func (r RPCConfig) GetRetryBackoff() time.Duration {
+	return parseDuration(r.RetryBackoff)
+}
+
+// parseDuration parses a positive duration, returning zero if s is empty or
+// invalid.
+func parseDuration(s string) time.Duration {
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0
+	}
+	return d
 }
 
 // ConnectionConfig holds Temporal connection settings.
 type ConnectionConfig struct {
-	Address   string    `yaml:"address"`
-	Namespace string    `yaml:"namespace"`
-	TLS       TLSConfig `yaml:"tls,omitempty"`
+	Address   string      `yaml:"address"`
+	Namespace string      `yaml:"namespace"`
+	APIKey    string      `yaml:"api_key,omitempty"` // Temporal Cloud API key; implies TLS
+	Proxy     string      `yaml:"proxy,omitempty"`   // http:// (CONNECT) or socks5:// proxy URL
+	TLS       TLSConfig   `yaml:"tls,omitempty"`
+	Codec     CodecConfig `yaml:"codec,omitempty"`
+	RPC       RPCConfig   `yaml:"rpc,omitempty"`
+	WebUI     string      `yaml:"web_ui,omitempty"` // Web UI base URL, for opening workflows in a browser
+
+	// Backend serving the profile: grpc (default), mock, file or record
+	Backend     string `yaml:"backend,omitempty"`
+	BackendPath string `yaml:"backend_path,omitempty"` // Snapshot file for the file and record backends
+
+	Tags       []string        `yaml:"tags,omitempty"` // e.g. [prod]
+	Confirm    ConfirmSettings `yaml:"confirm,omitempty"`
+	Namespaces []string        `yaml:"namespaces,omitempty"` // Allowlist: names or /regex/ entries
 }
 
 // ToTemporalConfig converts config.ConnectionConfig to temporal-compatible format.
@@ -54,6 +138,82 @@ type SavedFilter struct {
 	IsDefault bool   `yaml:"is_default,omitempty"`
 }
 
+// SignalTemplate is a named signal and payload offered in the Signal modal.
+type SignalTemplate struct {
+	Name         string `yaml:"name"`
+	WorkflowType string `yaml:"workflow_type,omitempty"` // Empty applies to every workflow type
+	Signal       string `yaml:"signal"`
+	Input        string `yaml:"input,omitempty"`
+}
+
+// Default list settings, used when the config file leaves them unset.
+const (
+	DefaultPageSize   = 100
+	DefaultMaxResults = 1000
+)
+
+// Workflow list orderings. OrderServerDefault leaves ordering to the server.
+const (
+	OrderServerDefault = ""
+	OrderStartTimeDesc = "start_time_desc"
+	OrderStartTimeAsc  = "start_time_asc"
+	OrderCloseTimeDesc = "close_time_desc"
+	OrderCloseTimeAsc  = "close_time_asc"
+)
+
+// ListOrderings returns the supported workflow list orderings in display order.
+func ListOrderings() []string {
+	return []string{
+		OrderServerDefault,
+		OrderStartTimeDesc,
+		OrderStartTimeAsc,
+		OrderCloseTimeDesc,
+		OrderCloseTimeAsc,
+	}
+}
+
+// ListSettings controls how workflow lists are fetched from the server.
+type ListSettings struct {
+	PageSize   int    `yaml:"page_size,omitempty"`
+	MaxResults int    `yaml:"max_results,omitempty"`
+	OrderBy    string `yaml:"order_by,omitempty"`
+}
+
+// GetPageSize returns the page size for list requests.
+// Defaults to DefaultPageSize if not set.
+func (l ListSettings) GetPageSize() int {
+	if l.PageSize <= 0 {
+		return DefaultPageSize
+	}
+	return l.PageSize
+}
+
+// GetMaxResults returns the maximum number of results to load across pages.
+// Defaults to DefaultMaxResults if not set.
+func (l ListSettings) GetMaxResults() int {
+	if l.MaxResults <= 0 {
+		return DefaultMaxResults
+	}
+	return l.MaxResults
+}
+
+// OrderClause returns the visibility ORDER BY clause for the configured ordering,
+// or an empty string when the server default should be used.
+func (l ListSettings) OrderClause() string {
+	switch l.OrderBy {
+	case OrderStartTimeDesc:
+		return "ORDER BY StartTime DESC"
+	case OrderStartTimeAsc:
+		return "ORDER BY StartTime ASC"
+	case OrderCloseTimeDesc:
+		return "ORDER BY CloseTime DESC"
+	case OrderCloseTimeAsc:
+		return "ORDER BY CloseTime ASC"
+	default:
+		return ""
+	}
+}
+
 // Config represents the application configuration.
 type Config struct {
 	Theme         string                      `yaml:"theme"`
@@ -61,6 +221,120 @@ type Config struct {
 	Profiles      map[string]ConnectionConfig `yaml:"profiles,omitempty"`
 	SavedFilters  []SavedFilter               `yaml:"saved_filters,omitempty"`
 	CheckUpdates  *bool                       `yaml:"check_updates,omitempty"`
+	Mouse         *bool                       `yaml:"mouse,omitempty"`
+	StartView     string                      `yaml:"start_view,omitempty"` // "namespaces" (default) or "dashboard"
+	Keymap        string                      `yaml:"keymap,omitempty"`     // "vim" (default), "emacs" or "arrows"
+	Clipboard     string                      `yaml:"clipboard,omitempty"`  // "auto" (default), "system" or "osc52"
+	KeyRemaps     map[string]string           `yaml:"key_remaps,omitempty"` // Extra remaps applied over the keymap preset
+	Aliases       map[string]string           `yaml:"aliases,omitempty"`    // Command bar aliases, e.g. fp: wf ExecutionStatus="Failed"
+	Lists         ListSettings                `yaml:"lists,omitempty"`
+	History       HistorySettings             `yaml:"history,omitempty"`
+	PanelRatios   map[string]int              `yaml:"panel_ratios,omitempty"` // First panel's share, in percent, per split view
+	StatusBar     StatusBarSettings           `yaml:"status_bar,omitempty"`
+	StatusPalette string                      `yaml:"status_palette,omitempty"` // "theme" (default), or a colorblind-safe palette
+	StatusMarkers bool                        `yaml:"status_markers,omitempty"` // Plain-text symbols beside status colors
+	NoColor       bool                        `yaml:"no_color,omitempty"`       // Plain text without colors, gradients or box drawing
+	WrapDetails   *bool                       `yaml:"wrap_details,omitempty"`   // Wrap long lines in detail panes, or scroll them with h/l
+	Times         TimeSettings                `yaml:"times,omitempty"`
+
+	SignalTemplates []SignalTemplate `yaml:"signal_templates,omitempty"`
+
+	autoTheme string // Theme picked for AutoTheme at startup
+}
+
+// Status bar segments.
+const (
+	SegmentProfile    = "profile"
+	SegmentNamespace  = "namespace"
+	SegmentConnection = "connection"
+	SegmentAlerts     = "alerts" // Stuck task queues
+	SegmentCounts     = "counts" // Running, completed and failed workflows in the list
+	SegmentClock      = "clock"
+	SegmentVersion    = "version" // Temporal server version
+)
+
+// StatusBarSegments returns every status bar segment.
+func StatusBarSegments() []string {
+	return []string{SegmentProfile, SegmentNamespace, SegmentConnection, SegmentAlerts, SegmentCounts, SegmentClock, SegmentVersion}
+}
+
+// StatusBarSettings controls which segments the status bar shows and how.
+type StatusBarSettings struct {
+	// Left and Right list the segments shown on each side of the bar, in order.
+	// The defaults are used when neither is set.
+	Left  []string `yaml:"left,omitempty"`
+	Right []string `yaml:"right,omitempty"`
+
+	// Compact draws the bar on a single line without a border, for small terminals.
+	Compact bool `yaml:"compact,omitempty"`
+}
+
+// Segments returns the segments shown on each side of the status bar.
+// Defaults to profile, namespace, connection and alerts on the left and
+// counts on the right.
+func (s StatusBarSettings) Segments() (left, right []string) {
+	if len(s.Left) == 0 && len(s.Right) == 0 {
+		return []string{SegmentProfile, SegmentNamespace, SegmentConnection, SegmentAlerts}, []string{SegmentCounts}
+	}
+	return s.Left, s.Right
+}
+
+// Shows returns whether the status bar shows segment.
+func (s StatusBarSettings) Shows(segment string) bool {
+	left, right := s.Segments()
+	return slices.Contains(left, segment) || slices.Contains(right, segment)
+}
+
+// InvalidSegments returns the configured segments that don't exist.
+func (s StatusBarSettings) InvalidSegments() []string {
+	var invalid []string
+	for _, segment := range append(slices.Clone(s.Left), s.Right...) {
+		if !slices.Contains(StatusBarSegments(), segment) {
+			invalid = append(invalid, segment)
+		}
+	}
+	return invalid
+}
+
+// DefaultMaxPayloadSize is the payload size, in bytes, above which history
+// payloads are truncated until the event is opened.
+const DefaultMaxPayloadSize = 64 * 1024
+
+// HistorySettings controls how workflow histories are loaded.
+type HistorySettings struct {
+	// MaxPayloadSize is the largest payload, in bytes, rendered inline when a
+	// history loads. A negative value disables truncation.
+	MaxPayloadSize int `yaml:"max_payload_size,omitempty"`
+
+	// LatencyThreshold is the schedule-to-start latency (e.g., "5s") above which
+	// activities and workflow tasks are flagged in the event tree.
+	LatencyThreshold string `yaml:"latency_threshold,omitempty"`
+}
+
+// GetMaxPayloadSize returns the inline payload size limit, or zero when
+// truncation is disabled. Defaults to DefaultMaxPayloadSize if not set.
+func (h HistorySettings) GetMaxPayloadSize() int {
+	switch {
+	case h.MaxPayloadSize < 0:
+		return 0
+	case h.MaxPayloadSize == 0:
+		return DefaultMaxPayloadSize
+	default:
+		return h.MaxPayloadSize
+	}
+}
+
+// DefaultLatencyThreshold is the schedule-to-start latency above which tasks are flagged.
+const DefaultLatencyThreshold = 5 * time.Second
+
+// GetLatencyThreshold returns the schedule-to-start latency threshold.
+// Defaults to DefaultLatencyThreshold if not set or invalid.
+func (h HistorySettings) GetLatencyThreshold() time.Duration {
+	d, err := time.ParseDuration(h.LatencyThreshold)
+	if err != nil || d <= 0 {
+		return DefaultLatencyThreshold
+	}
+	return d
 }
 
 // ShouldCheckUpdates returns whether update checking is enabled.
@@ -72,10 +346,71 @@ func (c *Config) ShouldCheckUpdates() bool {
 	return *c.CheckUpdates
 }
 
+// MouseEnabled returns whether mouse support is enabled.
+// Defaults to true if not explicitly set.
+func (c *Config) MouseEnabled() bool {
+	if c.Mouse == nil {
+		return true
+	}
+	return *c.Mouse
+}
+
+// WrapDetailsEnabled returns whether detail panes wrap long lines.
+// Defaults to true if not explicitly set.
+func (c *Config) WrapDetailsEnabled() bool {
+	if c.WrapDetails == nil {
+		return true
+	}
+	return *c.WrapDetails
+}
+
+// Keymap presets.
+const (
+	KeymapVim    = "vim"
+	KeymapEmacs  = "emacs"
+	KeymapArrows = "arrows"
+)
+
+// Keymaps returns the keymap presets in display order.
+func Keymaps() []string {
+	return []string{KeymapVim, KeymapEmacs, KeymapArrows}
+}
+
+// GetKeymap returns the keymap preset. Defaults to KeymapVim if not set.
+func (c *Config) GetKeymap() string {
+	if c.Keymap == "" {
+		return KeymapVim
+	}
+	return c.Keymap
+}
+
+// Clipboard modes.
+const (
+	ClipboardAuto   = "auto"   // System tool, or OSC 52 over SSH or when no tool works
+	ClipboardSystem = "system" // pbcopy, xclip, xsel or clip only
+	ClipboardOSC52  = "osc52"  // OSC 52 escape sequence only
+)
+
+// GetClipboard returns the clipboard mode. Defaults to ClipboardAuto if not set.
+func (c *Config) GetClipboard() string {
+	if c.Clipboard == "" {
+		return ClipboardAuto
+	}
+	return c.Clipboard
+}
+
+// StartViewDashboard opens the multi-namespace dashboard on launch.
+const StartViewDashboard = "dashboard"
+
+// StartsOnDashboard returns whether the dashboard is the landing page.
+func (c *Config) StartsOnDashboard() bool {
+	return c.StartView == StartViewDashboard
+}
+
 // DefaultConfig returns a config with default values.
 func DefaultConfig() *Config {
 	return &Config{
-		Theme:         DefaultTheme,
+		Theme:         AutoTheme,
 		ActiveProfile: "default",
 		Profiles: map[string]ConnectionConfig{
 			"default": {
@@ -137,6 +472,29 @@ func (c *Config) ensureDefaults() {
 	}
 }
 
+// Bounds on the share, in percent, of a split view given to its first panel.
+const (
+	MinPanelRatio = 10
+	MaxPanelRatio = 90
+)
+
+// PanelRatio returns the saved share, in percent, of the first panel of a split
+// view, or def if none was saved.
+func (c *Config) PanelRatio(view string, def int) int {
+	if ratio, ok := c.PanelRatios[view]; ok {
+		return min(max(ratio, MinPanelRatio), MaxPanelRatio)
+	}
+	return def
+}
+
+// SetPanelRatio remembers the share of the first panel of a split view.
+func (c *Config) SetPanelRatio(view string, ratio int) {
+	if c.PanelRatios == nil {
+		c.PanelRatios = make(map[string]int)
+	}
+	c.PanelRatios[view] = min(max(ratio, MinPanelRatio), MaxPanelRatio)
+}
+
 // Save writes the config to disk.
 func (c *Config) Save() error {
 	if err := EnsureConfigDir(); err != nil {
@@ -333,6 +691,20 @@ func (c *Config) DeleteFilter(name string) error {
 	return fmt.Errorf("filter %q not found", name)
 }
 
+// Signal template methods
+
+// GetSignalTemplates returns the templates that apply to a workflow type,
+// including templates not tied to any type.
+func (c *Config) GetSignalTemplates(workflowType string) []SignalTemplate {
+	var templates []SignalTemplate
+	for _, t := range c.SignalTemplates {
+		if t.WorkflowType == "" || t.WorkflowType == workflowType {
+			templates = append(templates, t)
+		}
+	}
+	return templates
+}
+
 // GetDefaultFilter returns the default filter if one is set.
 func (c *Config) GetDefaultFilter() (SavedFilter, bool) {
 	for _, f := range c.SavedFilters {
@@ -382,6 +754,47 @@ func loadThemeFile(path string) (*ParsedTheme, error) {
 	return theme.Parse()
 }
 
+// PickAutoTheme chooses the theme AutoTheme stands for, given whether the
+// terminal background is light, and returns it.
+func (c *Config) PickAutoTheme(light bool) string {
+	c.autoTheme = DefaultTheme
+	if light {
+		c.autoTheme = DefaultLightTheme
+	}
+	return c.autoTheme
+}
+
+// ThemeName returns the name of the theme in use, resolving AutoTheme to the
+// theme picked at startup.
+func (c *Config) ThemeName() string {
+	switch {
+	case c.Theme == AutoTheme && c.autoTheme != "":
+		return c.autoTheme
+	case c.Theme == "" || c.Theme == AutoTheme:
+		return DefaultTheme
+	}
+	return c.Theme
+}
+
+// CustomThemeNames returns the sorted names of the themes in the custom themes
+// directory, e.g. those imported with --import-theme.
+func CustomThemeNames() []string {
+	entries, err := os.ReadDir(ThemesDir())
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, e := range entries {
+		if name, ok := strings.CutSuffix(e.Name(), ".yaml"); ok && !e.IsDir() {
+			if _, builtin := BuiltinThemes[name]; !builtin {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 // ValidateTheme checks if a theme name is valid.
 func ValidateTheme(name string) bool {
 	// Built-in theme
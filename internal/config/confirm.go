@@ -0,0 +1,74 @@
+package config
+
+import "slices"
+
+// Confirmation modes for workflow operations.
+const (
+	ConfirmSkip     = "skip"     // Run operations without asking
+	ConfirmStandard = "standard" // Ask for confirmation (default)
+	ConfirmTyped    = "typed"    // Destructive operations require typing the target to confirm
+)
+
+// Operations covered by the confirmation policy.
+const (
+	OpCancel         = "cancel"
+	OpTerminate      = "terminate"
+	OpDelete         = "delete"
+	OpReset          = "reset"
+	OpBatchCancel    = "batch-cancel"
+	OpBatchTerminate = "batch-terminate"
+
+	OpNamespaceDelete    = "namespace-delete"
+	OpNamespaceDeprecate = "namespace-deprecate"
+	OpNamespaceFailover  = "namespace-failover"
+	OpScheduleDelete     = "schedule-delete"
+	OpNexusDelete        = "nexus-delete"
+	OpSetCurrentVersion  = "set-current-version"
+)
+
+// DefaultDestructiveOperations are the operations that require a typed
+// confirmation in typed mode when a profile doesn't list its own.
+var DefaultDestructiveOperations = []string{
+	OpTerminate, OpDelete, OpReset, OpBatchTerminate,
+	OpNamespaceDelete, OpNamespaceDeprecate, OpNamespaceFailover,
+	OpScheduleDelete, OpNexusDelete, OpSetCurrentVersion,
+}
+
+// ProdTag marks a production profile; such profiles default to typed confirmations.
+const ProdTag = "prod"
+
+// ConfirmSettings controls confirmation prompts for a profile.
+type ConfirmSettings struct {
+	Mode        string   `yaml:"mode,omitempty"`        // skip, standard or typed
+	Destructive []string `yaml:"destructive,omitempty"` // Operations treated as destructive in typed mode
+}
+
+// HasTag returns whether the profile carries a tag.
+func (c ConnectionConfig) HasTag(tag string) bool {
+	return slices.Contains(c.Tags, tag)
+}
+
+// ConfirmMode returns how an operation is confirmed on this profile. Unset modes
+// default to typed on prod-tagged profiles and standard elsewhere. In typed mode,
+// operations that aren't destructive get a standard confirmation.
+func (c ConnectionConfig) ConfirmMode(operation string) string {
+	mode := c.Confirm.Mode
+	if mode == "" {
+		mode = ConfirmStandard
+		if c.HasTag(ProdTag) {
+			mode = ConfirmTyped
+		}
+	}
+	if mode != ConfirmTyped {
+		return mode
+	}
+
+	destructive := c.Confirm.Destructive
+	if len(destructive) == 0 {
+		destructive = DefaultDestructiveOperations
+	}
+	if !slices.Contains(destructive, operation) {
+		return ConfirmStandard
+	}
+	return ConfirmTyped
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// envRef matches ${VAR} and ${VAR:-default} references in profile fields.
+var envRef = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(?::-([^}]*))?\}`)
+
+// ExpandEnv returns a copy of the profile with ${VAR} references resolved from the
+// environment. ${VAR:-default} falls back to default when VAR is unset or empty.
+// Profiles are stored unexpanded so saving the config keeps the references; expand
+// them when connecting. Returns an error naming any variables that are unset and
+// have no default.
+func (c ConnectionConfig) ExpandEnv() (ConnectionConfig, error) {
+	missing := make(map[string]bool)
+	expand := func(s string) string {
+		return envRef.ReplaceAllStringFunc(s, func(ref string) string {
+			m := envRef.FindStringSubmatch(ref)
+			if value := os.Getenv(m[1]); value != "" {
+				return value
+			}
+			if strings.Contains(ref, ":-") {
+				return m[2]
+			}
+			missing[m[1]] = true
+			return ""
+		})
+	}
+
+	out := c
+	out.Address = expand(c.Address)
+	out.Namespace = expand(c.Namespace)
+	out.APIKey = expand(c.APIKey)
+	out.Proxy = expand(c.Proxy)
+	out.TLS.Cert = expand(c.TLS.Cert)
+	out.TLS.Key = expand(c.TLS.Key)
+	out.TLS.KeyPassphrase = expand(c.TLS.KeyPassphrase)
+	out.TLS.CA = expand(c.TLS.CA)
+	out.TLS.ServerName = expand(c.TLS.ServerName)
+	out.Codec.Endpoint = expand(c.Codec.Endpoint)
+	out.Codec.Auth = expand(c.Codec.Auth)
+	out.Codec.Plugin = expand(c.Codec.Plugin)
+	out.WebUI = expand(c.WebUI)
+	out.BackendPath = expand(c.BackendPath)
+	if c.RPC.Headers != nil {
+		out.RPC.Headers = make(map[string]string, len(c.RPC.Headers))
+		for key, value := range c.RPC.Headers {
+			out.RPC.Headers[key] = expand(value)
+		}
+	}
+	if c.Codec.PluginArgs != nil {
+		out.Codec.PluginArgs = make([]string, len(c.Codec.PluginArgs))
+		for i, arg := range c.Codec.PluginArgs {
+			out.Codec.PluginArgs[i] = expand(arg)
+		}
+	}
+
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return out, fmt.Errorf("environment variables not set: %s", strings.Join(names, ", "))
+	}
+	return out, nil
+}
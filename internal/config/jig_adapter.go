@@ -2,9 +2,23 @@ package config
 
 import (
 	"github.com/atterpac/jig/theme"
+	"github.com/atterpac/jig/theme/themes"
 	"github.com/gdamore/tcell/v2"
 )
 
+// ResolveTheme returns a built-in theme by name or, failing that, a custom
+// theme from the themes directory. It returns nil if neither exists.
+func ResolveTheme(name string) theme.Theme {
+	if t := themes.Get(name); t != nil {
+		return t
+	}
+	parsed, err := LoadTheme(name)
+	if err != nil {
+		return nil
+	}
+	return NewJigThemeAdapter(parsed)
+}
+
 // JigThemeAdapter adapts tempo's ParsedTheme to jig's Theme interface.
 type JigThemeAdapter struct {
 	parsed *ParsedTheme
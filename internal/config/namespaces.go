@@ -0,0 +1,49 @@
+package config
+
+import (
+	"regexp"
+	"strings"
+)
+
+// AllowsNamespace returns whether a namespace may be listed and opened with this
+// profile. Entries in Namespaces are exact names, or regular expressions when
+// wrapped in slashes (e.g. /^team-a-/). An empty list allows every namespace;
+// invalid expressions match nothing.
+func (c ConnectionConfig) AllowsNamespace(name string) bool {
+	if len(c.Namespaces) == 0 {
+		return true
+	}
+	for _, entry := range c.Namespaces {
+		if pattern, ok := namespacePattern(entry); ok {
+			if re, err := regexp.Compile(pattern); err == nil && re.MatchString(name) {
+				return true
+			}
+			continue
+		}
+		if entry == name {
+			return true
+		}
+	}
+	return false
+}
+
+// InvalidNamespacePatterns returns the Namespaces entries whose expressions don't compile.
+func (c ConnectionConfig) InvalidNamespacePatterns() []string {
+	var invalid []string
+	for _, entry := range c.Namespaces {
+		if pattern, ok := namespacePattern(entry); ok {
+			if _, err := regexp.Compile(pattern); err != nil {
+				invalid = append(invalid, entry)
+			}
+		}
+	}
+	return invalid
+}
+
+// namespacePattern returns the expression of a /regex/ allowlist entry.
+func namespacePattern(entry string) (string, bool) {
+	if len(entry) > 2 && strings.HasPrefix(entry, "/") && strings.HasSuffix(entry, "/") {
+		return entry[1 : len(entry)-1], true
+	}
+	return "", false
+}
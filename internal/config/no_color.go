@@ -0,0 +1,59 @@
+package config
+
+import (
+	"os"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/gdamore/tcell/v2"
+)
+
+// NoColorEnabled returns whether no-color mode is on, set by no_color or the
+// NO_COLOR environment variable (https://no-color.org).
+func (c *Config) NoColorEnabled() bool {
+	return c.NoColor || os.Getenv("NO_COLOR") != ""
+}
+
+// monochromeTheme is the theme of no-color mode: white on black, so that with
+// colors turned off tcell draws plain text, and reverse video where the theme
+// background is used as a foreground, e.g. for selections.
+type monochromeTheme struct{}
+
+// MonochromeTheme returns the theme used in no-color mode.
+func MonochromeTheme() theme.Theme {
+	return monochromeTheme{}
+}
+
+// Base colors
+func (monochromeTheme) Bg() tcell.Color      { return tcell.ColorBlack }
+func (monochromeTheme) BgLight() tcell.Color { return tcell.ColorWhite }
+func (monochromeTheme) BgDark() tcell.Color  { return tcell.ColorWhite }
+func (monochromeTheme) Fg() tcell.Color      { return tcell.ColorWhite }
+func (monochromeTheme) FgDim() tcell.Color   { return tcell.ColorWhite }
+func (monochromeTheme) FgMuted() tcell.Color { return tcell.ColorWhite }
+
+// Accent colors
+func (monochromeTheme) Accent() tcell.Color    { return tcell.ColorWhite }
+func (monochromeTheme) AccentDim() tcell.Color { return tcell.ColorWhite }
+func (monochromeTheme) Highlight() tcell.Color { return tcell.ColorWhite }
+
+// Semantic colors
+func (monochromeTheme) Success() tcell.Color { return tcell.ColorWhite }
+func (monochromeTheme) Warning() tcell.Color { return tcell.ColorWhite }
+func (monochromeTheme) Error() tcell.Color   { return tcell.ColorWhite }
+func (monochromeTheme) Info() tcell.Color    { return tcell.ColorWhite }
+
+// Border colors
+func (monochromeTheme) Border() tcell.Color      { return tcell.ColorWhite }
+func (monochromeTheme) BorderFocus() tcell.Color { return tcell.ColorWhite }
+
+// UI element colors
+func (monochromeTheme) Header() tcell.Color      { return tcell.ColorWhite }
+func (monochromeTheme) Menu() tcell.Color        { return tcell.ColorWhite }
+func (monochromeTheme) TableHeader() tcell.Color { return tcell.ColorWhite }
+func (monochromeTheme) Key() tcell.Color         { return tcell.ColorWhite }
+func (monochromeTheme) Crumb() tcell.Color       { return tcell.ColorWhite }
+func (monochromeTheme) PanelBorder() tcell.Color { return tcell.ColorWhite }
+func (monochromeTheme) PanelTitle() tcell.Color  { return tcell.ColorWhite }
+
+// Verify interface compliance at compile time
+var _ theme.Theme = monochromeTheme{}
@@ -0,0 +1,124 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+)
+
+// SecretRefPrefix marks a profile field whose value is stored in the OS keychain,
+// e.g. api_key: keyring:prod/api_key.
+const SecretRefPrefix = "keyring:"
+
+// keyringService is the keychain service secrets are stored under.
+const keyringService = "tempo"
+
+// secretFields returns the profile's secret fields keyed by their keychain
+// account suffix.
+func (c *ConnectionConfig) secretFields() map[string]*string {
+	return map[string]*string{
+		"api_key":            &c.APIKey,
+		"tls.key_passphrase": &c.TLS.KeyPassphrase,
+		"codec.auth":         &c.Codec.Auth,
+	}
+}
+
+// IsSecretRef returns whether a field value references a keychain secret.
+func IsSecretRef(value string) bool {
+	return strings.HasPrefix(value, SecretRefPrefix)
+}
+
+// ResolveSecrets returns a copy of the profile with keychain references replaced
+// by the stored secrets. Like ExpandEnv, resolve when connecting and keep the
+// references in the saved config.
+func (c ConnectionConfig) ResolveSecrets() (ConnectionConfig, error) {
+	out := c
+	for _, field := range out.secretFields() {
+		account, ok := strings.CutPrefix(*field, SecretRefPrefix)
+		if !ok {
+			continue
+		}
+		value, err := keyring.Get(keyringService, account)
+		if err != nil {
+			return out, fmt.Errorf("failed to read secret %q from the keychain: %w", account, err)
+		}
+		*field = value
+	}
+	return out, nil
+}
+
+// Resolve checks the profile's settings and expands environment variables and
+// keychain references in it, giving the values to connect with.
+func (c ConnectionConfig) Resolve() (ConnectionConfig, error) {
+	if err := c.RPC.Validate(); err != nil {
+		return c, err
+	}
+	out, err := c.ExpandEnv()
+	if err != nil {
+		return out, err
+	}
+	return out.ResolveSecrets()
+}
+
+// StoreSecrets moves the profile's plaintext secrets into the OS keychain and
+// returns the profile with keychain references in their place. Empty fields,
+// references and ${VAR} values are left alone. On error the returned profile
+// references the secrets stored so far and keeps the rest in plaintext.
+func (c ConnectionConfig) StoreSecrets(profile string) (ConnectionConfig, error) {
+	out := c
+	for suffix, field := range out.secretFields() {
+		if *field == "" || IsSecretRef(*field) || envRef.MatchString(*field) {
+			continue
+		}
+		account := profile + "/" + suffix
+		if err := keyring.Set(keyringService, account, *field); err != nil {
+			return out, fmt.Errorf("failed to store secret in the keychain: %w", err)
+		}
+		*field = SecretRefPrefix + account
+	}
+	return out, nil
+}
+
+// DeleteSecrets removes a profile's secrets from the OS keychain.
+func DeleteSecrets(profile string) error {
+	var cfg ConnectionConfig
+	var errs []error
+	for suffix := range cfg.secretFields() {
+		err := keyring.Delete(keyringService, profile+"/"+suffix)
+		if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// MigrateSecrets moves plaintext secrets of every profile into the OS keychain.
+// Returns the number of profiles changed; save the config afterwards.
+func (c *Config) MigrateSecrets() (int, error) {
+	migrated := 0
+	for _, name := range c.ListProfiles() {
+		profile := c.Profiles[name]
+		stored, err := profile.StoreSecrets(name)
+		if stored.secretsDiffer(profile) {
+			c.Profiles[name] = stored
+			migrated++
+		}
+		if err != nil {
+			return migrated, fmt.Errorf("profile %q: %w", name, err)
+		}
+	}
+	return migrated, nil
+}
+
+// secretsDiffer returns whether any secret field differs from the original profile.
+func (c ConnectionConfig) secretsDiffer(original ConnectionConfig) bool {
+	before := original.secretFields()
+	for suffix, field := range c.secretFields() {
+		if *field != *before[suffix] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RecentSignal is a signal previously sent to a workflow of the given type.
+type RecentSignal struct {
+	WorkflowType string `yaml:"workflow_type"`
+	Signal       string `yaml:"signal"`
+	Input        string `yaml:"input,omitempty"`
+}
+
+// MaxRecentSignals is the number of recent signals remembered per workflow type.
+const MaxRecentSignals = 10
+
+// SignalHistory is the signals recently sent, offered again in the Signal
+// modal. It is kept out of config.yaml, which is often shared, since signal
+// payloads may hold private data.
+type SignalHistory struct {
+	Signals []RecentSignal `yaml:"signals,omitempty"`
+}
+
+// LoadSignalHistory reads the signal history, which is empty if none was saved.
+func LoadSignalHistory() (*SignalHistory, error) {
+	data, err := os.ReadFile(SignalHistoryPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &SignalHistory{}, nil
+		}
+		return nil, fmt.Errorf("reading signal history: %w", err)
+	}
+
+	history := &SignalHistory{}
+	if err := yaml.Unmarshal(data, history); err != nil {
+		return nil, fmt.Errorf("parsing signal history: %w", err)
+	}
+	return history, nil
+}
+
+// Save writes the signal history, readable only by the user.
+func (h *SignalHistory) Save() error {
+	if err := EnsureConfigDir(); err != nil {
+		return fmt.Errorf("creating config dir: %w", err)
+	}
+
+	data, err := yaml.Marshal(h)
+	if err != nil {
+		return fmt.Errorf("marshaling signal history: %w", err)
+	}
+
+	if err := os.WriteFile(SignalHistoryPath(), data, 0600); err != nil {
+		return fmt.Errorf("writing signal history: %w", err)
+	}
+	return nil
+}
+
+// Get returns signals recently sent to a workflow type, newest first.
+func (h *SignalHistory) Get(workflowType string) []RecentSignal {
+	var recent []RecentSignal
+	for _, r := range h.Signals {
+		if r.WorkflowType == workflowType {
+			recent = append(recent, r)
+		}
+	}
+	return recent
+}
+
+// Add records a sent signal for a workflow type, moving repeats to the front
+// and keeping at most MaxRecentSignals per type.
+func (h *SignalHistory) Add(workflowType, signal, input string) {
+	entry := RecentSignal{WorkflowType: workflowType, Signal: signal, Input: input}
+	recent := []RecentSignal{entry}
+	count := 1
+	for _, r := range h.Signals {
+		if r == entry {
+			continue
+		}
+		if r.WorkflowType == workflowType {
+			if count >= MaxRecentSignals {
+				continue
+			}
+			count++
+		}
+		recent = append(recent, r)
+	}
+	h.Signals = recent
+}
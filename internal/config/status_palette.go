@@ -0,0 +1,45 @@
+package config
+
+import "sort"
+
+// StatusPalette colors statuses in place of the theme's red, green and yellow,
+// which are hard to tell apart with a color vision deficiency. Colors are hex
+// strings.
+type StatusPalette struct {
+	Running string // Running and accepted
+	Success string // Completed and active
+	Failure string // Failed, terminated and deleted
+	Warning string // Canceled, timed out and deprecated
+}
+
+// StatusPaletteTheme colors statuses with the theme's own colors.
+const StatusPaletteTheme = "theme"
+
+// StatusPalettes are the built-in colorblind-safe status palettes.
+var StatusPalettes = map[string]StatusPalette{
+	// Okabe and Ito's palette, distinguishable with every common deficiency
+	"okabe-ito": {Running: "#56B4E9", Success: "#009E73", Failure: "#D55E00", Warning: "#F0E442"},
+	// Blue for success and orange for failure, the safest pair for red-green deficiencies
+	"blue-orange": {Running: "#CC79A7", Success: "#0072B2", Failure: "#E69F00", Warning: "#F0E442"},
+	// Paul Tol's bright scheme, which also holds up with blue-yellow deficiency
+	"tol": {Running: "#66CCEE", Success: "#228833", Failure: "#EE6677", Warning: "#CCBB44"},
+}
+
+// StatusPaletteNames returns the sorted names of the built-in status palettes.
+func StatusPaletteNames() []string {
+	names := make([]string, 0, len(StatusPalettes))
+	for name := range StatusPalettes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetStatusPalette returns the status palette name. Defaults to
+// StatusPaletteTheme if not set.
+func (c *Config) GetStatusPalette() string {
+	if c.StatusPalette == "" {
+		return StatusPaletteTheme
+	}
+	return c.StatusPalette
+}
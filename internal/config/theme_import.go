@@ -0,0 +1,355 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// terminalPalette is a terminal color scheme: background, foreground and the 16
+// ANSI colors (black, red, green, yellow, blue, magenta, cyan, white, then
+// their bright variants), as #rrggbb.
+type terminalPalette struct {
+	name string
+	bg   string
+	fg   string
+	ansi [16]string
+}
+
+// ImportTheme converts a terminal color scheme file into a theme. It reads
+// base16 schemes (base00..base0F, YAML or JSON), Gogh schemes (color_01..
+// color_16, YAML or JSON) and alacritty color files (TOML or YAML).
+func ImportTheme(path string) (*Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading color scheme: %w", err)
+	}
+
+	var doc map[string]any
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		doc = parseSimpleTOML(string(data))
+	} else {
+		var node yaml.Node
+		if err := yaml.Unmarshal(data, &node); err != nil {
+			return nil, fmt.Errorf("parsing color scheme: %w", err)
+		}
+		doc, _ = yamlStrings(&node).(map[string]any)
+	}
+	fallbackName := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	// tinted-theming nests the base16 colors under palette
+	if palette, ok := doc["palette"].(map[string]any); ok {
+		if _, ok := palette["base00"]; ok {
+			palette["scheme"] = firstString(doc, "name", "scheme")
+			doc = palette
+		}
+	}
+
+	var theme *Theme
+	switch {
+	case doc["base00"] != nil:
+		theme, err = base16Theme(doc, fallbackName)
+	case doc["color_01"] != nil:
+		theme, err = paletteTheme(goghPalette(doc, fallbackName))
+	case doc["colors"] != nil:
+		theme, err = paletteTheme(alacrittyPalette(doc, fallbackName))
+	default:
+		return nil, fmt.Errorf("unrecognized color scheme: expected base16 (base00..base0F), Gogh (color_01..color_16) or alacritty ([colors]) keys")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := theme.Parse(); err != nil {
+		return nil, fmt.Errorf("converted theme is invalid: %w", err)
+	}
+	return theme, nil
+}
+
+// SaveCustomTheme writes a theme to the custom themes directory as key.yaml,
+// where it can be selected like a built-in theme, and returns its path.
+func SaveCustomTheme(key string, theme *Theme) (string, error) {
+	if err := EnsureThemesDir(); err != nil {
+		return "", fmt.Errorf("creating themes dir: %w", err)
+	}
+	data, err := yaml.Marshal(theme)
+	if err != nil {
+		return "", fmt.Errorf("marshaling theme: %w", err)
+	}
+	path := filepath.Join(ThemesDir(), key+".yaml")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("writing theme: %w", err)
+	}
+	return path, nil
+}
+
+var nonKeyChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ThemeKey turns a theme name into the key it is saved and selected by, e.g.
+// "Gruvbox Material (Dark)" becomes "gruvbox-material-dark".
+func ThemeKey(name string) string {
+	return strings.Trim(nonKeyChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// base16Theme maps a base16 scheme onto theme colors, following the base16
+// styling guidelines: base00-03 backgrounds and comments, base05 text, base08-0F
+// the accents.
+func base16Theme(doc map[string]any, fallbackName string) (*Theme, error) {
+	var base [16]string
+	for i := range base {
+		key := fmt.Sprintf("base%02X", i)
+		value, ok := doc[key]
+		if !ok {
+			value = doc[strings.ToLower(key)]
+		}
+		color, err := normalizeHex(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		base[i] = color
+	}
+
+	name := firstString(doc, "scheme", "name")
+	if name == "" {
+		name = fallbackName
+	}
+	bgDark := mixHex(base[0], "#000000", 0.2)
+	return &Theme{
+		Name: name,
+		Type: themeType(base[0]),
+		Colors: ThemeColors{
+			Bg:          base[0x0],
+			BgLight:     base[0x1],
+			BgDark:      bgDark,
+			Fg:          base[0x5],
+			FgDim:       base[0x3],
+			Border:      bgDark,
+			Highlight:   base[0x2],
+			Accent:      base[0xD],
+			AccentDim:   base[0xE],
+			Running:     base[0xA],
+			Completed:   base[0xB],
+			Failed:      base[0x8],
+			Canceled:    base[0x9],
+			Terminated:  base[0xE],
+			TimedOut:    base[0x8],
+			Header:      bgDark,
+			Menu:        base[0x0],
+			TableHeader: base[0xD],
+			Key:         base[0xE],
+			Crumb:       base[0xD],
+			PanelBorder: base[0x2],
+			PanelTitle:  base[0xD],
+		},
+	}, nil
+}
+
+// goghPalette reads a Gogh scheme: color_01..color_16 are the ANSI colors.
+func goghPalette(doc map[string]any, fallbackName string) (*terminalPalette, error) {
+	p := &terminalPalette{name: firstString(doc, "name")}
+	if p.name == "" {
+		p.name = fallbackName
+	}
+	var err error
+	if p.bg, err = normalizeHex(doc["background"]); err != nil {
+		return nil, fmt.Errorf("background: %w", err)
+	}
+	if p.fg, err = normalizeHex(doc["foreground"]); err != nil {
+		return nil, fmt.Errorf("foreground: %w", err)
+	}
+	for i := range p.ansi {
+		key := fmt.Sprintf("color_%02d", i+1)
+		if p.ansi[i], err = normalizeHex(doc[key]); err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return p, nil
+}
+
+// ansiNames are the alacritty names of the ANSI colors, in order.
+var ansiNames = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// alacrittyPalette reads alacritty's colors.primary, colors.normal and
+// colors.bright tables. Missing bright colors fall back to the normal ones.
+func alacrittyPalette(doc map[string]any, fallbackName string) (*terminalPalette, error) {
+	colors, _ := doc["colors"].(map[string]any)
+	primary, _ := colors["primary"].(map[string]any)
+	normal, _ := colors["normal"].(map[string]any)
+	bright, _ := colors["bright"].(map[string]any)
+
+	p := &terminalPalette{name: fallbackName}
+	var err error
+	if p.bg, err = normalizeHex(primary["background"]); err != nil {
+		return nil, fmt.Errorf("colors.primary.background: %w", err)
+	}
+	if p.fg, err = normalizeHex(primary["foreground"]); err != nil {
+		return nil, fmt.Errorf("colors.primary.foreground: %w", err)
+	}
+	for i, name := range ansiNames {
+		if p.ansi[i], err = normalizeHex(normal[name]); err != nil {
+			return nil, fmt.Errorf("colors.normal.%s: %w", name, err)
+		}
+		if p.ansi[i+8], err = normalizeHex(bright[name]); err != nil {
+			p.ansi[i+8] = p.ansi[i]
+		}
+	}
+	return p, nil
+}
+
+// paletteTheme maps a terminal palette onto theme colors: blue accents, the
+// bright black for dimmed text and the status colors from red, green and
+// yellow.
+func paletteTheme(p *terminalPalette, err error) (*Theme, error) {
+	if err != nil {
+		return nil, err
+	}
+	const (
+		red, green, yellow, blue, magenta = 1, 2, 3, 4, 5
+		brightBlack, brightYellow         = 8, 11
+	)
+	bgDark := mixHex(p.bg, "#000000", 0.2)
+	highlight := mixHex(p.bg, p.ansi[blue], 0.25)
+	return &Theme{
+		Name: p.name,
+		Type: themeType(p.bg),
+		Colors: ThemeColors{
+			Bg:          p.bg,
+			BgLight:     mixHex(p.bg, p.fg, 0.08),
+			BgDark:      bgDark,
+			Fg:          p.fg,
+			FgDim:       p.ansi[brightBlack],
+			Border:      bgDark,
+			Highlight:   highlight,
+			Accent:      p.ansi[blue],
+			AccentDim:   p.ansi[magenta],
+			Running:     p.ansi[yellow],
+			Completed:   p.ansi[green],
+			Failed:      p.ansi[red],
+			Canceled:    p.ansi[brightYellow],
+			Terminated:  p.ansi[magenta],
+			TimedOut:    p.ansi[red],
+			Header:      bgDark,
+			Menu:        p.bg,
+			TableHeader: p.ansi[blue],
+			Key:         p.ansi[magenta],
+			Crumb:       p.ansi[blue],
+			PanelBorder: highlight,
+			PanelTitle:  p.ansi[blue],
+		},
+	}, nil
+}
+
+// normalizeHex accepts #rrggbb, 0xrrggbb or rrggbb and returns #rrggbb.
+func normalizeHex(value any) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("missing color")
+	}
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(strings.TrimPrefix(strings.TrimPrefix(s, "#"), "0x"), "0X")
+	if _, err := parseHexColor(s); err != nil {
+		return "", err
+	}
+	return "#" + strings.ToLower(s), nil
+}
+
+// hexRGB splits a #rrggbb color into its components.
+func hexRGB(hex string) (r, g, b float64) {
+	v, _ := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+	return float64(v >> 16 & 0xff), float64(v >> 8 & 0xff), float64(v & 0xff)
+}
+
+// mixHex blends color a toward b by t (0 keeps a, 1 gives b).
+func mixHex(a, b string, t float64) string {
+	ar, ag, ab := hexRGB(a)
+	br, bg, bb := hexRGB(b)
+	mix := func(x, y float64) int { return int(x + (y-x)*t + 0.5) }
+	return fmt.Sprintf("#%02x%02x%02x", mix(ar, br), mix(ag, bg), mix(ab, bb))
+}
+
+// themeType classifies a background as "light" or "dark" by its luminance.
+func themeType(bg string) string {
+	if luminance(bg) > 0.5 {
+		return "light"
+	}
+	return "dark"
+}
+
+// luminance returns the relative luminance of a #rrggbb color, from 0 to 1.
+func luminance(hex string) float64 {
+	r, g, b := hexRGB(hex)
+	return (0.2126*r + 0.7152*g + 0.0722*b) / 255
+}
+
+// firstString returns the first of keys holding a string in doc.
+func firstString(doc map[string]any, keys ...string) string {
+	for _, key := range keys {
+		if s, ok := doc[key].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// yamlStrings converts a YAML document to maps holding the raw text of its
+// scalars, so unquoted colors such as 282828 aren't read as numbers.
+func yamlStrings(node *yaml.Node) any {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) > 0 {
+			return yamlStrings(node.Content[0])
+		}
+	case yaml.MappingNode:
+		m := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			m[node.Content[i].Value] = yamlStrings(node.Content[i+1])
+		}
+		return m
+	case yaml.ScalarNode:
+		return node.Value
+	}
+	return nil
+}
+
+// parseSimpleTOML reads the subset of TOML used by alacritty color files:
+// [table] headers and key = "string" pairs. Other values are ignored.
+func parseSimpleTOML(data string) map[string]any {
+	doc := make(map[string]any)
+	table := doc
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			table = doc
+			for _, part := range strings.Split(strings.Trim(line, "[]"), ".") {
+				part = strings.Trim(strings.TrimSpace(part), `"'`)
+				next, ok := table[part].(map[string]any)
+				if !ok {
+					next = make(map[string]any)
+					table[part] = next
+				}
+				table = next
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if len(value) < 2 || (value[0] != '"' && value[0] != '\'') {
+			continue
+		}
+		if end := strings.IndexByte(value[1:], value[0]); end >= 0 {
+			table[strings.Trim(strings.TrimSpace(key), `"'`)] = value[1 : end+1]
+		}
+	}
+	return doc
+}
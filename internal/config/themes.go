@@ -947,6 +947,13 @@ var BuiltinThemes = map[string]*Theme{
 // DefaultTheme is the theme used when no config exists.
 const DefaultTheme = "tokyonight-night"
 
+// DefaultLightTheme is the theme AutoTheme picks on a light terminal.
+const DefaultLightTheme = "tokyonight-day"
+
+// AutoTheme picks DefaultTheme or DefaultLightTheme from the terminal
+// background at startup.
+const AutoTheme = "auto"
+
 // ThemeNames returns a sorted list of available built-in theme names.
 func ThemeNames() []string {
 	return []string{
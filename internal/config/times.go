@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Time display modes.
+const (
+	TimeRelative = "relative" // "5m ago"
+	TimeAbsolute = "absolute" // In the configured format and timezone
+)
+
+// Timezones.
+const (
+	TimezoneLocal = "local"
+	TimezoneUTC   = "utc"
+)
+
+// DefaultTimeFormat is the strftime format of absolute times.
+const DefaultTimeFormat = "%Y-%m-%d %H:%M:%S"
+
+// TimeSettings controls how timestamps are shown.
+type TimeSettings struct {
+	Display  string `yaml:"display,omitempty"`  // "relative" (default) or "absolute", for list columns
+	Format   string `yaml:"format,omitempty"`   // strftime format of absolute times, e.g. "%d %b %H:%M"
+	Timezone string `yaml:"timezone,omitempty"` // "local" (default) or "utc"
+}
+
+// Relative returns whether list columns show times relative to now.
+func (s TimeSettings) Relative() bool {
+	return s.Display != TimeAbsolute
+}
+
+// GetFormat returns the strftime format of absolute times. Defaults to
+// DefaultTimeFormat if not set.
+func (s TimeSettings) GetFormat() string {
+	if s.Format == "" {
+		return DefaultTimeFormat
+	}
+	return s.Format
+}
+
+// UTC returns whether times are shown in UTC rather than the local timezone.
+func (s TimeSettings) UTC() bool {
+	return strings.EqualFold(s.Timezone, TimezoneUTC)
+}
+
+// Location returns the timezone times are shown in.
+func (s TimeSettings) Location() *time.Location {
+	if s.UTC() {
+		return time.UTC
+	}
+	return time.Local
+}
+
+// InvalidFields returns the names of settings with unknown values.
+func (s TimeSettings) InvalidFields() []string {
+	var invalid []string
+	if s.Display != "" && s.Display != TimeRelative && s.Display != TimeAbsolute {
+		invalid = append(invalid, "display")
+	}
+	if s.Timezone != "" && !strings.EqualFold(s.Timezone, TimezoneLocal) && !s.UTC() {
+		invalid = append(invalid, "timezone")
+	}
+	return invalid
+}
+
+// Strftime formats t with a strftime format. It supports %Y %y %m %b %B %d %e
+// %j %a %A %H %I %M %S %L (milliseconds) %p %Z %z %F %T %R %D and %%; other
+// directives are kept as written.
+func Strftime(t time.Time, format string) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			b.WriteByte(format[i])
+			continue
+		}
+		i++
+		if layout, ok := strftimeLayouts[format[i]]; ok {
+			b.WriteString(t.Format(layout))
+			continue
+		}
+		switch format[i] {
+		case '%':
+			b.WriteByte('%')
+		case 'e':
+			b.WriteString(t.Format("_2"))
+		case 'L':
+			b.WriteString(fmt.Sprintf("%03d", t.Nanosecond()/int(time.Millisecond)))
+		default:
+			b.WriteByte('%')
+			b.WriteByte(format[i])
+		}
+	}
+	return b.String()
+}
+
+// strftimeLayouts maps strftime directives to Go time layouts.
+var strftimeLayouts = map[byte]string{
+	'Y': "2006",
+	'y': "06",
+	'm': "01",
+	'b': "Jan",
+	'B': "January",
+	'd': "02",
+	'j': "002",
+	'a': "Mon",
+	'A': "Monday",
+	'H': "15",
+	'I': "03",
+	'M': "04",
+	'S': "05",
+	'p': "PM",
+	'Z': "MST",
+	'z': "-0700",
+	'F': "2006-01-02",
+	'T': "15:04:05",
+	'R': "15:04",
+	'D': "01/02/06",
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+const (
+	// cloudWebUI is the Temporal Cloud web UI.
+	cloudWebUI = "https://cloud.temporal.io"
+	// devServerWebUI is the web UI of `temporal server start-dev`.
+	devServerWebUI = "http://localhost:8233"
+)
+
+// WebUIAddress returns the base URL of the Temporal web UI for this profile:
+// WebUI when set, the Temporal Cloud UI for cloud endpoints, or the dev server
+// UI for a server on localhost:7233. It returns "" when the UI is unknown.
+func (c ConnectionConfig) WebUIAddress() string {
+	if c.WebUI != "" {
+		return strings.TrimSuffix(c.WebUI, "/")
+	}
+
+	host, port, err := net.SplitHostPort(c.Address)
+	if err != nil {
+		host = c.Address
+	}
+	switch {
+	case strings.HasSuffix(host, ".tmprl.cloud"), strings.HasSuffix(host, ".api.temporal.io"):
+		return cloudWebUI
+	case (host == "localhost" || host == "127.0.0.1") && port == "7233":
+		return devServerWebUI
+	}
+	return ""
+}
+
+// WorkflowWebURL returns the web UI page of a workflow run, or "" when the UI is
+// unknown. An empty runID opens the latest run.
+func (c ConnectionConfig) WorkflowWebURL(namespace, workflowID, runID string) string {
+	base := c.WebUIAddress()
+	if base == "" {
+		return ""
+	}
+	u := base + "/namespaces/" + url.PathEscape(namespace) + "/workflows/" + url.PathEscape(workflowID)
+	if runID != "" {
+		u += "/" + url.PathEscape(runID) + "/history"
+	}
+	return u
+}
+
+// NamespaceWebURL returns the web UI page listing a namespace's workflows, or ""
+// when the UI is unknown.
+func (c ConnectionConfig) NamespaceWebURL(namespace string) string {
+	base := c.WebUIAddress()
+	if base == "" {
+		return ""
+	}
+	return base + "/namespaces/" + url.PathEscape(namespace) + "/workflows"
+}
+
+// ScheduleWebURL returns the web UI page of a schedule, or "" when the UI is
+// unknown.
+func (c ConnectionConfig) ScheduleWebURL(namespace, scheduleID string) string {
+	base := c.WebUIAddress()
+	if base == "" {
+		return ""
+	}
+	return base + "/namespaces/" + url.PathEscape(namespace) + "/schedules/" + url.PathEscape(scheduleID)
+}
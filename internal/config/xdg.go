@@ -31,6 +31,11 @@ func ConfigPath() string {
 	return filepath.Join(ConfigDir(), "config.yaml")
 }
 
+// SignalHistoryPath returns the path to the file of recently sent signals.
+func SignalHistoryPath() string {
+	return filepath.Join(ConfigDir(), "signal_history.yaml")
+}
+
 // ThemesDir returns the directory for custom themes.
 func ThemesDir() string {
 	return filepath.Join(ConfigDir(), "themes")
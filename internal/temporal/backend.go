@@ -0,0 +1,98 @@
+package temporal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Backend names. A profile picks its backend with the backend setting; the
+// default talks to a Temporal server over gRPC.
+const (
+	BackendGRPC   = "grpc"   // A Temporal server, through the SDK client
+	BackendMock   = "mock"   // Built-in sample data, for demos and trying the UI offline
+	BackendFile   = "file"   // A snapshot file (backend_path), e.g. written by the record backend
+	BackendRecord = "record" // A Temporal server, saving what is read to a snapshot file on close
+)
+
+// ErrUnsupported is returned for operations a backend cannot perform, such as
+// queries on a snapshot.
+var ErrUnsupported = errors.New("not supported by this backend")
+
+// BackendFactory opens a provider for a connection config.
+type BackendFactory func(ctx context.Context, config ConnectionConfig) (Provider, error)
+
+var (
+	backendsMu sync.RWMutex
+	backends   = make(map[string]BackendFactory)
+)
+
+func init() {
+	RegisterBackend(BackendGRPC, func(ctx context.Context, config ConnectionConfig) (Provider, error) {
+		client, err := NewClient(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+	RegisterBackend(BackendMock, func(ctx context.Context, config ConnectionConfig) (Provider, error) {
+		return NewMemoryProvider(config, MockSnapshot(time.Now())), nil
+	})
+	RegisterBackend(BackendFile, func(ctx context.Context, config ConnectionConfig) (Provider, error) {
+		snapshot, err := LoadSnapshot(config.BackendPath)
+		if err != nil {
+			return nil, err
+		}
+		return NewMemoryProvider(config, snapshot), nil
+	})
+	RegisterBackend(BackendRecord, func(ctx context.Context, config ConnectionConfig) (Provider, error) {
+		if config.BackendPath == "" {
+			return nil, fmt.Errorf("no snapshot file set (backend_path)")
+		}
+		client, err := NewClient(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+		return NewRecordingProvider(client, config.BackendPath), nil
+	})
+}
+
+// RegisterBackend makes a backend available under name, replacing any backend
+// registered under the same name.
+func RegisterBackend(name string, factory BackendFactory) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	backends[strings.ToLower(name)] = factory
+}
+
+// Backends returns the names of the registered backends, sorted.
+func Backends() []string {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewProvider opens a provider with the backend named by config.Backend, or
+// the gRPC backend if it is empty.
+func NewProvider(ctx context.Context, config ConnectionConfig) (Provider, error) {
+	name := strings.ToLower(config.Backend)
+	if name == "" {
+		name = BackendGRPC
+	}
+	backendsMu.RLock()
+	factory, ok := backends[name]
+	backendsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown backend %q (available: %s)", config.Backend, strings.Join(Backends(), ", "))
+	}
+	return factory(ctx, config)
+}
@@ -0,0 +1,138 @@
+package temporal
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+)
+
+// certReloader serves the client certificate from disk and reloads it when the
+// cert or key file changes, so short-lived certificates (Vault, SPIFFE) rotated
+// mid-session are used for new TLS handshakes instead of the expired one.
+type certReloader struct {
+	certPath   string
+	keyPath    string
+	passphrase string
+	caPath     string
+
+	initial string // File stamp when the connection was built
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	certStamp string // File stamp of the loaded cert
+}
+
+// newCertReloader loads the client certificate, if configured.
+func newCertReloader(config ConnectionConfig) (*certReloader, error) {
+	r := &certReloader{
+		certPath:   config.TLSCertPath,
+		keyPath:    config.TLSKeyPath,
+		passphrase: config.TLSKeyPassphrase,
+		caPath:     config.TLSCAPath,
+	}
+	r.initial = r.stamp()
+
+	if r.certPath != "" && r.keyPath != "" {
+		cert, err := loadX509KeyPair(r.certPath, r.keyPath, r.passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		r.cert = &cert
+		r.certStamp = r.initial
+	}
+	return r, nil
+}
+
+// hasClientCert returns whether a client certificate is configured.
+func (r *certReloader) hasClientCert() bool {
+	return r.cert != nil
+}
+
+// GetClientCertificate implements tls.Config.GetClientCertificate. If the files
+// changed since the last load the certificate is reloaded; a failed reload (e.g.
+// the files are mid-rotation) keeps serving the previous certificate.
+func (r *certReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stamp := r.stamp(); stamp != r.certStamp {
+		cert, err := loadX509KeyPair(r.certPath, r.keyPath, r.passphrase)
+		if err != nil {
+			log.Printf("TLS: keeping previous client certificate, reload failed: %v", err)
+		} else {
+			r.cert = &cert
+			r.certStamp = stamp
+		}
+	}
+	return r.cert, nil
+}
+
+// changed returns whether the cert, key or CA files changed since the connection
+// was built, meaning the connection should be re-established to use them.
+func (r *certReloader) changed() bool {
+	if r == nil {
+		return false
+	}
+	return r.stamp() != r.initial
+}
+
+// loadX509KeyPair loads a certificate and key, decrypting the key with passphrase
+// when it is an encrypted PEM block, either PKCS#8 ("ENCRYPTED PRIVATE KEY") or
+// traditional ("Proc-Type: 4,ENCRYPTED").
+func loadX509KeyPair(certPath, keyPath, passphrase string) (tls.Certificate, error) {
+	if passphrase == "" {
+		return tls.LoadX509KeyPair(certPath, keyPath)
+	}
+
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return tls.Certificate{}, fmt.Errorf("no PEM data in %s", keyPath)
+	}
+	if block.Type == "ENCRYPTED PRIVATE KEY" {
+		der, err := decryptPKCS8(block.Bytes, passphrase)
+		if err != nil {
+			return tls.Certificate{}, fmt.Errorf("failed to decrypt %s: %w", keyPath, err)
+		}
+		return tls.X509KeyPair(certPEM, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	}
+	// Legacy PEM encryption is insecure and deprecated, but it is the passphrase
+	// format the standard library reads
+	if x509.IsEncryptedPEMBlock(block) { //nolint:staticcheck
+		der, err := x509.DecryptPEMBlock(block, []byte(passphrase)) //nolint:staticcheck
+		if err != nil {
+			return tls.Certificate{}, fmt.Errorf("failed to decrypt %s: %w", keyPath, err)
+		}
+		keyPEM = pem.EncodeToMemory(&pem.Block{Type: block.Type, Bytes: der})
+	}
+	return tls.X509KeyPair(certPEM, keyPEM)
+}
+
+// stamp identifies the current version of the TLS files by modification time and size.
+func (r *certReloader) stamp() string {
+	var sb strings.Builder
+	for _, path := range []string{r.certPath, r.keyPath, r.caPath} {
+		if path == "" {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil {
+			fmt.Fprintf(&sb, "%d:%d;", info.ModTime().UnixNano(), info.Size())
+		} else {
+			sb.WriteString("-;")
+		}
+	}
+	return sb.String()
+}
@@ -5,24 +5,35 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/google/uuid"
 	commonpb "go.temporal.io/api/common/v1"
+	deploymentpb "go.temporal.io/api/deployment/v1"
 	"go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/operatorservice/v1"
+	querypb "go.temporal.io/api/query/v1"
+	replicationpb "go.temporal.io/api/replication/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/temporalproto"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -74,6 +85,8 @@ func initLogFile() {
 type Client struct {
 	client    client.Client
 	config    ConnectionConfig
+	codec     PayloadCodec  // Optional codec applied to history payloads
+	certs     *certReloader // Reloads TLS certificates rotated on disk
 	connected bool
 	mu        sync.RWMutex
 }
@@ -83,35 +96,106 @@ func NewClient(ctx context.Context, connConfig ConnectionConfig) (*Client, error
 	// Redirect logs to file instead of stdout
 	initLogFile()
 
+	certs, err := newCertReloader(connConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure TLS: %w", err)
+	}
+	opts, err := clientOptions(connConfig, certs)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.DialContext(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Temporal server: %w", err)
+	}
+
+	return &Client{
+		client:    c,
+		config:    connConfig,
+		codec:     newPayloadCodec(connConfig),
+		certs:     certs,
+		connected: true,
+	}, nil
+}
+
+// clientOptions builds SDK client options from the connection config. The client
+// certificate is served by certs.
+func clientOptions(connConfig ConnectionConfig, certs *certReloader) (client.Options, error) {
 	opts := client.Options{
 		HostPort:  connConfig.Address,
 		Namespace: connConfig.Namespace,
 		Logger:    sdkLogger,
 	}
 
-	// Configure TLS if any TLS options are provided
-	if connConfig.TLSCertPath != "" || connConfig.TLSCAPath != "" || connConfig.TLSSkipVerify {
-		tlsConfig, err := buildTLSConfig(connConfig)
+	// Configure TLS if any TLS options are provided. API keys are only accepted over TLS.
+	if connConfig.TLSCertPath != "" || connConfig.TLSCAPath != "" || connConfig.TLSSkipVerify || connConfig.APIKey != "" {
+		tlsConfig, err := buildTLSConfig(connConfig, certs)
 		if err != nil {
-			return nil, fmt.Errorf("failed to configure TLS: %w", err)
+			return opts, fmt.Errorf("failed to configure TLS: %w", err)
 		}
 		opts.ConnectionOptions.TLS = tlsConfig
 	}
 
-	c, err := client.DialContext(ctx, opts)
+	if connConfig.ProxyURL != "" {
+		dial, err := proxyDialFunc(connConfig.ProxyURL)
+		if err != nil {
+			return opts, err
+		}
+		opts.ConnectionOptions.DialOptions = append(opts.ConnectionOptions.DialOptions, grpc.WithContextDialer(dial))
+	}
+
+	if connConfig.APIKey != "" {
+		opts.Credentials = client.NewAPIKeyStaticCredentials(connConfig.APIKey)
+		opts.ConnectionOptions.DialOptions = append(opts.ConnectionOptions.DialOptions,
+			grpc.WithChainUnaryInterceptor(namespaceHeaderInterceptor(connConfig.Namespace)))
+	}
+
+	interceptors, err := rpcInterceptors(connConfig)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to Temporal server: %w", err)
+		return opts, err
 	}
+	opts.ConnectionOptions.DialOptions = append(opts.ConnectionOptions.DialOptions,
+		grpc.WithChainUnaryInterceptor(interceptors...))
 
-	return &Client{
-		client:    c,
-		config:    connConfig,
-		connected: true,
-	}, nil
+	return opts, nil
+}
+
+// ConnectionConfigFromProfile builds a connection config from a saved profile.
+func ConnectionConfigFromProfile(profile config.ConnectionConfig) ConnectionConfig {
+	return ConnectionConfig{
+		Address:            profile.Address,
+		Namespace:          profile.Namespace,
+		TLSCertPath:        profile.TLS.Cert,
+		TLSKeyPath:         profile.TLS.Key,
+		TLSKeyPassphrase:   profile.TLS.KeyPassphrase,
+		TLSCAPath:          profile.TLS.CA,
+		TLSServerName:      profile.TLS.ServerName,
+		TLSSkipVerify:      profile.TLS.SkipVerify,
+		APIKey:             profile.APIKey,
+		ProxyURL:           profile.Proxy,
+		CodecEndpoint:      profile.Codec.Endpoint,
+		CodecAuth:          profile.Codec.Auth,
+		CodecPassNamespace: profile.Codec.PassNamespace,
+		CodecPlugin:        profile.Codec.Plugin,
+		CodecPluginArgs:    profile.Codec.PluginArgs,
+		Backend:            profile.Backend,
+		BackendPath:        profile.BackendPath,
+		RPC: RPCPolicy{
+			Timeout:      profile.RPC.GetTimeout(),
+			Timeouts:     profile.RPC.GetTimeouts(),
+			Retries:      profile.RPC.Retries,
+			RetryBackoff: profile.RPC.GetRetryBackoff(),
+			RateLimit:    profile.RPC.RateLimit,
+			RateBurst:    profile.RPC.RateBurst,
+			Headers:      profile.RPC.Headers,
+			Interceptors: profile.RPC.Interceptors,
+		},
+	}
 }
 
 // buildTLSConfig creates a TLS configuration from the connection config.
-func buildTLSConfig(config ConnectionConfig) (*tls.Config, error) {
+func buildTLSConfig(config ConnectionConfig, certs *certReloader) (*tls.Config, error) {
 	tlsConfig := &tls.Config{
 		InsecureSkipVerify: config.TLSSkipVerify,
 	}
@@ -120,13 +204,9 @@ func buildTLSConfig(config ConnectionConfig) (*tls.Config, error) {
 		tlsConfig.ServerName = config.TLSServerName
 	}
 
-	// Load client certificate if provided
-	if config.TLSCertPath != "" && config.TLSKeyPath != "" {
-		cert, err := tls.LoadX509KeyPair(config.TLSCertPath, config.TLSKeyPath)
-		if err != nil {
-			return nil, fmt.Errorf("failed to load client certificate: %w", err)
-		}
-		tlsConfig.Certificates = []tls.Certificate{cert}
+	// Serve the client certificate from disk so rotated certs are picked up on re-dial
+	if certs.hasClientCert() {
+		tlsConfig.GetClientCertificate = certs.GetClientCertificate
 	}
 
 	// Load CA certificate if provided
@@ -156,6 +236,15 @@ func (c *Client) Close() error {
 	return nil
 }
 
+// ServerVersion returns the version of the Temporal server.
+func (c *Client) ServerVersion(ctx context.Context) (string, error) {
+	resp, err := c.client.WorkflowService().GetSystemInfo(ctx, &workflowservice.GetSystemInfoRequest{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get system info: %w", err)
+	}
+	return resp.GetServerVersion(), nil
+}
+
 // IsConnected returns true if the client has an active connection.
 func (c *Client) IsConnected() bool {
 	c.mu.RLock()
@@ -165,6 +254,18 @@ func (c *Client) IsConnected() bool {
 
 // CheckConnection verifies the connection is still alive by making a lightweight API call.
 func (c *Client) CheckConnection(ctx context.Context) error {
+	c.mu.RLock()
+	certs := c.certs
+	c.mu.RUnlock()
+
+	// Re-establish the connection with rotated certificates before the old ones expire
+	if certs.changed() {
+		log.Printf("TLS certificates changed on disk, reconnecting")
+		if err := c.Reconnect(ctx); err != nil {
+			return fmt.Errorf("reconnect with reloaded certificates failed: %w", err)
+		}
+	}
+
 	c.mu.RLock()
 	cl := c.client
 	c.mu.RUnlock()
@@ -219,19 +320,13 @@ func (c *Client) reconnectWithConfig(ctx context.Context, connConfig ConnectionC
 	c.connected = false
 	c.mu.Unlock()
 
-	opts := client.Options{
-		HostPort:  connConfig.Address,
-		Namespace: connConfig.Namespace,
-		Logger:    sdkLogger,
+	certs, err := newCertReloader(connConfig)
+	if err != nil {
+		return fmt.Errorf("failed to configure TLS: %w", err)
 	}
-
-	// Configure TLS if any TLS options are provided
-	if connConfig.TLSCertPath != "" || connConfig.TLSCAPath != "" || connConfig.TLSSkipVerify {
-		tlsConfig, err := buildTLSConfig(connConfig)
-		if err != nil {
-			return fmt.Errorf("failed to configure TLS: %w", err)
-		}
-		opts.ConnectionOptions.TLS = tlsConfig
+	opts, err := clientOptions(connConfig, certs)
+	if err != nil {
+		return err
 	}
 
 	newClient, err := client.DialContext(ctx, opts)
@@ -242,12 +337,29 @@ func (c *Client) reconnectWithConfig(ctx context.Context, connConfig ConnectionC
 	c.mu.Lock()
 	c.client = newClient
 	c.config = connConfig // Update stored config
+	c.codec = newPayloadCodec(connConfig)
+	c.certs = certs
 	c.connected = true
 	c.mu.Unlock()
 
 	return nil
 }
 
+// decodeEvents runs history events through the configured payload codec, if any.
+// A failure is logged and returned; the events keep their encoded payloads.
+// Codec failures are logged and the events are displayed in their encoded form.
+func (c *Client) decodeEvents(ctx context.Context, namespace string, events []*historypb.HistoryEvent) error {
+	c.mu.RLock()
+	codec := c.codec
+	c.mu.RUnlock()
+
+	err := decodeHistoryPayloads(ctx, codec, namespace, events)
+	if err != nil {
+		log.Printf("payload codec: %v", err)
+	}
+	return err
+}
+
 // Config returns the connection configuration used by this client.
 func (c *Client) Config() ConnectionConfig {
 	c.mu.RLock()
@@ -362,12 +474,9 @@ func (c *Client) DescribeNamespace(ctx context.Context, name string) (*Namespace
 		HistoryArchival:    historyArchival,
 		VisibilityArchival: visibilityArchival,
 		Clusters:           clusters,
-	}
-
-	// Parse timestamps if available
-	if info.GetData() != nil {
-		// Note: CreatedAt and UpdatedAt are not directly exposed in the API response
-		// They would need to be extracted from namespace info data if stored there
+		ActiveCluster:      replication.GetActiveClusterName(),
+		ReplicationState:   mapReplicationState(replication.GetState()),
+		Data:               info.GetData(),
 	}
 
 	return detail, nil
@@ -438,6 +547,32 @@ func (c *Client) DeleteNamespace(ctx context.Context, name string) error {
 	return nil
 }
 
+// FailoverNamespace makes cluster the active cluster of a global namespace.
+func (c *Client) FailoverNamespace(ctx context.Context, name, cluster string) error {
+	_, err := c.client.WorkflowService().UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: name,
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fail over namespace: %w", err)
+	}
+	return nil
+}
+
+// mapReplicationState converts a namespace replication state for display.
+func mapReplicationState(state enums.ReplicationState) string {
+	switch state {
+	case enums.REPLICATION_STATE_NORMAL:
+		return "Normal"
+	case enums.REPLICATION_STATE_HANDOVER:
+		return "Handover"
+	default:
+		return ""
+	}
+}
+
 // formatArchivalState formats archival state and URI for display.
 func formatArchivalState(state enums.ArchivalState, uri string) string {
 	stateStr := "Disabled"
@@ -454,6 +589,22 @@ func formatArchivalState(state enums.ArchivalState, uri string) string {
 	return stateStr
 }
 
+// CountWorkflows returns the number of workflows matching a visibility query.
+func (c *Client) CountWorkflows(ctx context.Context, namespace, query string) (int64, error) {
+	if c.client == nil {
+		return 0, fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.WorkflowService().CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: namespace,
+		Query:     query,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count workflows: %w", err)
+	}
+	return resp.GetCount(), nil
+}
+
 // ListWorkflows returns workflows for a namespace with optional filtering.
 func (c *Client) ListWorkflows(ctx context.Context, namespace string, opts ListOptions) ([]Workflow, string, error) {
 	if c.client == nil {
@@ -500,6 +651,7 @@ func (c *Client) ListWorkflows(ctx context.Context, namespace string, opts ListO
 		if exec.GetParentExecution() != nil && exec.GetParentExecution().GetWorkflowId() != "" {
 			parentID := exec.GetParentExecution().GetWorkflowId()
 			wf.ParentID = &parentID
+			wf.ParentRunID = exec.GetParentExecution().GetRunId()
 		}
 
 		// Extract memo if present
@@ -524,6 +676,33 @@ func (c *Client) ListWorkflows(ctx context.Context, namespace string, opts ListO
 	return workflows, string(resp.GetNextPageToken()), nil
 }
 
+// maxWorkflowRuns bounds how many runs ListWorkflowRuns will page through.
+const maxWorkflowRuns = 1000
+
+// ListWorkflowRuns returns every run for a workflow ID, newest first.
+func (c *Client) ListWorkflowRuns(ctx context.Context, namespace, workflowID string) ([]Workflow, error) {
+	query := fmt.Sprintf("WorkflowId = '%s'", strings.ReplaceAll(workflowID, "'", "\\'"))
+
+	var runs []Workflow
+	opts := ListOptions{PageSize: 100, Query: query}
+	for {
+		page, nextToken, err := c.ListWorkflows(ctx, namespace, opts)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, page...)
+		if nextToken == "" || len(runs) >= maxWorkflowRuns {
+			break
+		}
+		opts.PageToken = nextToken
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].StartTime.After(runs[j].StartTime)
+	})
+	return runs, nil
+}
+
 // GetWorkflow returns details for a specific workflow execution.
 func (c *Client) GetWorkflow(ctx context.Context, namespace, workflowID, runID string) (*Workflow, error) {
 	if c.client == nil {
@@ -550,6 +729,8 @@ func (c *Client) GetWorkflow(ctx context.Context, namespace, workflowID, runID s
 		Namespace: namespace,
 		TaskQueue: info.GetTaskQueue(),
 		StartTime: info.GetStartTime().AsTime(),
+
+		HistoryLength: info.GetHistoryLength(),
 	}
 
 	if info.GetCloseTime() != nil && !info.GetCloseTime().AsTime().IsZero() {
@@ -560,16 +741,145 @@ func (c *Client) GetWorkflow(ctx context.Context, namespace, workflowID, runID s
 	if info.GetParentExecution() != nil && info.GetParentExecution().GetWorkflowId() != "" {
 		parentID := info.GetParentExecution().GetWorkflowId()
 		wf.ParentID = &parentID
+		wf.ParentRunID = info.GetParentExecution().GetRunId()
+	}
+
+	if info.GetExecutionTime() != nil && !info.GetExecutionTime().AsTime().IsZero() {
+		t := info.GetExecutionTime().AsTime()
+		wf.ExecutionTime = &t
+	}
+
+	for _, pa := range resp.GetPendingActivities() {
+		wf.PendingActivities = append(wf.PendingActivities, pendingActivityFromProto(pa))
 	}
 
-	// Fetch input/output from workflow history
-	wf.Input, wf.Output = c.getWorkflowInputOutput(ctx, namespace, workflowID, runID)
+	// Fetch input/output and retry settings from workflow history
+	var started *historypb.WorkflowExecutionStartedEventAttributes
+	wf.Input, wf.Output, started = c.getWorkflowInputOutput(ctx, namespace, workflowID, runID)
+	if started != nil {
+		wf.Attempt = started.GetAttempt()
+		wf.RetryPolicy = retryPolicyFromProto(started.GetRetryPolicy())
+	}
 
 	return wf, nil
 }
 
-// getWorkflowInputOutput extracts input and output from workflow history events.
-func (c *Client) getWorkflowInputOutput(ctx context.Context, namespace, workflowID, runID string) (input, output string) {
+// retryPolicyFromProto converts an API retry policy, returning nil if none is set.
+func retryPolicyFromProto(rp *commonpb.RetryPolicy) *RetryPolicy {
+	if rp == nil {
+		return nil
+	}
+	return &RetryPolicy{
+		InitialInterval:    rp.GetInitialInterval().AsDuration(),
+		BackoffCoefficient: rp.GetBackoffCoefficient(),
+		MaximumInterval:    rp.GetMaximumInterval().AsDuration(),
+		MaximumAttempts:    rp.GetMaximumAttempts(),
+		NonRetryableErrors: rp.GetNonRetryableErrorTypes(),
+	}
+}
+
+// pendingActivityFromProto converts a pending activity from DescribeWorkflowExecution.
+func pendingActivityFromProto(pa *workflowpb.PendingActivityInfo) PendingActivity {
+	activity := PendingActivity{
+		ActivityID:      pa.GetActivityId(),
+		ActivityType:    pa.GetActivityType().GetName(),
+		State:           mapPendingActivityState(pa.GetState()),
+		Attempt:         pa.GetAttempt(),
+		MaximumAttempts: pa.GetMaximumAttempts(),
+		RetryPolicy:     retryPolicyFromProto(pa.GetActivityOptions().GetRetryPolicy()),
+		LastWorker:      pa.GetLastWorkerIdentity(),
+	}
+	if pa.GetLastFailure() != nil {
+		activity.LastFailure = pa.GetLastFailure().GetMessage()
+	}
+
+	// Older servers don't report the next attempt time; a future scheduled time
+	// on a retried activity means it is backing off until then.
+	if t := pa.GetNextAttemptScheduleTime(); t != nil && !t.AsTime().IsZero() {
+		next := t.AsTime()
+		activity.NextAttemptTime = &next
+	} else if t := pa.GetScheduledTime(); t != nil && pa.GetAttempt() > 1 && t.AsTime().After(time.Now()) {
+		next := t.AsTime()
+		activity.NextAttemptTime = &next
+	}
+	return activity
+}
+
+// mapPendingActivityState converts a pending activity state to a display string.
+func mapPendingActivityState(state enums.PendingActivityState) string {
+	switch state {
+	case enums.PENDING_ACTIVITY_STATE_SCHEDULED:
+		return "Scheduled"
+	case enums.PENDING_ACTIVITY_STATE_STARTED:
+		return "Started"
+	case enums.PENDING_ACTIVITY_STATE_CANCEL_REQUESTED:
+		return "CancelRequested"
+	case enums.PENDING_ACTIVITY_STATE_PAUSED:
+		return "Paused"
+	case enums.PENDING_ACTIVITY_STATE_PAUSE_REQUESTED:
+		return "PauseRequested"
+	default:
+		return "Unknown"
+	}
+}
+
+// maxAncestryDepth bounds how far GetWorkflowAncestry walks up the parent chain.
+const maxAncestryDepth = 20
+
+// GetWorkflowAncestry walks parent executions starting from the given workflow.
+// Only describe calls are made, so input/output are not populated.
+func (c *Client) GetWorkflowAncestry(ctx context.Context, namespace, workflowID, runID string) ([]Workflow, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	var ancestors []Workflow
+	for depth := 0; depth <= maxAncestryDepth; depth++ {
+		resp, err := c.client.WorkflowService().DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+		})
+		if err != nil {
+			if depth == 0 {
+				return nil, fmt.Errorf("failed to describe workflow: %w", err)
+			}
+			// A parent may have been deleted or aged out of retention
+			break
+		}
+
+		info := resp.GetWorkflowExecutionInfo()
+		if depth > 0 {
+			ancestors = append(ancestors, Workflow{
+				ID:        info.GetExecution().GetWorkflowId(),
+				RunID:     info.GetExecution().GetRunId(),
+				Type:      info.GetType().GetName(),
+				Status:    MapWorkflowStatus(info.GetStatus()),
+				Namespace: namespace,
+				TaskQueue: info.GetTaskQueue(),
+				StartTime: info.GetStartTime().AsTime(),
+			})
+		}
+
+		parent := info.GetParentExecution()
+		if parent.GetWorkflowId() == "" {
+			break
+		}
+		workflowID, runID = parent.GetWorkflowId(), parent.GetRunId()
+	}
+
+	// Reverse so the root comes first
+	for i, j := 0, len(ancestors)-1; i < j; i, j = i+1, j-1 {
+		ancestors[i], ancestors[j] = ancestors[j], ancestors[i]
+	}
+	return ancestors, nil
+}
+
+// getWorkflowInputOutput extracts input and output from workflow history events,
+// along with the started event's attributes (nil if not found).
+func (c *Client) getWorkflowInputOutput(ctx context.Context, namespace, workflowID, runID string) (input, output string, started *historypb.WorkflowExecutionStartedEventAttributes) {
 	// Get workflow history to extract input/output
 	histResp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
 		Namespace: namespace,
@@ -580,14 +890,16 @@ func (c *Client) getWorkflowInputOutput(ctx context.Context, namespace, workflow
 		MaximumPageSize: 100, // Usually enough to get start and end events
 	})
 	if err != nil {
-		return "", ""
+		return "", "", nil
 	}
 
 	events := histResp.GetHistory().GetEvents()
+	c.decodeEvents(ctx, namespace, events)
 	for _, event := range events {
 		switch event.GetEventType() {
 		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
 			attrs := event.GetWorkflowExecutionStartedEventAttributes()
+			started = attrs
 			if attrs != nil && attrs.GetInput() != nil {
 				input = formatPayloads(attrs.GetInput())
 			}
@@ -624,7 +936,7 @@ func (c *Client) getWorkflowInputOutput(ctx context.Context, namespace, workflow
 		}
 	}
 
-	return input, output
+	return input, output, started
 }
 
 // GetWorkflowHistory returns the event history for a workflow execution.
@@ -649,6 +961,7 @@ func (c *Client) GetWorkflowHistory(ctx context.Context, namespace, workflowID,
 			return nil, fmt.Errorf("failed to get workflow history: %w", err)
 		}
 
+		c.decodeEvents(ctx, namespace, resp.GetHistory().GetEvents())
 		for _, event := range resp.GetHistory().GetEvents() {
 			he := HistoryEvent{
 				ID:      event.GetEventId(),
@@ -668,15 +981,82 @@ func (c *Client) GetWorkflowHistory(ctx context.Context, namespace, workflowID,
 	return events, nil
 }
 
-// GetEnhancedWorkflowHistory returns event history with relational data for tree/timeline views.
-func (c *Client) GetEnhancedWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]EnhancedHistoryEvent, error) {
+// tailPollTimeout bounds a single long-poll; the server returns before this
+// with an empty page when no new events arrive.
+const tailPollTimeout = 90 * time.Second
+
+// TailWorkflowHistory pages through the history with WaitNewEvent set, so once
+// caught up each request blocks until new events are written. Only the first
+// tail of a run reads from the first page; the cursor resumes later ones.
+func (c *Client) TailWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, afterEventID int64, cursor *TailCursor, onEvents func([]EnhancedHistoryEvent)) error {
+	if c.client == nil {
+		return fmt.Errorf("client not connected")
+	}
+	maxPayloadSize := c.Config().MaxPayloadSize
+
+	nextPageToken := cursor.resume(runID)
+	resumed := nextPageToken != nil
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, tailPollTimeout)
+		resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(pollCtx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+			NextPageToken: nextPageToken,
+			WaitNewEvent:  true,
+		})
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if resumed {
+				// The saved position may no longer be valid; start over
+				resumed, nextPageToken = false, nil
+				continue
+			}
+			return fmt.Errorf("failed to poll workflow history: %w", err)
+		}
+		resumed = false
+
+		var fresh []*historypb.HistoryEvent
+		for _, event := range resp.GetHistory().GetEvents() {
+			if event.GetEventId() > afterEventID {
+				fresh = append(fresh, event)
+				afterEventID = event.GetEventId()
+			}
+		}
+		if len(fresh) > 0 {
+			codecErr := c.decodeEvents(ctx, namespace, fresh)
+			batch := make([]EnhancedHistoryEvent, 0, len(fresh))
+			for _, event := range fresh {
+				he := extractEnhancedEvent(event)
+				markCodecError(&he, codecErr)
+				truncateEvent(&he, maxPayloadSize)
+				batch = append(batch, he)
+			}
+			onEvents(batch)
+		}
+
+		// The server stops returning a token once the workflow has closed
+		nextPageToken = resp.GetNextPageToken()
+		cursor.advance(runID, nextPageToken)
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// GetHistoryEvent pages through the history until it reaches eventID, stopping
+// there whether or not the event was found.
+func (c *Client) GetHistoryEvent(ctx context.Context, namespace, workflowID, runID string, eventID int64) (*HistoryEventDetail, error) {
 	if c.client == nil {
 		return nil, fmt.Errorf("client not connected")
 	}
 
-	var events []EnhancedHistoryEvent
 	var nextPageToken []byte
-
 	for {
 		resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
 			Namespace: namespace,
@@ -691,26 +1071,153 @@ func (c *Client) GetEnhancedWorkflowHistory(ctx context.Context, namespace, work
 		}
 
 		for _, event := range resp.GetHistory().GetEvents() {
+			if event.GetEventId() > eventID {
+				// Events are in ID order, so it isn't on a later page either
+				return nil, fmt.Errorf("event %d not found in history", eventID)
+			}
+			if event.GetEventId() != eventID {
+				continue
+			}
+			codecErr := c.decodeEvents(ctx, namespace, []*historypb.HistoryEvent{event})
+			raw, err := temporalproto.CustomJSONMarshalOptions{Indent: "  "}.Marshal(event)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode event: %w", err)
+			}
 			he := extractEnhancedEvent(event)
-			events = append(events, he)
+			markCodecError(&he, codecErr)
+			return &HistoryEventDetail{
+				Event:   he,
+				RawJSON: string(raw),
+			}, nil
+		}
+
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return nil, fmt.Errorf("event %d not found in history", eventID)
+		}
+	}
+}
+
+// ExportWorkflowHistory fetches every history page and marshals the result with the
+// same JSON options as the Temporal CLI so it can be loaded by the SDK replayer.
+func (c *Client) ExportWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]byte, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	history := &historypb.History{}
+	var nextPageToken []byte
+	for {
+		resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workflow history: %w", err)
 		}
 
+		history.Events = append(history.Events, resp.GetHistory().GetEvents()...)
+
 		nextPageToken = resp.GetNextPageToken()
 		if len(nextPageToken) == 0 {
 			break
 		}
 	}
 
+	data, err := temporalproto.CustomJSONMarshalOptions{Indent: "  "}.Marshal(history)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode history: %w", err)
+	}
+	return data, nil
+}
+
+// GetEnhancedWorkflowHistory returns event history with relational data for tree/timeline views.
+func (c *Client) GetEnhancedWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]EnhancedHistoryEvent, error) {
+	var events []EnhancedHistoryEvent
+	err := c.StreamWorkflowHistory(ctx, namespace, workflowID, runID, func(page []EnhancedHistoryEvent) {
+		events = append(events, page...)
+	})
+	if err != nil {
+		return nil, err
+	}
 	return events, nil
 }
 
+// StreamWorkflowHistory fetches the history one page at a time, passing each
+// page to onPage before requesting the next.
+func (c *Client) StreamWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, onPage func([]EnhancedHistoryEvent)) error {
+	if c.client == nil {
+		return fmt.Errorf("client not connected")
+	}
+	maxPayloadSize := c.Config().MaxPayloadSize
+	// Each page, and decoding it, gets its own deadline so the total load time
+	// scales with history size instead of sharing one
+	pageTimeout := c.Config().RPC.Budget("GetWorkflowExecutionHistory")
+
+	var nextPageToken []byte
+	for {
+		pageCtx, cancel := context.WithTimeout(ctx, pageTimeout)
+		resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(pageCtx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			cancel()
+			return fmt.Errorf("failed to get workflow history: %w", err)
+		}
+
+		codecErr := c.decodeEvents(pageCtx, namespace, resp.GetHistory().GetEvents())
+		cancel()
+
+		page := make([]EnhancedHistoryEvent, 0, len(resp.GetHistory().GetEvents()))
+		for _, event := range resp.GetHistory().GetEvents() {
+			he := extractEnhancedEvent(event)
+			markCodecError(&he, codecErr)
+			truncateEvent(&he, maxPayloadSize)
+			page = append(page, he)
+		}
+		if len(page) > 0 {
+			onPage(page)
+		}
+
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// workflowTaskBuildID returns the build ID of the worker that completed a workflow
+// task, checking the deployment-based fields before the older version stamp.
+func workflowTaskBuildID(attrs *historypb.WorkflowTaskCompletedEventAttributes) string {
+	if id := attrs.GetDeploymentVersion().GetBuildId(); id != "" {
+		return id
+	}
+	if id := attrs.GetDeployment().GetBuildId(); id != "" {
+		return id
+	}
+	if id := attrs.GetWorkerVersion().GetBuildId(); id != "" {
+		return id
+	}
+	return attrs.GetBinaryChecksum()
+}
+
 // extractEnhancedEvent extracts structured data from a history event for tree/timeline views.
 func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 	he := EnhancedHistoryEvent{
-		ID:      event.GetEventId(),
-		Type:    formatEventType(event.GetEventType().String()),
-		Time:    event.GetEventTime().AsTime(),
-		Details: extractEventDetails(event),
+		ID:       event.GetEventId(),
+		Type:     formatEventType(event.GetEventType().String()),
+		Time:     event.GetEventTime().AsTime(),
+		Details:  extractEventDetails(event),
+		Payloads: extractEventPayloads(event),
 	}
 
 	switch event.GetEventType() {
@@ -745,6 +1252,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.ScheduledEventID = attrs.GetScheduledEventId()
 			he.StartedEventID = attrs.GetStartedEventId()
 			he.Identity = attrs.GetIdentity()
+			he.BuildID = workflowTaskBuildID(attrs)
 		}
 
 	case enums.EVENT_TYPE_WORKFLOW_TASK_TIMED_OUT:
@@ -781,6 +1289,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.ScheduledEventID = attrs.GetScheduledEventId()
 			he.Attempt = attrs.GetAttempt()
 			he.Identity = attrs.GetIdentity()
+			he.BuildID = attrs.GetWorkerVersion().GetBuildId()
 			if attrs.GetLastFailure() != nil {
 				he.Failure = attrs.GetLastFailure().GetMessage()
 			}
@@ -868,6 +1377,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 			if attrs.GetWorkflowType() != nil {
 				he.ChildWorkflowType = attrs.GetWorkflowType().GetName()
@@ -880,6 +1390,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 			if attrs.GetResult() != nil {
 				he.Result = formatPayloads(attrs.GetResult())
@@ -892,6 +1403,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 			if attrs.GetFailure() != nil {
 				he.Failure = attrs.GetFailure().GetMessage()
@@ -904,6 +1416,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 		}
 
@@ -913,6 +1426,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 		}
 
@@ -922,6 +1436,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 		}
 
@@ -929,6 +1444,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 		attrs := event.GetSignalExternalWorkflowExecutionInitiatedEventAttributes()
 		if attrs != nil && attrs.GetWorkflowExecution() != nil {
 			he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+			he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 		}
 
 	case enums.EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED:
@@ -937,6 +1453,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 		}
 	}
@@ -1375,38 +1892,511 @@ func formatPayloads(payloads *commonpb.Payloads) string {
 	}
 
 	var results []string
-	for _, p := range payloads.GetPayloads() {
-		if p == nil {
-			continue
-		}
-		data := p.GetData()
-		if len(data) == 0 {
+	for _, p := range DecodePayloads("", payloads) {
+		if p.Size == 0 && p.Encoding != EncodingNull {
 			continue
 		}
+		results = append(results, p.Data)
+	}
 
-		// Try to parse as JSON for nicer display
-		var jsonVal interface{}
-		if err := json.Unmarshal(data, &jsonVal); err == nil {
-			// Format as compact JSON
-			if b, err := json.Marshal(jsonVal); err == nil {
-				results = append(results, string(b))
-				continue
+	return strings.Join(results, ", ")
+}
+
+// DescribeTaskQueue returns task queue info and active pollers.
+func (c *Client) DescribeTaskQueue(ctx context.Context, namespace, taskQueue string) (*TaskQueueInfo, []Poller, error) {
+	// Enhanced mode reports pollers and backlog stats for every active build ID in one call
+	resp, err := c.client.WorkflowService().DescribeTaskQueue(ctx, &workflowservice.DescribeTaskQueueRequest{
+		Namespace: namespace,
+		TaskQueue: &taskqueue.TaskQueue{
+			Name: taskQueue,
+			Kind: enums.TASK_QUEUE_KIND_NORMAL,
+		},
+		ApiMode: enums.DESCRIBE_TASK_QUEUE_MODE_ENHANCED,
+		Versions: &taskqueue.TaskQueueVersionSelection{
+			Unversioned: true,
+			AllActive:   true,
+		},
+		TaskQueueTypes: []enums.TaskQueueType{enums.TASK_QUEUE_TYPE_WORKFLOW, enums.TASK_QUEUE_TYPE_ACTIVITY},
+		ReportPollers:  true,
+		ReportStats:    true,
+	})
+	if err != nil {
+		var unimplemented *serviceerror.Unimplemented
+		var invalid *serviceerror.InvalidArgument
+		if errors.As(err, &unimplemented) || errors.As(err, &invalid) {
+			// Older servers only support the default mode
+			return c.describeTaskQueueDefault(ctx, namespace, taskQueue)
+		}
+		return nil, nil, fmt.Errorf("failed to describe task queue: %w", err)
+	}
+
+	info := &TaskQueueInfo{
+		Name: taskQueue,
+		Type: "Combined",
+	}
+	var pollers []Poller
+	byType := make(map[string]*TaskQueueStats)
+
+	for buildID, version := range resp.GetVersionsInfo() {
+		for tqType, typeInfo := range version.GetTypesInfo() {
+			queueType := MapTaskQueueType(enums.TaskQueueType(tqType))
+
+			for _, p := range typeInfo.GetPollers() {
+				poller := newPoller(p, queueType)
+				if poller.BuildID == "" {
+					// Versions are keyed by build ID; empty for the unversioned queue
+					poller.BuildID = buildID
+				}
+				pollers = append(pollers, poller)
+			}
+
+			if stats := typeInfo.GetStats(); stats != nil {
+				typeStats := byType[queueType]
+				if typeStats == nil {
+					typeStats = &TaskQueueStats{TaskQueueType: queueType}
+					byType[queueType] = typeStats
+				}
+				typeStats.Backlog += int(stats.GetApproximateBacklogCount())
+				typeStats.AddRate += float64(stats.GetTasksAddRate())
+				typeStats.DispatchRate += float64(stats.GetTasksDispatchRate())
+				if age := stats.GetApproximateBacklogAge().AsDuration(); age > typeStats.BacklogAge {
+					typeStats.BacklogAge = age
+				}
 			}
 		}
+	}
 
-		// Fall back to raw string (truncated)
-		s := string(data)
-		if len(s) > 100 {
-			s = s[:100] + "..."
+	// Totals across types; workflow tasks are listed first
+	for _, queueType := range []string{TaskQueueTypeWorkflow, TaskQueueTypeActivity} {
+		typeStats := byType[queueType]
+		if typeStats == nil {
+			continue
+		}
+		info.Stats = append(info.Stats, *typeStats)
+		info.Backlog += typeStats.Backlog
+		info.AddRate += typeStats.AddRate
+		info.DispatchRate += typeStats.DispatchRate
+		if typeStats.BacklogAge > info.BacklogAge {
+			info.BacklogAge = typeStats.BacklogAge
 		}
-		results = append(results, s)
 	}
 
-	return strings.Join(results, ", ")
+	info.finishPollers(pollers)
+	return info, pollers, nil
 }
 
-// DescribeTaskQueue returns task queue info and active pollers.
-func (c *Client) DescribeTaskQueue(ctx context.Context, namespace, taskQueue string) (*TaskQueueInfo, []Poller, error) {
+// GetVersioningRules returns the Build ID assignment and redirect rules of a task queue.
+func (c *Client) GetVersioningRules(ctx context.Context, namespace, taskQueue string) (*VersioningRules, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.WorkflowService().GetWorkerVersioningRules(ctx, &workflowservice.GetWorkerVersioningRulesRequest{
+		Namespace: namespace,
+		TaskQueue: taskQueue,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get versioning rules: %w", err)
+	}
+	return convertVersioningRules(resp.GetAssignmentRules(), resp.GetCompatibleRedirectRules(), resp.GetConflictToken()), nil
+}
+
+// UpdateVersioningRules applies a single change to a task queue's versioning rules.
+func (c *Client) UpdateVersioningRules(ctx context.Context, namespace, taskQueue string, change VersioningRuleChange) (*VersioningRules, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+	if err := change.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := &workflowservice.UpdateWorkerVersioningRulesRequest{
+		Namespace:     namespace,
+		TaskQueue:     taskQueue,
+		ConflictToken: change.ConflictToken,
+	}
+
+	assignment := &taskqueue.BuildIdAssignmentRule{TargetBuildId: change.BuildID}
+	if change.Ramp != nil {
+		assignment.Ramp = &taskqueue.BuildIdAssignmentRule_PercentageRamp{
+			PercentageRamp: &taskqueue.RampByPercentage{RampPercentage: *change.Ramp},
+		}
+	}
+	redirect := &taskqueue.CompatibleBuildIdRedirectRule{
+		SourceBuildId: change.SourceBuildID,
+		TargetBuildId: change.BuildID,
+	}
+
+	switch change.Op {
+	case VersioningInsertAssignmentRule:
+		req.Operation = &workflowservice.UpdateWorkerVersioningRulesRequest_InsertAssignmentRule{
+			InsertAssignmentRule: &workflowservice.UpdateWorkerVersioningRulesRequest_InsertBuildIdAssignmentRule{
+				RuleIndex: change.RuleIndex,
+				Rule:      assignment,
+			},
+		}
+	case VersioningReplaceAssignmentRule:
+		req.Operation = &workflowservice.UpdateWorkerVersioningRulesRequest_ReplaceAssignmentRule{
+			ReplaceAssignmentRule: &workflowservice.UpdateWorkerVersioningRulesRequest_ReplaceBuildIdAssignmentRule{
+				RuleIndex: change.RuleIndex,
+				Rule:      assignment,
+				Force:     change.Force,
+			},
+		}
+	case VersioningDeleteAssignmentRule:
+		req.Operation = &workflowservice.UpdateWorkerVersioningRulesRequest_DeleteAssignmentRule{
+			DeleteAssignmentRule: &workflowservice.UpdateWorkerVersioningRulesRequest_DeleteBuildIdAssignmentRule{
+				RuleIndex: change.RuleIndex,
+				Force:     change.Force,
+			},
+		}
+	case VersioningAddRedirectRule:
+		req.Operation = &workflowservice.UpdateWorkerVersioningRulesRequest_AddCompatibleRedirectRule{
+			AddCompatibleRedirectRule: &workflowservice.UpdateWorkerVersioningRulesRequest_AddCompatibleBuildIdRedirectRule{
+				Rule: redirect,
+			},
+		}
+	case VersioningDeleteRedirectRule:
+		req.Operation = &workflowservice.UpdateWorkerVersioningRulesRequest_DeleteCompatibleRedirectRule{
+			DeleteCompatibleRedirectRule: &workflowservice.UpdateWorkerVersioningRulesRequest_DeleteCompatibleBuildIdRedirectRule{
+				SourceBuildId: change.SourceBuildID,
+			},
+		}
+	case VersioningCommitBuildID:
+		req.Operation = &workflowservice.UpdateWorkerVersioningRulesRequest_CommitBuildId_{
+			CommitBuildId: &workflowservice.UpdateWorkerVersioningRulesRequest_CommitBuildId{
+				TargetBuildId: change.BuildID,
+				Force:         change.Force,
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unknown versioning rule operation %q", change.Op)
+	}
+
+	resp, err := c.client.WorkflowService().UpdateWorkerVersioningRules(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update versioning rules: %w", err)
+	}
+	return convertVersioningRules(resp.GetAssignmentRules(), resp.GetCompatibleRedirectRules(), resp.GetConflictToken()), nil
+}
+
+// GetBuildIDReachability checks which workflows on a task queue may still run on a Build ID.
+func (c *Client) GetBuildIDReachability(ctx context.Context, namespace, taskQueue, buildID string) (*BuildIDReachability, error) {
+	resp, err := c.client.WorkflowService().DescribeTaskQueue(ctx, &workflowservice.DescribeTaskQueueRequest{
+		Namespace: namespace,
+		TaskQueue: &taskqueue.TaskQueue{
+			Name: taskQueue,
+			Kind: enums.TASK_QUEUE_KIND_NORMAL,
+		},
+		ApiMode:                enums.DESCRIBE_TASK_QUEUE_MODE_ENHANCED,
+		Versions:               &taskqueue.TaskQueueVersionSelection{BuildIds: []string{buildID}},
+		ReportTaskReachability: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check build ID reachability: %w", err)
+	}
+
+	result := &BuildIDReachability{BuildID: buildID, Reachability: ReachabilityUnknown}
+	switch resp.GetVersionsInfo()[buildID].GetTaskReachability() {
+	case enums.BUILD_ID_TASK_REACHABILITY_REACHABLE:
+		result.Reachability = ReachabilityReachable
+	case enums.BUILD_ID_TASK_REACHABILITY_CLOSED_WORKFLOWS_ONLY:
+		result.Reachability = ReachabilityClosedOnly
+	case enums.BUILD_ID_TASK_REACHABILITY_UNREACHABLE:
+		result.Reachability = ReachabilityUnreachable
+	}
+
+	// The server doesn't say why a Build ID is reachable; the assignment rules tell
+	// whether new workflows can still start on it
+	rules, err := c.GetVersioningRules(ctx, namespace, taskQueue)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules.AssignmentRules {
+		if rule.TargetBuildID == buildID {
+			result.NewWorkflows = true
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func convertVersioningRules(assignment []*taskqueue.TimestampedBuildIdAssignmentRule, redirect []*taskqueue.TimestampedCompatibleBuildIdRedirectRule, token []byte) *VersioningRules {
+	rules := &VersioningRules{ConflictToken: token}
+	for _, r := range assignment {
+		rule := AssignmentRule{
+			TargetBuildID: r.GetRule().GetTargetBuildId(),
+			CreateTime:    r.GetCreateTime().AsTime(),
+		}
+		if ramp := r.GetRule().GetPercentageRamp(); ramp != nil {
+			rule.Ramped = true
+			rule.Percentage = ramp.GetRampPercentage()
+		}
+		rules.AssignmentRules = append(rules.AssignmentRules, rule)
+	}
+	for _, r := range redirect {
+		rules.RedirectRules = append(rules.RedirectRules, RedirectRule{
+			SourceBuildID: r.GetRule().GetSourceBuildId(),
+			TargetBuildID: r.GetRule().GetTargetBuildId(),
+			CreateTime:    r.GetCreateTime().AsTime(),
+		})
+	}
+	return rules
+}
+
+// ListWorkerDeployments returns the worker deployments in a namespace with their
+// current and ramping versions.
+func (c *Client) ListWorkerDeployments(ctx context.Context, namespace string) ([]WorkerDeployment, error) {
+	var deployments []WorkerDeployment
+	var nextPageToken []byte
+
+	for {
+		resp, err := c.client.WorkflowService().ListWorkerDeployments(ctx, &workflowservice.ListWorkerDeploymentsRequest{
+			Namespace:     namespace,
+			PageSize:      100,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list worker deployments: %w", err)
+		}
+
+		for _, d := range resp.GetWorkerDeployments() {
+			deployment := WorkerDeployment{
+				Name:          d.GetName(),
+				CreateTime:    optionalTime(d.GetCreateTime()),
+				LatestBuildID: d.GetLatestVersionSummary().GetDeploymentVersion().GetBuildId(),
+			}
+			deployment.applyRouting(d.GetRoutingConfig())
+			deployments = append(deployments, deployment)
+		}
+
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	sort.Slice(deployments, func(i, j int) bool {
+		return deployments[i].Name < deployments[j].Name
+	})
+	return deployments, nil
+}
+
+// DescribeWorkerDeployment returns a worker deployment with all of its versions.
+func (c *Client) DescribeWorkerDeployment(ctx context.Context, namespace, name string) (*WorkerDeployment, error) {
+	resp, err := c.client.WorkflowService().DescribeWorkerDeployment(ctx, &workflowservice.DescribeWorkerDeploymentRequest{
+		Namespace:      namespace,
+		DeploymentName: name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe worker deployment: %w", err)
+	}
+
+	info := resp.GetWorkerDeploymentInfo()
+	deployment := &WorkerDeployment{
+		Name:          info.GetName(),
+		CreateTime:    optionalTime(info.GetCreateTime()),
+		LastModifier:  info.GetLastModifierIdentity(),
+		ConflictToken: resp.GetConflictToken(),
+	}
+	deployment.applyRouting(info.GetRoutingConfig())
+
+	var latest time.Time
+	for _, v := range info.GetVersionSummaries() {
+		version := WorkerDeploymentVersion{
+			BuildID:      v.GetDeploymentVersion().GetBuildId(),
+			Status:       deploymentVersionStatus(v.GetStatus(), v.GetDrainageStatus()),
+			CreateTime:   optionalTime(v.GetCreateTime()),
+			CurrentSince: optionalTime(v.GetCurrentSinceTime()),
+			RampingSince: optionalTime(v.GetRampingSinceTime()),
+		}
+		if !version.CreateTime.Before(latest) {
+			latest = version.CreateTime
+			deployment.LatestBuildID = version.BuildID
+		}
+		deployment.Versions = append(deployment.Versions, version)
+	}
+
+	// Newest versions first
+	sort.SliceStable(deployment.Versions, func(i, j int) bool {
+		return deployment.Versions[i].CreateTime.After(deployment.Versions[j].CreateTime)
+	})
+	return deployment, nil
+}
+
+// SetWorkerDeploymentCurrentVersion routes new workflows of a deployment to buildID,
+// or to unversioned workers when buildID is empty. A non-nil conflict token makes the
+// change fail if the deployment was modified since it was read.
+func (c *Client) SetWorkerDeploymentCurrentVersion(ctx context.Context, namespace, name, buildID string, conflictToken []byte) error {
+	_, err := c.client.WorkflowService().SetWorkerDeploymentCurrentVersion(ctx, &workflowservice.SetWorkerDeploymentCurrentVersionRequest{
+		Namespace:      namespace,
+		DeploymentName: name,
+		BuildId:        buildID,
+		ConflictToken:  conflictToken,
+		Identity:       "tempo",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set current version: %w", err)
+	}
+	return nil
+}
+
+// ListNexusEndpoints returns the cluster's Nexus endpoints sorted by name.
+func (c *Client) ListNexusEndpoints(ctx context.Context) ([]NexusEndpoint, error) {
+	var endpoints []NexusEndpoint
+	var nextPageToken []byte
+	for {
+		resp, err := c.client.OperatorService().ListNexusEndpoints(ctx, &operatorservice.ListNexusEndpointsRequest{
+			PageSize:      100,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list nexus endpoints: %w", err)
+		}
+		for _, e := range resp.GetEndpoints() {
+			endpoints = append(endpoints, nexusEndpointFromProto(e))
+		}
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].Name < endpoints[j].Name
+	})
+	return endpoints, nil
+}
+
+// GetNexusEndpoint returns a Nexus endpoint by ID.
+func (c *Client) GetNexusEndpoint(ctx context.Context, id string) (*NexusEndpoint, error) {
+	resp, err := c.client.OperatorService().GetNexusEndpoint(ctx, &operatorservice.GetNexusEndpointRequest{Id: id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nexus endpoint: %w", err)
+	}
+	endpoint := nexusEndpointFromProto(resp.GetEndpoint())
+	return &endpoint, nil
+}
+
+// CreateNexusEndpoint registers a Nexus endpoint.
+func (c *Client) CreateNexusEndpoint(ctx context.Context, req NexusEndpointRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	spec, err := req.spec()
+	if err != nil {
+		return err
+	}
+	_, err = c.client.OperatorService().CreateNexusEndpoint(ctx, &operatorservice.CreateNexusEndpointRequest{Spec: spec})
+	if err != nil {
+		return fmt.Errorf("failed to create nexus endpoint: %w", err)
+	}
+	return nil
+}
+
+// UpdateNexusEndpoint replaces a Nexus endpoint's spec.
+func (c *Client) UpdateNexusEndpoint(ctx context.Context, id string, version int64, req NexusEndpointRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	spec, err := req.spec()
+	if err != nil {
+		return err
+	}
+	_, err = c.client.OperatorService().UpdateNexusEndpoint(ctx, &operatorservice.UpdateNexusEndpointRequest{
+		Id:      id,
+		Version: version,
+		Spec:    spec,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update nexus endpoint: %w", err)
+	}
+	return nil
+}
+
+// DeleteNexusEndpoint deletes a Nexus endpoint.
+func (c *Client) DeleteNexusEndpoint(ctx context.Context, id string, version int64) error {
+	_, err := c.client.OperatorService().DeleteNexusEndpoint(ctx, &operatorservice.DeleteNexusEndpointRequest{
+		Id:      id,
+		Version: version,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete nexus endpoint: %w", err)
+	}
+	return nil
+}
+
+// applyRouting copies a deployment's current and ramping versions.
+func (d *WorkerDeployment) applyRouting(routing *deploymentpb.RoutingConfig) {
+	d.CurrentBuildID = routing.GetCurrentDeploymentVersion().GetBuildId()
+	d.RampingBuildID = routing.GetRampingDeploymentVersion().GetBuildId()
+	d.RampPercentage = routing.GetRampingVersionPercentage()
+}
+
+func deploymentVersionStatus(status enums.WorkerDeploymentVersionStatus, drainage enums.VersionDrainageStatus) string {
+	switch status {
+	case enums.WORKER_DEPLOYMENT_VERSION_STATUS_CURRENT:
+		return DeploymentVersionCurrent
+	case enums.WORKER_DEPLOYMENT_VERSION_STATUS_RAMPING:
+		return DeploymentVersionRamping
+	case enums.WORKER_DEPLOYMENT_VERSION_STATUS_DRAINING:
+		return DeploymentVersionDraining
+	case enums.WORKER_DEPLOYMENT_VERSION_STATUS_DRAINED:
+		return DeploymentVersionDrained
+	}
+	// Older servers only report drainage
+	switch drainage {
+	case enums.VERSION_DRAINAGE_STATUS_DRAINING:
+		return DeploymentVersionDraining
+	case enums.VERSION_DRAINAGE_STATUS_DRAINED:
+		return DeploymentVersionDrained
+	}
+	return DeploymentVersionInactive
+}
+
+// optionalTime converts a timestamp that may be unset, returning the zero time for nil.
+func optionalTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// newPoller converts poller info reported by the server, including the worker's
+// versioning capabilities.
+func newPoller(p *taskqueue.PollerInfo, queueType string) Poller {
+	poller := Poller{
+		Identity:       p.GetIdentity(),
+		LastAccessTime: p.GetLastAccessTime().AsTime(),
+		TaskQueueType:  queueType,
+		RatePerSecond:  p.GetRatePerSecond(),
+	}
+
+	if opts := p.GetDeploymentOptions(); opts != nil {
+		poller.BuildID = opts.GetBuildId()
+		poller.DeploymentName = opts.GetDeploymentName()
+		switch opts.GetWorkerVersioningMode() {
+		case enums.WORKER_VERSIONING_MODE_VERSIONED:
+			poller.Versioning = "Versioned"
+		case enums.WORKER_VERSIONING_MODE_UNVERSIONED:
+			poller.Versioning = "Unversioned"
+		}
+	} else if caps := p.GetWorkerVersionCapabilities(); caps != nil {
+		poller.BuildID = caps.GetBuildId()
+		poller.DeploymentName = caps.GetDeploymentSeriesName()
+		poller.Versioning = "Unversioned"
+		if caps.GetUseVersioning() {
+			poller.Versioning = "Versioned"
+		}
+	}
+
+	return poller
+}
+
+// describeTaskQueueDefault describes a task queue using the default API mode, which
+// reports pollers per task queue type but no backlog stats.
+func (c *Client) describeTaskQueueDefault(ctx context.Context, namespace, taskQueue string) (*TaskQueueInfo, []Poller, error) {
 	// Query workflow task queue
 	wfResp, err := c.client.WorkflowService().DescribeTaskQueue(ctx, &workflowservice.DescribeTaskQueueRequest{
 		Namespace: namespace,
@@ -1437,30 +2427,18 @@ func (c *Client) DescribeTaskQueue(ctx context.Context, namespace, taskQueue str
 	var pollers []Poller
 
 	for _, p := range wfResp.GetPollers() {
-		pollers = append(pollers, Poller{
-			Identity:       p.GetIdentity(),
-			LastAccessTime: p.GetLastAccessTime().AsTime(),
-			TaskQueueType:  TaskQueueTypeWorkflow,
-			RatePerSecond:  p.GetRatePerSecond(),
-		})
+		pollers = append(pollers, newPoller(p, TaskQueueTypeWorkflow))
 	}
 
 	for _, p := range actResp.GetPollers() {
-		pollers = append(pollers, Poller{
-			Identity:       p.GetIdentity(),
-			LastAccessTime: p.GetLastAccessTime().AsTime(),
-			TaskQueueType:  TaskQueueTypeActivity,
-			RatePerSecond:  p.GetRatePerSecond(),
-		})
+		pollers = append(pollers, newPoller(p, TaskQueueTypeActivity))
 	}
 
 	info := &TaskQueueInfo{
-		Name:        taskQueue,
-		Type:        "Combined",
-		PollerCount: len(pollers),
-		Backlog:     0, // Backlog info requires enhanced visibility or approximation
+		Name: taskQueue,
+		Type: "Combined",
 	}
-
+	info.finishPollers(pollers)
 	return info, pollers, nil
 }
 
@@ -1475,7 +2453,7 @@ func formatDuration(d *durationpb.Duration) string {
 	if dur < time.Hour {
 		return fmt.Sprintf("%d minutes", int(dur.Minutes()))
 	}
-	if dur < 24*time.Hour {
+	if dur < 24*time.Hour || dur%(24*time.Hour) != 0 {
 		return fmt.Sprintf("%d hours", int(dur.Hours()))
 	}
 
@@ -1488,16 +2466,22 @@ func formatDuration(d *durationpb.Duration) string {
 
 // CancelWorkflow requests graceful cancellation of a workflow execution.
 func (c *Client) CancelWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	ctx, cancel := c.callContext(ctx, "RequestCancelWorkflowExecution")
+	defer cancel()
 	return c.client.CancelWorkflow(ctx, workflowID, runID)
 }
 
 // TerminateWorkflow forcefully terminates a workflow execution immediately.
 func (c *Client) TerminateWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	ctx, cancel := c.callContext(ctx, "TerminateWorkflowExecution")
+	defer cancel()
 	return c.client.TerminateWorkflow(ctx, workflowID, runID, reason)
 }
 
 // SignalWorkflow sends a signal to a running workflow execution.
 func (c *Client) SignalWorkflow(ctx context.Context, namespace, workflowID, runID, signalName string, input []byte) error {
+	ctx, cancel := c.callContext(ctx, "SignalWorkflowExecution")
+	defer cancel()
 	return c.client.SignalWorkflow(ctx, workflowID, runID, signalName, input)
 }
 
@@ -1508,6 +2492,8 @@ func (c *Client) SignalWithStartWorkflow(ctx context.Context, namespace string,
 		TaskQueue: req.TaskQueue,
 	}
 
+	ctx, cancel := c.callContext(ctx, "SignalWithStartWorkflowExecution")
+	defer cancel()
 	run, err := c.client.SignalWithStartWorkflow(
 		ctx,
 		req.WorkflowID,
@@ -1523,6 +2509,78 @@ func (c *Client) SignalWithStartWorkflow(ctx context.Context, namespace string,
 	return run.GetRunID(), nil
 }
 
+// RerunWorkflow starts a new execution from the WorkflowExecutionStarted event of an
+// existing run, copying its timeouts, retry policy, memo, search attributes and headers.
+func (c *Client) RerunWorkflow(ctx context.Context, namespace, workflowID, runID string, opts RerunOptions) (string, string, error) {
+	if c.client == nil {
+		return "", "", fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		MaximumPageSize: 1,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get workflow history: %w", err)
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 || events[0].GetWorkflowExecutionStartedEventAttributes() == nil {
+		return "", "", fmt.Errorf("workflow start event not found")
+	}
+	started := events[0].GetWorkflowExecutionStartedEventAttributes()
+
+	req := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                namespace,
+		WorkflowId:               workflowID,
+		WorkflowType:             started.GetWorkflowType(),
+		TaskQueue:                started.GetTaskQueue(),
+		Input:                    started.GetInput(),
+		WorkflowExecutionTimeout: started.GetWorkflowExecutionTimeout(),
+		WorkflowRunTimeout:       started.GetWorkflowRunTimeout(),
+		WorkflowTaskTimeout:      started.GetWorkflowTaskTimeout(),
+		Identity:                 "tempo",
+		RequestId:                uuid.NewString(),
+		RetryPolicy:              started.GetRetryPolicy(),
+		Memo:                     started.GetMemo(),
+		SearchAttributes:         started.GetSearchAttributes(),
+		Header:                   started.GetHeader(),
+	}
+	if opts.WorkflowID != "" {
+		req.WorkflowId = opts.WorkflowID
+	}
+	if opts.TaskQueue != "" {
+		req.TaskQueue = &taskqueue.TaskQueue{Name: opts.TaskQueue, Kind: enums.TASK_QUEUE_KIND_NORMAL}
+	}
+	if opts.Args != nil {
+		var payloads []*commonpb.Payload
+		for _, arg := range opts.Args {
+			payloads = append(payloads, &commonpb.Payload{
+				Metadata: map[string][]byte{metadataEncoding: []byte(EncodingJSON)},
+				Data:     arg,
+			})
+		}
+		// Workers using a custom data converter expect the codec's encoding
+		c.mu.RLock()
+		codec := c.codec
+		c.mu.RUnlock()
+		payloads, err = encodePayloads(ctx, codec, namespace, payloads)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to encode input: %w", err)
+		}
+		req.Input = &commonpb.Payloads{Payloads: payloads}
+	}
+
+	startResp, err := c.client.WorkflowService().StartWorkflowExecution(ctx, req)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to start workflow: %w", err)
+	}
+	return req.WorkflowId, startResp.GetRunId(), nil
+}
+
 // DeleteWorkflow permanently deletes a workflow execution and its history.
 func (c *Client) DeleteWorkflow(ctx context.Context, namespace, workflowID, runID string) error {
 	_, err := c.client.WorkflowService().DeleteWorkflowExecution(ctx,
@@ -1537,16 +2595,46 @@ func (c *Client) DeleteWorkflow(ctx context.Context, namespace, workflowID, runI
 }
 
 // ResetWorkflow resets a workflow to a previous state, creating a new run.
-func (c *Client) ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, eventID int64, reason string) (string, error) {
-	resp, err := c.client.WorkflowService().ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
+func (c *Client) ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, opts ResetOptions) (string, error) {
+	req := &workflowservice.ResetWorkflowExecutionRequest{
 		Namespace: namespace,
 		WorkflowExecution: &commonpb.WorkflowExecution{
 			WorkflowId: workflowID,
 			RunId:      runID,
 		},
-		Reason:                    reason,
-		WorkflowTaskFinishEventId: eventID,
-	})
+		Reason:                    opts.Reason,
+		WorkflowTaskFinishEventId: opts.EventID,
+		RequestId:                 uuid.NewString(), // Shared by retries, so the server resets once
+	}
+
+	switch opts.ReapplyType {
+	case "":
+	case ResetReapplyAll:
+		req.ResetReapplyType = enums.RESET_REAPPLY_TYPE_ALL_ELIGIBLE
+	case ResetReapplySignal:
+		req.ResetReapplyType = enums.RESET_REAPPLY_TYPE_SIGNAL
+	case ResetReapplyNone:
+		req.ResetReapplyType = enums.RESET_REAPPLY_TYPE_NONE
+	default:
+		return "", fmt.Errorf("unknown reapply type %q", opts.ReapplyType)
+	}
+
+	for _, t := range opts.ExcludeTypes {
+		switch t {
+		case ResetExcludeSignal:
+			req.ResetReapplyExcludeTypes = append(req.ResetReapplyExcludeTypes, enums.RESET_REAPPLY_EXCLUDE_TYPE_SIGNAL)
+		case ResetExcludeUpdate:
+			req.ResetReapplyExcludeTypes = append(req.ResetReapplyExcludeTypes, enums.RESET_REAPPLY_EXCLUDE_TYPE_UPDATE)
+		case ResetExcludeNexus:
+			req.ResetReapplyExcludeTypes = append(req.ResetReapplyExcludeTypes, enums.RESET_REAPPLY_EXCLUDE_TYPE_NEXUS)
+		case ResetExcludeCancelRequest:
+			req.ResetReapplyExcludeTypes = append(req.ResetReapplyExcludeTypes, enums.RESET_REAPPLY_EXCLUDE_TYPE_CANCEL_REQUEST)
+		default:
+			return "", fmt.Errorf("unknown reapply exclude type %q", t)
+		}
+	}
+
+	resp, err := c.client.WorkflowService().ResetWorkflowExecution(ctx, req)
 	if err != nil {
 		return "", err
 	}
@@ -1632,6 +2720,12 @@ func (c *Client) GetSchedule(ctx context.Context, namespace, scheduleID string)
 	// Extract spec info
 	if desc.Schedule.Spec != nil {
 		schedule.Spec = formatScheduleSpec(desc.Schedule.Spec)
+		schedule.EditableSpec = editableScheduleSpec(desc.Schedule.Spec)
+	}
+	if policy := desc.Schedule.Policy; policy != nil {
+		schedule.OverlapPolicy = overlapPolicyName(policy.Overlap)
+		schedule.CatchupWindow = policy.CatchupWindow
+		schedule.PauseOnFailure = policy.PauseOnFailure
 	}
 
 	// Info from description
@@ -1645,10 +2739,118 @@ func (c *Client) GetSchedule(ctx context.Context, namespace, scheduleID string)
 		t := desc.Info.NextActionTimes[0]
 		schedule.NextRunTime = &t
 	}
+	schedule.UpcomingRuns = desc.Info.NextActionTimes
+	for i := len(desc.Info.RecentActions) - 1; i >= 0; i-- {
+		action := desc.Info.RecentActions[i]
+		if action.StartWorkflowResult == nil {
+			continue
+		}
+		schedule.Actions = append(schedule.Actions, ScheduleAction{
+			ScheduleTime: action.ScheduleTime,
+			ActualTime:   action.ActualTime,
+			WorkflowID:   action.StartWorkflowResult.WorkflowID,
+			RunID:        action.StartWorkflowResult.FirstExecutionRunID,
+		})
+	}
 
 	return schedule, nil
 }
 
+// CreateSchedule creates a schedule that starts a workflow.
+func (c *Client) CreateSchedule(ctx context.Context, namespace string, req ScheduleCreateRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	spec, err := scheduleSpec(req.Spec)
+	if err != nil {
+		return err
+	}
+
+	workflowID := req.WorkflowID
+	if workflowID == "" {
+		workflowID = req.ScheduleID
+	}
+
+	_, err = c.client.ScheduleClient().Create(ctx, client.ScheduleOptions{
+		ID:   req.ScheduleID,
+		Spec: spec,
+		Action: &client.ScheduleWorkflowAction{
+			ID:        workflowID,
+			Workflow:  req.WorkflowType,
+			Args:      decodeJSONArgs(req.Input),
+			TaskQueue: req.TaskQueue,
+		},
+		Overlap:        overlapPolicy(req.OverlapPolicy),
+		CatchupWindow:  req.CatchupWindow,
+		PauseOnFailure: req.PauseOnFailure,
+		Note:           req.Notes,
+		Paused:         req.Paused,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create schedule: %w", err)
+	}
+	return nil
+}
+
+// UpdateSchedule replaces the spec and policies of an existing schedule, keeping its
+// action and state.
+func (c *Client) UpdateSchedule(ctx context.Context, namespace string, req ScheduleUpdateRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	handle := c.client.ScheduleClient().GetHandle(ctx, req.ScheduleID)
+	err := handle.Update(ctx, client.ScheduleUpdateOptions{
+		DoUpdate: func(input client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+			schedule := input.Description.Schedule
+			if req.Spec != "" {
+				spec, err := scheduleSpec(req.Spec)
+				if err != nil {
+					return nil, err
+				}
+				// Keep the time zone, jitter and start/end bounds of the current spec
+				if schedule.Spec != nil {
+					spec.Jitter = schedule.Spec.Jitter
+					spec.TimeZoneName = schedule.Spec.TimeZoneName
+					spec.StartAt = schedule.Spec.StartAt
+					spec.EndAt = schedule.Spec.EndAt
+				}
+				schedule.Spec = &spec
+			}
+			if schedule.Policy == nil {
+				schedule.Policy = &client.SchedulePolicies{}
+			}
+			schedule.Policy.Overlap = overlapPolicy(req.OverlapPolicy)
+			schedule.Policy.CatchupWindow = req.CatchupWindow
+			schedule.Policy.PauseOnFailure = req.PauseOnFailure
+			return &client.ScheduleUpdate{Schedule: &schedule}, nil
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+	return nil
+}
+
+// BackfillSchedule runs the actions a schedule would have taken between start and end.
+func (c *Client) BackfillSchedule(ctx context.Context, namespace, scheduleID string, start, end time.Time, overlapPolicyName string) error {
+	if !end.After(start) {
+		return fmt.Errorf("backfill end must be after start")
+	}
+	handle := c.client.ScheduleClient().GetHandle(ctx, scheduleID)
+	err := handle.Backfill(ctx, client.ScheduleBackfillOptions{
+		Backfill: []client.ScheduleBackfill{{
+			Start:   start,
+			End:     end,
+			Overlap: overlapPolicy(overlapPolicyName),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to backfill schedule: %w", err)
+	}
+	return nil
+}
+
 // PauseSchedule pauses a schedule.
 func (c *Client) PauseSchedule(ctx context.Context, namespace, scheduleID, reason string) error {
 	handle := c.client.ScheduleClient().GetHandle(ctx, scheduleID)
@@ -1720,6 +2922,8 @@ func (c *Client) QueryWorkflow(ctx context.Context, namespace, workflowID, runID
 	}
 
 	// Execute the query
+	ctx, cancel := c.callContext(ctx, "QueryWorkflow")
+	defer cancel()
 	response, err := c.client.QueryWorkflow(ctx, workflowID, runID, queryType, queryArgs)
 	if err != nil {
 		return &QueryResult{
@@ -1752,6 +2956,293 @@ func (c *Client) QueryWorkflow(ctx context.Context, namespace, workflowID, runID
 	}, nil
 }
 
+// GetWorkflowFailure summarizes how a closed workflow ended. Only the close event
+// is fetched, so this is cheap even for long histories.
+func (c *Client) GetWorkflowFailure(ctx context.Context, namespace, workflowID, runID string) (*FailureSummary, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		HistoryEventFilterType: enums.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get close event: %w", err)
+	}
+
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return nil, fmt.Errorf("workflow has not closed")
+	}
+	event := events[len(events)-1]
+	c.decodeEvents(ctx, namespace, []*historypb.HistoryEvent{event})
+
+	summary := &FailureSummary{
+		EventID: event.GetEventId(),
+		Time:    event.GetEventTime().AsTime(),
+	}
+	switch event.GetEventType() {
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		attrs := event.GetWorkflowExecutionFailedEventAttributes()
+		summary.Status = "Failed"
+		summary.RetryState = formatRetryState(attrs.GetRetryState())
+		summary.Causes = failureChain(attrs.GetFailure())
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		attrs := event.GetWorkflowExecutionTimedOutEventAttributes()
+		summary.Status = "TimedOut"
+		summary.RetryState = formatRetryState(attrs.GetRetryState())
+		summary.Causes = []FailureCause{{
+			Type:    "WorkflowTimeout",
+			Message: "Workflow exceeded its execution or run timeout",
+		}}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		attrs := event.GetWorkflowExecutionTerminatedEventAttributes()
+		summary.Status = "Terminated"
+		summary.Identity = attrs.GetIdentity()
+		summary.Causes = []FailureCause{{
+			Type:    "Terminated",
+			Message: attrs.GetReason(),
+		}}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		summary.Status = "Canceled"
+
+	default:
+		summary.Status = formatEventType(event.GetEventType().String())
+	}
+
+	return summary, nil
+}
+
+// GetWorkflowMetadata runs the built-in metadata query against a workflow.
+func (c *Client) GetWorkflowMetadata(ctx context.Context, namespace, workflowID, runID string) (*WorkflowMetadata, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.WorkflowService().QueryWorkflow(ctx, &workflowservice.QueryWorkflowRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Query: &querypb.WorkflowQuery{QueryType: metadataQueryType},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query workflow metadata: %w", err)
+	}
+
+	payloads := resp.GetQueryResult().GetPayloads()
+	if len(payloads) == 0 {
+		return &WorkflowMetadata{}, nil
+	}
+
+	c.mu.RLock()
+	codec := c.codec
+	c.mu.RUnlock()
+	if codec != nil {
+		decoded, err := codec.Decode(ctx, namespace, payloads)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode workflow metadata: %w", err)
+		}
+		payloads = decoded
+	}
+
+	md, err := decodeWorkflowMetadata(payloads[0])
+	if err != nil {
+		return nil, err
+	}
+
+	result := &WorkflowMetadata{CurrentDetails: md.GetCurrentDetails()}
+	for _, q := range md.GetDefinition().GetQueryDefinitions() {
+		result.QueryTypes = append(result.QueryTypes, q.GetName())
+	}
+	for _, s := range md.GetDefinition().GetSignalDefinitions() {
+		result.SignalTypes = append(result.SignalTypes, s.GetName())
+	}
+	for _, u := range md.GetDefinition().GetUpdateDefinitions() {
+		result.UpdateTypes = append(result.UpdateTypes, u.GetName())
+	}
+	return result, nil
+}
+
+// UpdateWorkflow sends an update to a running workflow and waits for it to complete.
+func (c *Client) UpdateWorkflow(ctx context.Context, namespace, workflowID, runID, updateName string, args []byte) (*UpdateResult, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	ctx, cancel := c.callContext(ctx, "UpdateWorkflowExecution")
+	defer cancel()
+	handle, err := c.client.UpdateWorkflow(ctx, client.UpdateWorkflowOptions{
+		WorkflowID:   workflowID,
+		RunID:        runID,
+		UpdateName:   updateName,
+		Args:         decodeJSONArgs(args),
+		WaitForStage: client.WorkflowUpdateStageCompleted,
+	})
+	if err != nil {
+		return &UpdateResult{
+			UpdateName: updateName,
+			Error:      err.Error(),
+		}, nil
+	}
+
+	return awaitUpdateResult(ctx, handle, updateName), nil
+}
+
+// UpdateWithStartWorkflow starts a workflow (or uses the running one) and delivers an update to it.
+func (c *Client) UpdateWithStartWorkflow(ctx context.Context, namespace string, req UpdateWithStartRequest) (*UpdateResult, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	startOp := c.client.NewWithStartWorkflowOperation(client.StartWorkflowOptions{
+		ID:                       req.WorkflowID,
+		TaskQueue:                req.TaskQueue,
+		WorkflowIDConflictPolicy: enums.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+	}, req.WorkflowType, decodeJSONArgs(req.WorkflowInput)...)
+
+	ctx, cancel := c.callContext(ctx, "ExecuteMultiOperation")
+	defer cancel()
+	handle, err := c.client.UpdateWithStartWorkflow(ctx, client.UpdateWithStartWorkflowOptions{
+		StartWorkflowOperation: startOp,
+		UpdateOptions: client.UpdateWorkflowOptions{
+			WorkflowID:   req.WorkflowID,
+			UpdateName:   req.UpdateName,
+			Args:         decodeJSONArgs(req.UpdateInput),
+			WaitForStage: client.WorkflowUpdateStageCompleted,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update with start workflow: %w", err)
+	}
+
+	return awaitUpdateResult(ctx, handle, req.UpdateName), nil
+}
+
+// awaitUpdateResult waits for an update to finish and formats its outcome.
+// Rejections and handler failures are reported in UpdateResult.Error.
+func awaitUpdateResult(ctx context.Context, handle client.WorkflowUpdateHandle, updateName string) *UpdateResult {
+	result := &UpdateResult{
+		UpdateID:   handle.UpdateID(),
+		UpdateName: updateName,
+		RunID:      handle.RunID(),
+	}
+
+	var value interface{}
+	if err := handle.Get(ctx, &value); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	resultJSON, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		result.Result = fmt.Sprintf("%v", value)
+		return result
+	}
+	result.Result = string(resultJSON)
+	return result
+}
+
+// decodeJSONArgs converts JSON-encoded input into a single SDK argument.
+// Input that is not valid JSON is passed as a raw string.
+func decodeJSONArgs(data []byte) []interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	var arg interface{}
+	if err := json.Unmarshal(data, &arg); err != nil {
+		arg = string(data)
+	}
+	return []interface{}{arg}
+}
+
+// ListWorkflowUpdates returns the updates recorded in a workflow's history.
+func (c *Client) ListWorkflowUpdates(ctx context.Context, namespace, workflowID, runID string) ([]WorkflowUpdate, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	var updates []WorkflowUpdate
+	index := make(map[string]int)
+	track := func(updateID string, eventID int64, eventTime time.Time) *WorkflowUpdate {
+		if i, ok := index[updateID]; ok {
+			return &updates[i]
+		}
+		index[updateID] = len(updates)
+		updates = append(updates, WorkflowUpdate{
+			UpdateID:     updateID,
+			EventID:      eventID,
+			AdmittedTime: eventTime,
+		})
+		return &updates[len(updates)-1]
+	}
+
+	var nextPageToken []byte
+	for {
+		resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workflow history: %w", err)
+		}
+
+		c.decodeEvents(ctx, namespace, resp.GetHistory().GetEvents())
+		for _, event := range resp.GetHistory().GetEvents() {
+			eventTime := event.GetEventTime().AsTime()
+			switch event.GetEventType() {
+			case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ADMITTED:
+				req := event.GetWorkflowExecutionUpdateAdmittedEventAttributes().GetRequest()
+				u := track(req.GetMeta().GetUpdateId(), event.GetEventId(), eventTime)
+				u.Name = req.GetInput().GetName()
+				u.Input = formatPayloads(req.GetInput().GetArgs())
+				u.Identity = req.GetMeta().GetIdentity()
+				u.Status = UpdateStatusAdmitted
+
+			case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
+				req := event.GetWorkflowExecutionUpdateAcceptedEventAttributes().GetAcceptedRequest()
+				u := track(req.GetMeta().GetUpdateId(), event.GetEventId(), eventTime)
+				u.Name = req.GetInput().GetName()
+				u.Input = formatPayloads(req.GetInput().GetArgs())
+				u.Identity = req.GetMeta().GetIdentity()
+				u.Status = UpdateStatusAccepted
+
+			case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:
+				attrs := event.GetWorkflowExecutionUpdateCompletedEventAttributes()
+				u := track(attrs.GetMeta().GetUpdateId(), event.GetEventId(), eventTime)
+				u.CompletedTime = &eventTime
+				if failure := attrs.GetOutcome().GetFailure(); failure != nil {
+					u.Status = StatusFailed
+					u.Failure = failure.GetMessage()
+				} else {
+					u.Status = StatusCompleted
+					u.Result = formatPayloads(attrs.GetOutcome().GetSuccess())
+				}
+			}
+		}
+
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	return updates, nil
+}
+
 // CancelWorkflows cancels multiple workflows and returns results for each.
 func (c *Client) CancelWorkflows(ctx context.Context, namespace string, workflows []WorkflowIdentifier) ([]BatchResult, error) {
 	results := make([]BatchResult, len(workflows))
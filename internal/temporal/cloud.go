@@ -0,0 +1,68 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// CloudRegions lists the Temporal Cloud regions that accept API key connections,
+// in display order. IDs match the region names shown in the Temporal Cloud UI.
+var CloudRegions = []string{
+	"aws-us-east-1",
+	"aws-us-east-2",
+	"aws-us-west-2",
+	"aws-ca-central-1",
+	"aws-sa-east-1",
+	"aws-eu-central-1",
+	"aws-eu-west-1",
+	"aws-eu-west-2",
+	"aws-ap-northeast-1",
+	"aws-ap-south-1",
+	"aws-ap-southeast-1",
+	"aws-ap-southeast-2",
+	"gcp-us-central1",
+	"gcp-us-east4",
+	"gcp-us-west1",
+	"gcp-europe-west3",
+	"gcp-asia-south1",
+}
+
+// CloudRegionAddress returns the API key endpoint for a Temporal Cloud region,
+// e.g. "aws-us-east-1" maps to "us-east-1.aws.api.temporal.io:7233".
+func CloudRegionAddress(region string) (string, error) {
+	cloud, name, ok := strings.Cut(region, "-")
+	if !ok || (cloud != "aws" && cloud != "gcp") || name == "" {
+		return "", fmt.Errorf("unknown Temporal Cloud region %q", region)
+	}
+	return fmt.Sprintf("%s.%s.api.temporal.io:7233", name, cloud), nil
+}
+
+// CloudRegionForAddress returns the region whose API key endpoint is address,
+// or an empty string if address is not a regional endpoint.
+func CloudRegionForAddress(address string) string {
+	for _, region := range CloudRegions {
+		if regionAddress, _ := CloudRegionAddress(region); regionAddress == address {
+			return region
+		}
+	}
+	return ""
+}
+
+// namespaceHeaderInterceptor sets the temporal-namespace header on requests that
+// don't carry a namespace of their own (e.g. ListNamespaces). Temporal Cloud's
+// regional endpoints route every call by this header; the SDK only sets it for
+// requests with a namespace field.
+func namespaceHeaderInterceptor(namespace string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := req.(interface{ GetNamespace() string }); !ok && namespace != "" {
+			if md, _ := metadata.FromOutgoingContext(ctx); len(md.Get("temporal-namespace")) == 0 {
+				ctx = metadata.AppendToOutgoingContext(ctx, "temporal-namespace", namespace)
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,334 @@
+package temporal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// codecRequestTimeout bounds a single round trip to a remote codec server.
+const codecRequestTimeout = 10 * time.Second
+
+// PayloadCodec decodes payloads that were encoded by a custom data converter
+// (e.g., encrypted or compressed) so they can be rendered.
+type PayloadCodec interface {
+	Decode(ctx context.Context, namespace string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error)
+}
+
+// PayloadEncoder is implemented by codecs that can also encode payloads, so
+// edited input reaches workers in the form their data converter expects.
+type PayloadEncoder interface {
+	Encode(ctx context.Context, namespace string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error)
+}
+
+// RemoteCodec decodes payloads with a remote codec server, using the same
+// HTTP protocol as the Temporal Web UI and CLI.
+type RemoteCodec struct {
+	endpoint      string
+	auth          string
+	passNamespace bool
+	httpClient    *http.Client
+}
+
+// NewRemoteCodec creates a codec that POSTs payloads to endpoint + "/decode".
+// auth is sent verbatim as the Authorization header when non-empty.
+// When passNamespace is true the namespace is sent in the X-Namespace header.
+func NewRemoteCodec(endpoint, auth string, passNamespace bool) *RemoteCodec {
+	return &RemoteCodec{
+		endpoint:      strings.TrimRight(endpoint, "/"),
+		auth:          auth,
+		passNamespace: passNamespace,
+		httpClient:    &http.Client{Timeout: codecRequestTimeout},
+	}
+}
+
+// Decode sends payloads to the codec server and returns the decoded payloads.
+func (r *RemoteCodec) Decode(ctx context.Context, namespace string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	return r.post(ctx, "/decode", namespace, payloads)
+}
+
+// Encode sends payloads to the codec server and returns the encoded payloads.
+func (r *RemoteCodec) Encode(ctx context.Context, namespace string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	return r.post(ctx, "/encode", namespace, payloads)
+}
+
+// post runs payloads through one of the codec server's endpoints.
+func (r *RemoteCodec) post(ctx context.Context, path, namespace string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	body, err := protojson.Marshal(&commonpb.Payloads{Payloads: payloads})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode codec request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create codec request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.auth != "" {
+		req.Header.Set("Authorization", r.auth)
+	}
+	if r.passNamespace && namespace != "" {
+		req.Header.Set("X-Namespace", namespace)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("codec server request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read codec response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("codec server returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+
+	var result commonpb.Payloads
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse codec response: %w", err)
+	}
+	if len(result.GetPayloads()) != len(payloads) {
+		return nil, fmt.Errorf("codec server returned %d payloads, expected %d", len(result.GetPayloads()), len(payloads))
+	}
+	return result.GetPayloads(), nil
+}
+
+// PluginCodec decodes payloads by invoking an external executable.
+//
+// The plugin receives a single JSON request on stdin:
+//
+//	{"namespace": "default", "payloads": [{"metadata": {"encoding": "<base64>"}, "data": "<base64>"}]}
+//
+// and must write a JSON response to stdout with the same number of payloads,
+// in the same order, using the same encoding as the request:
+//
+//	{"payloads": [...]}
+//
+// A non-empty "error" field or a non-zero exit status fails the request.
+type PluginCodec struct {
+	command string
+	args    []string
+}
+
+// pluginMessage is the stdin/stdout envelope exchanged with decoder plugins.
+type pluginMessage struct {
+	Namespace string            `json:"namespace,omitempty"`
+	Payloads  []json.RawMessage `json:"payloads"`
+	Error     string            `json:"error,omitempty"`
+}
+
+// NewPluginCodec creates a codec that runs command with args for each batch of payloads.
+func NewPluginCodec(command string, args []string) *PluginCodec {
+	return &PluginCodec{
+		command: command,
+		args:    args,
+	}
+}
+
+// Decode runs the plugin and returns the payloads it decoded.
+func (p *PluginCodec) Decode(ctx context.Context, namespace string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	req := pluginMessage{Namespace: namespace}
+	for _, payload := range payloads {
+		raw, err := protojson.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode plugin request: %w", err)
+		}
+		req.Payloads = append(req.Payloads, raw)
+	}
+	input, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode plugin request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, codecRequestTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.command, p.args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("decoder plugin %s failed: %w: %s", p.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var resp pluginMessage
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse plugin response: %w", err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("decoder plugin %s: %s", p.command, resp.Error)
+	}
+	if len(resp.Payloads) != len(payloads) {
+		return nil, fmt.Errorf("decoder plugin returned %d payloads, expected %d", len(resp.Payloads), len(payloads))
+	}
+
+	decoded := make([]*commonpb.Payload, len(resp.Payloads))
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+	for i, raw := range resp.Payloads {
+		decoded[i] = &commonpb.Payload{}
+		if err := unmarshal.Unmarshal(raw, decoded[i]); err != nil {
+			return nil, fmt.Errorf("failed to parse plugin payload %d: %w", i, err)
+		}
+	}
+	return decoded, nil
+}
+
+// chainCodec applies several codecs in order, feeding each the previous output.
+type chainCodec []PayloadCodec
+
+// Decode runs payloads through every codec in the chain.
+func (c chainCodec) Decode(ctx context.Context, namespace string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	var err error
+	for _, codec := range c {
+		payloads, err = codec.Decode(ctx, namespace, payloads)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return payloads, nil
+}
+
+// Encode runs payloads through every codec in the chain in reverse, undoing the
+// order Decode applies them in. Every codec must be able to encode.
+func (c chainCodec) Encode(ctx context.Context, namespace string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	var err error
+	for i := len(c) - 1; i >= 0; i-- {
+		payloads, err = encodePayloads(ctx, c[i], namespace, payloads)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return payloads, nil
+}
+
+// encodePayloads runs payloads through codec's encoder. Without a codec they are
+// returned as they are; a codec that only decodes (such as a decoder plugin) fails.
+func encodePayloads(ctx context.Context, codec PayloadCodec, namespace string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	if codec == nil || len(payloads) == 0 {
+		return payloads, nil
+	}
+	encoder, ok := codec.(PayloadEncoder)
+	if !ok {
+		return nil, fmt.Errorf("the configured codec can't encode payloads; a decoder plugin only decodes")
+	}
+	encoded, err := encoder.Encode(ctx, namespace, payloads)
+	if err != nil {
+		return nil, err
+	}
+	if len(encoded) != len(payloads) {
+		return nil, fmt.Errorf("codec returned %d payloads, expected %d", len(encoded), len(payloads))
+	}
+	return encoded, nil
+}
+
+// newPayloadCodec builds the codec configured for a connection, or nil if none.
+// When both are configured, the remote codec server runs before the decoder plugin.
+func newPayloadCodec(cfg ConnectionConfig) PayloadCodec {
+	var codecs chainCodec
+	if cfg.CodecEndpoint != "" {
+		codecs = append(codecs, NewRemoteCodec(cfg.CodecEndpoint, cfg.CodecAuth, cfg.CodecPassNamespace))
+	}
+	if cfg.CodecPlugin != "" {
+		codecs = append(codecs, NewPluginCodec(cfg.CodecPlugin, cfg.CodecPluginArgs))
+	}
+
+	switch len(codecs) {
+	case 0:
+		return nil
+	case 1:
+		return codecs[0]
+	default:
+		return codecs
+	}
+}
+
+// decodeHistoryPayloads runs every payload in events through codec, replacing
+// them in place. Events are left untouched if decoding fails.
+func decodeHistoryPayloads(ctx context.Context, codec PayloadCodec, namespace string, events []*historypb.HistoryEvent) error {
+	if codec == nil || len(events) == 0 {
+		return nil
+	}
+
+	var payloads []*commonpb.Payload
+	for _, event := range events {
+		collectPayloadRefs(event.ProtoReflect(), &payloads)
+	}
+	if len(payloads) == 0 {
+		return nil
+	}
+
+	decoded, err := codec.Decode(ctx, namespace, payloads)
+	if err != nil {
+		return err
+	}
+	if len(decoded) != len(payloads) {
+		return fmt.Errorf("codec returned %d payloads, expected %d", len(decoded), len(payloads))
+	}
+
+	for i, p := range payloads {
+		if decoded[i] == nil {
+			continue
+		}
+		proto.Reset(p)
+		proto.Merge(p, decoded[i])
+	}
+	return nil
+}
+
+// markCodecError records a failed decode on every payload of an event, so the
+// payload view can say why they are still encoded.
+func markCodecError(he *EnhancedHistoryEvent, err error) {
+	if err == nil {
+		return
+	}
+	for i := range he.Payloads {
+		he.Payloads[i].CodecError = err.Error()
+	}
+}
+
+// collectPayloadRefs appends pointers to every Payload nested in msg.
+func collectPayloadRefs(msg protoreflect.Message, out *[]*commonpb.Payload) {
+	if msg.Descriptor().FullName() == payloadFullName {
+		if p, ok := msg.Interface().(*commonpb.Payload); ok {
+			*out = append(*out, p)
+		}
+		return
+	}
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					collectPayloadRefs(mv.Message(), out)
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Kind() == protoreflect.MessageKind {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					collectPayloadRefs(list.Get(i).Message(), out)
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			collectPayloadRefs(v.Message(), out)
+		}
+		return true
+	})
+}
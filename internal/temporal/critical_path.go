@@ -0,0 +1,112 @@
+package temporal
+
+import "time"
+
+// CriticalPath is the chain of activities, timers and child workflows that
+// determined a workflow's total duration.
+type CriticalPath struct {
+	Nodes []*EventTreeNode   // Nodes on the path, in chronological order
+	Lanes []CriticalPathLane // Time on the path per lane name, in order of first appearance
+	Total time.Duration      // Workflow duration covered by the analysis
+	Other time.Duration      // Time on the path not spent in any node (workflow tasks, idle)
+	set   map[*EventTreeNode]bool
+}
+
+// CriticalPathLane is the time a lane contributed to the critical path.
+type CriticalPathLane struct {
+	Name     string
+	Duration time.Duration
+	Count    int // Number of nodes with this name on the path
+}
+
+// Contains reports whether node is on the critical path.
+func (cp *CriticalPath) Contains(node *EventTreeNode) bool {
+	return cp != nil && cp.set[node]
+}
+
+// ComputeCriticalPath walks back from the end of the workflow, repeatedly taking
+// the node that finished last before the current point, until the workflow start
+// is reached. Nodes still running are treated as ending at now.
+func ComputeCriticalPath(nodes []*EventTreeNode, now time.Time) *CriticalPath {
+	var start, end time.Time
+	var candidates []*EventTreeNode
+	for _, node := range nodes {
+		switch node.Type {
+		case GroupWorkflow:
+			if node.EndTime == nil {
+				start = node.StartTime
+			} else {
+				end = *node.EndTime
+			}
+		case GroupActivity, GroupTimer, GroupChildWorkflow:
+			if !node.StartTime.IsZero() {
+				candidates = append(candidates, node)
+			}
+		}
+	}
+	if end.IsZero() {
+		end = now
+	}
+
+	nodeEnd := func(n *EventTreeNode) time.Time {
+		if n.EndTime == nil {
+			return end
+		}
+		return *n.EndTime
+	}
+
+	cp := &CriticalPath{set: make(map[*EventTreeNode]bool)}
+	cursor := end
+	for {
+		var best *EventTreeNode
+		for _, c := range candidates {
+			if cp.set[c] || nodeEnd(c).After(cursor) {
+				continue
+			}
+			if best == nil || nodeEnd(c).After(nodeEnd(best)) ||
+				(nodeEnd(c).Equal(nodeEnd(best)) && c.StartTime.Before(best.StartTime)) {
+				best = c
+			}
+		}
+		if best == nil {
+			break
+		}
+		cp.set[best] = true
+		cp.Nodes = append(cp.Nodes, best)
+		if best.StartTime.Before(cursor) {
+			cursor = best.StartTime
+		}
+	}
+
+	// Walked backwards; present the path in chronological order
+	for i, j := 0, len(cp.Nodes)-1; i < j; i, j = i+1, j-1 {
+		cp.Nodes[i], cp.Nodes[j] = cp.Nodes[j], cp.Nodes[i]
+	}
+
+	if start.IsZero() && len(cp.Nodes) > 0 {
+		start = cp.Nodes[0].StartTime
+	}
+	if !start.IsZero() && end.After(start) {
+		cp.Total = end.Sub(start)
+	}
+
+	index := make(map[string]int)
+	var onPath time.Duration
+	for _, n := range cp.Nodes {
+		d := nodeEnd(n).Sub(n.StartTime)
+		onPath += d
+		i, ok := index[n.Name]
+		if !ok {
+			i = len(cp.Lanes)
+			index[n.Name] = i
+			cp.Lanes = append(cp.Lanes, CriticalPathLane{Name: n.Name})
+		}
+		cp.Lanes[i].Duration += d
+		cp.Lanes[i].Count++
+	}
+	if cp.Total > onPath {
+		cp.Other = cp.Total - onPath
+	}
+
+	return cp
+}
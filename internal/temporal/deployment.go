@@ -0,0 +1,56 @@
+package temporal
+
+import (
+	"strings"
+	"time"
+)
+
+// WorkerDeployment is a named set of worker versions the server routes workflows
+// between: new workflows go to the current version, and a percentage of them to
+// the ramping version.
+type WorkerDeployment struct {
+	Name           string
+	CreateTime     time.Time
+	CurrentBuildID string // Empty when the current version is unversioned workers
+	RampingBuildID string // Empty when no version is ramping
+	RampPercentage float32
+	LatestBuildID  string
+	LastModifier   string
+	// Versions are only set by DescribeWorkerDeployment
+	Versions []WorkerDeploymentVersion
+	// Token from the last read, used to reject changes made in the meantime
+	ConflictToken []byte
+}
+
+// Worker deployment version status.
+const (
+	DeploymentVersionCurrent  = "Current"
+	DeploymentVersionRamping  = "Ramping"
+	DeploymentVersionDraining = "Draining"
+	DeploymentVersionDrained  = "Drained"
+	DeploymentVersionInactive = "Inactive"
+)
+
+// WorkerDeploymentVersion is one Build ID of a worker deployment.
+type WorkerDeploymentVersion struct {
+	BuildID      string
+	Status       string // One of the DeploymentVersion constants
+	CreateTime   time.Time
+	CurrentSince time.Time // Zero unless current
+	RampingSince time.Time // Zero unless ramping
+}
+
+// SetCurrentVersionCommand returns the Temporal CLI command that makes buildID the
+// deployment's current version, or unversioned workers when buildID is empty.
+func SetCurrentVersionCommand(namespace, deploymentName, buildID string) string {
+	args := []string{"temporal", "worker", "deployment", "set-current-version",
+		"--namespace", shellQuote(namespace),
+		"--deployment-name", shellQuote(deploymentName)}
+	if buildID == "" {
+		args = append(args, "--unversioned")
+	} else {
+		args = append(args, "--build-id", shellQuote(buildID))
+	}
+	args = append(args, "--yes")
+	return strings.Join(args, " ")
+}
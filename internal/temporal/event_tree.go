@@ -42,6 +42,43 @@ func (g EventGroupType) String() string {
 	}
 }
 
+// EventGroupTypes lists every group type in display order.
+func EventGroupTypes() []EventGroupType {
+	return []EventGroupType{
+		GroupWorkflow,
+		GroupWorkflowTask,
+		GroupActivity,
+		GroupTimer,
+		GroupChildWorkflow,
+		GroupSignal,
+		GroupMarker,
+		GroupOther,
+	}
+}
+
+// ClassifyEvent returns the group an event type belongs to. BuildEventTree
+// groups events by it, so filters by group match the tree.
+func ClassifyEvent(eventType string) EventGroupType {
+	switch {
+	case eventType == "WorkflowExecutionSignaled":
+		return GroupSignal
+	case strings.HasPrefix(eventType, "WorkflowExecution"):
+		return GroupWorkflow
+	case strings.HasPrefix(eventType, "WorkflowTask"):
+		return GroupWorkflowTask
+	case strings.HasPrefix(eventType, "ActivityTask"):
+		return GroupActivity
+	case strings.HasPrefix(eventType, "Timer"):
+		return GroupTimer
+	case eventType == "StartChildWorkflowExecutionInitiated" || strings.HasPrefix(eventType, "ChildWorkflowExecution"):
+		return GroupChildWorkflow
+	case eventType == "MarkerRecorded":
+		return GroupMarker
+	default:
+		return GroupOther
+	}
+}
+
 // EventTreeNode represents a node in the event tree.
 type EventTreeNode struct {
 	Name      string                 // Display name (e.g., "Activity: ValidateOrder")
@@ -54,6 +91,13 @@ type EventTreeNode struct {
 	Children  []*EventTreeNode       // Child nodes (for attempts/nested)
 	Collapsed bool                   // UI state for expand/collapse
 	Attempts  int                    // Number of retry attempts
+	Attempt   int                    // Attempt number, for attempt child nodes
+	Identity  string                 // Worker that ran the attempt, if known
+	Failure   string                 // Failure that ended the attempt, if known
+
+	// Latencies of activity and workflow task groups; zero when not known
+	ScheduleToStart time.Duration // Time spent waiting in the task queue
+	StartToClose    time.Duration // Time spent running on the worker
 }
 
 // IsLeaf returns true if this node has no children.
@@ -103,6 +147,7 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 			continue
 		}
 
+		groupType := ClassifyEvent(ev.Type)
 		switch {
 		// Workflow start event
 		case ev.Type == "WorkflowExecutionStarted":
@@ -117,7 +162,7 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 			processed[ev.ID] = true
 
 		// Workflow terminal events
-		case strings.HasPrefix(ev.Type, "WorkflowExecution") && ev.Type != "WorkflowExecutionStarted" && ev.Type != "WorkflowExecutionSignaled":
+		case groupType == GroupWorkflow:
 			status := extractWorkflowStatus(ev.Type)
 			node := &EventTreeNode{
 				Name:      fmt.Sprintf("Workflow %s", status),
@@ -148,17 +193,13 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 			if group, ok := activityGroups[ev.ScheduledEventID]; ok {
 				group.Events = append(group.Events, ev)
 				group.Status = "Running"
+				group.EndTime = nil
 				if ev.Attempt > 1 {
+					// The wait before the final attempt includes retry backoff
 					group.Attempts = int(ev.Attempt)
-					// Create attempt child node
-					attemptNode := &EventTreeNode{
-						Name:      fmt.Sprintf("Attempt %d", ev.Attempt),
-						Type:      GroupActivity,
-						Status:    "Running",
-						StartTime: ev.Time,
-						Events:    []*EnhancedHistoryEvent{ev},
-					}
-					group.Children = append(group.Children, attemptNode)
+					addActivityAttempt(group, ev)
+				} else {
+					group.ScheduleToStart = ev.Time.Sub(group.StartTime)
 				}
 			}
 			processed[ev.ID] = true
@@ -171,6 +212,9 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 				group.Status = extractActivityStatus(ev.Type)
 				group.EndTime = &ev.Time
 				group.Duration = ev.Time.Sub(group.StartTime)
+				if started := groupEvent(group, "ActivityTaskStarted"); started != nil {
+					group.StartToClose = ev.Time.Sub(started.Time)
+				}
 
 				// Update attempt child if exists
 				if len(group.Children) > 0 {
@@ -179,6 +223,8 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 					lastAttempt.Status = group.Status
 					lastAttempt.EndTime = &ev.Time
 					lastAttempt.Duration = ev.Time.Sub(lastAttempt.StartTime)
+					lastAttempt.StartToClose = lastAttempt.Duration
+					lastAttempt.Failure = ev.Failure
 				}
 			}
 			processed[ev.ID] = true
@@ -232,7 +278,7 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 			processed[ev.ID] = true
 
 		// Child Workflow terminal events
-		case strings.HasPrefix(ev.Type, "ChildWorkflowExecution") && ev.Type != "ChildWorkflowExecutionStarted":
+		case groupType == GroupChildWorkflow:
 			if group, ok := childWfGroups[ev.InitiatedEventID]; ok {
 				group.Events = append(group.Events, ev)
 				group.Status = extractChildWorkflowStatus(ev.Type)
@@ -259,6 +305,7 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 			if group, ok := wfTaskGroups[ev.ScheduledEventID]; ok {
 				group.Events = append(group.Events, ev)
 				group.Status = "Running"
+				group.ScheduleToStart = ev.Time.Sub(group.StartTime)
 			}
 			processed[ev.ID] = true
 
@@ -269,11 +316,14 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 				group.Status = extractWorkflowTaskStatus(ev.Type)
 				group.EndTime = &ev.Time
 				group.Duration = ev.Time.Sub(group.StartTime)
+				if started := groupEvent(group, "WorkflowTaskStarted"); started != nil {
+					group.StartToClose = ev.Time.Sub(started.Time)
+				}
 			}
 			processed[ev.ID] = true
 
 		// Signal events
-		case ev.Type == "WorkflowExecutionSignaled":
+		case groupType == GroupSignal:
 			node := &EventTreeNode{
 				Name:      "Signal Received",
 				Type:      GroupSignal,
@@ -286,7 +336,7 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 			processed[ev.ID] = true
 
 		// Marker events
-		case ev.Type == "MarkerRecorded":
+		case groupType == GroupMarker:
 			node := &EventTreeNode{
 				Name:      "Marker",
 				Type:      GroupMarker,
@@ -298,12 +348,28 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 			rootNodes = append(rootNodes, node)
 			processed[ev.ID] = true
 
-		// Other unhandled events
+		// Other events join the group they refer to, e.g. ActivityTaskCancelRequested
+		// its activity, or stand alone in their category
 		default:
+			var group *EventTreeNode
+			switch groupType {
+			case GroupActivity:
+				group = activityGroups[ev.ScheduledEventID]
+			case GroupWorkflowTask:
+				group = wfTaskGroups[ev.ScheduledEventID]
+			case GroupTimer:
+				group = timerGroups[ev.StartedEventID]
+			case GroupChildWorkflow:
+				group = childWfGroups[ev.InitiatedEventID]
+			}
+			if group != nil {
+				group.Events = append(group.Events, ev)
+				processed[ev.ID] = true
+			}
 			if !processed[ev.ID] {
 				node := &EventTreeNode{
 					Name:      ev.Type,
-					Type:      GroupOther,
+					Type:      groupType,
 					Status:    "Unknown",
 					StartTime: ev.Time,
 					Events:    []*EnhancedHistoryEvent{ev},
@@ -317,6 +383,60 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 	return rootNodes
 }
 
+// addActivityAttempt adds a child node for the activity attempt started by ev.
+// History only records the start of the final attempt, so the attempts before it
+// are summarized in one node that spans from scheduling (or the previous known
+// attempt) to the final start, including retry backoff, with the last failure.
+func addActivityAttempt(group *EventTreeNode, ev *EnhancedHistoryEvent) {
+	first := 1
+	start := group.StartTime
+	if n := len(group.Children); n > 0 {
+		last := group.Children[n-1]
+		first = last.Attempt + 1
+		if last.EndTime != nil {
+			start = *last.EndTime
+		}
+	}
+
+	if prev := int(ev.Attempt) - 1; first <= prev {
+		name := fmt.Sprintf("Attempt %d", first)
+		if first < prev {
+			name = fmt.Sprintf("Attempts %d-%d", first, prev)
+		}
+		end := ev.Time
+		group.Children = append(group.Children, &EventTreeNode{
+			Name:      name,
+			Type:      GroupActivity,
+			Status:    "Failed",
+			StartTime: start,
+			EndTime:   &end,
+			Duration:  end.Sub(start),
+			Attempt:   prev,
+			Failure:   ev.Failure,
+		})
+	}
+
+	group.Children = append(group.Children, &EventTreeNode{
+		Name:      fmt.Sprintf("Attempt %d", ev.Attempt),
+		Type:      GroupActivity,
+		Status:    "Running",
+		StartTime: ev.Time,
+		Events:    []*EnhancedHistoryEvent{ev},
+		Attempt:   int(ev.Attempt),
+		Identity:  ev.Identity,
+	})
+}
+
+// groupEvent returns the last event of the given type in a group, or nil.
+func groupEvent(group *EventTreeNode, eventType string) *EnhancedHistoryEvent {
+	for i := len(group.Events) - 1; i >= 0; i-- {
+		if group.Events[i].Type == eventType {
+			return group.Events[i]
+		}
+	}
+	return nil
+}
+
 // extractWorkflowStatus extracts status from workflow terminal event type.
 func extractWorkflowStatus(eventType string) string {
 	switch eventType {
@@ -0,0 +1,91 @@
+package temporal
+
+import (
+	"encoding/json"
+
+	"go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+)
+
+// maxFailureChainDepth bounds how many causes are followed in a failure chain.
+const maxFailureChainDepth = 20
+
+// failureChain flattens a failure and its causes, outermost first.
+func failureChain(f *failurepb.Failure) []FailureCause {
+	var chain []FailureCause
+	for ; f != nil && len(chain) < maxFailureChainDepth; f = f.GetCause() {
+		cause := FailureCause{
+			Type:       failureType(f),
+			Message:    f.GetMessage(),
+			Source:     f.GetSource(),
+			StackTrace: f.GetStackTrace(),
+		}
+
+		// With failure encoding enabled, message and stack trace live in the
+		// (codec-decoded) encoded attributes
+		if attrs := f.GetEncodedAttributes(); attrs != nil {
+			var decoded struct {
+				Message    string `json:"message"`
+				StackTrace string `json:"stack_trace"`
+			}
+			if err := json.Unmarshal(attrs.GetData(), &decoded); err == nil {
+				if decoded.Message != "" {
+					cause.Message = decoded.Message
+				}
+				if decoded.StackTrace != "" {
+					cause.StackTrace = decoded.StackTrace
+				}
+			}
+		}
+
+		if info := f.GetApplicationFailureInfo(); info != nil {
+			cause.NonRetryable = info.GetNonRetryable()
+		}
+		if info := f.GetActivityFailureInfo(); info != nil {
+			cause.ActivityType = info.GetActivityType().GetName()
+			cause.ActivityID = info.GetActivityId()
+			cause.Identity = info.GetIdentity()
+		}
+		chain = append(chain, cause)
+	}
+	return chain
+}
+
+// failureType names the kind of failure, preferring the application error type.
+func failureType(f *failurepb.Failure) string {
+	switch {
+	case f.GetApplicationFailureInfo() != nil:
+		if t := f.GetApplicationFailureInfo().GetType(); t != "" {
+			return t
+		}
+		return "ApplicationFailure"
+	case f.GetTimeoutFailureInfo() != nil:
+		return "TimeoutFailure (" + f.GetTimeoutFailureInfo().GetTimeoutType().String() + ")"
+	case f.GetCanceledFailureInfo() != nil:
+		return "CanceledFailure"
+	case f.GetTerminatedFailureInfo() != nil:
+		return "TerminatedFailure"
+	case f.GetServerFailureInfo() != nil:
+		return "ServerFailure"
+	case f.GetResetWorkflowFailureInfo() != nil:
+		return "ResetWorkflowFailure"
+	case f.GetActivityFailureInfo() != nil:
+		return "ActivityFailure"
+	case f.GetChildWorkflowExecutionFailureInfo() != nil:
+		return "ChildWorkflowFailure"
+	case f.GetNexusOperationExecutionFailureInfo() != nil:
+		return "NexusOperationFailure"
+	case f.GetNexusHandlerFailureInfo() != nil:
+		return "NexusHandlerFailure"
+	default:
+		return "Failure"
+	}
+}
+
+// formatRetryState returns a display name for a retry state, or "" if unspecified.
+func formatRetryState(state enums.RetryState) string {
+	if state == enums.RETRY_STATE_UNSPECIFIED {
+		return ""
+	}
+	return state.String()
+}
@@ -0,0 +1,893 @@
+package temporal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MemoryProvider implements the Provider interface over a snapshot held in
+// memory. It backs the mock and file backends. Reads are served from the
+// snapshot, and simple mutations (cancel, terminate, delete, pause) change it
+// in memory only; operations that need workers to run code, such as queries,
+// updates and resets, return ErrUnsupported.
+type MemoryProvider struct {
+	snapshot  *Snapshot
+	config    ConnectionConfig
+	connected bool
+	mu        sync.RWMutex
+}
+
+// NewMemoryProvider returns a provider serving snapshot.
+func NewMemoryProvider(config ConnectionConfig, snapshot *Snapshot) *MemoryProvider {
+	if snapshot == nil {
+		snapshot = NewSnapshot()
+	}
+	return &MemoryProvider{
+		snapshot:  snapshot,
+		config:    config,
+		connected: true,
+	}
+}
+
+// unsupported returns the error of an operation the backend cannot perform.
+func unsupported(operation string) error {
+	return fmt.Errorf("%s: %w", operation, ErrUnsupported)
+}
+
+// Namespace Operations
+
+// ListNamespaces returns the snapshot's namespaces.
+func (m *MemoryProvider) ListNamespaces(ctx context.Context) ([]Namespace, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	namespaces := make([]Namespace, len(m.snapshot.Namespaces))
+	for i, ns := range m.snapshot.Namespaces {
+		namespaces[i] = ns.Namespace
+	}
+	return namespaces, nil
+}
+
+// CreateNamespace adds a namespace.
+func (m *MemoryProvider) CreateNamespace(ctx context.Context, req NamespaceCreateRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.namespace(req.Name) != nil {
+		return fmt.Errorf("namespace %s already exists", req.Name)
+	}
+	now := time.Now()
+	m.snapshot.putNamespace(NamespaceDetail{
+		Namespace: Namespace{
+			Name:            req.Name,
+			State:           "Active",
+			RetentionPeriod: formatRetention(req.RetentionDays),
+			Description:     req.Description,
+			OwnerEmail:      req.OwnerEmail,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	return nil
+}
+
+// DescribeNamespace returns a namespace of the snapshot.
+func (m *MemoryProvider) DescribeNamespace(ctx context.Context, name string) (*NamespaceDetail, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ns := m.namespace(name)
+	if ns == nil {
+		return nil, fmt.Errorf("namespace %s not found", name)
+	}
+	detail := *ns
+	return &detail, nil
+}
+
+// UpdateNamespace changes a namespace's description, owner and retention.
+func (m *MemoryProvider) UpdateNamespace(ctx context.Context, req NamespaceUpdateRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ns := m.namespace(req.Name)
+	if ns == nil {
+		return fmt.Errorf("namespace %s not found", req.Name)
+	}
+	ns.Description = req.Description
+	ns.OwnerEmail = req.OwnerEmail
+	if req.RetentionDays > 0 {
+		ns.RetentionPeriod = formatRetention(req.RetentionDays)
+	}
+	ns.UpdatedAt = time.Now()
+	return nil
+}
+
+// DeprecateNamespace marks a namespace deprecated.
+func (m *MemoryProvider) DeprecateNamespace(ctx context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ns := m.namespace(name)
+	if ns == nil {
+		return fmt.Errorf("namespace %s not found", name)
+	}
+	ns.State = "Deprecated"
+	ns.UpdatedAt = time.Now()
+	return nil
+}
+
+// DeleteNamespace removes a deprecated namespace and its data.
+func (m *MemoryProvider) DeleteNamespace(ctx context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ns := m.namespace(name)
+	if ns == nil {
+		return fmt.Errorf("namespace %s not found", name)
+	}
+	if ns.State != "Deprecated" {
+		return fmt.Errorf("namespace %s must be deprecated before it is deleted", name)
+	}
+	for i := range m.snapshot.Namespaces {
+		if m.snapshot.Namespaces[i].Name == name {
+			m.snapshot.Namespaces = append(m.snapshot.Namespaces[:i], m.snapshot.Namespaces[i+1:]...)
+			break
+		}
+	}
+	delete(m.snapshot.Workflows, name)
+	delete(m.snapshot.TaskQueues, name)
+	delete(m.snapshot.Schedules, name)
+	return nil
+}
+
+// FailoverNamespace makes cluster the active cluster of a global namespace.
+func (m *MemoryProvider) FailoverNamespace(ctx context.Context, name, cluster string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ns := m.namespace(name)
+	if ns == nil {
+		return fmt.Errorf("namespace %s not found", name)
+	}
+	for _, c := range ns.Clusters {
+		if c == cluster {
+			ns.ActiveCluster = cluster
+			return nil
+		}
+	}
+	return fmt.Errorf("cluster %s is not a cluster of namespace %s", cluster, name)
+}
+
+// namespace returns the namespace named name, or nil. The caller holds the lock.
+func (m *MemoryProvider) namespace(name string) *NamespaceDetail {
+	for i := range m.snapshot.Namespaces {
+		if m.snapshot.Namespaces[i].Name == name {
+			return &m.snapshot.Namespaces[i]
+		}
+	}
+	return nil
+}
+
+// formatRetention formats a retention period like the server reports it.
+func formatRetention(days int) string {
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
+
+// Workflow Operations
+
+// queryClause matches an equality clause of a visibility query, e.g.
+// WorkflowType = 'OrderWorkflow'.
+var queryClause = regexp.MustCompile(`(\w+)\s*=\s*(?:'([^']*)'|"([^"]*)")`)
+
+// matchesQuery reports whether wf matches the equality clauses of a visibility
+// query on WorkflowId, WorkflowType, ExecutionStatus and TaskQueue, taken as
+// joined by AND. Other clauses are ignored.
+func matchesQuery(wf Workflow, query string) bool {
+	for _, m := range queryClause.FindAllStringSubmatch(query, -1) {
+		value := m[2] + m[3]
+		switch m[1] {
+		case "WorkflowId":
+			if wf.ID != value {
+				return false
+			}
+		case "WorkflowType":
+			if wf.Type != value {
+				return false
+			}
+		case "ExecutionStatus":
+			if wf.Status != value {
+				return false
+			}
+		case "TaskQueue":
+			if wf.TaskQueue != value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ListWorkflows returns the namespace's workflow runs that match the query,
+// newest first. The page token is the offset of the next page.
+func (m *MemoryProvider) ListWorkflows(ctx context.Context, namespace string, opts ListOptions) ([]Workflow, string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var matched []Workflow
+	for _, wf := range m.snapshot.Workflows[namespace] {
+		if matchesQuery(wf, opts.Query) {
+			matched = append(matched, wf)
+		}
+	}
+
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+	offset, _ := strconv.Atoi(opts.PageToken)
+	if offset >= len(matched) {
+		return nil, "", nil
+	}
+	end := min(offset+pageSize, len(matched))
+	next := ""
+	if end < len(matched) {
+		next = strconv.Itoa(end)
+	}
+	return append([]Workflow(nil), matched[offset:end]...), next, nil
+}
+
+// CountWorkflows returns the number of workflow runs that match the query.
+func (m *MemoryProvider) CountWorkflows(ctx context.Context, namespace, query string) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var count int64
+	for _, wf := range m.snapshot.Workflows[namespace] {
+		if matchesQuery(wf, query) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// GetWorkflow returns a workflow run, or the latest run if runID is empty.
+func (m *MemoryProvider) GetWorkflow(ctx context.Context, namespace, workflowID, runID string) (*Workflow, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	wf := m.workflow(namespace, workflowID, runID)
+	if wf == nil {
+		return nil, fmt.Errorf("workflow %s not found", workflowID)
+	}
+	found := *wf
+	return &found, nil
+}
+
+// ListWorkflowRuns returns every run of a workflow ID, newest first.
+func (m *MemoryProvider) ListWorkflowRuns(ctx context.Context, namespace, workflowID string) ([]Workflow, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var runs []Workflow
+	for _, wf := range m.snapshot.Workflows[namespace] {
+		if wf.ID == workflowID {
+			runs = append(runs, wf)
+		}
+	}
+	return runs, nil
+}
+
+// GetWorkflowAncestry follows the parents of a workflow run, root first.
+func (m *MemoryProvider) GetWorkflowAncestry(ctx context.Context, namespace, workflowID, runID string) ([]Workflow, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	wf := m.workflow(namespace, workflowID, runID)
+	if wf == nil {
+		return nil, fmt.Errorf("workflow %s not found", workflowID)
+	}
+
+	var ancestry []Workflow
+	for depth := 0; wf.ParentID != nil && depth < 100; depth++ {
+		parent := m.workflow(namespace, *wf.ParentID, wf.ParentRunID)
+		if parent == nil {
+			break
+		}
+		ancestry = append([]Workflow{*parent}, ancestry...)
+		wf = parent
+	}
+	return ancestry, nil
+}
+
+// workflow returns a workflow run, or the latest run if runID is empty, or
+// nil. The caller holds the lock.
+func (m *MemoryProvider) workflow(namespace, workflowID, runID string) *Workflow {
+	runs := m.snapshot.Workflows[namespace]
+	for i := range runs {
+		if runs[i].ID == workflowID && (runID == "" || runs[i].RunID == runID) {
+			return &runs[i]
+		}
+	}
+	return nil
+}
+
+// history returns the events of a workflow run. Histories recorded without a
+// run ID stand in for the latest run. The caller holds the lock.
+func (m *MemoryProvider) history(namespace, workflowID, runID string) ([]EnhancedHistoryEvent, error) {
+	if events, ok := m.snapshot.Histories[historyKey(namespace, workflowID, runID)]; ok {
+		return events, nil
+	}
+	if wf := m.workflow(namespace, workflowID, runID); wf != nil {
+		if events, ok := m.snapshot.Histories[historyKey(namespace, workflowID, wf.RunID)]; ok {
+			return events, nil
+		}
+		if latest := m.workflow(namespace, workflowID, ""); latest == wf {
+			if events, ok := m.snapshot.Histories[historyKey(namespace, workflowID, "")]; ok {
+				return events, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("history of workflow %s not found", workflowID)
+}
+
+// GetWorkflowHistory returns the events of a workflow run.
+func (m *MemoryProvider) GetWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]HistoryEvent, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	enhanced, err := m.history(namespace, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]HistoryEvent, len(enhanced))
+	for i, ev := range enhanced {
+		events[i] = HistoryEvent{ID: ev.ID, Type: ev.Type, Time: ev.Time, Details: ev.Details}
+	}
+	return events, nil
+}
+
+// StreamWorkflowHistory passes the whole history as a single page.
+func (m *MemoryProvider) StreamWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, onPage func([]EnhancedHistoryEvent)) error {
+	events, err := m.GetEnhancedWorkflowHistory(ctx, namespace, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	onPage(events)
+	return nil
+}
+
+// TailWorkflowHistory passes the events after afterEventID, then waits for the
+// context to end if the workflow is still running, since a snapshot never
+// gets new events.
+func (m *MemoryProvider) TailWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, afterEventID int64, _ *TailCursor, onEvents func([]EnhancedHistoryEvent)) error {
+	events, err := m.GetEnhancedWorkflowHistory(ctx, namespace, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	var newer []EnhancedHistoryEvent
+	for _, ev := range events {
+		if ev.ID > afterEventID {
+			newer = append(newer, ev)
+		}
+	}
+	if len(newer) > 0 {
+		onEvents(newer)
+	}
+
+	wf, err := m.GetWorkflow(ctx, namespace, workflowID, runID)
+	if err != nil || wf.Status != "Running" {
+		return err
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// GetHistoryEvent returns one event, with its fields as the raw JSON.
+func (m *MemoryProvider) GetHistoryEvent(ctx context.Context, namespace, workflowID, runID string, eventID int64) (*HistoryEventDetail, error) {
+	events, err := m.GetEnhancedWorkflowHistory(ctx, namespace, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ev := range events {
+		if ev.ID == eventID {
+			raw, err := json.MarshalIndent(ev, "", "  ")
+			if err != nil {
+				return nil, err
+			}
+			return &HistoryEventDetail{Event: ev, RawJSON: string(raw)}, nil
+		}
+	}
+	return nil, fmt.Errorf("event %d not found", eventID)
+}
+
+// ExportWorkflowHistory is not supported: snapshots don't keep the raw events
+// the SDK replayer needs.
+func (m *MemoryProvider) ExportWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]byte, error) {
+	return nil, unsupported("export history")
+}
+
+// GetEnhancedWorkflowHistory returns the events of a workflow run.
+func (m *MemoryProvider) GetEnhancedWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]EnhancedHistoryEvent, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	events, err := m.history(namespace, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return append([]EnhancedHistoryEvent(nil), events...), nil
+}
+
+// Task Queue Operations
+
+// DescribeTaskQueue returns a task queue of the snapshot and its pollers.
+func (m *MemoryProvider) DescribeTaskQueue(ctx context.Context, namespace, taskQueue string) (*TaskQueueInfo, []Poller, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, tq := range m.snapshot.TaskQueues[namespace] {
+		if tq.Info.Name == taskQueue {
+			info := tq.Info
+			return &info, append([]Poller(nil), tq.Pollers...), nil
+		}
+	}
+	return &TaskQueueInfo{Name: taskQueue}, nil, nil
+}
+
+// GetVersioningRules returns no rules.
+func (m *MemoryProvider) GetVersioningRules(ctx context.Context, namespace, taskQueue string) (*VersioningRules, error) {
+	return &VersioningRules{}, nil
+}
+
+// UpdateVersioningRules is not supported.
+func (m *MemoryProvider) UpdateVersioningRules(ctx context.Context, namespace, taskQueue string, change VersioningRuleChange) (*VersioningRules, error) {
+	return nil, unsupported("update versioning rules")
+}
+
+// GetBuildIDReachability is not supported.
+func (m *MemoryProvider) GetBuildIDReachability(ctx context.Context, namespace, taskQueue, buildID string) (*BuildIDReachability, error) {
+	return nil, unsupported("build ID reachability")
+}
+
+// ListWorkerDeployments returns no deployments.
+func (m *MemoryProvider) ListWorkerDeployments(ctx context.Context, namespace string) ([]WorkerDeployment, error) {
+	return nil, nil
+}
+
+// DescribeWorkerDeployment reports every deployment as missing.
+func (m *MemoryProvider) DescribeWorkerDeployment(ctx context.Context, namespace, name string) (*WorkerDeployment, error) {
+	return nil, fmt.Errorf("worker deployment %s not found", name)
+}
+
+// SetWorkerDeploymentCurrentVersion is not supported.
+func (m *MemoryProvider) SetWorkerDeploymentCurrentVersion(ctx context.Context, namespace, name, buildID string, conflictToken []byte) error {
+	return unsupported("set current version")
+}
+
+// Nexus Endpoint Operations
+
+// ListNexusEndpoints returns the snapshot's Nexus endpoints.
+func (m *MemoryProvider) ListNexusEndpoints(ctx context.Context) ([]NexusEndpoint, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]NexusEndpoint(nil), m.snapshot.NexusEndpoints...), nil
+}
+
+// GetNexusEndpoint returns a Nexus endpoint by ID.
+func (m *MemoryProvider) GetNexusEndpoint(ctx context.Context, id string) (*NexusEndpoint, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if e := m.nexusEndpoint(id); e != nil {
+		endpoint := *e
+		return &endpoint, nil
+	}
+	return nil, fmt.Errorf("nexus endpoint %s not found", id)
+}
+
+// CreateNexusEndpoint adds a Nexus endpoint.
+func (m *MemoryProvider) CreateNexusEndpoint(ctx context.Context, req NexusEndpointRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	endpoint := NexusEndpoint{
+		ID:               fmt.Sprintf("endpoint-%d", now.UnixNano()),
+		Version:          1,
+		CreateTime:       now,
+		LastModifiedTime: now,
+	}
+	setNexusEndpointSpec(&endpoint, req)
+	m.snapshot.NexusEndpoints = append(m.snapshot.NexusEndpoints, endpoint)
+	return nil
+}
+
+// UpdateNexusEndpoint replaces a Nexus endpoint's spec.
+func (m *MemoryProvider) UpdateNexusEndpoint(ctx context.Context, id string, version int64, req NexusEndpointRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e := m.nexusEndpoint(id)
+	if e == nil {
+		return fmt.Errorf("nexus endpoint %s not found", id)
+	}
+	if e.Version != version {
+		return fmt.Errorf("nexus endpoint %s was changed since it was read", e.Name)
+	}
+	setNexusEndpointSpec(e, req)
+	e.Version++
+	e.LastModifiedTime = time.Now()
+	return nil
+}
+
+// DeleteNexusEndpoint removes a Nexus endpoint.
+func (m *MemoryProvider) DeleteNexusEndpoint(ctx context.Context, id string, version int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, e := range m.snapshot.NexusEndpoints {
+		if e.ID != id {
+			continue
+		}
+		if e.Version != version {
+			return fmt.Errorf("nexus endpoint %s was changed since it was read", e.Name)
+		}
+		m.snapshot.NexusEndpoints = append(m.snapshot.NexusEndpoints[:i], m.snapshot.NexusEndpoints[i+1:]...)
+		return nil
+	}
+	return fmt.Errorf("nexus endpoint %s not found", id)
+}
+
+// nexusEndpoint returns a Nexus endpoint by ID, or nil. The caller holds the lock.
+func (m *MemoryProvider) nexusEndpoint(id string) *NexusEndpoint {
+	for i := range m.snapshot.NexusEndpoints {
+		if m.snapshot.NexusEndpoints[i].ID == id {
+			return &m.snapshot.NexusEndpoints[i]
+		}
+	}
+	return nil
+}
+
+// setNexusEndpointSpec copies the spec of a request to an endpoint.
+func setNexusEndpointSpec(e *NexusEndpoint, req NexusEndpointRequest) {
+	e.Name = req.Name
+	e.Description = req.Description
+	e.TargetNamespace = req.TargetNamespace
+	e.TargetTaskQueue = req.TargetTaskQueue
+	e.TargetURL = req.TargetURL
+}
+
+// Connection Management
+
+// Close marks the provider disconnected.
+func (m *MemoryProvider) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.connected = false
+	return nil
+}
+
+// IsConnected returns true until the provider is closed.
+func (m *MemoryProvider) IsConnected() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.connected
+}
+
+// CheckConnection fails once the provider is closed.
+func (m *MemoryProvider) CheckConnection(ctx context.Context) error {
+	if !m.IsConnected() {
+		return fmt.Errorf("client not connected")
+	}
+	return nil
+}
+
+// Reconnect marks the provider connected.
+func (m *MemoryProvider) Reconnect(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.connected = true
+	return nil
+}
+
+// ReconnectWithConfig keeps the snapshot and takes the new configuration.
+func (m *MemoryProvider) ReconnectWithConfig(ctx context.Context, config ConnectionConfig) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.config = config
+	m.connected = true
+	return nil
+}
+
+// Config returns the connection configuration.
+func (m *MemoryProvider) Config() ConnectionConfig {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.config
+}
+
+// ServerVersion returns the server version the snapshot was taken from.
+func (m *MemoryProvider) ServerVersion(ctx context.Context) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.snapshot.ServerVersion, nil
+}
+
+// Workflow Mutations
+
+// CancelWorkflow marks a running workflow canceled.
+func (m *MemoryProvider) CancelWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	return m.closeWorkflow(namespace, workflowID, runID, "Canceled")
+}
+
+// TerminateWorkflow marks a running workflow terminated.
+func (m *MemoryProvider) TerminateWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	return m.closeWorkflow(namespace, workflowID, runID, "Terminated")
+}
+
+// closeWorkflow gives a running workflow a closed status.
+func (m *MemoryProvider) closeWorkflow(namespace, workflowID, runID, status string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	wf := m.workflow(namespace, workflowID, runID)
+	if wf == nil {
+		return fmt.Errorf("workflow %s not found", workflowID)
+	}
+	if wf.Status != "Running" {
+		return fmt.Errorf("workflow execution already completed")
+	}
+	now := time.Now()
+	wf.Status = status
+	wf.EndTime = &now
+	return nil
+}
+
+// SignalWorkflow accepts signals to running workflows and drops them.
+func (m *MemoryProvider) SignalWorkflow(ctx context.Context, namespace, workflowID, runID, signalName string, input []byte) error {
+	wf, err := m.GetWorkflow(ctx, namespace, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	if wf.Status != "Running" {
+		return fmt.Errorf("workflow execution already completed")
+	}
+	return nil
+}
+
+// SignalWithStartWorkflow is not supported.
+func (m *MemoryProvider) SignalWithStartWorkflow(ctx context.Context, namespace string, req SignalWithStartRequest) (string, error) {
+	return "", unsupported("signal with start")
+}
+
+// RerunWorkflow is not supported.
+func (m *MemoryProvider) RerunWorkflow(ctx context.Context, namespace, workflowID, runID string, opts RerunOptions) (string, string, error) {
+	return "", "", unsupported("rerun workflow")
+}
+
+// DeleteWorkflow removes a workflow run and its history.
+func (m *MemoryProvider) DeleteWorkflow(ctx context.Context, namespace, workflowID, runID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	wf := m.workflow(namespace, workflowID, runID)
+	if wf == nil {
+		return fmt.Errorf("workflow %s not found", workflowID)
+	}
+	delete(m.snapshot.Histories, historyKey(namespace, workflowID, wf.RunID))
+	runs := m.snapshot.Workflows[namespace]
+	for i := range runs {
+		if &runs[i] == wf {
+			m.snapshot.Workflows[namespace] = append(runs[:i], runs[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// ResetWorkflow is not supported.
+func (m *MemoryProvider) ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, opts ResetOptions) (string, error) {
+	return "", unsupported("reset workflow")
+}
+
+// Schedule Operations
+
+// ListSchedules returns the namespace's schedules.
+func (m *MemoryProvider) ListSchedules(ctx context.Context, namespace string, opts ListOptions) ([]Schedule, string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]Schedule(nil), m.snapshot.Schedules[namespace]...), "", nil
+}
+
+// GetSchedule returns a schedule by ID.
+func (m *MemoryProvider) GetSchedule(ctx context.Context, namespace, scheduleID string) (*Schedule, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if s := m.schedule(namespace, scheduleID); s != nil {
+		found := *s
+		return &found, nil
+	}
+	return nil, fmt.Errorf("schedule %s not found", scheduleID)
+}
+
+// CreateSchedule adds a schedule.
+func (m *MemoryProvider) CreateSchedule(ctx context.Context, namespace string, req ScheduleCreateRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.schedule(namespace, req.ScheduleID) != nil {
+		return fmt.Errorf("schedule %s already exists", req.ScheduleID)
+	}
+	workflowID := req.WorkflowID
+	if workflowID == "" {
+		workflowID = req.ScheduleID
+	}
+	m.snapshot.putSchedule(namespace, Schedule{
+		ID:             req.ScheduleID,
+		Spec:           req.Spec,
+		EditableSpec:   req.Spec,
+		WorkflowType:   req.WorkflowType,
+		WorkflowID:     workflowID,
+		TaskQueue:      req.TaskQueue,
+		Paused:         req.Paused,
+		Notes:          req.Notes,
+		OverlapPolicy:  req.OverlapPolicy,
+		CatchupWindow:  req.CatchupWindow,
+		PauseOnFailure: req.PauseOnFailure,
+	})
+	return nil
+}
+
+// UpdateSchedule changes a schedule's spec and policies.
+func (m *MemoryProvider) UpdateSchedule(ctx context.Context, namespace string, req ScheduleUpdateRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := m.schedule(namespace, req.ScheduleID)
+	if s == nil {
+		return fmt.Errorf("schedule %s not found", req.ScheduleID)
+	}
+	if req.Spec != "" {
+		s.Spec, s.EditableSpec = req.Spec, req.Spec
+	}
+	s.OverlapPolicy = req.OverlapPolicy
+	s.CatchupWindow = req.CatchupWindow
+	s.PauseOnFailure = req.PauseOnFailure
+	return nil
+}
+
+// BackfillSchedule is not supported.
+func (m *MemoryProvider) BackfillSchedule(ctx context.Context, namespace, scheduleID string, start, end time.Time, overlapPolicy string) error {
+	return unsupported("backfill schedule")
+}
+
+// PauseSchedule pauses a schedule.
+func (m *MemoryProvider) PauseSchedule(ctx context.Context, namespace, scheduleID, reason string) error {
+	return m.setSchedulePaused(namespace, scheduleID, true, reason)
+}
+
+// UnpauseSchedule unpauses a schedule.
+func (m *MemoryProvider) UnpauseSchedule(ctx context.Context, namespace, scheduleID, reason string) error {
+	return m.setSchedulePaused(namespace, scheduleID, false, reason)
+}
+
+// setSchedulePaused pauses or unpauses a schedule, keeping reason as its notes.
+func (m *MemoryProvider) setSchedulePaused(namespace, scheduleID string, paused bool, reason string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := m.schedule(namespace, scheduleID)
+	if s == nil {
+		return fmt.Errorf("schedule %s not found", scheduleID)
+	}
+	s.Paused = paused
+	if reason != "" {
+		s.Notes = reason
+	}
+	return nil
+}
+
+// TriggerSchedule is not supported.
+func (m *MemoryProvider) TriggerSchedule(ctx context.Context, namespace, scheduleID string) error {
+	return unsupported("trigger schedule")
+}
+
+// DeleteSchedule removes a schedule.
+func (m *MemoryProvider) DeleteSchedule(ctx context.Context, namespace, scheduleID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	schedules := m.snapshot.Schedules[namespace]
+	for i := range schedules {
+		if schedules[i].ID == scheduleID {
+			m.snapshot.Schedules[namespace] = append(schedules[:i], schedules[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("schedule %s not found", scheduleID)
+}
+
+// schedule returns a schedule by ID, or nil. The caller holds the lock.
+func (m *MemoryProvider) schedule(namespace, scheduleID string) *Schedule {
+	schedules := m.snapshot.Schedules[namespace]
+	for i := range schedules {
+		if schedules[i].ID == scheduleID {
+			return &schedules[i]
+		}
+	}
+	return nil
+}
+
+// Query Operations
+
+// QueryWorkflow is not supported: there are no workers to answer queries.
+func (m *MemoryProvider) QueryWorkflow(ctx context.Context, namespace, workflowID, runID, queryType string, args []byte) (*QueryResult, error) {
+	return nil, unsupported("query workflow")
+}
+
+// GetWorkflowFailure describes the close event of a workflow that did not
+// complete, from the last event of its history.
+func (m *MemoryProvider) GetWorkflowFailure(ctx context.Context, namespace, workflowID, runID string) (*FailureSummary, error) {
+	wf, err := m.GetWorkflow(ctx, namespace, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	if wf.Status == "Running" {
+		return nil, fmt.Errorf("workflow has not closed")
+	}
+	summary := &FailureSummary{Status: wf.Status}
+	if wf.EndTime != nil {
+		summary.Time = *wf.EndTime
+	}
+	if events, err := m.GetEnhancedWorkflowHistory(ctx, namespace, workflowID, runID); err == nil && len(events) > 0 {
+		last := events[len(events)-1]
+		summary.EventID, summary.Time, summary.Identity = last.ID, last.Time, last.Identity
+		if last.Failure != "" {
+			summary.Causes = []FailureCause{{Type: wf.Status, Message: last.Failure}}
+		}
+	}
+	return summary, nil
+}
+
+// GetWorkflowMetadata is not supported: there are no workers to answer the
+// metadata query.
+func (m *MemoryProvider) GetWorkflowMetadata(ctx context.Context, namespace, workflowID, runID string) (*WorkflowMetadata, error) {
+	return nil, unsupported("workflow metadata")
+}
+
+// Update Operations
+
+// UpdateWorkflow is not supported: there are no workers to handle updates.
+func (m *MemoryProvider) UpdateWorkflow(ctx context.Context, namespace, workflowID, runID, updateName string, args []byte) (*UpdateResult, error) {
+	return nil, unsupported("update workflow")
+}
+
+// ListWorkflowUpdates returns no updates.
+func (m *MemoryProvider) ListWorkflowUpdates(ctx context.Context, namespace, workflowID, runID string) ([]WorkflowUpdate, error) {
+	return nil, nil
+}
+
+// UpdateWithStartWorkflow is not supported.
+func (m *MemoryProvider) UpdateWithStartWorkflow(ctx context.Context, namespace string, req UpdateWithStartRequest) (*UpdateResult, error) {
+	return nil, unsupported("update with start")
+}
+
+// Batch Operations
+
+// CancelWorkflows cancels each workflow, reporting results per workflow.
+func (m *MemoryProvider) CancelWorkflows(ctx context.Context, namespace string, workflows []WorkflowIdentifier) ([]BatchResult, error) {
+	return m.batch(workflows, func(wf WorkflowIdentifier) error {
+		return m.CancelWorkflow(ctx, namespace, wf.WorkflowID, wf.RunID, "")
+	}), nil
+}
+
+// TerminateWorkflows terminates each workflow, reporting results per workflow.
+func (m *MemoryProvider) TerminateWorkflows(ctx context.Context, namespace string, workflows []WorkflowIdentifier, reason string) ([]BatchResult, error) {
+	return m.batch(workflows, func(wf WorkflowIdentifier) error {
+		return m.TerminateWorkflow(ctx, namespace, wf.WorkflowID, wf.RunID, reason)
+	}), nil
+}
+
+// batch runs op on each workflow.
+func (m *MemoryProvider) batch(workflows []WorkflowIdentifier, op func(WorkflowIdentifier) error) []BatchResult {
+	results := make([]BatchResult, len(workflows))
+	for i, wf := range workflows {
+		results[i] = BatchResult{WorkflowID: wf.WorkflowID, RunID: wf.RunID, Success: true}
+		if err := op(wf); err != nil {
+			results[i].Success = false
+			results[i].Error = err.Error()
+		}
+	}
+	return results
+}
+
+// Reset Operations
+
+// GetResetPoints returns no reset points, since resets are not supported.
+func (m *MemoryProvider) GetResetPoints(ctx context.Context, namespace, workflowID, runID string) ([]ResetPoint, error) {
+	return nil, nil
+}
@@ -0,0 +1,161 @@
+package temporal
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/mock"
+)
+
+// mockDetailField matches a "Name: value" field of a mock event's details.
+var mockDetailField = regexp.MustCompile(`(\w+): ([^,]+)`)
+
+// MockSnapshot returns the sample data served by the mock backend, with times
+// relative to now.
+func MockSnapshot(now time.Time) *Snapshot {
+	s := NewSnapshot()
+	s.ServerVersion = "mock"
+
+	for _, ns := range mock.Namespaces() {
+		s.putNamespace(NamespaceDetail{
+			Namespace: Namespace{
+				Name:            ns.Name,
+				State:           ns.State,
+				RetentionPeriod: ns.RetentionPeriod,
+			},
+			CreatedAt: now.Add(-30 * 24 * time.Hour),
+			UpdatedAt: now.Add(-24 * time.Hour),
+		})
+
+		for _, wf := range mock.Workflows(ns.Name) {
+			events := mockHistory(wf)
+			s.putWorkflow(ns.Name, Workflow{
+				ID:            wf.ID,
+				RunID:         wf.RunID,
+				Type:          wf.Type,
+				Status:        wf.Status,
+				Namespace:     ns.Name,
+				TaskQueue:     wf.TaskQueue,
+				StartTime:     wf.StartTime,
+				EndTime:       wf.EndTime,
+				ParentID:      wf.ParentID,
+				HistoryLength: int64(len(events)),
+			})
+			s.Histories[historyKey(ns.Name, wf.ID, wf.RunID)] = events
+		}
+
+		queues := make(map[string]*SnapshotTaskQueue)
+		var names []string
+		for _, tq := range mock.TaskQueues() {
+			q, ok := queues[tq.Name]
+			if !ok {
+				q = &SnapshotTaskQueue{Info: TaskQueueInfo{Name: tq.Name, Type: tq.Type}}
+				queues[tq.Name] = q
+				names = append(names, tq.Name)
+			}
+			q.Info.Backlog += tq.Backlog
+			q.Info.Stats = append(q.Info.Stats, TaskQueueStats{TaskQueueType: tq.Type, Backlog: tq.Backlog})
+			for _, p := range mock.Pollers(tq.Name) {
+				if p.TaskQueueType == tq.Type {
+					q.Pollers = append(q.Pollers, Poller{
+						Identity:       p.Identity,
+						LastAccessTime: p.LastAccessTime,
+						TaskQueueType:  p.TaskQueueType,
+					})
+				}
+			}
+		}
+		for _, name := range names {
+			q := queues[name]
+			q.Info.finishPollers(q.Pollers)
+			s.putTaskQueue(ns.Name, *q)
+		}
+	}
+	return s
+}
+
+// mockHistory returns the sample history of a mock workflow, with the
+// relations between events filled in from their details.
+func mockHistory(wf mock.Workflow) []EnhancedHistoryEvent {
+	source := mock.Events(wf.ID)
+	events := make([]EnhancedHistoryEvent, 0, len(source))
+	scheduled := make(map[string]int64) // Last scheduled event by kind, e.g. "ActivityTask"
+	started := make(map[int64]int64)    // Started event by scheduled event
+
+	for _, e := range source {
+		ev := EnhancedHistoryEvent{
+			ID:      e.ID,
+			Type:    e.Type,
+			Time:    e.Time,
+			Details: e.Details,
+		}
+		for _, m := range mockDetailField.FindAllStringSubmatch(e.Details, -1) {
+			value := strings.TrimSpace(m[2])
+			switch m[1] {
+			case "ScheduledEventId":
+				ev.ScheduledEventID, _ = strconv.ParseInt(value, 10, 64)
+			case "ActivityType":
+				ev.ActivityType = value
+			case "TaskQueue":
+				ev.TaskQueue = value
+			case "Identity":
+				ev.Identity = value
+			case "Attempt":
+				attempt, _ := strconv.Atoi(value)
+				ev.Attempt = int32(attempt)
+			case "TimerId":
+				ev.TimerID = value
+			case "WorkflowType":
+				if strings.Contains(e.Type, "Child") {
+					ev.ChildWorkflowType = value
+				}
+			case "WorkflowId":
+				if strings.Contains(e.Type, "Child") {
+					ev.ChildWorkflowID = value
+				}
+			case "RunId":
+				ev.ChildRunID = value
+			case "Failure":
+				ev.Failure = value
+			case "Result":
+				ev.Result = value
+			}
+		}
+
+		for _, kind := range []string{"ActivityTask", "WorkflowTask"} {
+			switch e.Type {
+			case kind + "Scheduled":
+				scheduled[kind] = e.ID
+			case kind + "Started":
+				ev.ScheduledEventID = scheduled[kind]
+				started[ev.ScheduledEventID] = e.ID
+			case kind + "Completed", kind + "Failed", kind + "TimedOut":
+				if ev.ScheduledEventID == 0 {
+					ev.ScheduledEventID = scheduled[kind]
+				}
+				ev.StartedEventID = started[ev.ScheduledEventID]
+			}
+		}
+		events = append(events, ev)
+	}
+
+	// Each mock workflow gets the same history; close it like the workflow
+	if wf.EndTime != nil && len(events) > 0 {
+		last := events[len(events)-1]
+		closed := EnhancedHistoryEvent{
+			ID:   last.ID + 1,
+			Type: "WorkflowExecution" + wf.Status,
+			Time: last.Time,
+		}
+		if wf.EndTime.After(last.Time) {
+			closed.Time = *wf.EndTime
+		}
+		if wf.Status == "Failed" {
+			closed.Failure = "activity task failed"
+		}
+		events = append(events, closed)
+	}
+	return events
+}
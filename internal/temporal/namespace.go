@@ -0,0 +1,11 @@
+package temporal
+
+import "strings"
+
+// NamespaceFailoverCommand returns the Temporal CLI command that makes cluster the
+// active cluster of a global namespace.
+func NamespaceFailoverCommand(namespace, cluster string) string {
+	return strings.Join([]string{"temporal", "operator", "namespace", "update",
+		"--namespace", shellQuote(namespace),
+		"--active-cluster", shellQuote(cluster)}, " ")
+}
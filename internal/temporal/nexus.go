@@ -0,0 +1,113 @@
+package temporal
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	nexuspb "go.temporal.io/api/nexus/v1"
+	"go.temporal.io/sdk/converter"
+)
+
+// NexusEndpoint is a Nexus endpoint registered with the cluster. Requests are routed
+// either to a worker task queue or to an external URL.
+type NexusEndpoint struct {
+	ID               string
+	Version          int64 // Must be passed back on update and delete
+	Name             string
+	Description      string
+	TargetNamespace  string // Worker target
+	TargetTaskQueue  string // Worker target
+	TargetURL        string // External target
+	URLPrefix        string // Path to call the endpoint on the server's HTTP API
+	CreateTime       time.Time
+	LastModifiedTime time.Time
+}
+
+// Target describes where the endpoint routes requests.
+func (e NexusEndpoint) Target() string {
+	if e.TargetURL != "" {
+		return e.TargetURL
+	}
+	return fmt.Sprintf("%s/%s", e.TargetNamespace, e.TargetTaskQueue)
+}
+
+// NexusEndpointRequest contains parameters for creating or updating a Nexus endpoint.
+// Set either the worker target or TargetURL.
+type NexusEndpointRequest struct {
+	Name            string
+	Description     string // Markdown
+	TargetNamespace string
+	TargetTaskQueue string
+	TargetURL       string
+}
+
+var nexusEndpointName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Validate checks the endpoint name and that exactly one target is set.
+func (r NexusEndpointRequest) Validate() error {
+	if !nexusEndpointName.MatchString(r.Name) {
+		return fmt.Errorf("endpoint name must start with a letter or underscore and contain only letters, digits and underscores")
+	}
+	worker := r.TargetNamespace != "" || r.TargetTaskQueue != ""
+	switch {
+	case worker && r.TargetURL != "":
+		return fmt.Errorf("set either a worker target or an external URL, not both")
+	case r.TargetURL != "":
+		return nil
+	case r.TargetNamespace == "" || r.TargetTaskQueue == "":
+		return fmt.Errorf("target namespace and task queue are required")
+	}
+	return nil
+}
+
+func (r NexusEndpointRequest) spec() (*nexuspb.EndpointSpec, error) {
+	spec := &nexuspb.EndpointSpec{Name: r.Name}
+	if r.Description != "" {
+		payload, err := converter.GetDefaultDataConverter().ToPayload(r.Description)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode description: %w", err)
+		}
+		spec.Description = payload
+	}
+	if r.TargetURL != "" {
+		spec.Target = &nexuspb.EndpointTarget{Variant: &nexuspb.EndpointTarget_External_{
+			External: &nexuspb.EndpointTarget_External{Url: r.TargetURL},
+		}}
+	} else {
+		spec.Target = &nexuspb.EndpointTarget{Variant: &nexuspb.EndpointTarget_Worker_{
+			Worker: &nexuspb.EndpointTarget_Worker{Namespace: r.TargetNamespace, TaskQueue: r.TargetTaskQueue},
+		}}
+	}
+	return spec, nil
+}
+
+func nexusEndpointFromProto(e *nexuspb.Endpoint) NexusEndpoint {
+	spec := e.GetSpec()
+	endpoint := NexusEndpoint{
+		ID:               e.GetId(),
+		Version:          e.GetVersion(),
+		Name:             spec.GetName(),
+		URLPrefix:        e.GetUrlPrefix(),
+		CreateTime:       optionalTime(e.GetCreatedTime()),
+		LastModifiedTime: optionalTime(e.GetLastModifiedTime()),
+		TargetURL:        spec.GetTarget().GetExternal().GetUrl(),
+	}
+	if worker := spec.GetTarget().GetWorker(); worker != nil {
+		endpoint.TargetNamespace = worker.GetNamespace()
+		endpoint.TargetTaskQueue = worker.GetTaskQueue()
+	}
+	if desc := spec.GetDescription(); desc != nil {
+		if err := converter.GetDefaultDataConverter().FromPayload(desc, &endpoint.Description); err != nil {
+			endpoint.Description = string(desc.GetData())
+		}
+	}
+	return endpoint
+}
+
+// NexusEndpointDeleteCommand returns the Temporal CLI command that deletes a Nexus endpoint.
+func NexusEndpointDeleteCommand(name string) string {
+	return strings.Join([]string{"temporal", "operator", "nexus", "endpoint", "delete",
+		"--name", shellQuote(name)}, " ")
+}
@@ -0,0 +1,272 @@
+package temporal
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	sdkpb "go.temporal.io/api/sdk/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Standard payload encodings written by the Temporal SDKs' default data converter.
+const (
+	EncodingJSON         = "json/plain"
+	EncodingJSONProtobuf = "json/protobuf"
+	EncodingProtobuf     = "binary/protobuf"
+	EncodingNull         = "binary/null"
+	EncodingBinary       = "binary/plain"
+)
+
+// metadataQueryType is the built-in query answered with temporal.api.sdk.v1.WorkflowMetadata.
+const metadataQueryType = "__temporal_workflow_metadata"
+
+const (
+	metadataEncoding     = "encoding"
+	metadataMessageType  = "messageType"
+	maxRawPayloadDisplay = 100
+	payloadFullName      = "temporal.api.common.v1.Payload"
+	payloadsFullName     = "temporal.api.common.v1.Payloads"
+)
+
+// Payload is a history payload decoded for display.
+type Payload struct {
+	Field       string // Attribute the payload came from (e.g., "input", "result")
+	Encoding    string // Value of the "encoding" metadata key
+	MessageType string // Protobuf message type, if any
+	Size        int    // Size of the raw data in bytes
+	Data        string // Display-ready data (JSON when decodable)
+	Decoded     bool   // Whether Data is a decoded value rather than an opaque summary
+	Truncated   bool   // Whether Data is only a preview because the payload exceeded the size limit
+	CodecError  string // Why the configured codec couldn't decode the payload, if it failed
+}
+
+// DecodePayload decodes a single payload using the standard encodings.
+// Unknown encodings are summarized rather than decoded.
+func DecodePayload(field string, p *commonpb.Payload) Payload {
+	metadata := p.GetMetadata()
+	data := p.GetData()
+	decoded := Payload{
+		Field:       field,
+		Encoding:    string(metadata[metadataEncoding]),
+		MessageType: string(metadata[metadataMessageType]),
+		Size:        len(data),
+	}
+
+	switch decoded.Encoding {
+	case EncodingNull:
+		decoded.Data = "null"
+		decoded.Decoded = true
+
+	case EncodingJSON, EncodingJSONProtobuf:
+		decoded.Data, decoded.Decoded = compactJSON(data)
+
+	case EncodingProtobuf:
+		decoded.Data, decoded.Decoded = decodeProtobuf(decoded.MessageType, data)
+
+	case EncodingBinary:
+		if utf8.Valid(data) {
+			decoded.Data = string(data)
+			decoded.Decoded = true
+		} else {
+			decoded.Data = base64.StdEncoding.EncodeToString(data)
+		}
+
+	case "":
+		// Payloads without metadata are usually JSON from older clients
+		decoded.Data, decoded.Decoded = compactJSON(data)
+
+	default:
+		decoded.Data = fmt.Sprintf("<%s: %s>", decoded.Encoding, FormatBytes(len(data)))
+	}
+
+	return decoded
+}
+
+// DecodePayloads decodes every payload in a Payloads message.
+func DecodePayloads(field string, payloads *commonpb.Payloads) []Payload {
+	var results []Payload
+	for _, p := range payloads.GetPayloads() {
+		if p == nil {
+			continue
+		}
+		results = append(results, DecodePayload(field, p))
+	}
+	return results
+}
+
+// FormatBytes formats a byte count for display.
+func FormatBytes(n int) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
+
+// compactJSON returns compact JSON for valid JSON data, or a truncated raw string.
+func compactJSON(data []byte) (string, bool) {
+	var jsonVal interface{}
+	if err := json.Unmarshal(data, &jsonVal); err == nil {
+		if b, err := json.Marshal(jsonVal); err == nil {
+			return string(b), true
+		}
+	}
+
+	s := string(data)
+	if len(s) > maxRawPayloadDisplay {
+		s = s[:maxRawPayloadDisplay] + "..."
+	}
+	return s, false
+}
+
+// decodeProtobuf decodes binary protobuf data as JSON when the message type is
+// linked into this binary (e.g., Temporal API types). Otherwise it is summarized.
+func decodeProtobuf(messageType string, data []byte) (string, bool) {
+	if messageType != "" {
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(messageType))
+		if err == nil {
+			msg := mt.New().Interface()
+			if err := proto.Unmarshal(data, msg); err == nil {
+				if b, err := protojson.Marshal(msg); err == nil {
+					return string(b), true
+				}
+			}
+		}
+		return fmt.Sprintf("<%s: %s>", messageType, FormatBytes(len(data))), false
+	}
+	return fmt.Sprintf("<protobuf: %s>", FormatBytes(len(data))), false
+}
+
+// truncateEvent trims payloads and details larger than limit bytes so large inputs
+// and results are not held for every event in a history. Use GetHistoryEvent to
+// fetch the full event. A limit of zero or less disables truncation.
+func truncateEvent(he *EnhancedHistoryEvent, limit int) {
+	if limit <= 0 {
+		return
+	}
+	for i := range he.Payloads {
+		p := &he.Payloads[i]
+		if p.Size <= limit {
+			continue
+		}
+		p.Data = truncateUTF8(p.Data, maxRawPayloadDisplay)
+		p.Decoded = false
+		p.Truncated = true
+		he.Truncated = true
+	}
+	if len(he.Details) > limit {
+		he.Details = truncateUTF8(he.Details, limit)
+		he.Truncated = true
+	}
+	if len(he.Result) > limit {
+		he.Result = truncateUTF8(he.Result, limit)
+		he.Truncated = true
+	}
+}
+
+// truncateUTF8 shortens s to at most n bytes without splitting a rune, adding an
+// ellipsis when anything was removed.
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + "..."
+}
+
+// extractEventPayloads collects every payload carried by a history event's attributes,
+// including nested ones such as failure details.
+func extractEventPayloads(event *historypb.HistoryEvent) []Payload {
+	msg := event.ProtoReflect()
+	oneof := msg.Descriptor().Oneofs().ByName("attributes")
+	if oneof == nil {
+		return nil
+	}
+	fd := msg.WhichOneof(oneof)
+	if fd == nil {
+		return nil
+	}
+
+	var payloads []Payload
+	collectPayloads(msg.Get(fd).Message(), "", &payloads)
+	return payloads
+}
+
+// collectPayloads walks a message and appends any Payload/Payloads fields it finds.
+func collectPayloads(msg protoreflect.Message, prefix string, out *[]Payload) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		// Headers carry propagated context (tracing etc.) rather than user data
+		if fd.Kind() != protoreflect.MessageKind || fd.Name() == "header" {
+			return true
+		}
+		name := string(fd.Name())
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() != protoreflect.MessageKind {
+				return true
+			}
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				collectPayloadValue(mv.Message(), name+"."+k.String(), out)
+				return true
+			})
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				collectPayloadValue(list.Get(i).Message(), fmt.Sprintf("%s[%d]", name, i), out)
+			}
+		default:
+			collectPayloadValue(v.Message(), name, out)
+		}
+		return true
+	})
+}
+
+// collectPayloadValue decodes msg if it is a payload type, otherwise recurses into it.
+func collectPayloadValue(msg protoreflect.Message, name string, out *[]Payload) {
+	switch msg.Descriptor().FullName() {
+	case payloadFullName:
+		if p, ok := msg.Interface().(*commonpb.Payload); ok {
+			*out = append(*out, DecodePayload(name, p))
+		}
+	case payloadsFullName:
+		if p, ok := msg.Interface().(*commonpb.Payloads); ok {
+			*out = append(*out, DecodePayloads(name, p)...)
+		}
+	default:
+		collectPayloads(msg, name, out)
+	}
+}
+
+// decodeWorkflowMetadata decodes the payload returned by the metadata query.
+func decodeWorkflowMetadata(p *commonpb.Payload) (*sdkpb.WorkflowMetadata, error) {
+	md := &sdkpb.WorkflowMetadata{}
+	switch enc := string(p.GetMetadata()[metadataEncoding]); enc {
+	case EncodingJSONProtobuf, EncodingJSON:
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(p.GetData(), md); err != nil {
+			return nil, fmt.Errorf("failed to decode workflow metadata: %w", err)
+		}
+	case EncodingProtobuf:
+		if err := proto.Unmarshal(p.GetData(), md); err != nil {
+			return nil, fmt.Errorf("failed to decode workflow metadata: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported workflow metadata encoding %q", enc)
+	}
+	return md, nil
+}
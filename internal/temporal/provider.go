@@ -2,11 +2,16 @@ package temporal
 
 import (
 	"context"
+	"sync"
 	"time"
 )
 
-// Provider defines the interface for Temporal data access.
-// This abstraction allows for different implementations (real SDK, mock, etc.)
+// Provider defines the interface for Temporal data access. Views only talk to
+// a Provider, never to a concrete backend, so the data can come from a server
+// (*Client), a snapshot file or sample data (*MemoryProvider), or a server
+// being recorded (*RecordingProvider). Open one with NewProvider; backends are
+// registered with RegisterBackend. A backend that cannot perform an operation
+// returns an error wrapping ErrUnsupported.
 type Provider interface {
 	// Namespace Operations
 
@@ -30,21 +35,93 @@ type Provider interface {
 	// The namespace must be deprecated first before it can be deleted.
 	DeleteNamespace(ctx context.Context, name string) error
 
+	// FailoverNamespace makes cluster the active cluster of a global namespace.
+	FailoverNamespace(ctx context.Context, name, cluster string) error
+
 	// ListWorkflows returns workflows for a namespace with optional filtering.
 	ListWorkflows(ctx context.Context, namespace string, opts ListOptions) ([]Workflow, string, error)
 
+	// CountWorkflows returns the number of workflows matching a visibility query.
+	CountWorkflows(ctx context.Context, namespace, query string) (int64, error)
+
 	// GetWorkflow returns details for a specific workflow execution.
 	GetWorkflow(ctx context.Context, namespace, workflowID, runID string) (*Workflow, error)
 
+	// ListWorkflowRuns returns every run recorded for a workflow ID (retries, resets,
+	// continue-as-new), newest first.
+	ListWorkflowRuns(ctx context.Context, namespace, workflowID string) ([]Workflow, error)
+
+	// GetWorkflowAncestry returns the parent chain of a workflow execution,
+	// ordered from the root workflow down to the immediate parent.
+	GetWorkflowAncestry(ctx context.Context, namespace, workflowID, runID string) ([]Workflow, error)
+
 	// GetWorkflowHistory returns the event history for a workflow execution.
 	GetWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]HistoryEvent, error)
 
+	// StreamWorkflowHistory fetches history page by page, calling onPage as each page
+	// arrives so large histories can be displayed before they finish loading.
+	StreamWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, onPage func([]EnhancedHistoryEvent)) error
+
+	// TailWorkflowHistory long-polls a workflow's history, calling onEvents with each batch
+	// of events newer than afterEventID. It returns nil once the workflow closes, or the
+	// context's error when canceled. A non-nil cursor resumes where an earlier tail of the
+	// run stopped and is advanced as the tail reads.
+	TailWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, afterEventID int64, cursor *TailCursor, onEvents func([]EnhancedHistoryEvent)) error
+
+	// GetHistoryEvent returns a single history event with its complete attributes.
+	GetHistoryEvent(ctx context.Context, namespace, workflowID, runID string, eventID int64) (*HistoryEventDetail, error)
+
+	// ExportWorkflowHistory returns the complete raw history as JSON in the same format as
+	// `temporal workflow show --output json`, suitable for the SDK replayer. Payloads are
+	// exported as stored, without codec decoding.
+	ExportWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]byte, error)
+
 	// GetEnhancedWorkflowHistory returns event history with relational data for tree/timeline views.
 	GetEnhancedWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]EnhancedHistoryEvent, error)
 
 	// DescribeTaskQueue returns task queue info and active pollers.
 	DescribeTaskQueue(ctx context.Context, namespace, taskQueue string) (*TaskQueueInfo, []Poller, error)
 
+	// GetVersioningRules returns the Build ID assignment and redirect rules of a task queue.
+	GetVersioningRules(ctx context.Context, namespace, taskQueue string) (*VersioningRules, error)
+
+	// UpdateVersioningRules applies a single change to a task queue's versioning rules and
+	// returns the updated rules. The change's ConflictToken must come from the rules it edits.
+	UpdateVersioningRules(ctx context.Context, namespace, taskQueue string, change VersioningRuleChange) (*VersioningRules, error)
+
+	// GetBuildIDReachability checks whether new, open or only closed workflows on a task
+	// queue may still run on a Build ID, to tell when its workers can be retired.
+	GetBuildIDReachability(ctx context.Context, namespace, taskQueue, buildID string) (*BuildIDReachability, error)
+
+	// ListWorkerDeployments returns the worker deployments in a namespace.
+	ListWorkerDeployments(ctx context.Context, namespace string) ([]WorkerDeployment, error)
+
+	// DescribeWorkerDeployment returns a worker deployment with all of its versions.
+	DescribeWorkerDeployment(ctx context.Context, namespace, name string) (*WorkerDeployment, error)
+
+	// SetWorkerDeploymentCurrentVersion routes new workflows of a deployment to a Build ID,
+	// or to unversioned workers when it is empty.
+	SetWorkerDeploymentCurrentVersion(ctx context.Context, namespace, name, buildID string, conflictToken []byte) error
+
+	// Nexus Endpoint Operations
+
+	// ListNexusEndpoints returns the cluster's Nexus endpoints sorted by name.
+	ListNexusEndpoints(ctx context.Context) ([]NexusEndpoint, error)
+
+	// GetNexusEndpoint returns a Nexus endpoint by ID.
+	GetNexusEndpoint(ctx context.Context, id string) (*NexusEndpoint, error)
+
+	// CreateNexusEndpoint registers a Nexus endpoint.
+	CreateNexusEndpoint(ctx context.Context, req NexusEndpointRequest) error
+
+	// UpdateNexusEndpoint replaces a Nexus endpoint's spec. The version must match
+	// the endpoint's current version.
+	UpdateNexusEndpoint(ctx context.Context, id string, version int64, req NexusEndpointRequest) error
+
+	// DeleteNexusEndpoint deletes a Nexus endpoint. The version must match the
+	// endpoint's current version.
+	DeleteNexusEndpoint(ctx context.Context, id string, version int64) error
+
 	// Close releases any resources held by the provider.
 	Close() error
 
@@ -65,6 +142,9 @@ type Provider interface {
 	// Config returns the connection configuration used by this provider.
 	Config() ConnectionConfig
 
+	// ServerVersion returns the version of the Temporal server, e.g. "1.24.2".
+	ServerVersion(ctx context.Context) (string, error)
+
 	// Workflow Mutations
 
 	// CancelWorkflow requests graceful cancellation of a workflow execution.
@@ -82,11 +162,15 @@ type Provider interface {
 	// Returns the run ID of the workflow.
 	SignalWithStartWorkflow(ctx context.Context, namespace string, req SignalWithStartRequest) (string, error)
 
+	// RerunWorkflow starts a new execution with the type, options and input of an
+	// existing run. Returns the workflow ID and run ID of the new execution.
+	RerunWorkflow(ctx context.Context, namespace, workflowID, runID string, opts RerunOptions) (string, string, error)
+
 	// DeleteWorkflow permanently deletes a workflow execution and its history.
 	DeleteWorkflow(ctx context.Context, namespace, workflowID, runID string) error
 
 	// ResetWorkflow resets a workflow to a previous state, creating a new run.
-	ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, eventID int64, reason string) (string, error)
+	ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, opts ResetOptions) (string, error)
 
 	// Schedule Operations
 
@@ -96,6 +180,16 @@ type Provider interface {
 	// GetSchedule returns details for a specific schedule.
 	GetSchedule(ctx context.Context, namespace, scheduleID string) (*Schedule, error)
 
+	// CreateSchedule creates a schedule that starts a workflow.
+	CreateSchedule(ctx context.Context, namespace string, req ScheduleCreateRequest) error
+
+	// UpdateSchedule replaces the spec and policies of an existing schedule.
+	UpdateSchedule(ctx context.Context, namespace string, req ScheduleUpdateRequest) error
+
+	// BackfillSchedule runs the actions a schedule would have taken between start and end,
+	// using the given overlap policy (empty for the schedule's own).
+	BackfillSchedule(ctx context.Context, namespace, scheduleID string, start, end time.Time, overlapPolicy string) error
+
 	// PauseSchedule pauses a schedule.
 	PauseSchedule(ctx context.Context, namespace, scheduleID, reason string) error
 
@@ -115,6 +209,28 @@ type Provider interface {
 	// args is optional JSON-encoded arguments to pass to the query handler.
 	QueryWorkflow(ctx context.Context, namespace, workflowID, runID, queryType string, args []byte) (*QueryResult, error)
 
+	// GetWorkflowFailure summarizes how a closed workflow ended from its close event.
+	GetWorkflowFailure(ctx context.Context, namespace, workflowID, runID string) (*FailureSummary, error)
+
+	// GetWorkflowMetadata runs the built-in metadata query, which newer SDKs answer
+	// with the workflow's handler definitions and current details.
+	GetWorkflowMetadata(ctx context.Context, namespace, workflowID, runID string) (*WorkflowMetadata, error)
+
+	// Update Operations
+
+	// UpdateWorkflow sends an update to a running workflow and waits for it to complete.
+	// args is optional JSON-encoded arguments to pass to the update handler.
+	// Rejections and handler failures are reported in UpdateResult.Error.
+	UpdateWorkflow(ctx context.Context, namespace, workflowID, runID, updateName string, args []byte) (*UpdateResult, error)
+
+	// ListWorkflowUpdates returns the updates recorded in a workflow's history.
+	// Rejected updates are not written to history and are not included.
+	ListWorkflowUpdates(ctx context.Context, namespace, workflowID, runID string) ([]WorkflowUpdate, error)
+
+	// UpdateWithStartWorkflow starts a workflow (or uses the running one) and delivers
+	// an update to it atomically, waiting for the update to complete.
+	UpdateWithStartWorkflow(ctx context.Context, namespace string, req UpdateWithStartRequest) (*UpdateResult, error)
+
 	// Batch Operations
 
 	// CancelWorkflows cancels multiple workflows and returns results for each.
@@ -170,22 +286,95 @@ type NamespaceDetail struct {
 	IsGlobalNamespace  bool
 	FailoverVersion    int64
 	Clusters           []string // Active clusters for multi-region
+	ActiveCluster      string
+	ReplicationState   string            // "Normal", "Handover", or empty when not reported
+	Data               map[string]string // Custom namespace data
 }
 
 // Workflow represents a workflow execution.
 type Workflow struct {
-	ID        string
-	RunID     string
-	Type      string
-	Status    string // "Running", "Completed", "Failed", "Canceled", "Terminated", "TimedOut"
-	Namespace string
-	TaskQueue string
-	StartTime time.Time
-	EndTime   *time.Time
-	ParentID  *string
-	Memo      map[string]string
-	Input     string // JSON-formatted workflow input
-	Output    string // JSON-formatted workflow result (or failure message)
+	ID          string
+	RunID       string
+	Type        string
+	Status      string // "Running", "Completed", "Failed", "Canceled", "Terminated", "TimedOut"
+	Namespace   string
+	TaskQueue   string
+	StartTime   time.Time
+	EndTime     *time.Time
+	ParentID    *string
+	ParentRunID string
+	Memo        map[string]string
+	Input       string // JSON-formatted workflow input
+	Output      string // JSON-formatted workflow result (or failure message)
+
+	HistoryLength int64 // Number of events in the history
+
+	// Retry state (populated by GetWorkflow)
+	Attempt           int32
+	RetryPolicy       *RetryPolicy // nil if the workflow has no retry policy
+	ExecutionTime     *time.Time   // When the first workflow task runs; later than StartTime while a retry backs off, or for a start delay or cron schedule
+	PendingActivities []PendingActivity
+}
+
+// RetryPolicy describes how a workflow or activity is retried.
+type RetryPolicy struct {
+	InitialInterval    time.Duration
+	BackoffCoefficient float64
+	MaximumInterval    time.Duration
+	MaximumAttempts    int32 // 0 means unlimited
+	NonRetryableErrors []string
+}
+
+// PendingActivity is an activity that is scheduled, running, or waiting to retry.
+type PendingActivity struct {
+	ActivityID      string
+	ActivityType    string
+	State           string // "Scheduled", "Started", "CancelRequested", "Paused", "PauseRequested"
+	Attempt         int32
+	MaximumAttempts int32 // 0 means unlimited
+	RetryPolicy     *RetryPolicy
+	NextAttemptTime *time.Time // Set while waiting for a retry backoff to elapse
+	LastFailure     string
+	LastWorker      string
+}
+
+// TailCursor is the position a history tail reached, so following the run again
+// doesn't read its history from the first page. It may be shared by a stopped
+// tail and the one replacing it.
+type TailCursor struct {
+	mu        sync.Mutex
+	runID     string
+	pageToken []byte
+}
+
+// resume returns the saved page token when it belongs to runID.
+func (c *TailCursor) resume(runID string) []byte {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.runID != runID {
+		return nil
+	}
+	return c.pageToken
+}
+
+// advance saves the page token for the next poll of runID.
+func (c *TailCursor) advance(runID string, pageToken []byte) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.runID = runID
+	c.pageToken = pageToken
+}
+
+// HistoryEventDetail is a single history event along with its raw attributes.
+type HistoryEventDetail struct {
+	Event   EnhancedHistoryEvent
+	RawJSON string // Every proto field of the event as JSON, with payloads decoded where possible
 }
 
 // HistoryEvent represents a workflow history event.
@@ -216,6 +405,7 @@ type EnhancedHistoryEvent struct {
 	// Child workflow info
 	ChildWorkflowID   string
 	ChildWorkflowType string
+	ChildRunID        string // Empty until the child has started
 
 	// Timing for Gantt view
 	EndTime *time.Time // Computed from linked completion event
@@ -224,16 +414,48 @@ type EnhancedHistoryEvent struct {
 	Attempt   int32
 	TaskQueue string
 	Identity  string
+	BuildID   string // Worker build ID for workflow/activity tasks, when versioning is used
 	Failure   string
 	Result    string
+
+	// Decoded payloads carried by the event (input, result, details, ...)
+	Payloads []Payload
+
+	// Set when payloads or details were trimmed by the payload size limit
+	Truncated bool
 }
 
 // TaskQueueInfo represents task queue status information.
 type TaskQueueInfo struct {
-	Name        string
-	Type        string // "Workflow" or "Activity"
-	PollerCount int
-	Backlog     int
+	Name         string
+	Type         string // "Workflow" or "Activity"
+	PollerCount  int
+	Backlog      int              // Approximate tasks waiting, across task queue types
+	BacklogAge   time.Duration    // Age of the oldest backlogged task
+	AddRate      float64          // Tasks added per second, across task queue types
+	DispatchRate float64          // Tasks dispatched per second, across task queue types
+	LastPollTime time.Time        // Most recent poll from any worker, zero if none
+	Stats        []TaskQueueStats // Per task queue type; empty when the server doesn't report stats
+}
+
+// TaskQueueStats holds approximate backlog and throughput for one task queue type.
+// Rates are averaged by the server over the last 30 seconds.
+type TaskQueueStats struct {
+	TaskQueueType string // "Workflow" or "Activity"
+	Backlog       int
+	BacklogAge    time.Duration
+	AddRate       float64
+	DispatchRate  float64
+}
+
+// finishPollers sets the poller count and last poll time from pollers.
+func (i *TaskQueueInfo) finishPollers(pollers []Poller) {
+	i.PollerCount = len(pollers)
+	for _, p := range pollers {
+		if p.LastAccessTime.After(i.LastPollTime) {
+			i.LastPollTime = p.LastAccessTime
+		}
+	}
 }
 
 // Poller represents a worker polling a task queue.
@@ -242,6 +464,9 @@ type Poller struct {
 	LastAccessTime time.Time
 	TaskQueueType  string // "Workflow" or "Activity"
 	RatePerSecond  float64
+	BuildID        string // Worker build ID, if reported
+	DeploymentName string // Worker deployment (or deployment series) name
+	Versioning     string // "Versioned", "Unversioned", or empty when not reported
 }
 
 // Schedule represents a Temporal schedule.
@@ -259,19 +484,93 @@ type Schedule struct {
 	TotalActions   int64
 	RecentActions  int64 // Actions in the last 24h
 	OverlapPolicy  string
+	CatchupWindow  time.Duration
+	PauseOnFailure bool
+	EditableSpec   string           // Spec as accepted by ParseScheduleSpec; empty for calendar or multi-part specs
+	UpcomingRuns   []time.Time      // Next fire times as computed by the server
+	Actions        []ScheduleAction // Most recent first
+}
+
+// ScheduleAction is a workflow start recently taken by a schedule.
+type ScheduleAction struct {
+	ScheduleTime time.Time // Includes jitter
+	ActualTime   time.Time
+	WorkflowID   string
+	RunID        string // First run started; later runs have their own IDs
+}
+
+// ScheduleCreateRequest contains parameters for creating a schedule that starts a workflow.
+type ScheduleCreateRequest struct {
+	ScheduleID     string
+	Spec           string // Interval or cron expression, as accepted by ParseScheduleSpec
+	WorkflowType   string
+	WorkflowID     string // Defaults to the schedule ID
+	TaskQueue      string
+	Input          []byte // JSON-encoded workflow input
+	OverlapPolicy  string // One of ScheduleOverlapPolicies; empty uses the server default
+	CatchupWindow  time.Duration
+	PauseOnFailure bool
+	Notes          string
+	Paused         bool
+}
+
+// ScheduleUpdateRequest contains the spec and policies to change on an existing schedule.
+type ScheduleUpdateRequest struct {
+	ScheduleID     string
+	Spec           string // Empty keeps the current spec
+	OverlapPolicy  string
+	CatchupWindow  time.Duration // Zero uses the server default
+	PauseOnFailure bool
 }
 
 // ConnectionConfig holds Temporal server connection settings.
 type ConnectionConfig struct {
-	Address       string
-	Namespace     string
-	TLSCertPath   string
-	TLSKeyPath    string
-	TLSCAPath     string
-	TLSServerName string
-	TLSSkipVerify bool
+	Address          string
+	Namespace        string
+	TLSCertPath      string
+	TLSKeyPath       string
+	TLSKeyPassphrase string // Decrypts a passphrase-protected TLS key
+	TLSCAPath        string
+	TLSServerName    string
+	TLSSkipVerify    bool
+
+	// Temporal Cloud API key, sent as a bearer token. Setting it enables TLS.
+	APIKey string
+
+	// Proxy for the gRPC connection: http://[user:pass@]host:port (HTTP CONNECT)
+	// or socks5://[user:pass@]host:port. Overrides the HTTPS_PROXY environment variable.
+	ProxyURL string
+
+	// Remote codec server for decoding encrypted/compressed payloads
+	CodecEndpoint      string
+	CodecAuth          string // Sent as the Authorization header
+	CodecPassNamespace bool   // Send the namespace in the X-Namespace header
+
+	// External decoder plugin (see PluginCodec for the protocol)
+	CodecPlugin     string
+	CodecPluginArgs []string
+
+	// Payloads larger than this many bytes are truncated when loading histories.
+	// Zero disables truncation.
+	MaxPayloadSize int
+
+	// Backend serving the connection: grpc (default), mock, file or record.
+	// See NewProvider.
+	Backend string
+	// Snapshot file read by the file backend or written by the record backend
+	BackendPath string
+
+	// Timeouts, retries, headers and interceptors for gRPC calls
+	RPC RPCPolicy
 }
 
+// Compile-time checks that the backends implement Provider.
+var (
+	_ Provider = (*Client)(nil)
+	_ Provider = (*MemoryProvider)(nil)
+	_ Provider = (*RecordingProvider)(nil)
+)
+
 // DefaultConnectionConfig returns default connection settings.
 func DefaultConnectionConfig() ConnectionConfig {
 	return ConnectionConfig{
@@ -287,6 +586,59 @@ type QueryResult struct {
 	Error     string // Error message if query failed
 }
 
+// FailureSummary describes the close event of a workflow that did not complete.
+type FailureSummary struct {
+	Status     string // Failed, TimedOut, Terminated, Canceled
+	EventID    int64
+	Time       time.Time
+	RetryState string         // Why the workflow was not retried, if reported
+	Identity   string         // Who terminated the workflow
+	Causes     []FailureCause // Failure chain, outermost first
+}
+
+// FailureCause is one link in a failure chain.
+type FailureCause struct {
+	Type         string // Application error type, or the failure kind (e.g., "ActivityFailure")
+	Message      string
+	Source       string // SDK that produced the failure
+	StackTrace   string
+	NonRetryable bool
+	ActivityType string // Set for activity failures
+	ActivityID   string
+	Identity     string // Worker that reported an activity failure
+}
+
+// WorkflowMetadata is what a workflow reports through the built-in metadata query.
+type WorkflowMetadata struct {
+	CurrentDetails string // Human-readable status set by the workflow, often Markdown
+	QueryTypes     []string
+	SignalTypes    []string
+	UpdateTypes    []string
+}
+
+// UpdateResult represents the outcome of a workflow update request.
+type UpdateResult struct {
+	UpdateID   string
+	UpdateName string
+	RunID      string // Run ID of the workflow that received the update
+	Result     string // JSON-formatted result
+	Error      string // Rejection or failure message
+}
+
+// WorkflowUpdate represents a workflow update recorded in history.
+type WorkflowUpdate struct {
+	UpdateID      string
+	Name          string
+	Status        string // UpdateStatusAdmitted, UpdateStatusAccepted, StatusCompleted, or StatusFailed
+	Input         string
+	Result        string
+	Failure       string
+	Identity      string
+	EventID       int64 // ID of the first history event for this update
+	AdmittedTime  time.Time
+	CompletedTime *time.Time
+}
+
 // WorkflowIdentifier uniquely identifies a workflow execution.
 type WorkflowIdentifier struct {
 	WorkflowID string
@@ -310,6 +662,37 @@ type ResetPoint struct {
 	Reason      string // Why this is a valid reset point
 }
 
+// Reset reapply types, controlling which events after the reset point are
+// reapplied to the new run.
+const (
+	ResetReapplyAll    = "all"    // Signals and updates (server default)
+	ResetReapplySignal = "signal" // Signals only
+	ResetReapplyNone   = "none"   // Nothing is reapplied
+)
+
+// Event kinds that can be excluded from reapply on reset.
+const (
+	ResetExcludeSignal        = "signal"
+	ResetExcludeUpdate        = "update"
+	ResetExcludeNexus         = "nexus"
+	ResetExcludeCancelRequest = "cancel_request"
+)
+
+// ResetOptions contains parameters for resetting a workflow.
+type ResetOptions struct {
+	EventID      int64 // Workflow task event to reset to
+	Reason       string
+	ReapplyType  string   // One of the ResetReapply constants; empty uses the server default
+	ExcludeTypes []string // ResetExclude event kinds that should not be reapplied
+}
+
+// RerunOptions overrides parts of the original start request when re-running a workflow.
+type RerunOptions struct {
+	WorkflowID string   // Empty reuses the original workflow ID
+	TaskQueue  string   // Empty reuses the original task queue
+	Args       [][]byte // JSON-encoded arguments, run through the codec's encoder; nil reuses the original input payloads as-is
+}
+
 // SignalWithStartRequest contains parameters for starting a workflow with a signal.
 type SignalWithStartRequest struct {
 	WorkflowID    string
@@ -319,3 +702,13 @@ type SignalWithStartRequest struct {
 	SignalInput   []byte // JSON-encoded signal input
 	WorkflowInput []byte // JSON-encoded workflow input
 }
+
+// UpdateWithStartRequest contains parameters for starting a workflow with an update.
+type UpdateWithStartRequest struct {
+	WorkflowID    string
+	WorkflowType  string
+	TaskQueue     string
+	UpdateName    string
+	UpdateInput   []byte // JSON-encoded update arguments
+	WorkflowInput []byte // JSON-encoded workflow input
+}
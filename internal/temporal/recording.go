@@ -0,0 +1,191 @@
+package temporal
+
+import (
+	"context"
+	"sync"
+)
+
+// RecordingProvider wraps a provider and keeps what is read through it in a
+// snapshot, saved to a file on Close. The file backend can then serve the same
+// data offline, e.g. to reproduce a bug or demo without a server. Operations
+// that are not recorded go straight to the wrapped provider.
+type RecordingProvider struct {
+	Provider
+	path     string
+	snapshot *Snapshot
+	mu       sync.Mutex
+}
+
+// NewRecordingProvider records what is read through p to the snapshot file
+// at path. An existing snapshot at path is extended rather than replaced.
+func NewRecordingProvider(p Provider, path string) *RecordingProvider {
+	snapshot, err := LoadSnapshot(path)
+	if err != nil {
+		snapshot = NewSnapshot()
+	}
+	return &RecordingProvider{Provider: p, path: path, snapshot: snapshot}
+}
+
+// record runs fn on the snapshot under the lock.
+func (r *RecordingProvider) record(fn func(s *Snapshot)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fn(r.snapshot)
+}
+
+// Save writes the recorded snapshot to its file.
+func (r *RecordingProvider) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.snapshot.Save(r.path)
+}
+
+// Close saves the snapshot and closes the wrapped provider.
+func (r *RecordingProvider) Close() error {
+	saveErr := r.Save()
+	if err := r.Provider.Close(); err != nil {
+		return err
+	}
+	return saveErr
+}
+
+// ServerVersion records the server version.
+func (r *RecordingProvider) ServerVersion(ctx context.Context) (string, error) {
+	version, err := r.Provider.ServerVersion(ctx)
+	if err == nil {
+		r.record(func(s *Snapshot) { s.ServerVersion = version })
+	}
+	return version, err
+}
+
+// ListNamespaces records the namespaces, keeping details already recorded.
+func (r *RecordingProvider) ListNamespaces(ctx context.Context) ([]Namespace, error) {
+	namespaces, err := r.Provider.ListNamespaces(ctx)
+	if err == nil {
+		r.record(func(s *Snapshot) {
+			for _, ns := range namespaces {
+				detail := NamespaceDetail{Namespace: ns}
+				for _, existing := range s.Namespaces {
+					if existing.Name == ns.Name {
+						detail = existing
+						detail.Namespace = ns
+					}
+				}
+				s.putNamespace(detail)
+			}
+		})
+	}
+	return namespaces, err
+}
+
+// DescribeNamespace records the namespace details.
+func (r *RecordingProvider) DescribeNamespace(ctx context.Context, name string) (*NamespaceDetail, error) {
+	detail, err := r.Provider.DescribeNamespace(ctx, name)
+	if err == nil && detail != nil {
+		r.record(func(s *Snapshot) { s.putNamespace(*detail) })
+	}
+	return detail, err
+}
+
+// ListWorkflows records the listed workflows.
+func (r *RecordingProvider) ListWorkflows(ctx context.Context, namespace string, opts ListOptions) ([]Workflow, string, error) {
+	workflows, next, err := r.Provider.ListWorkflows(ctx, namespace, opts)
+	if err == nil {
+		r.record(func(s *Snapshot) {
+			for _, wf := range workflows {
+				s.putWorkflow(namespace, wf)
+			}
+		})
+	}
+	return workflows, next, err
+}
+
+// GetWorkflow records the workflow with its details.
+func (r *RecordingProvider) GetWorkflow(ctx context.Context, namespace, workflowID, runID string) (*Workflow, error) {
+	wf, err := r.Provider.GetWorkflow(ctx, namespace, workflowID, runID)
+	if err == nil && wf != nil {
+		r.record(func(s *Snapshot) { s.putWorkflow(namespace, *wf) })
+	}
+	return wf, err
+}
+
+// ListWorkflowRuns records the runs.
+func (r *RecordingProvider) ListWorkflowRuns(ctx context.Context, namespace, workflowID string) ([]Workflow, error) {
+	runs, err := r.Provider.ListWorkflowRuns(ctx, namespace, workflowID)
+	if err == nil {
+		r.record(func(s *Snapshot) {
+			for _, wf := range runs {
+				s.putWorkflow(namespace, wf)
+			}
+		})
+	}
+	return runs, err
+}
+
+// GetEnhancedWorkflowHistory records the history.
+func (r *RecordingProvider) GetEnhancedWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]EnhancedHistoryEvent, error) {
+	events, err := r.Provider.GetEnhancedWorkflowHistory(ctx, namespace, workflowID, runID)
+	if err == nil {
+		r.record(func(s *Snapshot) { s.Histories[historyKey(namespace, workflowID, runID)] = events })
+	}
+	return events, err
+}
+
+// StreamWorkflowHistory records the history once every page has arrived.
+func (r *RecordingProvider) StreamWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, onPage func([]EnhancedHistoryEvent)) error {
+	var events []EnhancedHistoryEvent
+	err := r.Provider.StreamWorkflowHistory(ctx, namespace, workflowID, runID, func(page []EnhancedHistoryEvent) {
+		events = append(events, page...)
+		onPage(page)
+	})
+	if err == nil {
+		r.record(func(s *Snapshot) { s.Histories[historyKey(namespace, workflowID, runID)] = events })
+	}
+	return err
+}
+
+// DescribeTaskQueue records the task queue and its pollers.
+func (r *RecordingProvider) DescribeTaskQueue(ctx context.Context, namespace, taskQueue string) (*TaskQueueInfo, []Poller, error) {
+	info, pollers, err := r.Provider.DescribeTaskQueue(ctx, namespace, taskQueue)
+	if err == nil && info != nil {
+		r.record(func(s *Snapshot) { s.putTaskQueue(namespace, SnapshotTaskQueue{Info: *info, Pollers: pollers}) })
+	}
+	return info, pollers, err
+}
+
+// ListSchedules records the listed schedules, keeping details already recorded.
+func (r *RecordingProvider) ListSchedules(ctx context.Context, namespace string, opts ListOptions) ([]Schedule, string, error) {
+	schedules, next, err := r.Provider.ListSchedules(ctx, namespace, opts)
+	if err == nil {
+		r.record(func(s *Snapshot) {
+			for _, sched := range schedules {
+				recorded := false
+				for _, existing := range s.Schedules[namespace] {
+					recorded = recorded || existing.ID == sched.ID
+				}
+				if !recorded {
+					s.putSchedule(namespace, sched)
+				}
+			}
+		})
+	}
+	return schedules, next, err
+}
+
+// GetSchedule records the schedule with its details.
+func (r *RecordingProvider) GetSchedule(ctx context.Context, namespace, scheduleID string) (*Schedule, error) {
+	sched, err := r.Provider.GetSchedule(ctx, namespace, scheduleID)
+	if err == nil && sched != nil {
+		r.record(func(s *Snapshot) { s.putSchedule(namespace, *sched) })
+	}
+	return sched, err
+}
+
+// ListNexusEndpoints records the Nexus endpoints.
+func (r *RecordingProvider) ListNexusEndpoints(ctx context.Context) ([]NexusEndpoint, error) {
+	endpoints, err := r.Provider.ListNexusEndpoints(ctx)
+	if err == nil {
+		r.record(func(s *Snapshot) { s.NexusEndpoints = endpoints })
+	}
+	return endpoints, err
+}
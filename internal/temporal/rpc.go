@@ -0,0 +1,333 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/workflowservice/v1"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Call policy defaults, used when a profile doesn't set its own.
+const (
+	DefaultRPCTimeout      = 10 * time.Second
+	DefaultRPCRetries      = 2
+	DefaultRPCRetryBackoff = 250 * time.Millisecond
+
+	maxRPCRetryBackoff = 5 * time.Second
+)
+
+// defaultRPCTimeouts are the per-method timeouts used unless overridden; these
+// calls routinely take longer than DefaultRPCTimeout on large namespaces,
+// histories or workflows slow to answer.
+var defaultRPCTimeouts = map[string]time.Duration{
+	"ListWorkflowExecutions":      30 * time.Second,
+	"CountWorkflowExecutions":     30 * time.Second,
+	"GetWorkflowExecutionHistory": 30 * time.Second,
+	"DescribeTaskQueue":           20 * time.Second,
+	"QueryWorkflow":               30 * time.Second,
+	"UpdateWorkflowExecution":     30 * time.Second,
+	"ExecuteMultiOperation":       30 * time.Second,
+}
+
+// RPCPolicy sets how gRPC calls to the server are timed out and retried, and
+// what is added to them. Methods are named as in the Temporal API, without the
+// service, e.g. "ListWorkflowExecutions".
+type RPCPolicy struct {
+	// Timeout bounds each attempt of a call. Zero uses DefaultRPCTimeout.
+	Timeout time.Duration
+	// Timeouts overrides Timeout for specific methods.
+	Timeouts map[string]time.Duration
+
+	// Retries of a call that fails because the server is unavailable or the
+	// attempt timed out. Zero uses DefaultRPCRetries; negative disables retries.
+	// Only reads and changes the server deduplicates are retried (see
+	// retrySafeMethods); other changes are tried once.
+	Retries int
+	// Delay before the first retry, doubled for each one after. Zero uses
+	// DefaultRPCRetryBackoff.
+	RetryBackoff time.Duration
+
+	// RateLimit caps the calls per second sent to the server, shared by every
+	// view on the connection, so auto-refreshing views can't flood it. Calls
+	// over the limit wait their turn. Zero means no limit.
+	RateLimit float64
+	// RateBurst is how many calls may go out at once before RateLimit applies.
+	// Zero uses RateLimit rounded up.
+	RateBurst int
+
+	// gRPC metadata sent with every call, e.g. headers a corporate proxy requires.
+	Headers map[string]string
+	// Interceptors registered with RegisterInterceptor, by name, run in order
+	// on every attempt.
+	Interceptors []string
+}
+
+// TimeoutFor returns the timeout of each attempt of a call to method.
+func (p RPCPolicy) TimeoutFor(method string) time.Duration {
+	if timeout, ok := p.Timeouts[method]; ok && timeout > 0 {
+		return timeout
+	}
+	if timeout, ok := defaultRPCTimeouts[method]; ok && p.Timeout <= 0 {
+		return timeout
+	}
+	if p.Timeout > 0 {
+		return p.Timeout
+	}
+	return DefaultRPCTimeout
+}
+
+// retrySafeMethods are the methods other than reads that are safe to retry:
+// changes the server deduplicates by the request ID every attempt shares (see
+// setRequestID), or by the update ID. Any other change may already have been
+// applied when an attempt times out, so trying it again could apply it twice.
+var retrySafeMethods = map[string]bool{
+	"QueryWorkflow":                    true,
+	"PollWorkflowExecutionUpdate":      true,
+	"StartWorkflowExecution":           true,
+	"SignalWorkflowExecution":          true,
+	"SignalWithStartWorkflowExecution": true,
+	"RequestCancelWorkflowExecution":   true,
+	"ResetWorkflowExecution":           true,
+	"UpdateWorkflowExecution":          true,
+	"ExecuteMultiOperation":            true,
+	"CreateSchedule":                   true,
+	"UpdateSchedule":                   true,
+	"PatchSchedule":                    true,
+}
+
+// retrySafe reports whether a failed call to method may be tried again.
+func retrySafe(method string) bool {
+	for _, prefix := range []string{"Get", "List", "Describe", "Count", "Scan"} {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return retrySafeMethods[method]
+}
+
+// attemptsFor returns how many times a call to method is tried at most.
+func (p RPCPolicy) attemptsFor(method string) int {
+	if !retrySafe(method) {
+		return 1
+	}
+	return p.attempts()
+}
+
+// attempts returns how many times a call safe to retry is tried at most.
+func (p RPCPolicy) attempts() int {
+	switch {
+	case p.Retries < 0:
+		return 1
+	case p.Retries == 0:
+		return DefaultRPCRetries + 1
+	default:
+		return p.Retries + 1
+	}
+}
+
+// retryBackoff returns the delay before the first retry.
+func (p RPCPolicy) retryBackoff() time.Duration {
+	if p.RetryBackoff > 0 {
+		return p.RetryBackoff
+	}
+	return DefaultRPCRetryBackoff
+}
+
+// Budget returns the longest a call to method can take with every attempt and
+// the delays between them, for callers that bound the whole operation.
+func (p RPCPolicy) Budget(method string) time.Duration {
+	attempts := p.attemptsFor(method)
+	budget := time.Duration(attempts) * p.TimeoutFor(method)
+	backoff := p.retryBackoff()
+	for i := 1; i < attempts; i++ {
+		budget += backoff
+		backoff = min(backoff*2, maxRPCRetryBackoff)
+	}
+	return budget
+}
+
+// InterceptorFactory builds a gRPC interceptor for a connection.
+type InterceptorFactory func(config ConnectionConfig) (grpc.UnaryClientInterceptor, error)
+
+var (
+	interceptorsMu sync.RWMutex
+	interceptors   = make(map[string]InterceptorFactory)
+)
+
+// RegisterInterceptor makes a gRPC interceptor available to profiles under
+// name (see RPCPolicy.Interceptors), replacing any registered under the same
+// name. Register interceptors before connecting, e.g. from an init function.
+func RegisterInterceptor(name string, factory InterceptorFactory) {
+	interceptorsMu.Lock()
+	defer interceptorsMu.Unlock()
+	interceptors[name] = factory
+}
+
+// Interceptors returns the names of the registered interceptors, sorted.
+func Interceptors() []string {
+	interceptorsMu.RLock()
+	defer interceptorsMu.RUnlock()
+	names := make([]string, 0, len(interceptors))
+	for name := range interceptors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// rateBurst returns how many calls may go out at once.
+func (p RPCPolicy) rateBurst() int {
+	if p.RateBurst > 0 {
+		return p.RateBurst
+	}
+	return max(1, int(math.Ceil(p.RateLimit)))
+}
+
+// rpcInterceptors returns the interceptors applying the connection's call
+// policy. The rate limit comes first, so a call queues for its turn before its
+// first attempt's timeout starts; headers and custom interceptors run on every
+// attempt.
+func rpcInterceptors(config ConnectionConfig) ([]grpc.UnaryClientInterceptor, error) {
+	policy := config.RPC
+	var chain []grpc.UnaryClientInterceptor
+	switch {
+	case policy.RateLimit < 0:
+		return nil, fmt.Errorf("rate limit must not be negative")
+	case policy.RateLimit > 0:
+		chain = append(chain, rateLimitInterceptor(rate.NewLimiter(rate.Limit(policy.RateLimit), policy.rateBurst())))
+	}
+	chain = append(chain, rpcPolicyInterceptor(policy))
+	if len(policy.Headers) > 0 {
+		chain = append(chain, headersInterceptor(policy.Headers))
+	}
+	for _, name := range policy.Interceptors {
+		interceptorsMu.RLock()
+		factory, ok := interceptors[name]
+		interceptorsMu.RUnlock()
+		if !ok {
+			available := "none registered"
+			if names := Interceptors(); len(names) > 0 {
+				available = "available: " + strings.Join(names, ", ")
+			}
+			return nil, fmt.Errorf("unknown interceptor %q (%s)", name, available)
+		}
+		interceptor, err := factory(config)
+		if err != nil {
+			return nil, fmt.Errorf("interceptor %q: %w", name, err)
+		}
+		chain = append(chain, interceptor)
+	}
+	return chain, nil
+}
+
+// rpcPolicyInterceptor times out each attempt of a call and retries calls safe
+// to retry while the server is unavailable or an attempt times out, backing off
+// between attempts. Long polls for new history events are bounded by their
+// caller.
+func rpcPolicyInterceptor(policy RPCPolicy) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		name := path.Base(method)
+		timeout := policy.TimeoutFor(name)
+		if r, ok := req.(*workflowservice.GetWorkflowExecutionHistoryRequest); ok && r.GetWaitNewEvent() {
+			timeout = 0
+		}
+		attempts := policy.attemptsFor(name)
+		setRequestID(req)
+		backoff := policy.retryBackoff()
+
+		for attempt := 1; ; attempt++ {
+			attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+			if timeout > 0 {
+				attemptCtx, cancel = context.WithTimeout(ctx, timeout)
+			}
+			err := invoker(attemptCtx, method, req, reply, cc, opts...)
+			cancel()
+			if err == nil || attempt >= attempts || ctx.Err() != nil || !retryableCode(status.Code(err)) {
+				return err
+			}
+
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, maxRPCRetryBackoff)
+		}
+	}
+}
+
+// setRequestID gives a request that carries a request ID one if it has none,
+// before its first attempt, so every attempt sends the same ID and the server
+// applies the change once.
+func setRequestID(req any) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return
+	}
+	r := msg.ProtoReflect()
+	field := r.Descriptor().Fields().ByName("request_id")
+	if field == nil || field.Kind() != protoreflect.StringKind || r.Get(field).String() != "" {
+		return
+	}
+	r.Set(field, protoreflect.ValueOfString(uuid.NewString()))
+}
+
+// retryableCode reports whether a call failing with code may succeed if tried
+// again.
+func retryableCode(code codes.Code) bool {
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
+
+// rateLimitInterceptor holds each call until limiter allows it. A call only
+// fails here if its context ends while it waits.
+func rateLimitInterceptor(limiter *rate.Limiter) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		reservation := limiter.Reserve()
+		if delay := reservation.Delay(); delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				reservation.Cancel()
+				return status.FromContextError(ctx.Err()).Err()
+			case <-timer.C:
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// headersInterceptor sends headers as gRPC metadata with every call.
+func headersInterceptor(headers map[string]string) grpc.UnaryClientInterceptor {
+	pairs := make([]string, 0, 2*len(headers))
+	for key, value := range headers {
+		pairs = append(pairs, strings.ToLower(key), value)
+	}
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(metadata.AppendToOutgoingContext(ctx, pairs...), method, req, reply, cc, opts...)
+	}
+}
+
+// callContext bounds a call made through the SDK client by the policy's budget
+// for method, unless the caller set a deadline. The SDK otherwise caps such
+// calls with its own defaults, and waits for updates without any.
+func (c *Client) callContext(ctx context.Context, method string) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.Config().RPC.Budget(method))
+}
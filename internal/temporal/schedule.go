@@ -0,0 +1,204 @@
+package temporal
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// ScheduleOverlapPolicies are the overlap policy names, as accepted by the CLI's
+// --overlap-policy flag. Skip is the server default.
+var ScheduleOverlapPolicies = []string{"Skip", "BufferOne", "BufferAll", "CancelOther", "TerminateOther", "AllowAll"}
+
+var overlapPolicies = map[string]enums.ScheduleOverlapPolicy{
+	"Skip":           enums.SCHEDULE_OVERLAP_POLICY_SKIP,
+	"BufferOne":      enums.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE,
+	"BufferAll":      enums.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL,
+	"CancelOther":    enums.SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER,
+	"TerminateOther": enums.SCHEDULE_OVERLAP_POLICY_TERMINATE_OTHER,
+	"AllowAll":       enums.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+}
+
+// overlapPolicy converts an overlap policy name; empty or unknown names leave the
+// policy unspecified so the server default applies.
+func overlapPolicy(name string) enums.ScheduleOverlapPolicy {
+	return overlapPolicies[name]
+}
+
+// overlapPolicyName returns the name of an overlap policy, or empty if unspecified.
+func overlapPolicyName(policy enums.ScheduleOverlapPolicy) string {
+	for name, p := range overlapPolicies {
+		if p == policy {
+			return name
+		}
+	}
+	return ""
+}
+
+// ParseScheduleSpec parses a schedule spec as entered by a user: an interval
+// ("every 1h", "@every 30m" or just "15m") or a cron expression ("0 9 * * MON-FRI",
+// "@daily"). Exactly one of the results is set.
+func ParseScheduleSpec(spec string) (cron string, interval time.Duration, err error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return "", 0, fmt.Errorf("schedule spec is required")
+	}
+
+	lower := strings.ToLower(spec)
+	for _, prefix := range []string{"@every ", "every "} {
+		if strings.HasPrefix(lower, prefix) {
+			return parseInterval(spec[len(prefix):])
+		}
+	}
+	if _, err := time.ParseDuration(spec); err == nil {
+		return parseInterval(spec)
+	}
+
+	if strings.HasPrefix(spec, "@") {
+		return spec, 0, nil
+	}
+	fields := strings.Fields(spec)
+	if len(fields) > 0 && (strings.HasPrefix(fields[0], "CRON_TZ=") || strings.HasPrefix(fields[0], "TZ=")) {
+		fields = fields[1:]
+	}
+	if len(fields) < 5 || len(fields) > 7 {
+		return "", 0, fmt.Errorf("invalid schedule spec %q: expected an interval like \"every 1h\" or a cron expression with 5 fields", spec)
+	}
+	return spec, 0, nil
+}
+
+func parseInterval(s string) (string, time.Duration, error) {
+	d, err := time.ParseDuration(strings.TrimSpace(s))
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid interval %q: %w", s, err)
+	}
+	if d < time.Second {
+		return "", 0, fmt.Errorf("interval must be at least 1s")
+	}
+	return "", d, nil
+}
+
+// scheduleSpec builds an SDK schedule spec from a user-entered spec.
+func scheduleSpec(spec string) (client.ScheduleSpec, error) {
+	cron, interval, err := ParseScheduleSpec(spec)
+	if err != nil {
+		return client.ScheduleSpec{}, err
+	}
+	if interval > 0 {
+		return client.ScheduleSpec{Intervals: []client.ScheduleIntervalSpec{{Every: interval}}}, nil
+	}
+	return client.ScheduleSpec{CronExpressions: []string{cron}}, nil
+}
+
+// editableScheduleSpec returns the spec in the form accepted by ParseScheduleSpec,
+// or empty when it has more than a single interval or cron expression.
+func editableScheduleSpec(spec *client.ScheduleSpec) string {
+	if spec == nil || len(spec.Calendars) > 0 || len(spec.Intervals)+len(spec.CronExpressions) != 1 {
+		return ""
+	}
+	if len(spec.Intervals) == 1 {
+		if spec.Intervals[0].Offset != 0 {
+			return ""
+		}
+		return "every " + spec.Intervals[0].Every.String()
+	}
+	return spec.CronExpressions[0]
+}
+
+// Validate checks that the fields required to create a schedule are set and valid.
+func (r ScheduleCreateRequest) Validate() error {
+	switch {
+	case strings.TrimSpace(r.ScheduleID) == "":
+		return fmt.Errorf("schedule ID is required")
+	case strings.TrimSpace(r.WorkflowType) == "":
+		return fmt.Errorf("workflow type is required")
+	case strings.TrimSpace(r.TaskQueue) == "":
+		return fmt.Errorf("task queue is required")
+	}
+	if _, _, err := ParseScheduleSpec(r.Spec); err != nil {
+		return err
+	}
+	if r.OverlapPolicy != "" && overlapPolicy(r.OverlapPolicy) == enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		return fmt.Errorf("unknown overlap policy %q", r.OverlapPolicy)
+	}
+	if r.CatchupWindow < 0 {
+		return fmt.Errorf("catchup window must not be negative")
+	}
+	return nil
+}
+
+// Validate checks the fields of a schedule update.
+func (r ScheduleUpdateRequest) Validate() error {
+	if r.Spec != "" {
+		if _, _, err := ParseScheduleSpec(r.Spec); err != nil {
+			return err
+		}
+	}
+	if r.OverlapPolicy != "" && overlapPolicy(r.OverlapPolicy) == enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		return fmt.Errorf("unknown overlap policy %q", r.OverlapPolicy)
+	}
+	if r.CatchupWindow < 0 {
+		return fmt.Errorf("catchup window must not be negative")
+	}
+	return nil
+}
+
+// ScheduleToggleCommand returns the Temporal CLI command that pauses or unpauses a
+// schedule with a note.
+func ScheduleToggleCommand(namespace, scheduleID string, pause bool, note string) string {
+	args := []string{"temporal", "schedule", "toggle",
+		"--namespace", shellQuote(namespace),
+		"--schedule-id", shellQuote(scheduleID)}
+	if pause {
+		args = append(args, "--pause")
+	} else {
+		args = append(args, "--unpause")
+	}
+	if note != "" {
+		args = append(args, "--reason", shellQuote(note))
+	}
+	return strings.Join(args, " ")
+}
+
+// ScheduleTriggerCommand returns the Temporal CLI command that triggers an
+// immediate run of a schedule.
+func ScheduleTriggerCommand(namespace, scheduleID string) string {
+	return strings.Join([]string{"temporal", "schedule", "trigger",
+		"--namespace", shellQuote(namespace),
+		"--schedule-id", shellQuote(scheduleID)}, " ")
+}
+
+// ScheduleBackfillCommand returns the Temporal CLI command that backfills a schedule
+// over a time range.
+func ScheduleBackfillCommand(namespace, scheduleID string, start, end time.Time, overlapPolicy string) string {
+	args := []string{"temporal", "schedule", "backfill",
+		"--namespace", shellQuote(namespace),
+		"--schedule-id", shellQuote(scheduleID),
+		"--start-time", start.Format(time.RFC3339),
+		"--end-time", end.Format(time.RFC3339)}
+	if overlapPolicy != "" {
+		args = append(args, "--overlap-policy", overlapPolicy)
+	}
+	return strings.Join(args, " ")
+}
+
+// ScheduledWorkflowsQuery returns the visibility query for workflows started by a
+// schedule, optionally limited to those scheduled between start and end.
+func ScheduledWorkflowsQuery(scheduleID string, start, end time.Time) string {
+	query := fmt.Sprintf(`TemporalScheduledById = "%s"`, strings.ReplaceAll(scheduleID, `"`, `\"`))
+	if !start.IsZero() && !end.IsZero() {
+		query += fmt.Sprintf(` AND TemporalScheduledStartTime BETWEEN "%s" AND "%s"`,
+			start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+	}
+	return query
+}
+
+// ScheduleDeleteCommand returns the Temporal CLI command that deletes a schedule.
+func ScheduleDeleteCommand(namespace, scheduleID string) string {
+	return strings.Join([]string{"temporal", "schedule", "delete",
+		"--namespace", shellQuote(namespace),
+		"--schedule-id", shellQuote(scheduleID)}, " ")
+}
@@ -0,0 +1,214 @@
+package temporal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UpcomingScheduleTimes projects when a spec, as accepted by ParseScheduleSpec, fires
+// after from and up to until, returning at most limit times. Cron expressions are
+// evaluated in UTC, the server default, unless they start with CRON_TZ= or TZ=.
+// Jitter and the server's catchup behavior are not modeled.
+func UpcomingScheduleTimes(spec string, from, until time.Time, limit int) ([]time.Time, error) {
+	cron, interval, err := ParseScheduleSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var times []time.Time
+	if interval > 0 {
+		// Intervals are aligned to the Unix epoch
+		next := time.Unix(0, 0).Add((from.Sub(time.Unix(0, 0))/interval + 1) * interval)
+		for ; !next.After(until) && len(times) < limit; next = next.Add(interval) {
+			times = append(times, next)
+		}
+		return times, nil
+	}
+
+	cal, err := parseCron(cron)
+	if err != nil {
+		return nil, err
+	}
+	t := from.In(cal.loc).Truncate(time.Minute)
+	for ; !t.After(until) && len(times) < limit; t = t.Add(time.Minute) {
+		if !cal.matchesMinute(t) {
+			continue
+		}
+		for _, sec := range cal.seconds {
+			fire := t.Add(time.Duration(sec) * time.Second)
+			if fire.After(from) && !fire.After(until) && len(times) < limit {
+				times = append(times, fire)
+			}
+		}
+	}
+	return times, nil
+}
+
+// cronCalendar is a parsed cron expression. Like the server's calendar specs, a
+// time matches when every field matches, including both day of month and day of week.
+type cronCalendar struct {
+	loc                          *time.Location
+	seconds                      []int // Ascending
+	minutes, hours, doms, months fieldSet
+	dows                         fieldSet // 0 is Sunday
+	years                        fieldSet // Nil matches any year
+}
+
+// fieldSet is the set of values a cron field matches.
+type fieldSet map[int]bool
+
+func (c *cronCalendar) matchesMinute(t time.Time) bool {
+	return c.minutes[t.Minute()] && c.hours[t.Hour()] && c.doms[t.Day()] &&
+		c.months[int(t.Month())] && c.dows[int(t.Weekday())] &&
+		(c.years == nil || c.years[t.Year()])
+}
+
+var cronShortcuts = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	monthNames = map[string]int{"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12}
+	dayNames = map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}
+)
+
+// parseCron parses a cron expression with 5 fields (minute to day of week), 6 fields
+// (with a trailing year, as Temporal reads them) or 7 fields (with leading seconds
+// and a trailing year).
+func parseCron(expr string) (*cronCalendar, error) {
+	cal := &cronCalendar{loc: time.UTC}
+
+	fields := strings.Fields(expr)
+	for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+		if len(fields) == 0 {
+			break
+		}
+		if tz, ok := strings.CutPrefix(fields[0], prefix); ok {
+			loc, err := time.LoadLocation(tz)
+			if err != nil {
+				return nil, fmt.Errorf("unknown time zone %q", tz)
+			}
+			cal.loc = loc
+			fields = fields[1:]
+			break
+		}
+	}
+	if len(fields) == 1 {
+		shortcut, ok := cronShortcuts[strings.ToLower(fields[0])]
+		if !ok {
+			return nil, fmt.Errorf("unsupported cron shortcut %q", fields[0])
+		}
+		fields = strings.Fields(shortcut)
+	}
+
+	secondField := "0"
+	switch len(fields) {
+	case 5, 6:
+	case 7:
+		secondField, fields = fields[0], fields[1:]
+	default:
+		return nil, fmt.Errorf("cron expression must have 5 to 7 fields")
+	}
+
+	seconds, err := parseCronField(secondField, 0, 59, nil)
+	if err != nil {
+		return nil, fmt.Errorf("seconds: %w", err)
+	}
+	for s := 0; s < 60; s++ {
+		if seconds[s] {
+			cal.seconds = append(cal.seconds, s)
+		}
+	}
+
+	specs := []struct {
+		name     string
+		set      *fieldSet
+		min, max int
+		names    map[string]int
+	}{
+		{"minute", &cal.minutes, 0, 59, nil},
+		{"hour", &cal.hours, 0, 23, nil},
+		{"day of month", &cal.doms, 1, 31, nil},
+		{"month", &cal.months, 1, 12, monthNames},
+		{"day of week", &cal.dows, 0, 7, dayNames},
+	}
+	for i, spec := range specs {
+		set, err := parseCronField(fields[i], spec.min, spec.max, spec.names)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", spec.name, err)
+		}
+		*spec.set = set
+	}
+	if cal.dows[7] {
+		cal.dows[0] = true // 7 is also Sunday
+	}
+	if len(fields) == 6 && fields[5] != "*" {
+		years, err := parseCronField(fields[5], 1970, 2199, nil)
+		if err != nil {
+			return nil, fmt.Errorf("year: %w", err)
+		}
+		cal.years = years
+	}
+	return cal, nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges and steps, such as
+// "*/15", "1-5" or "MON,WED,FRI".
+func parseCronField(field string, min, max int, names map[string]int) (fieldSet, error) {
+	set := fieldSet{}
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid step %q", stepPart)
+			}
+			step = n
+		}
+
+		lo, hi := min, max
+		if rangePart != "*" && rangePart != "?" {
+			startPart, endPart, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = cronValue(startPart, names); err != nil {
+				return nil, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = cronValue(endPart, names); err != nil {
+					return nil, err
+				}
+			} else if hasStep {
+				hi = max // "5/10" steps from 5 to the end
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return nil, fmt.Errorf("%q is out of range %d-%d", part, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+	return set, nil
+}
+
+func cronValue(s string, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported value %q", s)
+	}
+	return v, nil
+}
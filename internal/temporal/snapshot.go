@@ -0,0 +1,149 @@
+package temporal
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Snapshot is Temporal data saved to a file: what the file backend serves and
+// the record backend writes. Data is grouped by namespace, and histories are
+// keyed by namespace, workflow ID and run ID (see historyKey).
+type Snapshot struct {
+	ServerVersion  string                            `json:"server_version,omitempty"`
+	Namespaces     []NamespaceDetail                 `json:"namespaces,omitempty"`
+	Workflows      map[string][]Workflow             `json:"workflows,omitempty"`
+	Histories      map[string][]EnhancedHistoryEvent `json:"histories,omitempty"`
+	TaskQueues     map[string][]SnapshotTaskQueue    `json:"task_queues,omitempty"`
+	Schedules      map[string][]Schedule             `json:"schedules,omitempty"`
+	NexusEndpoints []NexusEndpoint                   `json:"nexus_endpoints,omitempty"`
+}
+
+// SnapshotTaskQueue is a task queue with its pollers.
+type SnapshotTaskQueue struct {
+	Info    TaskQueueInfo `json:"info"`
+	Pollers []Poller      `json:"pollers,omitempty"`
+}
+
+// NewSnapshot returns an empty snapshot.
+func NewSnapshot() *Snapshot {
+	s := &Snapshot{}
+	s.initMaps()
+	return s
+}
+
+// LoadSnapshot reads a snapshot file.
+func LoadSnapshot(path string) (*Snapshot, error) {
+	if path == "" {
+		return nil, fmt.Errorf("no snapshot file set (backend_path)")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot %s: %w", path, err)
+	}
+	s.initMaps()
+	return &s, nil
+}
+
+// initMaps makes the maps that are nil, e.g. when left out of a file.
+func (s *Snapshot) initMaps() {
+	if s.Workflows == nil {
+		s.Workflows = make(map[string][]Workflow)
+	}
+	if s.Histories == nil {
+		s.Histories = make(map[string][]EnhancedHistoryEvent)
+	}
+	if s.TaskQueues == nil {
+		s.TaskQueues = make(map[string][]SnapshotTaskQueue)
+	}
+	if s.Schedules == nil {
+		s.Schedules = make(map[string][]Schedule)
+	}
+}
+
+// Save writes the snapshot to path, replacing it only once fully written.
+func (s *Snapshot) Save(path string) error {
+	if path == "" {
+		return fmt.Errorf("no snapshot file set (backend_path)")
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// historyKey keys the history of a workflow run in Snapshot.Histories. The
+// run ID is empty for a history recorded without one (the latest run).
+func historyKey(namespace, workflowID, runID string) string {
+	return namespace + "/" + workflowID + "/" + runID
+}
+
+// putNamespace adds a namespace, or replaces the one with the same name.
+func (s *Snapshot) putNamespace(ns NamespaceDetail) {
+	for i := range s.Namespaces {
+		if s.Namespaces[i].Name == ns.Name {
+			s.Namespaces[i] = ns
+			return
+		}
+	}
+	s.Namespaces = append(s.Namespaces, ns)
+}
+
+// putWorkflow adds a workflow run, or replaces the one with the same IDs, and
+// keeps the namespace's runs newest first.
+func (s *Snapshot) putWorkflow(namespace string, wf Workflow) {
+	runs := s.Workflows[namespace]
+	replaced := false
+	for i := range runs {
+		if runs[i].ID == wf.ID && runs[i].RunID == wf.RunID {
+			runs[i] = wf
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		runs = append(runs, wf)
+	}
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].StartTime.After(runs[j].StartTime)
+	})
+	s.Workflows[namespace] = runs
+}
+
+// putTaskQueue adds a task queue, or replaces the one with the same name.
+func (s *Snapshot) putTaskQueue(namespace string, tq SnapshotTaskQueue) {
+	queues := s.TaskQueues[namespace]
+	for i := range queues {
+		if queues[i].Info.Name == tq.Info.Name {
+			queues[i] = tq
+			return
+		}
+	}
+	s.TaskQueues[namespace] = append(queues, tq)
+}
+
+// putSchedule adds a schedule, or replaces the one with the same ID.
+func (s *Snapshot) putSchedule(namespace string, sched Schedule) {
+	schedules := s.Schedules[namespace]
+	for i := range schedules {
+		if schedules[i].ID == sched.ID {
+			schedules[i] = sched
+			return
+		}
+	}
+	s.Schedules[namespace] = append(schedules, sched)
+}
@@ -60,6 +60,13 @@ func MapNamespaceState(state enums.NamespaceState) string {
 	}
 }
 
+// UpdateStatus constants for workflow updates recorded in history.
+// Completed and failed updates reuse StatusCompleted and StatusFailed.
+const (
+	UpdateStatusAdmitted = "Admitted"
+	UpdateStatusAccepted = "Accepted"
+)
+
 // TaskQueueType constants.
 const (
 	TaskQueueTypeWorkflow = "Workflow"
@@ -78,20 +85,86 @@ func MapTaskQueueType(tqType enums.TaskQueueType) string {
 	}
 }
 
+// StatusColors are the colors statuses are drawn in, by what they mean.
+type StatusColors struct {
+	Running theme.ColorFunc // Running and accepted
+	Success theme.ColorFunc // Completed and active
+	Failure theme.ColorFunc // Failed, terminated and deleted
+	Warning theme.ColorFunc // Canceled, timed out and deprecated
+}
+
+// ThemeStatusColors returns the current theme's status colors.
+func ThemeStatusColors() StatusColors {
+	return StatusColors{
+		Running: theme.Info,
+		Success: theme.Success,
+		Failure: theme.Error,
+		Warning: theme.Warning,
+	}
+}
+
+// statusMarkers are plain-text symbols that tell statuses apart without color
+// or a Nerd Font.
+var statusMarkers = map[string]string{
+	StatusRunning:            "▶",
+	StatusCompleted:          "✓",
+	StatusFailed:             "✗",
+	StatusCanceled:           "⊘",
+	StatusTerminated:         "■",
+	StatusTimedOut:           "◷",
+	StatusUnknown:            "?",
+	NamespaceStateActive:     "✓",
+	NamespaceStateDeprecated: "!",
+	NamespaceStateDeleted:    "✗",
+	UpdateStatusAdmitted:     "…",
+	UpdateStatusAccepted:     "▶",
+}
+
+// markersEnabled is set when statuses are registered with markers.
+var markersEnabled bool
+
+// StatusMarker returns the plain-text symbol of a status when statuses are
+// registered with markers, or "" otherwise.
+func StatusMarker(status string) string {
+	if !markersEnabled {
+		return ""
+	}
+	return statusMarkers[status]
+}
+
 // RegisterTemporalStatuses registers Temporal-specific statuses with jig's theme system.
 // Uses dynamic colors that update when theme changes.
 func RegisterTemporalStatuses() {
-	// Workflow execution statuses - use dynamic theme colors
-	theme.RegisterStatusDynamic(StatusRunning, theme.Info, theme.IconRunning)
-	theme.RegisterStatusDynamic(StatusCompleted, theme.Success, theme.IconCompleted)
-	theme.RegisterStatusDynamic(StatusFailed, theme.Error, theme.IconFailed)
-	theme.RegisterStatusDynamic(StatusCanceled, theme.Warning, theme.IconCanceled)
-	theme.RegisterStatusDynamic(StatusTerminated, theme.Error, theme.IconStop)
-	theme.RegisterStatusDynamic(StatusTimedOut, theme.Warning, theme.IconTimedOut)
-	theme.RegisterStatusDynamic(StatusUnknown, theme.FgDim, theme.IconPending)
+	RegisterStatuses(ThemeStatusColors(), false)
+}
+
+// RegisterStatuses registers Temporal-specific statuses with jig's theme system
+// in the given colors. With markers, statuses use plain-text symbols as icons
+// instead of Nerd Font glyphs, and StatusMarker returns them.
+func RegisterStatuses(colors StatusColors, markers bool) {
+	markersEnabled = markers
+	icon := func(status, glyph string) string {
+		if markers {
+			return statusMarkers[status]
+		}
+		return glyph
+	}
+
+	// Workflow execution statuses
+	theme.RegisterStatusDynamic(StatusRunning, colors.Running, icon(StatusRunning, theme.IconRunning))
+	theme.RegisterStatusDynamic(StatusCompleted, colors.Success, icon(StatusCompleted, theme.IconCompleted))
+	theme.RegisterStatusDynamic(StatusFailed, colors.Failure, icon(StatusFailed, theme.IconFailed))
+	theme.RegisterStatusDynamic(StatusCanceled, colors.Warning, icon(StatusCanceled, theme.IconCanceled))
+	theme.RegisterStatusDynamic(StatusTerminated, colors.Failure, icon(StatusTerminated, theme.IconStop))
+	theme.RegisterStatusDynamic(StatusTimedOut, colors.Warning, icon(StatusTimedOut, theme.IconTimedOut))
+	theme.RegisterStatusDynamic(StatusUnknown, theme.FgDim, icon(StatusUnknown, theme.IconPending))
 
 	// Namespace states
-	theme.RegisterStatusDynamic(NamespaceStateActive, theme.Success, theme.IconCheck)
-	theme.RegisterStatusDynamic(NamespaceStateDeprecated, theme.Warning, theme.IconWarning)
-	theme.RegisterStatusDynamic(NamespaceStateDeleted, theme.Error, theme.IconDelete)
+	theme.RegisterStatusDynamic(NamespaceStateActive, colors.Success, icon(NamespaceStateActive, theme.IconCheck))
+	theme.RegisterStatusDynamic(NamespaceStateDeprecated, colors.Warning, icon(NamespaceStateDeprecated, theme.IconWarning))
+	theme.RegisterStatusDynamic(NamespaceStateDeleted, colors.Failure, icon(NamespaceStateDeleted, theme.IconDelete))
+
+	// Workflow update states
+	theme.RegisterStatusDynamic(UpdateStatusAdmitted, theme.FgDim, icon(UpdateStatusAdmitted, theme.IconPending))
+	theme.RegisterStatusDynamic(UpdateStatusAccepted, colors.Running, icon(UpdateStatusAccepted, theme.IconRunning))
 }
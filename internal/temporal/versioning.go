@@ -0,0 +1,169 @@
+package temporal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// VersioningRules are a task queue's Build ID assignment and redirect rules.
+type VersioningRules struct {
+	// Assignment rules in priority order; the first unconditional rule is the default build
+	AssignmentRules []AssignmentRule
+	RedirectRules   []RedirectRule
+	// Token from the last read, required to update the rules
+	ConflictToken []byte
+}
+
+// AssignmentRule assigns new workflows to a Build ID, optionally for only a
+// percentage of them.
+type AssignmentRule struct {
+	TargetBuildID string
+	Ramped        bool    // Whether the rule applies to only a percentage of workflows
+	Percentage    float32 // Ramp percentage, when Ramped
+	CreateTime    time.Time
+}
+
+// RedirectRule moves workflows on the source Build ID to a compatible target Build ID.
+type RedirectRule struct {
+	SourceBuildID string
+	TargetBuildID string
+	CreateTime    time.Time
+}
+
+// DefaultBuildID returns the Build ID of the first unconditional assignment rule,
+// or empty if new workflows go to unversioned workers.
+func (r *VersioningRules) DefaultBuildID() string {
+	for _, rule := range r.AssignmentRules {
+		if !rule.Ramped {
+			return rule.TargetBuildID
+		}
+	}
+	return ""
+}
+
+// Build ID reachability as reported by the server.
+const (
+	ReachabilityReachable   = "Reachable"
+	ReachabilityClosedOnly  = "ClosedWorkflowsOnly"
+	ReachabilityUnreachable = "Unreachable"
+	ReachabilityUnknown     = "Unknown"
+)
+
+// BuildIDReachability describes which workflows may still run on a Build ID. The
+// server's answer is eventually consistent and errs on the side of reachable.
+type BuildIDReachability struct {
+	BuildID      string
+	Reachability string // One of the Reachability constants
+	NewWorkflows bool   // An assignment rule sends new workflows to the Build ID
+}
+
+// Summary describes the reachability in a short phrase.
+func (r BuildIDReachability) Summary() string {
+	switch r.Reachability {
+	case ReachabilityReachable:
+		if r.NewWorkflows {
+			return "Reachable by new workflows"
+		}
+		return "Reachable by open workflows"
+	case ReachabilityClosedOnly:
+		return "Closed workflows only"
+	case ReachabilityUnreachable:
+		return "Unreachable"
+	default:
+		return "Not reported by the server"
+	}
+}
+
+// SafeToDecommission reports whether no open or new workflows can reach the Build ID.
+// Closed workflows may still need it to answer queries within the retention period.
+func (r BuildIDReachability) SafeToDecommission() bool {
+	return r.Reachability == ReachabilityClosedOnly || r.Reachability == ReachabilityUnreachable
+}
+
+// VersioningRuleOp is a kind of change to a task queue's versioning rules.
+type VersioningRuleOp string
+
+// Versioning rule operations, named after the equivalent CLI subcommands.
+const (
+	VersioningInsertAssignmentRule  VersioningRuleOp = "insert-assignment-rule"
+	VersioningReplaceAssignmentRule VersioningRuleOp = "replace-assignment-rule"
+	VersioningDeleteAssignmentRule  VersioningRuleOp = "delete-assignment-rule"
+	VersioningAddRedirectRule       VersioningRuleOp = "add-redirect-rule"
+	VersioningDeleteRedirectRule    VersioningRuleOp = "delete-redirect-rule"
+	VersioningCommitBuildID         VersioningRuleOp = "commit-build-id"
+)
+
+// VersioningRuleChange is a single change to a task queue's versioning rules.
+type VersioningRuleChange struct {
+	Op            VersioningRuleOp
+	RuleIndex     int32    // Assignment rule position for insert, replace and delete
+	BuildID       string   // Target Build ID
+	SourceBuildID string   // Source Build ID for redirect rules
+	Ramp          *float32 // Ramp percentage for assignment rules; nil for unconditional
+	Force         bool     // Skip the server's safety checks
+	ConflictToken []byte
+}
+
+// Command returns the Temporal CLI command equivalent to the change.
+func (c VersioningRuleChange) Command(namespace, taskQueue string) string {
+	args := []string{"temporal", "task-queue", "versioning", string(c.Op),
+		"--namespace", shellQuote(namespace),
+		"--task-queue", shellQuote(taskQueue)}
+
+	switch c.Op {
+	case VersioningInsertAssignmentRule, VersioningReplaceAssignmentRule:
+		args = append(args, "--build-id", shellQuote(c.BuildID))
+		if c.RuleIndex != 0 || c.Op == VersioningReplaceAssignmentRule {
+			args = append(args, "--rule-index", strconv.Itoa(int(c.RuleIndex)))
+		}
+		if c.Ramp != nil {
+			args = append(args, "--percentage", strconv.FormatFloat(float64(*c.Ramp), 'f', -1, 32))
+		}
+	case VersioningDeleteAssignmentRule:
+		args = append(args, "--rule-index", strconv.Itoa(int(c.RuleIndex)))
+	case VersioningAddRedirectRule:
+		args = append(args, "--source-build-id", shellQuote(c.SourceBuildID), "--target-build-id", shellQuote(c.BuildID))
+	case VersioningDeleteRedirectRule:
+		args = append(args, "--source-build-id", shellQuote(c.SourceBuildID))
+	case VersioningCommitBuildID:
+		args = append(args, "--build-id", shellQuote(c.BuildID))
+	}
+	if c.Force {
+		args = append(args, "--force")
+	}
+	args = append(args, "--yes")
+	return strings.Join(args, " ")
+}
+
+// shellQuote quotes s for a POSIX shell when it contains anything but safe characters.
+func shellQuote(s string) string {
+	if s != "" && strings.Trim(s, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_.:/@+=") == "" {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// Validate checks that the fields required by the operation are set.
+func (c VersioningRuleChange) Validate() error {
+	switch c.Op {
+	case VersioningInsertAssignmentRule, VersioningReplaceAssignmentRule, VersioningAddRedirectRule, VersioningCommitBuildID:
+		if c.BuildID == "" {
+			return fmt.Errorf("build ID is required")
+		}
+	}
+	switch c.Op {
+	case VersioningAddRedirectRule, VersioningDeleteRedirectRule:
+		if c.SourceBuildID == "" {
+			return fmt.Errorf("source build ID is required")
+		}
+	}
+	if c.Ramp != nil && (*c.Ramp < 0 || *c.Ramp > 100) {
+		return fmt.Errorf("ramp percentage must be between 0 and 100")
+	}
+	if c.RuleIndex < 0 {
+		return fmt.Errorf("rule index must not be negative")
+	}
+	return nil
+}
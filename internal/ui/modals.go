@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"slices"
+
+	"github.com/atterpac/jig/layout"
+	"github.com/atterpac/jig/nav"
+	"github.com/rivo/tview"
+)
+
+// Modals shows modal pages over the app's views. Modals stack in the order they
+// were opened, and closing one gives focus back to whatever had it when the
+// modal opened.
+type Modals struct {
+	app   *layout.App
+	stack []*modal
+}
+
+// modal is an open modal page.
+type modal struct {
+	name  string
+	focus tview.Primitive // Focused when the modal opened
+	view  nav.Component   // View shown when the modal opened
+}
+
+// NewModals creates a modal manager for app.
+func NewModals(app *layout.App) *Modals {
+	return &Modals{app: app}
+}
+
+// Show opens p as a modal page and focuses focus, or p itself when focus is
+// nil. Showing a modal under a name that is already open replaces that modal
+// and brings it to the front; it still restores the focus it first found.
+func (m *Modals) Show(name string, p, focus tview.Primitive) {
+	entry := &modal{
+		name:  name,
+		focus: m.app.GetApplication().GetFocus(),
+		view:  m.app.Pages().Current(),
+	}
+	if i := m.index(name); i >= 0 {
+		entry.focus, entry.view = m.stack[i].focus, m.stack[i].view
+		m.remove(i)
+	}
+	m.stack = append(m.stack, entry)
+
+	m.app.Pages().AddPage(name, p, true, true)
+	if focus == nil {
+		focus = p
+	}
+	m.app.SetFocus(focus)
+}
+
+// Close closes the named modal. When it was the front modal, focus returns to
+// what had it when the modal opened, or to the current view if the view has
+// changed since. Closing a modal that isn't open does nothing.
+func (m *Modals) Close(name string) {
+	i := m.index(name)
+	if i < 0 {
+		return
+	}
+	entry := m.stack[i]
+	front := i == len(m.stack)-1
+	m.remove(i)
+	m.app.Pages().RemovePage(name)
+	if !front {
+		return
+	}
+
+	current := m.app.Pages().Current()
+	switch {
+	case entry.focus != nil && entry.view == current:
+		m.app.SetFocus(entry.focus)
+	case current != nil:
+		m.app.SetFocus(current)
+	}
+}
+
+// Reset closes every modal without restoring focus, for when the views behind
+// them are being replaced. Clearing the app's pages drops the modal pages too,
+// so call it wherever pages are cleared to keep the stack in step.
+func (m *Modals) Reset() {
+	for _, entry := range m.stack {
+		m.app.Pages().RemovePage(entry.name)
+	}
+	m.stack = nil
+}
+
+// IsOpen reports whether the named modal is open.
+func (m *Modals) IsOpen(name string) bool {
+	return m.index(name) >= 0
+}
+
+// Len returns the number of open modals.
+func (m *Modals) Len() int {
+	return len(m.stack)
+}
+
+// Front returns the name of the front modal, or "" when none is open.
+func (m *Modals) Front() string {
+	if len(m.stack) == 0 {
+		return ""
+	}
+	return m.stack[len(m.stack)-1].name
+}
+
+func (m *Modals) index(name string) int {
+	return slices.IndexFunc(m.stack, func(e *modal) bool { return e.name == name })
+}
+
+// remove takes the modal at i off the stack. A modal opened over it was opened
+// from it, so it inherits the focus to restore.
+func (m *Modals) remove(i int) {
+	if i+1 < len(m.stack) {
+		m.stack[i+1].focus = m.stack[i].focus
+		m.stack[i+1].view = m.stack[i].view
+	}
+	m.stack = slices.Delete(m.stack, i, i+1)
+}
@@ -0,0 +1,61 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+)
+
+// actionMenuPage is the page name of the row action menu.
+const actionMenuPage = "action-menu"
+
+// rowAction is an entry in the action menu of a table row.
+type rowAction struct {
+	Label string
+	Key   string // Binding that runs the action from the view, if any
+	Run   func()
+}
+
+// showActionMenu opens a menu of every action that applies to a table row,
+// like a right-click menu, with the keys that run them directly. Enter closes
+// the menu and runs the selected action.
+func (a *App) showActionMenu(title string, actions []rowAction) {
+	if len(actions) == 0 {
+		return
+	}
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s %s", theme.IconInfo, truncate(title, 40)),
+		Width:    50,
+		Height:   min(len(actions)+6, 24),
+		Backdrop: true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders("ACTION", "KEY")
+	table.SetBorder(false)
+	for _, action := range actions {
+		table.AddRow(action.Label, action.Key)
+		table.GetCell(table.GetRowCount()-1, 1).SetTextColor(theme.FgDim())
+	}
+	table.SelectRow(0)
+
+	table.SetOnSelect(func(row int) {
+		if row >= 0 && row < len(actions) {
+			a.modals.Close(actionMenuPage)
+			actions[row].Run()
+		}
+	})
+
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Run"},
+		{Key: "Esc", Description: "Close"},
+	})
+	modal.SetOnCancel(func() {
+		a.modals.Close(actionMenuPage)
+	})
+
+	a.modals.Show(actionMenuPage, modal, table)
+}
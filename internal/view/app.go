@@ -4,15 +4,16 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/layout"
 	"github.com/atterpac/jig/nav"
 	"github.com/atterpac/jig/theme"
-	"github.com/atterpac/jig/theme/themes"
 	"github.com/galaxy-io/tempo/internal/config"
 	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/galaxy-io/tempo/internal/ui"
 	"github.com/galaxy-io/tempo/internal/update"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -31,18 +32,44 @@ type App struct {
 	statusBar     *layout.StatusBar
 	menu          *layout.Menu
 	toasts        *components.ToastManager
+	modals        *ui.Modals
 	provider      temporal.Provider
+	providerMu    sync.RWMutex                 // Guards provider for background monitors
+	connections   map[string]temporal.Provider // Open connections by profile, including the active one
 	namespaceList *NamespaceList
 	currentNS     string
 
 	// Connection monitor
-	stopMonitor  chan struct{}
-	reconnecting bool
+	stopMonitor chan struct{}
+	stuckQueues []string // Task queues with a backlog and no live pollers
+
+	statusSegments map[string][]layout.StatusSection // Status bar contents by segment
+
+	commandNamespaces []string         // Namespace completions for the command bar
+	recentWorkflows   []recentWorkflow // Workflows viewed this session, for the palette
+
+	// Tabbed workspaces; the active tab's views are in the page stack
+	tabs      []*tab
+	activeTab int
+	pendingG  bool            // g was pressed, for gt/gT and g1..g9
+	gTimer    *time.Timer     // Hands a pending g to the view when no second key follows
+	replayedG *tcell.EventKey // The g handed to the view, passed through the prefix check
+
+	loads map[*loadingView]context.CancelFunc // Requests showing a spinner
+
+	prefetch *workflowPrefetcher // Details of the highlighted workflow, loaded ahead
+
+	signals *config.SignalHistory // Recently sent signals, read when first needed
+
+	viewHelp []viewHelp // Bindings of every view, read when help first opens
 
 	// Profile management
 	config        *config.Config
 	activeProfile string
 
+	// Key remapping for the selected keymap preset
+	keys *keyRemapper
+
 	// Dev mode
 	devMode bool
 }
@@ -61,6 +88,7 @@ func NewApp() *App {
 func NewAppWithProvider(provider temporal.Provider, defaultNamespace string, cfg *config.Config, activeProfile string) *App {
 	a := &App{
 		provider:      provider,
+		connections:   map[string]temporal.Provider{activeProfile: provider},
 		currentNS:     defaultNamespace,
 		stopMonitor:   make(chan struct{}),
 		config:        cfg,
@@ -68,10 +96,12 @@ func NewAppWithProvider(provider temporal.Provider, defaultNamespace string, cfg
 	}
 	a.buildApp()
 	a.setup()
+	if cfg != nil {
+		a.setKeymap(cfg.GetKeymap())
+	}
 
-	// Set initial profile name in stats bar (must be first - clears sections)
+	// Set initial profile name and connection status in the status bar
 	a.setProfile(activeProfile)
-	// Set initial connection status based on provider (adds section 2)
 	if provider != nil {
 		a.setConnected(provider.IsConnected())
 	}
@@ -80,7 +110,8 @@ func NewAppWithProvider(provider temporal.Provider, defaultNamespace string, cfg
 
 func (a *App) buildApp() {
 	// Register Temporal-specific statuses with jig's theme system
-	temporal.RegisterTemporalStatuses()
+	a.registerStatuses()
+	a.applyTimeSettings()
 
 	// Create status bar with left-aligned title and content
 	a.statusBar = layout.NewStatusBar()
@@ -88,23 +119,37 @@ func (a *App) buildApp() {
 	a.statusBar.SetTitleAlign(components.AlignLeft)
 	a.statusBar.SetContentAlign(components.AlignLeft)
 
+	if a.noColor() {
+		useASCIIBorders()
+	}
+
+	var topBar tview.Primitive = a.statusBar
+	topBarHeight := 3
+	if a.statusBarSettings().Compact || a.noColor() {
+		topBar = &compactStatusBar{StatusBar: a.statusBar}
+		topBarHeight = 1
+	}
+
 	// Create menu
 	a.menu = layout.NewMenu()
 
 	// Create app with jig layout
 	a.app = layout.NewApp(layout.AppConfig{
-		TopBar:       a.statusBar,
-		TopBarHeight: 3,
+		TopBar:       topBar,
+		TopBarHeight: topBarHeight,
 		ShowCrumbs:   true,
 		BottomBar:    a.menu,
 		OnComponentChange: func(c nav.Component) {
 			if c != nil {
-				a.menu.SetHints(c.Hints())
+				a.menu.SetHints(a.keys.hints(c.Hints()))
 			}
 			a.updateCrumbs()
 		},
 	})
 
+	a.setupCrumbs()
+	a.modals = ui.NewModals(a.app)
+
 	// Create toast manager for notifications
 	a.toasts = components.NewToastManager(a.app.GetApplication())
 	a.toasts.SetPosition(components.ToastBottomRight)
@@ -117,18 +162,17 @@ func (a *App) buildApp() {
 }
 
 func (a *App) setup() {
+	a.resetTabs()
+
 	// Set up command bar callbacks
+	a.setCommandCompletion()
 	a.statusBar.SetOnCommandSubmit(func(text string) {
 		a.statusBar.ExitCommandMode()
-		text = strings.TrimSpace(text)
-		if strings.HasPrefix(text, "profile") {
-			args := strings.TrimPrefix(text, "profile")
-			a.handleProfileCommand(strings.TrimSpace(args))
-		}
-		// Restore focus to current view
+		// Restore focus to current view, before a command opens a modal over it
 		if current := a.app.Pages().Current(); current != nil {
 			a.app.SetFocus(current)
 		}
+		a.handleCommand(text)
 	})
 
 	a.statusBar.SetOnCommandCancel(func() {
@@ -146,31 +190,26 @@ func (a *App) setup() {
 			return event
 		}
 
-		frontPage, _ := a.app.Pages().GetFrontPage()
-
-		// Check if we're on a modal page that should handle its own escape
-		isModalPage := strings.HasSuffix(frontPage, "-confirm") || // cancel-confirm, terminate-confirm, delete-confirm, etc.
-			strings.HasSuffix(frontPage, "-modal") || // help-modal, event-detail-modal, io-modal, etc.
-			strings.HasSuffix(frontPage, "-form") || // profile-form, edit-form
-			strings.HasSuffix(frontPage, "-input") || // signal-input, query-input, template-input, diff-input, workflow-input
-			strings.HasSuffix(frontPage, "-error") || // query-error, reset-error
-			strings.HasSuffix(frontPage, "-result") || // query-result
-			strings.HasSuffix(frontPage, "-loading") || // reset-loading
-			strings.HasSuffix(frontPage, "-picker") || // reset-picker
-			strings.HasSuffix(frontPage, "-selector") || // theme-selector, profile-selector
-			strings.HasSuffix(frontPage, "-query") || // visibility-query
-			strings.HasPrefix(frontPage, "batch-") || // batch-cancel, batch-terminate
-			strings.HasPrefix(frontPage, "quick-") || // quick-reset
-			frontPage == "splash-test" ||
-			frontPage == "query-templates" ||
-			frontPage == "date-range" ||
-			frontPage == "saved-filters" ||
-			frontPage == "save-filter" ||
-			frontPage == "event-detail"
+		// Modals handle their own escape and keys
+		isModalPage := a.modals.Len() > 0
+
+		// gt/gT switch tabs and g1..g9 jump to a breadcrumb - read before the
+		// keymap, which may swallow a bare g
+		if !isModalPage && a.handleGPrefix(event) {
+			return nil
+		}
+
+		// Translate keys for the active keymap (modals keep their own bindings,
+		// a maximized panel keeps its view's)
+		if !isModalPage || a.modals.Front() == zenPage {
+			if event = a.keys.remap(event); event == nil {
+				return nil
+			}
+		}
 
 		// Global quit (only on root view, not in modals)
 		if event.Rune() == 'q' && !isModalPage {
-			if a.app.Pages().StackDepth() <= 1 {
+			if a.stackDepth() <= 1 {
 				a.Stop()
 				return nil
 			}
@@ -187,16 +226,48 @@ func (a *App) setup() {
 						}
 					}
 				}
-				if a.app.Pages().CanPop() {
-					a.app.Pages().Pop()
-					if current := a.app.Pages().Current(); current != nil {
-						a.app.SetFocus(current)
-					}
+				if a.GoBack() {
 					return nil
 				}
 			}
 		}
 
+		// Tabs: Ctrl+T opens, Ctrl+W closes, Alt+1..9 switch - work everywhere except modals
+		if !isModalPage {
+			switch {
+			case event.Key() == tcell.KeyCtrlT:
+				a.NewTab()
+				return nil
+			case event.Key() == tcell.KeyCtrlW:
+				a.CloseTab()
+				return nil
+			case event.Modifiers()&tcell.ModAlt != 0 && event.Rune() >= '1' && event.Rune() <= '9':
+				a.SwitchTab(int(event.Rune() - '1'))
+				return nil
+			}
+		}
+
+		// History: Alt+Left/Alt+Right go back/forward, Alt+H lists visited locations - work everywhere except modals
+		if !isModalPage && event.Modifiers()&tcell.ModAlt != 0 {
+			switch {
+			case event.Key() == tcell.KeyLeft:
+				a.GoBack()
+				return nil
+			case event.Key() == tcell.KeyRight:
+				a.GoForward()
+				return nil
+			case event.Rune() == 'h' || event.Rune() == 'H':
+				a.ShowHistory()
+				return nil
+			}
+		}
+
+		// Time display: Alt+T cycles relative, local and UTC times - works everywhere except modals
+		if !isModalPage && event.Modifiers()&tcell.ModAlt != 0 && (event.Rune() == 't' || event.Rune() == 'T') {
+			a.cycleTimeDisplay()
+			return nil
+		}
+
 		// Help (works everywhere except modals)
 		if event.Rune() == '?' && !isModalPage {
 			a.showHelp()
@@ -215,12 +286,35 @@ func (a *App) setup() {
 			return nil
 		}
 
+		// List settings (capital O) - works everywhere except modals
+		if event.Rune() == 'O' && !isModalPage {
+			a.showSettings()
+			return nil
+		}
+
 		// Command bar (: key) - works everywhere except modals
 		if event.Rune() == ':' && !isModalPage {
 			a.showCommandBar()
 			return nil
 		}
 
+		// Command palette (Ctrl+P, or Ctrl+K where the keymap uses Ctrl+P) - works everywhere except modals
+		if (event.Key() == tcell.KeyCtrlP || event.Key() == tcell.KeyCtrlK) && !isModalPage {
+			a.ShowCommandPalette()
+			return nil
+		}
+
+		// Cancel slow requests (Ctrl+X) - works everywhere except modals
+		if event.Key() == tcell.KeyCtrlX && !isModalPage && a.cancelLoading() {
+			return nil
+		}
+
+		// Namespace switcher (Ctrl+E) - works everywhere except modals
+		if event.Key() == tcell.KeyCtrlE && !isModalPage {
+			a.ShowNamespaceSwitcher()
+			return nil
+		}
+
 		// Dev mode: splash screen test (capital S)
 		if a.devMode && event.Rune() == 'S' {
 			a.showSplashTest()
@@ -230,9 +324,17 @@ func (a *App) setup() {
 		return event
 	})
 
-	// Create and push the home view
+	a.pushHomeView()
+}
+
+// pushHomeView pushes the namespace list, with the dashboard on top when it is
+// configured as the landing page.
+func (a *App) pushHomeView() {
 	a.namespaceList = NewNamespaceList(a)
-	a.app.Pages().Push(a.namespaceList)
+	a.navigate(a.namespaceList)
+	if a.config != nil && a.config.StartsOnDashboard() {
+		a.NavigateToDashboard()
+	}
 }
 
 func (a *App) updateCrumbs() {
@@ -241,11 +343,26 @@ func (a *App) updateCrumbs() {
 		return
 	}
 
+	path := a.crumbPath(current)
+	if crumb := a.tabCrumb(); crumb != "" {
+		path = append([]string{crumb}, path...)
+	}
+	a.app.Crumbs().SetPath(path)
+}
+
+// crumbPath returns the breadcrumbs for a view.
+func (a *App) crumbPath(c nav.Component) []string {
 	var path []string
-	if named, ok := current.(interface{ Name() string }); ok {
+	if named, ok := c.(interface{ Name() string }); ok {
 		switch named.Name() {
 		case "namespaces":
 			path = []string{"Namespaces"}
+		case "dashboard":
+			path = []string{"Namespaces", "Dashboard"}
+		case "nexus-endpoints":
+			path = []string{"Namespaces", "Nexus Endpoints"}
+		case "global-search":
+			path = []string{"Namespaces", "Global Search"}
 		case "workflows":
 			path = []string{"Namespaces", a.currentNS, "Workflows"}
 		case "workflow-detail":
@@ -254,17 +371,22 @@ func (a *App) updateCrumbs() {
 			path = []string{"Namespaces", a.currentNS, "Workflows", "Detail", "Events"}
 		case "task-queues":
 			path = []string{"Namespaces", a.currentNS, "Task Queues"}
+		case "task-queue-detail":
+			path = []string{"Namespaces", a.currentNS, "Task Queues", "Detail"}
+		case "versioning-rules":
+			path = []string{"Namespaces", a.currentNS, "Task Queues", "Detail", "Versioning"}
+		case "worker-deployments":
+			path = []string{"Namespaces", a.currentNS, "Deployments"}
 		case "schedules":
 			path = []string{"Namespaces", a.currentNS, "Schedules"}
 		case "workflow-diff":
 			path = []string{"Namespaces", a.currentNS, "Workflows", "Diff"}
 		}
 	}
-	a.app.Crumbs().SetPath(path)
+	return path
 }
 
 // Status bar helpers
-// Section layout: [0] profile, [1] namespace, [2] connection status
 
 func (a *App) setConnected(connected bool) {
 	icon := theme.IconDisconnected
@@ -276,37 +398,34 @@ func (a *App) setConnected(connected bool) {
 		colorFunc = theme.Success
 	}
 
-	section := layout.StatusSection{
+	a.setStatusSegment(config.SegmentConnection, layout.StatusSection{
 		Icon:      icon,
 		Text:      text,
 		ColorFunc: colorFunc,
-	}
+	})
+}
 
-	// Connection status is section 2
-	if a.statusBar.SectionCount() >= 3 {
-		a.statusBar.UpdateSection(2, section)
-	} else {
-		a.statusBar.AddSection(section)
-	}
+// setReconnecting shows that the connection is down and when the next attempt is made.
+func (a *App) setReconnecting(next time.Duration) {
+	a.setStatusSegment(config.SegmentConnection, layout.StatusSection{
+		Icon:      theme.IconDisconnected,
+		Text:      fmt.Sprintf("reconnecting in %s", next),
+		ColorFunc: theme.Warning,
+	})
 }
 
 func (a *App) setProfile(name string) {
-	a.statusBar.ClearSections()
-	// Section 0: profile (accent color, no icon)
-	a.statusBar.AddSection(layout.StatusSection{
+	// Profile (accent color, no icon)
+	a.setStatusSegment(config.SegmentProfile, layout.StatusSection{
 		Text:      name,
 		ColorFunc: theme.Accent,
 	})
-	// Section 1: namespace (no icon)
-	a.statusBar.AddSection(layout.StatusSection{
-		Text: a.currentNS,
-	})
-	// Section 2: connection status (will be set by setConnected)
+	a.setNamespace(a.currentNS)
 }
 
 func (a *App) setNamespace(ns string) {
-	// Namespace is section 1 (no icon)
-	a.statusBar.UpdateSection(1, layout.StatusSection{
+	// Namespace (no icon)
+	a.setStatusSegment(config.SegmentNamespace, layout.StatusSection{
 		Text: ns,
 	})
 }
@@ -318,31 +437,30 @@ type WorkflowStats struct {
 	Failed    int
 }
 
-// SetWorkflowStats updates the workflow statistics in the status bar (right-aligned).
+// SetWorkflowStats updates the workflow counts segment of the status bar.
 func (a *App) SetWorkflowStats(stats WorkflowStats) {
-	// Clear existing right sections and add new stats
-	a.statusBar.ClearRightSections()
-
 	// Format: dimmed label, colored number
 	dimTag := theme.TagFgDim()
 	runningColor := theme.TagInfo()
 	completedColor := theme.TagSuccess()
 	failedColor := theme.TagError()
 
-	a.statusBar.AddRightSection(layout.StatusSection{
-		Text: fmt.Sprintf("[%s]Running:[-] [%s]%d[-]", dimTag, runningColor, stats.Running),
-	})
-	a.statusBar.AddRightSection(layout.StatusSection{
-		Text: fmt.Sprintf("[%s]Completed:[-] [%s]%d[-]", dimTag, completedColor, stats.Completed),
-	})
-	a.statusBar.AddRightSection(layout.StatusSection{
-		Text: fmt.Sprintf("[%s]Failed:[-] [%s]%d[-]", dimTag, failedColor, stats.Failed),
-	})
+	a.setStatusSegment(config.SegmentCounts,
+		layout.StatusSection{
+			Text: fmt.Sprintf("[%s]Running:[-] [%s]%d[-]", dimTag, runningColor, stats.Running),
+		},
+		layout.StatusSection{
+			Text: fmt.Sprintf("[%s]Completed:[-] [%s]%d[-]", dimTag, completedColor, stats.Completed),
+		},
+		layout.StatusSection{
+			Text: fmt.Sprintf("[%s]Failed:[-] [%s]%d[-]", dimTag, failedColor, stats.Failed),
+		},
+	)
 }
 
-// ClearWorkflowStats removes workflow statistics from the status bar.
+// ClearWorkflowStats removes the workflow counts from the status bar.
 func (a *App) ClearWorkflowStats() {
-	a.statusBar.ClearRightSections()
+	a.setStatusSegment(config.SegmentCounts)
 }
 
 // App returns the underlying jig layout.App.
@@ -350,15 +468,27 @@ func (a *App) JigApp() *layout.App {
 	return a.app
 }
 
+// Modals returns the manager that shows modal pages over the views.
+func (a *App) Modals() *ui.Modals {
+	return a.modals
+}
+
 // Provider returns the Temporal provider.
 func (a *App) Provider() temporal.Provider {
+	a.providerMu.RLock()
+	defer a.providerMu.RUnlock()
 	return a.provider
 }
 
 // SetNamespace sets the current namespace context.
 func (a *App) SetNamespace(ns string) {
+	changed := ns != a.currentNS
 	a.currentNS = ns
 	a.setNamespace(ns)
+	if changed && a.provider != nil {
+		a.setStuckQueues(nil)
+		go a.checkStuckQueues()
+	}
 }
 
 // CurrentNamespace returns the current namespace.
@@ -366,53 +496,163 @@ func (a *App) CurrentNamespace() string {
 	return a.currentNS
 }
 
+// OpenDeepLink opens the workflow list of the current namespace, filtered by query
+// when set, and the workflow's detail view when workflowID is set. It is used at
+// launch so links from runbooks open on the right view; Esc walks back to the
+// home view as usual.
+func (a *App) OpenDeepLink(workflowID, runID, query string) {
+	if !a.NamespaceAllowed(a.currentNS) {
+		a.toasts.Warning(fmt.Sprintf("Namespace %q is not allowed by profile %q", a.currentNS, a.activeProfile))
+		return
+	}
+	if query != "" {
+		a.NavigateToWorkflowsWithQuery(query)
+	} else {
+		a.NavigateToWorkflows(a.currentNS)
+	}
+	if workflowID != "" {
+		a.NavigateToWorkflowDetail(workflowID, runID)
+	}
+}
+
+// NavigateToNexusEndpoints pushes the Nexus endpoints view.
+func (a *App) NavigateToNexusEndpoints() {
+	a.navigate(NewNexusEndpointsView(a))
+}
+
+// NavigateToGlobalSearch pushes a workflow search across all allowed namespaces,
+// searching for query right away when it is set.
+func (a *App) NavigateToGlobalSearch(query string) {
+	a.navigate(NewGlobalSearchView(a, query))
+}
+
+// NavigateToDashboard pushes the multi-namespace dashboard view.
+func (a *App) NavigateToDashboard() {
+	a.navigate(NewDashboardView(a))
+}
+
+// NamespaceAllowed returns whether the active profile's namespace allowlist permits ns.
+func (a *App) NamespaceAllowed(ns string) bool {
+	if a.config == nil {
+		return true
+	}
+	profile, ok := a.config.GetProfile(a.activeProfile)
+	return !ok || profile.AllowsNamespace(ns)
+}
+
+// warnInvalidNamespacePatterns reports allowlist expressions in the active profile
+// that don't compile and therefore match nothing.
+func (a *App) warnInvalidNamespacePatterns() {
+	if a.config == nil {
+		return
+	}
+	profile, ok := a.config.GetProfile(a.activeProfile)
+	if !ok {
+		return
+	}
+	if invalid := profile.InvalidNamespacePatterns(); len(invalid) > 0 {
+		a.toasts.Warning("Invalid namespace patterns ignored: " + strings.Join(invalid, ", "))
+	}
+}
+
+// allowedNamespaces filters namespaces by the active profile's allowlist.
+func (a *App) allowedNamespaces(namespaces []temporal.Namespace) []temporal.Namespace {
+	var allowed []temporal.Namespace
+	for _, ns := range namespaces {
+		if a.NamespaceAllowed(ns.Name) {
+			allowed = append(allowed, ns)
+		}
+	}
+	return allowed
+}
+
 // NavigateToWorkflows pushes the workflow list view.
 func (a *App) NavigateToWorkflows(namespace string) {
+	if !a.NamespaceAllowed(namespace) {
+		a.toasts.Warning(fmt.Sprintf("Namespace %q is not allowed by profile %q", namespace, a.activeProfile))
+		return
+	}
 	a.SetNamespace(namespace)
 	wl := NewWorkflowList(a, namespace)
-	a.app.Pages().Push(wl)
+	a.navigate(wl)
+}
+
+// NavigateToWorkflowsWithQuery pushes the workflow list view filtered by a visibility query.
+func (a *App) NavigateToWorkflowsWithQuery(query string) {
+	if !a.NamespaceAllowed(a.currentNS) {
+		a.toasts.Warning(fmt.Sprintf("Namespace %q is not allowed by profile %q", a.currentNS, a.activeProfile))
+		return
+	}
+	wl := NewWorkflowListWithQuery(a, a.currentNS, query)
+	a.navigate(wl)
 }
 
 // NavigateToWorkflowDetail pushes the workflow detail view.
 func (a *App) NavigateToWorkflowDetail(workflowID, runID string) {
+	a.openWorkflowDetail(workflowID, runID)
+}
+
+// openWorkflowDetail pushes the workflow detail view and returns it, so an
+// action can be started there.
+func (a *App) openWorkflowDetail(workflowID, runID string) *WorkflowDetail {
+	a.recordRecentWorkflow(a.currentNS, workflowID, runID)
 	wd := NewWorkflowDetail(a, workflowID, runID)
-	a.app.Pages().Push(wd)
+	a.navigate(wd)
+	return wd
 }
 
 // NavigateToEvents pushes the event history view.
 func (a *App) NavigateToEvents(workflowID, runID string) {
 	ev := NewEventHistory(a, workflowID, runID)
-	a.app.Pages().Push(ev)
+	a.navigate(ev)
 }
 
 // NavigateToTaskQueues pushes the task queue view.
 func (a *App) NavigateToTaskQueues() {
 	tq := NewTaskQueueView(a)
-	a.app.Pages().Push(tq)
+	a.navigate(tq)
+}
+
+// NavigateToTaskQueueDetail pushes the task queue detail view.
+func (a *App) NavigateToTaskQueueDetail(taskQueue string) {
+	td := NewTaskQueueDetail(a, taskQueue)
+	a.navigate(td)
+}
+
+// NavigateToVersioningRules pushes the worker versioning rules view for a task queue.
+func (a *App) NavigateToVersioningRules(taskQueue string) {
+	vr := NewVersioningRulesView(a, taskQueue)
+	a.navigate(vr)
+}
+
+// NavigateToWorkerDeployments pushes the worker deployments view.
+func (a *App) NavigateToWorkerDeployments() {
+	wd := NewWorkerDeploymentsView(a)
+	a.navigate(wd)
 }
 
 // NavigateToSchedules pushes the schedule list view.
 func (a *App) NavigateToSchedules() {
 	sl := NewScheduleList(a, a.currentNS)
-	a.app.Pages().Push(sl)
+	a.navigate(sl)
 }
 
 // NavigateToNamespaceDetail pushes the namespace detail view.
 func (a *App) NavigateToNamespaceDetail(namespace string) {
 	nd := NewNamespaceDetail(a, namespace)
-	a.app.Pages().Push(nd)
+	a.navigate(nd)
 }
 
 // NavigateToWorkflowDiff pushes the workflow diff view.
 func (a *App) NavigateToWorkflowDiff(workflowA, workflowB *temporal.Workflow) {
 	wd := NewWorkflowDiffWithWorkflows(a, a.currentNS, workflowA, workflowB)
-	a.app.Pages().Push(wd)
+	a.navigate(wd)
 }
 
 // NavigateToWorkflowDiffEmpty pushes an empty workflow diff view.
 func (a *App) NavigateToWorkflowDiffEmpty() {
 	wd := NewWorkflowDiff(a, a.currentNS)
-	a.app.Pages().Push(wd)
+	a.navigate(wd)
 }
 
 // Run starts the application.
@@ -420,6 +660,7 @@ func (a *App) Run() error {
 	// Start connection monitor if we have a provider
 	if a.provider != nil && a.stopMonitor != nil {
 		go a.connectionMonitor()
+		go a.stuckQueueMonitor()
 	}
 
 	// Check for updates if enabled
@@ -427,6 +668,18 @@ func (a *App) Run() error {
 		go a.checkForUpdates()
 	}
 
+	if a.statusBarSettings().Shows(config.SegmentClock) {
+		go a.clockMonitor()
+	}
+	a.loadServerVersion(a.provider)
+
+	a.app.GetApplication().EnableMouse(a.config == nil || a.config.MouseEnabled())
+	a.warnInvalidNamespacePatterns()
+	a.warnInvalidStatusSegments()
+	a.warnUnknownStatusPalette()
+	a.warnInvalidTimeSettings()
+	defer a.closeConnections()
+
 	return a.app.Run()
 }
 
@@ -472,6 +725,34 @@ func (a *App) ShowToastError(message string) {
 	})
 }
 
+// ShowToastSuccess displays a success toast notification.
+func (a *App) ShowToastSuccess(message string) {
+	a.app.QueueUpdateDraw(func() {
+		a.toasts.Success(message)
+	})
+}
+
+// ShowToastInfo displays an informational toast notification.
+func (a *App) ShowToastInfo(message string) {
+	a.app.QueueUpdateDraw(func() {
+		a.toasts.Info(message)
+	})
+}
+
+// CopyToClipboard copies text and confirms it, or reports the failure, with a
+// toast. what names the copied content, e.g. "Workflow ID".
+func (a *App) CopyToClipboard(text, what string) {
+	mode := config.ClipboardAuto
+	if a.config != nil {
+		mode = a.config.GetClipboard()
+	}
+	if err := copyToClipboard(text, mode); err != nil {
+		a.toasts.Error(fmt.Sprintf("Failed to copy: %v", err))
+		return
+	}
+	a.toasts.Success(what + " copied to clipboard")
+}
+
 // ShowToastWarning displays a warning toast notification.
 func (a *App) ShowToastWarning(message string) {
 	a.app.QueueUpdateDraw(func() {
@@ -479,74 +760,80 @@ func (a *App) ShowToastWarning(message string) {
 	})
 }
 
-// connectionMonitor periodically checks the connection and attempts reconnection if needed.
+// connectionMonitor periodically checks the active connection. When a check fails it
+// marks the app disconnected and reconnects with exponential backoff until the server
+// is reachable again. Checks and reconnects run sequentially on this goroutine.
 func (a *App) connectionMonitor() {
-	ticker := time.NewTicker(connectionCheckInterval)
-	defer ticker.Stop()
-
-	backoff := reconnectInitialBackoff
-
 	for {
 		select {
 		case <-a.stopMonitor:
 			return
-		case <-ticker.C:
-			if a.provider == nil {
-				continue
-			}
+		case <-time.After(connectionCheckInterval):
+		}
 
-			// Check connection
-			ctx, cancel := context.WithTimeout(context.Background(), connectionCheckTimeout)
-			err := a.provider.CheckConnection(ctx)
-			cancel()
+		provider := a.Provider()
+		ctx, cancel := context.WithTimeout(context.Background(), connectionCheckTimeout)
+		err := provider.CheckConnection(ctx)
+		cancel()
 
-			if err != nil {
-				// Connection lost - update UI
-				a.app.QueueUpdateDraw(func() {
-					a.setConnected(false)
-				})
-
-				// Attempt reconnection with backoff
-				if !a.reconnecting {
-					a.reconnecting = true
-					go a.attemptReconnect(backoff)
-					backoff = backoff * 2
-					if backoff > reconnectMaxBackoff {
-						backoff = reconnectMaxBackoff
-					}
+		if err == nil {
+			a.app.QueueUpdateDraw(func() {
+				if a.provider == provider {
+					a.setConnected(true)
 				}
-			} else {
-				// Connection is good - reset backoff
-				backoff = reconnectInitialBackoff
-				a.reconnecting = false
+			})
+			continue
+		}
 
-				// Ensure UI shows connected
-				a.app.QueueUpdateDraw(func() {
-					a.setConnected(true)
-				})
+		a.app.QueueUpdateDraw(func() {
+			if a.provider == provider {
+				a.setConnected(false)
+				a.toasts.Warning("Connection lost, reconnecting...")
 			}
+		})
+		if !a.reconnect(provider) {
+			return
 		}
+		a.app.QueueUpdateDraw(func() {
+			if a.provider == provider {
+				a.setConnected(true)
+				a.loadServerVersion(provider) // The server may have been upgraded
+				a.toasts.Success("Reconnected to " + provider.Config().Address)
+			}
+		})
 	}
 }
 
-// attemptReconnect tries to reconnect to the Temporal server.
-func (a *App) attemptReconnect(backoff time.Duration) {
-	select {
-	case <-a.stopMonitor:
-		return
-	case <-time.After(backoff):
-	}
+// reconnect retries the connection with exponential backoff until it succeeds or
+// another profile becomes active. Returns false if the monitor was stopped first.
+func (a *App) reconnect(provider temporal.Provider) bool {
+	backoff := reconnectInitialBackoff
+	for a.Provider() == provider {
+		a.app.QueueUpdateDraw(func() {
+			if a.provider == provider {
+				a.setReconnecting(backoff)
+			}
+		})
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	err := a.provider.Reconnect(ctx)
-	cancel()
+		select {
+		case <-a.stopMonitor:
+			return false
+		case <-time.After(backoff):
+		}
 
-	a.app.QueueUpdateDraw(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := provider.Reconnect(ctx)
+		cancel()
 		if err == nil {
-			a.setConnected(true)
-			a.reconnecting = false
+			return true
 		}
-	})
+
+		backoff *= 2
+		if backoff > reconnectMaxBackoff {
+			backoff = reconnectMaxBackoff
+		}
+	}
+	return true
 }
 
 // Stop stops the application and connection monitor.
@@ -568,9 +855,9 @@ func (a *App) SetDevMode(enabled bool) {
 
 // showSplashTest shows the splash screen for testing gradients and themes.
 func (a *App) showSplashTest() {
-	currentTheme := "tokyonight-night"
-	if a.config != nil && a.config.Theme != "" {
-		currentTheme = a.config.Theme
+	currentTheme := config.DefaultTheme
+	if a.config != nil {
+		currentTheme = a.config.ThemeName()
 	}
 
 	splash := NewSplashTestView(currentTheme)
@@ -586,75 +873,165 @@ func (a *App) showSplashTest() {
 		a.app.RefreshTheme()
 	})
 
-	a.app.Pages().AddPage("splash-test", splash, true, true)
-	a.app.SetFocus(splash)
+	a.modals.Show("splash-test", splash, nil)
 }
 
 func (a *App) closeSplashTest() {
-	a.app.Pages().RemovePage("splash-test")
-	if current := a.app.Pages().Current(); current != nil {
-		a.app.SetFocus(current)
+	a.modals.Close("splash-test")
+}
+
+// helpViews lists the views whose bindings the help modal shows, with a
+// constructor for a blank instance to read them from.
+var helpViews = []struct {
+	title string
+	build func(a *App) nav.Component
+}{
+	{"Namespaces", func(a *App) nav.Component { return NewNamespaceList(a) }},
+	{"Namespace Detail", func(a *App) nav.Component { return NewNamespaceDetail(a, "") }},
+	{"Dashboard", func(a *App) nav.Component { return NewDashboardView(a) }},
+	{"Global Search", func(a *App) nav.Component { return NewGlobalSearchView(a, "") }},
+	{"Workflows", func(a *App) nav.Component { return NewWorkflowList(a, "") }},
+	{"Workflow Detail", func(a *App) nav.Component { return NewWorkflowDetail(a, "", "") }},
+	{"Event History", func(a *App) nav.Component { return NewEventHistory(a, "", "") }},
+	{"Workflow Diff", func(a *App) nav.Component { return NewWorkflowDiff(a, "") }},
+	{"Task Queues", func(a *App) nav.Component { return NewTaskQueueView(a) }},
+	{"Task Queue Detail", func(a *App) nav.Component { return NewTaskQueueDetail(a, "") }},
+	{"Versioning Rules", func(a *App) nav.Component { return NewVersioningRulesView(a, "") }},
+	{"Worker Deployments", func(a *App) nav.Component { return NewWorkerDeploymentsView(a) }},
+	{"Schedules", func(a *App) nav.Component { return NewScheduleList(a, "") }},
+	{"Nexus Endpoints", func(a *App) nav.Component { return NewNexusEndpointsView(a) }},
+}
+
+// viewHelp is the bindings of a view listed in the help modal.
+type viewHelp struct {
+	name  string
+	title string
+	hints []KeyHint
+}
+
+// helpHinter is implemented by views whose hints change with their state, to
+// list every binding they may offer.
+type helpHinter interface {
+	helpHints() []KeyHint
+}
+
+// viewHelpHints returns the bindings of a view for the help modal.
+func viewHelpHints(c nav.Component) []KeyHint {
+	if h, ok := c.(helpHinter); ok {
+		return h.helpHints()
 	}
+	return c.Hints()
 }
 
-func (a *App) showHelp() {
-	helpModal := NewHelpModal()
+// mergeHints joins hint lists, dropping repeated bindings.
+func mergeHints(lists ...[]KeyHint) []KeyHint {
+	var merged []KeyHint
+	seen := make(map[KeyHint]bool)
+	for _, hints := range lists {
+		for _, hint := range hints {
+			if !seen[hint] {
+				seen[hint] = true
+				merged = append(merged, hint)
+			}
+		}
+	}
+	return merged
+}
+
+// helpSections groups the bindings for the help modal: the current view's
+// first and expanded, then the global ones, then every other view's collapsed.
+// The other views' bindings are read once from blank instances.
+func (a *App) helpSections() []*helpSection {
+	if a.viewHelp == nil {
+		for _, v := range helpViews {
+			c := v.build(a)
+			name := ""
+			if named, ok := c.(interface{ Name() string }); ok {
+				name = named.Name()
+			}
+			a.viewHelp = append(a.viewHelp, viewHelp{name: name, title: v.title, hints: viewHelpHints(c)})
+		}
+	}
 
-	// Get current view's hints
+	currentName := ""
 	current := a.app.Pages().Current()
-	if current != nil {
-		if named, ok := current.(interface{ Name() string }); ok {
-			helpModal.SetViewHints(named.Name(), current.Hints())
+	if named, ok := current.(interface{ Name() string }); ok {
+		currentName = named.Name()
+	}
+
+	var sections []*helpSection
+	for _, v := range a.viewHelp {
+		if v.name == currentName {
+			sections = append(sections, &helpSection{title: v.title, hints: mergeHints(current.Hints(), v.hints)})
 		}
 	}
+	sections = append(sections,
+		&helpSection{title: "Global", hints: globalHelpHints},
+		&helpSection{title: "Navigation", hints: navigationHelpHints},
+	)
+	for _, v := range a.viewHelp {
+		if v.name != currentName {
+			sections = append(sections, &helpSection{title: v.title, hints: v.hints, collapsed: true})
+		}
+	}
+	return sections
+}
+
+func (a *App) showHelp() {
+	helpModal := NewHelpModal(a.helpSections(), func(p tview.Primitive) { a.app.SetFocus(p) })
 
 	helpModal.SetOnClose(func() {
 		a.closeHelp()
 	})
 
-	a.app.Pages().AddPage("help-modal", helpModal, true, true)
-	a.app.SetFocus(helpModal)
+	a.modals.Show("help-modal", helpModal, nil)
 }
 
 func (a *App) closeHelp() {
-	a.app.Pages().RemovePage("help-modal")
-	if current := a.app.Pages().Current(); current != nil {
-		a.app.SetFocus(current)
-	}
+	a.modals.Close("help-modal")
 }
 
 func (a *App) closeThemeSelector() {
-	a.app.Pages().RemovePage("theme-selector")
-	if current := a.app.Pages().Current(); current != nil {
-		a.app.SetFocus(current)
-	}
+	a.modals.Close("theme-selector")
 }
 
 func (a *App) showCommandBar() {
 	a.statusBar.SetCommandPrompt(": ")
 	a.statusBar.SetCommandPlaceholder("command...")
+	a.statusBar.ClearSuggestion()
+	go a.loadCommandNamespaces(nil)
 	a.statusBar.EnterCommandMode()
 	a.app.SetFocus(a.statusBar.GetCommandInput())
 }
 
 func (a *App) showThemeSelector() {
+	if a.noColor() {
+		a.toasts.Info("Themes are off in no-color mode")
+		return
+	}
 	// Get current theme name from config
-	currentTheme := "tokyonight-night"
-	if a.config != nil && a.config.Theme != "" {
-		currentTheme = a.config.Theme
+	currentTheme := config.DefaultTheme
+	if a.config != nil {
+		currentTheme = a.config.ThemeName()
 	}
 	originalTheme := currentTheme
 
 	// Separate themes into dark and light categories
-	allThemes := config.ThemeNames()
+	allThemes := append(config.ThemeNames(), config.CustomThemeNames()...)
 	var darkThemes, lightThemes []string
 	for _, name := range allThemes {
+		themeType := ""
 		if t, ok := config.BuiltinThemes[name]; ok {
-			if t.Type == "light" {
-				lightThemes = append(lightThemes, name)
-			} else {
-				darkThemes = append(darkThemes, name)
-			}
+			themeType = t.Type
+		} else if parsed, err := config.LoadTheme(name); err == nil {
+			themeType = parsed.Type
+		} else {
+			continue
+		}
+		if themeType == "light" {
+			lightThemes = append(lightThemes, name)
+		} else {
+			darkThemes = append(darkThemes, name)
 		}
 	}
 
@@ -704,7 +1081,7 @@ func (a *App) showThemeSelector() {
 		}
 		listToTheme[listIdx] = name
 		list.AddItem(prefix+name, "", 0, func() {
-			newTheme := themes.Get(name)
+			newTheme := config.ResolveTheme(name)
 			if newTheme != nil {
 				theme.SetProvider(newTheme)
 				a.refreshCurrentView()
@@ -737,7 +1114,7 @@ func (a *App) showThemeSelector() {
 		}
 		listToTheme[listIdx] = name
 		list.AddItem(prefix+name, "", 0, func() {
-			newTheme := themes.Get(name)
+			newTheme := config.ResolveTheme(name)
 			if newTheme != nil {
 				theme.SetProvider(newTheme)
 				a.refreshCurrentView()
@@ -772,7 +1149,7 @@ func (a *App) showThemeSelector() {
 	// Live preview on navigation
 	list.SetChangedFunc(func(index int, mainText, secondaryText string, shortcut rune) {
 		if themeName, ok := listToTheme[index]; ok {
-			newTheme := themes.Get(themeName)
+			newTheme := config.ResolveTheme(themeName)
 			if newTheme != nil {
 				theme.SetProvider(newTheme)
 				// Update list colors for new theme
@@ -797,7 +1174,7 @@ func (a *App) showThemeSelector() {
 		}).
 		SetOnCancel(func() {
 			// Restore original theme on cancel
-			origTheme := themes.Get(originalTheme)
+			origTheme := config.ResolveTheme(originalTheme)
 			if origTheme != nil {
 				theme.SetProvider(origTheme)
 				a.refreshCurrentView()
@@ -812,7 +1189,7 @@ func (a *App) showThemeSelector() {
 		// Handle Escape and q to cancel
 		if event.Key() == tcell.KeyEscape || event.Rune() == 'q' {
 			// Restore original theme on cancel
-			origTheme := themes.Get(originalTheme)
+			origTheme := config.ResolveTheme(originalTheme)
 			if origTheme != nil {
 				theme.SetProvider(origTheme)
 				a.refreshCurrentView()
@@ -849,9 +1226,7 @@ func (a *App) showThemeSelector() {
 		return event
 	})
 
-	// Use AddPage with explicit name so global InputCapture knows to skip Escape handling
-	a.app.Pages().AddPage("theme-selector", modal, true, true)
-	a.app.SetFocus(list)
+	a.modals.Show("theme-selector", modal, list)
 }
 
 // refreshCurrentView calls RefreshTheme on the current view if it supports it.
@@ -873,7 +1248,7 @@ func (a *App) ShowProfileSelector() {
 	}
 
 	modal := NewProfileModal()
-	modal.SetProfiles(a.config.ListProfiles(), a.activeProfile)
+	modal.SetProfiles(a.config.ListProfiles(), a.activeProfile, a.openProfiles())
 	modal.SetOnSelect(func(name string) {
 		a.closeProfileSelector()
 		a.SwitchProfile(name)
@@ -888,21 +1263,21 @@ func (a *App) ShowProfileSelector() {
 	})
 	modal.SetOnDelete(func(name string) {
 		a.deleteProfile(name)
-		modal.SetProfiles(a.config.ListProfiles(), a.activeProfile)
+		modal.SetProfiles(a.config.ListProfiles(), a.activeProfile, a.openProfiles())
+	})
+	modal.SetOnDisconnect(func(name string) {
+		a.closeConnection(name)
+		modal.SetProfiles(a.config.ListProfiles(), a.activeProfile, a.openProfiles())
 	})
 	modal.SetOnClose(func() {
 		a.closeProfileSelector()
 	})
 
-	a.app.Pages().AddPage("profile-selector", modal, true, true)
-	a.app.SetFocus(modal)
+	a.modals.Show("profile-selector", modal, nil)
 }
 
 func (a *App) closeProfileSelector() {
-	a.app.Pages().RemovePage("profile-selector")
-	if current := a.app.Pages().Current(); current != nil {
-		a.app.SetFocus(current)
-	}
+	a.modals.Close("profile-selector")
 }
 
 func (a *App) showProfileForm(editName string) {
@@ -916,6 +1291,11 @@ func (a *App) showProfileForm(editName string) {
 
 	form.SetOnSave(func(name string, cfg config.ConnectionConfig) {
 		a.closeProfileForm()
+		// Keep secrets out of the config file; fall back to plaintext without a keychain
+		cfg, err := cfg.StoreSecrets(name)
+		if err != nil {
+			a.toasts.Warning(fmt.Sprintf("Secrets saved in plaintext: %v", err))
+		}
 		a.config.SaveProfile(name, cfg)
 		if err := a.config.Save(); err != nil {
 			// Log error but continue
@@ -925,16 +1305,13 @@ func (a *App) showProfileForm(editName string) {
 	form.SetOnCancel(func() {
 		a.closeProfileForm()
 	})
+	form.SetOnBrowse(a.showFilePicker)
 
-	a.app.Pages().AddPage("profile-form", form, true, true)
-	a.app.SetFocus(form)
+	a.modals.Show("profile-form", form, nil)
 }
 
 func (a *App) closeProfileForm() {
-	a.app.Pages().RemovePage("profile-form")
-	if current := a.app.Pages().Current(); current != nil {
-		a.app.SetFocus(current)
-	}
+	a.modals.Close("profile-form")
 }
 
 func (a *App) deleteProfile(name string) {
@@ -944,7 +1321,9 @@ func (a *App) deleteProfile(name string) {
 	if err := a.config.DeleteProfile(name); err != nil {
 		return
 	}
+	a.closeConnection(name)
 	_ = a.config.Save()
+	go func() { _ = config.DeleteSecrets(name) }()
 }
 
 // SwitchProfile switches to a different connection profile.
@@ -957,29 +1336,38 @@ func (a *App) SwitchProfile(name string) {
 	if !ok {
 		return
 	}
+	profileCfg, err := profileCfg.Resolve()
+	if err != nil {
+		ShowErrorModal(a.modals, "Profile Error", fmt.Sprintf("Cannot connect with profile %q: %v", name, err))
+		return
+	}
+
+	connConfig := temporal.ConnectionConfigFromProfile(profileCfg)
+	connConfig.MaxPayloadSize = a.config.History.GetMaxPayloadSize()
 
-	connConfig := temporal.ConnectionConfig{
-		Address:       profileCfg.Address,
-		Namespace:     profileCfg.Namespace,
-		TLSCertPath:   profileCfg.TLS.Cert,
-		TLSKeyPath:    profileCfg.TLS.Key,
-		TLSCAPath:     profileCfg.TLS.CA,
-		TLSServerName: profileCfg.TLS.ServerName,
-		TLSSkipVerify: profileCfg.TLS.SkipVerify,
+	if name != a.activeProfile {
+		// Switch instantly to a profile that is still connected, or open a new connection
+		if conn := a.pooledConnection(name, connConfig); conn != nil {
+			a.activateConnection(name, conn)
+		} else {
+			a.dialProfile(name, connConfig)
+		}
+		return
 	}
 
-	// Stop current views
+	// The active profile was edited: reconnect it with the new settings
 	if current := a.app.Pages().Current(); current != nil {
 		current.Stop()
 	}
 
-	// Update UI to show connecting state (setProfile must be first - clears sections)
+	// Update UI to show connecting state
 	a.setProfile(name + " (connecting...)")
 	a.setConnected(false)
 
+	provider := a.provider
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		err := a.provider.ReconnectWithConfig(ctx, connConfig)
+		err := provider.ReconnectWithConfig(ctx, connConfig)
 		cancel()
 
 		a.app.QueueUpdateDraw(func() {
@@ -988,60 +1376,89 @@ func (a *App) SwitchProfile(name string) {
 				a.setConnected(false)
 				return
 			}
-
-			a.activeProfile = name
-			a.currentNS = connConfig.Namespace
-			a.config.SetActiveProfile(name)
-			_ = a.config.Save()
-
-			a.setProfile(name)
-			a.setConnected(true)
-			a.setNamespace(connConfig.Namespace)
-
-			a.reinitializeViews()
+			a.activateConnection(name, provider)
 		})
 	}()
 }
 
 // reinitializeViews resets the view stack after a profile switch.
 func (a *App) reinitializeViews() {
+	a.clearPages()
+	a.pushHomeView()
+	if current := a.app.Pages().Current(); current != nil {
+		a.app.SetFocus(current)
+	}
+}
+
+// clearPages removes every view and modal page. Modals are closed first so
+// their stack doesn't outlive the pages.
+func (a *App) clearPages() {
+	a.modals.Reset()
 	a.app.Pages().Clear()
-	a.namespaceList = NewNamespaceList(a)
-	a.app.Pages().Push(a.namespaceList)
-	a.app.SetFocus(a.namespaceList)
+	if len(a.tabs) > 0 {
+		a.tabs[a.activeTab].below = nil
+	}
 }
 
-func (a *App) handleProfileCommand(args string) {
-	args = strings.TrimSpace(args)
+// toggleMouse turns mouse support on or off and saves the choice. With mouse
+// support off, the terminal's native text selection works again.
+func (a *App) toggleMouse() {
+	enabled := a.config == nil || a.config.MouseEnabled()
+	enabled = !enabled
+	a.app.GetApplication().EnableMouse(enabled)
+	if enabled {
+		a.toasts.Info("Mouse enabled")
+	} else {
+		a.toasts.Info("Mouse disabled, terminal text selection restored")
+	}
 
+	if a.config != nil {
+		a.config.Mouse = &enabled
+		if err := a.config.Save(); err != nil {
+			a.ShowToastError(fmt.Sprintf("Failed to save config: %s", err))
+		}
+	}
+}
+
+func (a *App) handleProfileCommand(args string) error {
+	if a.config == nil {
+		return fmt.Errorf("no config loaded")
+	}
 	if args == "" {
 		a.ShowProfileSelector()
-		return
+		return nil
 	}
 
 	parts := strings.Fields(args)
 	cmd := parts[0]
 
 	switch cmd {
-	case "new":
+	case "new", "save":
 		a.showProfileForm("")
 	case "edit":
 		if len(parts) > 1 {
+			if !a.config.ProfileExists(parts[1]) {
+				return fmt.Errorf("profile %q not found", parts[1])
+			}
 			a.showProfileForm(parts[1])
 		} else {
 			a.showProfileForm(a.activeProfile)
 		}
 	case "delete":
-		if len(parts) > 1 {
-			a.deleteProfile(parts[1])
+		if len(parts) < 2 {
+			return fmt.Errorf("missing profile name")
 		}
-	case "save":
-		a.showProfileForm("")
+		if !a.config.ProfileExists(parts[1]) {
+			return fmt.Errorf("profile %q not found", parts[1])
+		}
+		a.deleteProfile(parts[1])
 	default:
-		if a.config != nil && a.config.ProfileExists(cmd) {
-			a.SwitchProfile(cmd)
+		if !a.config.ProfileExists(cmd) {
+			return fmt.Errorf("profile %q not found", cmd)
 		}
+		a.SwitchProfile(cmd)
 	}
+	return nil
 }
 
 // ActiveProfile returns the currently active profile name.
@@ -1054,11 +1471,95 @@ func (a *App) Config() *config.Config {
 	return a.config
 }
 
+// SignalHistory returns the recently sent signals, reading them on first use.
+// Returns nil if they can't be read, after reporting why.
+func (a *App) SignalHistory() *config.SignalHistory {
+	if a.signals == nil {
+		history, err := config.LoadSignalHistory()
+		if err != nil {
+			a.ShowToastError(fmt.Sprintf("Failed to load signal history: %s", err))
+			return nil
+		}
+		a.signals = history
+	}
+	return a.signals
+}
+
+// ListSettings returns the configured list settings.
+// Returns zero-value settings (which resolve to defaults) when no config is loaded.
+func (a *App) ListSettings() config.ListSettings {
+	if a.config == nil {
+		return config.ListSettings{}
+	}
+	return a.config.Lists
+}
+
+// HistorySettings returns the configured history settings.
+// Returns zero-value settings (which resolve to defaults) when no config is loaded.
+func (a *App) HistorySettings() config.HistorySettings {
+	if a.config == nil {
+		return config.HistorySettings{}
+	}
+	return a.config.History
+}
+
+// showSettings opens the list settings form.
+func (a *App) showSettings() {
+	keymap := config.KeymapVim
+	if a.config != nil {
+		keymap = a.config.GetKeymap()
+	}
+	form := NewSettingsForm(a.ListSettings(), keymap)
+	form.SetOnSave(func(settings config.ListSettings, keymap string) {
+		a.closeSettings()
+		if a.config != nil {
+			a.config.Lists = settings
+			a.config.Keymap = keymap
+			a.setKeymap(keymap)
+			if err := a.config.Save(); err != nil {
+				a.ShowToastError(fmt.Sprintf("Failed to save settings: %v", err))
+			}
+		}
+		// Reload the workflow list so the new limits take effect
+		if wl, ok := a.app.Pages().Current().(*WorkflowList); ok {
+			wl.loadData()
+		}
+	})
+	form.SetOnCancel(func() {
+		a.closeSettings()
+	})
+
+	a.modals.Show("settings-form", form, nil)
+}
+
+// setKeymap switches to a keymap preset, layering the configured key_remaps over it.
+func (a *App) setKeymap(preset string) {
+	var custom map[string]string
+	if a.config != nil {
+		custom = a.config.KeyRemaps
+	}
+	keys, err := newKeyRemapper(preset, custom)
+	if err != nil {
+		a.ShowToastWarning(err.Error())
+	}
+	a.keys = keys
+	if current := a.app.Pages().Current(); current != nil {
+		a.menu.SetHints(a.keys.hints(current.Hints()))
+	}
+}
+
+func (a *App) closeSettings() {
+	a.modals.Close("settings-form")
+}
+
 // FilterModeCallbacks holds callbacks for filter mode.
 type FilterModeCallbacks struct {
 	OnSubmit func(text string)
 	OnCancel func()
 	OnChange func(text string)
+
+	// Placeholder overrides the default "Filter workflows..." prompt text.
+	Placeholder string
 }
 
 // filterModeActive tracks if we're in filter mode with custom callbacks.
@@ -1068,9 +1569,15 @@ var filterModeCallbacks *FilterModeCallbacks
 // The filter input replaces the status bar content with a "/" prompt.
 func (a *App) ShowFilterMode(initialText string, callbacks FilterModeCallbacks) {
 	filterModeCallbacks = &callbacks
+	a.statusBar.SetOnComplete(nil)
+	a.statusBar.GetCommandInput().SetChangedFunc(nil)
 
 	a.statusBar.SetCommandPrompt("/ ")
-	a.statusBar.SetCommandPlaceholder("Filter workflows...")
+	placeholder := callbacks.Placeholder
+	if placeholder == "" {
+		placeholder = "Filter workflows..."
+	}
+	a.statusBar.SetCommandPlaceholder(placeholder)
 
 	// Set up the callbacks
 	a.statusBar.SetOnCommandSubmit(func(text string) {
@@ -1147,19 +1654,15 @@ func (a *App) IsFilterMode() bool {
 func (a *App) restoreDefaultCommandCallbacks() {
 	a.statusBar.SetCommandPrompt(": ")
 	a.statusBar.SetCommandPlaceholder("command...")
-	a.statusBar.GetCommandInput().SetChangedFunc(nil)
+	a.setCommandCompletion()
 
 	a.statusBar.SetOnCommandSubmit(func(text string) {
 		a.statusBar.ExitCommandMode()
-		text = strings.TrimSpace(text)
-		if strings.HasPrefix(text, "profile") {
-			args := strings.TrimPrefix(text, "profile")
-			a.handleProfileCommand(strings.TrimSpace(args))
-		}
-		// Restore focus to current view
+		// Restore focus to current view, before a command opens a modal over it
 		if current := a.app.Pages().Current(); current != nil {
 			a.app.SetFocus(current)
 		}
+		a.handleCommand(text)
 	})
 
 	a.statusBar.SetOnCommandCancel(func() {
@@ -0,0 +1,65 @@
+package view
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+
+	"github.com/galaxy-io/tempo/internal/config"
+)
+
+// openBrowser opens url in the default browser.
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "linux", "freebsd", "openbsd", "netbsd":
+		if _, err := exec.LookPath("xdg-open"); err != nil {
+			return fmt.Errorf("browser not available: install xdg-utils")
+		}
+		cmd = exec.Command("xdg-open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		return fmt.Errorf("opening a browser is not supported on %s", runtime.GOOS)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go cmd.Wait() // Reap the opener; browsers detach from it
+	return nil
+}
+
+// webProfile returns the active profile with environment references expanded,
+// for building web UI links.
+func (a *App) webProfile() config.ConnectionConfig {
+	if a.config == nil {
+		return config.ConnectionConfig{}
+	}
+	profile, _ := a.config.GetProfile(a.activeProfile)
+	if expanded, err := profile.ExpandEnv(); err == nil {
+		return expanded
+	}
+	return profile
+}
+
+// OpenInWebUI opens url, a web UI page, in the browser. Over SSH, or when no
+// browser opens, the link is copied instead.
+func (a *App) OpenInWebUI(url string) {
+	if url == "" {
+		a.toasts.Warning("Web UI address unknown: set web_ui in the profile")
+		return
+	}
+	if inSSHSession() {
+		a.CopyToClipboard(url, "Web UI link")
+		return
+	}
+	if err := openBrowser(url); err != nil {
+		a.CopyToClipboard(url, "Web UI link")
+		return
+	}
+	a.toasts.Info("Opened in web UI")
+}
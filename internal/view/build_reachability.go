@@ -0,0 +1,96 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// showReachabilityForm asks for a Build ID and checks whether workflows on the task
+// queue can still reach it, to tell when its workers can be decommissioned.
+func showReachabilityForm(app *App, taskQueue, buildID string) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Check Build ID Reachability", theme.IconTaskQueue),
+		Width:    70,
+		Height:   11,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("buildId", "Build ID", "")
+	_ = form.SetValues(map[string]any{
+		"buildId": buildID,
+	})
+
+	closeForm := func() {
+		app.Modals().Close("reachability-form")
+	}
+	submit := func(values map[string]any) {
+		buildID := strings.TrimSpace(values["buildId"].(string))
+		if buildID == "" {
+			return
+		}
+		closeForm()
+		checkReachability(app, taskQueue, buildID)
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(closeForm)
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Check"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(closeForm)
+
+	app.Modals().Show("reachability-form", modal, form)
+}
+
+func checkReachability(app *App, taskQueue, buildID string) {
+	provider := app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		result, err := provider.GetBuildIDReachability(ctx, app.CurrentNamespace(), taskQueue, buildID)
+
+		app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(app.Modals(), "Reachability Check Failed", err.Error())
+				return
+			}
+			ShowInfoModal(app.Modals(), "Build ID Reachability", formatReachability(result))
+		})
+	}()
+}
+
+// formatReachability describes a reachability result and whether the Build ID's
+// workers can be retired.
+func formatReachability(r *temporal.BuildIDReachability) string {
+	tag := theme.TagWarning()
+	advice := "Keep its workers running."
+	switch {
+	case r.Reachability == temporal.ReachabilityClosedOnly:
+		tag = theme.StatusColorTag("Completed")
+		advice = "Safe to decommission, unless closed workflows must still answer queries."
+	case r.SafeToDecommission():
+		tag = theme.StatusColorTag("Completed")
+		advice = "Safe to decommission."
+	case r.Reachability == temporal.ReachabilityUnknown:
+		tag = theme.TagFgDim()
+		advice = "The server did not report reachability for this Build ID."
+	}
+
+	return fmt.Sprintf("%s\n\n[%s]%s[-]\n\n%s", r.BuildID, tag, r.Summary(), advice)
+}
@@ -0,0 +1,110 @@
+package view
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/galaxy-io/tempo/internal/config"
+)
+
+// osc52MaxSize is the largest payload, in base64 bytes, sent with OSC 52;
+// terminals silently drop longer sequences (xterm's limit is about 100KB).
+const osc52MaxSize = 100_000
+
+// copyToClipboard copies text with the given clipboard mode. In auto mode the
+// system tool is used locally, and OSC 52 over SSH or when no tool works.
+func copyToClipboard(text, mode string) error {
+	switch mode {
+	case config.ClipboardSystem:
+		return systemClipboard(text)
+	case config.ClipboardOSC52:
+		return osc52Clipboard(text)
+	}
+
+	if inSSHSession() {
+		return osc52Clipboard(text)
+	}
+	if err := systemClipboard(text); err != nil {
+		if oscErr := osc52Clipboard(text); oscErr != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inSSHSession reports whether tempo runs in an SSH session, where the system
+// clipboard tools would copy on the remote host.
+func inSSHSession() bool {
+	return os.Getenv("SSH_TTY") != "" || os.Getenv("SSH_CONNECTION") != ""
+}
+
+// systemClipboard copies text with the OS clipboard tool.
+func systemClipboard(text string) error {
+	// Use OS-specific clipboard commands
+	var cmd *exec.Cmd
+
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("pbcopy")
+	case "linux":
+		// Try xclip first, fall back to xsel
+		if _, err := exec.LookPath("xclip"); err == nil {
+			cmd = exec.Command("xclip", "-selection", "clipboard")
+		} else if _, err := exec.LookPath("xsel"); err == nil {
+			cmd = exec.Command("xsel", "--clipboard", "--input")
+		} else {
+			return fmt.Errorf("clipboard not available: install xclip or xsel")
+		}
+	case "windows":
+		cmd = exec.Command("clip")
+	default:
+		return fmt.Errorf("clipboard not supported on %s", runtime.GOOS)
+	}
+
+	pipe, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	if _, err := pipe.Write([]byte(text)); err != nil {
+		return err
+	}
+
+	if err := pipe.Close(); err != nil {
+		return err
+	}
+
+	return cmd.Wait()
+}
+
+// osc52Clipboard copies text by writing an OSC 52 escape sequence to the
+// terminal, which sets the clipboard of the machine the terminal runs on. Inside
+// tmux the sequence is wrapped in a passthrough, which needs tmux's
+// allow-passthrough option.
+func osc52Clipboard(text string) error {
+	encoded := base64.StdEncoding.EncodeToString([]byte(text))
+	if len(encoded) > osc52MaxSize {
+		return fmt.Errorf("%d bytes is too large to copy with OSC 52", len(text))
+	}
+
+	seq := "\x1b]52;c;" + encoded + "\x1b\\"
+	if os.Getenv("TMUX") != "" {
+		seq = "\x1bPtmux;" + strings.ReplaceAll(seq, "\x1b", "\x1b\x1b") + "\x1b\\"
+	}
+
+	tty, err := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+	if err != nil {
+		return fmt.Errorf("no terminal for OSC 52: %w", err)
+	}
+	defer tty.Close()
+	_, err = tty.WriteString(seq)
+	return err
+}
@@ -0,0 +1,293 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+)
+
+// command is a command bar command, e.g. ":ns production".
+type command struct {
+	names    []string // Canonical name first, then short forms
+	usage    string   // Arguments, e.g. "<namespace>"
+	run      func(a *App, args string) error
+	complete func(a *App) []string // Candidates for the first argument
+}
+
+var commands = []command{
+	{names: []string{"ns", "namespace", "namespaces"}, usage: "[namespace]", run: (*App).runNamespaceCommand, complete: (*App).namespaceCandidates},
+	{names: []string{"wf", "workflows"}, usage: "[query]", run: func(a *App, args string) error {
+		if args == "" {
+			a.NavigateToWorkflows(a.currentNS)
+		} else {
+			a.NavigateToWorkflowsWithQuery(args)
+		}
+		return nil
+	}},
+	{names: []string{"search", "gs"}, usage: "[workflow id | query]", run: func(a *App, args string) error {
+		a.NavigateToGlobalSearch(args)
+		return nil
+	}},
+	{names: []string{"tq", "taskqueues"}, run: noArgs((*App).NavigateToTaskQueues)},
+	{names: []string{"schedules", "sch"}, run: noArgs((*App).NavigateToSchedules)},
+	{names: []string{"deployments", "deploy"}, run: noArgs((*App).NavigateToWorkerDeployments)},
+	{names: []string{"dashboard", "dash"}, run: noArgs((*App).NavigateToDashboard)},
+	{names: []string{"nexus"}, run: noArgs((*App).NavigateToNexusEndpoints)},
+	{names: []string{"theme"}, usage: "[name]", run: (*App).runThemeCommand, complete: (*App).themeCandidates},
+	{names: []string{"profile", "ctx"}, usage: "[name | new | edit [name] | delete <name>]", run: (*App).handleProfileCommand, complete: (*App).profileCandidates},
+	{names: []string{"tabnew"}, usage: "[namespace]", run: func(a *App, args string) error {
+		a.NewTab()
+		if args == "" {
+			return nil
+		}
+		return a.runNamespaceCommand(args)
+	}, complete: (*App).namespaceCandidates},
+	{names: []string{"tabclose"}, run: noArgs((*App).CloseTab)},
+	{names: []string{"tabnext", "tabn"}, run: noArgs((*App).NextTab)},
+	{names: []string{"tabprev", "tabp"}, run: noArgs((*App).PrevTab)},
+	{names: []string{"back"}, run: noArgs(func(a *App) { a.GoBack() })},
+	{names: []string{"forward", "fwd"}, run: noArgs((*App).GoForward)},
+	{names: []string{"history", "hist"}, run: noArgs((*App).ShowHistory)},
+	{names: []string{"settings"}, run: noArgs((*App).showSettings)},
+	{names: []string{"mouse"}, run: noArgs((*App).toggleMouse)},
+	{names: []string{"help"}, run: noArgs((*App).showHelp)},
+}
+
+// noArgs adapts an action that takes no arguments to a command.
+func noArgs(fn func(*App)) func(*App, string) error {
+	return func(a *App, args string) error {
+		if args != "" {
+			return fmt.Errorf("unexpected arguments %q", args)
+		}
+		fn(a)
+		return nil
+	}
+}
+
+// lookupCommand finds a command by any of its names.
+func lookupCommand(name string) (command, bool) {
+	name = strings.ToLower(name)
+	for _, cmd := range commands {
+		for _, n := range cmd.names {
+			if n == name {
+				return cmd, true
+			}
+		}
+	}
+	return command{}, false
+}
+
+// expandAlias replaces a leading alias from the config with its command. Extra
+// arguments are appended to the expansion. Aliases don't expand recursively and
+// can't shadow built-in commands.
+func (a *App) expandAlias(text string) string {
+	if a.config == nil {
+		return text
+	}
+	name, args, _ := strings.Cut(strings.TrimSpace(text), " ")
+	if _, ok := lookupCommand(name); ok {
+		return text
+	}
+	expansion, ok := a.config.Aliases[name]
+	if !ok {
+		return text
+	}
+	expansion = strings.TrimPrefix(strings.TrimSpace(expansion), ":")
+	if args = strings.TrimSpace(args); args != "" {
+		expansion += " " + args
+	}
+	return expansion
+}
+
+// handleCommand dispatches a command entered in the command bar after expanding
+// aliases. Unknown commands and bad arguments are reported as error toasts.
+func (a *App) handleCommand(text string) {
+	name, args, _ := strings.Cut(strings.TrimSpace(a.expandAlias(text)), " ")
+	if name == "" {
+		return
+	}
+	cmd, ok := lookupCommand(name)
+	if !ok {
+		a.toasts.Error(fmt.Sprintf("Unknown command :%s", name))
+		return
+	}
+	if err := cmd.run(a, strings.TrimSpace(args)); err != nil {
+		msg := fmt.Sprintf(":%s: %v", cmd.names[0], err)
+		if cmd.usage != "" {
+			msg += fmt.Sprintf(" (usage: :%s %s)", cmd.names[0], cmd.usage)
+		}
+		a.toasts.Error(msg)
+	}
+}
+
+func (a *App) runNamespaceCommand(args string) error {
+	if args == "" {
+		a.reinitializeViews()
+		return nil
+	}
+	if !a.NamespaceAllowed(args) {
+		return fmt.Errorf("namespace %q is not allowed by profile %q", args, a.activeProfile)
+	}
+	a.NavigateToWorkflows(args)
+	return nil
+}
+
+func (a *App) runThemeCommand(args string) error {
+	if a.noColor() {
+		return fmt.Errorf("themes are off in no-color mode")
+	}
+	if args == "" {
+		a.showThemeSelector()
+		return nil
+	}
+	name := args
+	if args == config.AutoTheme && a.config != nil {
+		// The background is only detected at startup; reuse that pick if any
+		a.config.Theme = args
+		name = a.config.ThemeName()
+	}
+	selected := config.ResolveTheme(name)
+	if selected == nil {
+		return fmt.Errorf("unknown theme %q", args)
+	}
+	theme.SetProvider(selected)
+	a.refreshCurrentView()
+	if a.config != nil {
+		a.config.Theme = args
+		if err := a.config.Save(); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+	}
+	return nil
+}
+
+// themeCandidates returns the theme names offered as completions.
+func (a *App) themeCandidates() []string {
+	return append(append(config.ThemeNames(), config.CustomThemeNames()...), config.AutoTheme)
+}
+
+// aliasNames returns the configured command aliases.
+func (a *App) aliasNames() []string {
+	if a.config == nil {
+		return nil
+	}
+	names := make([]string, 0, len(a.config.Aliases))
+	for name := range a.config.Aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// namespaceCandidates returns the namespaces loaded for completion.
+func (a *App) namespaceCandidates() []string {
+	return a.commandNamespaces
+}
+
+func (a *App) profileCandidates() []string {
+	candidates := []string{"new", "edit", "delete"}
+	if a.config != nil {
+		candidates = append(a.config.ListProfiles(), candidates...)
+	}
+	return candidates
+}
+
+// loadCommandNamespaces fetches the namespace names offered as completions and
+// calls then, if set, once they are loaded.
+func (a *App) loadCommandNamespaces(then func()) {
+	provider := a.Provider()
+	if provider == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	namespaces, err := provider.ListNamespaces(ctx)
+	cancel()
+	if err != nil {
+		return
+	}
+
+	a.app.QueueUpdateDraw(func() {
+		if a.Provider() != provider {
+			return // Loaded for a connection that is no longer active
+		}
+		names := make([]string, 0, len(namespaces))
+		for _, ns := range a.allowedNamespaces(namespaces) {
+			names = append(names, ns.Name)
+		}
+		sort.Strings(names)
+		a.commandNamespaces = names
+		if then != nil {
+			then()
+		}
+	})
+}
+
+// completeCommand returns completions for the command bar input. The status bar
+// replaces the last word of the input with the chosen completion, so after a
+// trailing space the completions carry the command name as well.
+func (a *App) completeCommand(input string) []string {
+	fields := strings.Fields(input)
+	trailing := len(fields) > 0 && strings.HasSuffix(input, " ")
+
+	var candidates []string
+	var prefix string
+	switch {
+	case len(fields) == 0 || (len(fields) == 1 && !trailing):
+		for _, cmd := range commands {
+			candidates = append(candidates, cmd.names...)
+		}
+		candidates = append(candidates, a.aliasNames()...)
+		if len(fields) == 1 {
+			prefix = fields[0]
+		}
+	case len(fields) == 1 || (len(fields) == 2 && !trailing):
+		cmd, ok := lookupCommand(fields[0])
+		if !ok || cmd.complete == nil {
+			return nil
+		}
+		candidates = cmd.complete(a)
+		if len(fields) == 2 {
+			prefix = fields[1]
+		}
+	default:
+		return nil
+	}
+
+	var matches []string
+	for _, c := range candidates {
+		if strings.HasPrefix(strings.ToLower(c), strings.ToLower(prefix)) && c != prefix {
+			if trailing {
+				c = fields[0] + " " + c
+			}
+			matches = append(matches, c)
+		}
+	}
+	return matches
+}
+
+// suggestCommand shows the first completion as ghost text after the input.
+func (a *App) suggestCommand(input string) {
+	matches := a.completeCommand(input)
+	if len(matches) == 0 || strings.TrimSpace(input) == "" {
+		a.statusBar.ClearSuggestion()
+		return
+	}
+	if strings.HasSuffix(input, " ") {
+		a.statusBar.SetSuggestion(matches[0])
+		return
+	}
+	lastWord := input[strings.LastIndex(input, " ")+1:]
+	a.statusBar.SetSuggestion(input[:len(input)-len(lastWord)] + matches[0])
+}
+
+// setCommandCompletion enables command completion in the command bar.
+func (a *App) setCommandCompletion() {
+	a.statusBar.ClearSuggestion()
+	a.statusBar.SetOnComplete(a.completeCommand)
+	a.statusBar.GetCommandInput().SetChangedFunc(a.suggestCommand)
+}
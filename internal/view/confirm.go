@@ -0,0 +1,123 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/rivo/tview"
+)
+
+// ConfirmMode returns how the active profile confirms an operation (see
+// config.ConnectionConfig.ConfirmMode).
+func (a *App) ConfirmMode(operation string) string {
+	if a.config == nil {
+		return config.ConfirmStandard
+	}
+	profile, ok := a.config.GetProfile(a.activeProfile)
+	if !ok {
+		return config.ConfirmStandard
+	}
+	return profile.ConfirmMode(operation)
+}
+
+// addTypedConfirmField adds a field asking for the target to be typed when the
+// confirmation mode requires it.
+func addTypedConfirmField(form *components.Form, mode, label string) {
+	if mode == config.ConfirmTyped {
+		form.AddTextField("confirm", label, "")
+	}
+}
+
+// destructiveConfirm describes the prompt of an operation covered by the
+// confirmation policy.
+type destructiveConfirm struct {
+	Op            string // Operation, e.g. config.OpScheduleDelete
+	Page          string // Modal page name
+	Title         string
+	Width         int
+	Warning       string // Text shown above the typed confirmation
+	WarningHeight int
+	Action        string // What Enter does, for the hints
+	TypedLabel    string // Label of the typed confirmation field
+	Expected      string // What must be typed in typed mode
+}
+
+// confirmDestructive runs onConfirm as the active profile's confirmation policy
+// for c.Op says: right away in skip mode, after Enter on the warning in standard
+// mode, and once c.Expected is typed in typed mode.
+func (a *App) confirmDestructive(c destructiveConfirm, onConfirm func()) {
+	mode := a.ConfirmMode(c.Op)
+	if mode == config.ConfirmSkip {
+		onConfirm()
+		return
+	}
+
+	height := c.WarningHeight + 6
+	if mode == config.ConfirmTyped {
+		height += 3
+	}
+	modal := components.NewModal(components.ModalConfig{
+		Title:    c.Title,
+		Width:    c.Width,
+		Height:   height,
+		Backdrop: true,
+	})
+
+	contentFlex := tview.NewFlex().SetDirection(tview.FlexRow)
+	contentFlex.SetBackgroundColor(theme.Bg())
+
+	warningText := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWordWrap(true).
+		SetTextAlign(tview.AlignLeft)
+	warningText.SetBackgroundColor(theme.Bg())
+	warningText.SetText(c.Warning)
+
+	closeModal := func() {
+		a.modals.Close(c.Page)
+	}
+	form := components.NewForm()
+	addTypedConfirmField(form, mode, c.TypedLabel)
+	submit := func(values map[string]any) {
+		if !typedConfirmMatches(mode, values, c.Expected) {
+			return // Must match the target
+		}
+		closeModal()
+		onConfirm()
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(closeModal)
+
+	var focus tview.Primitive
+	if mode == config.ConfirmTyped {
+		contentFlex.AddItem(warningText, c.WarningHeight, 0, false)
+		contentFlex.AddItem(form, 0, 1, true)
+		focus = form
+	} else {
+		contentFlex.AddItem(warningText, 0, 1, false)
+	}
+
+	modal.SetContent(contentFlex)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: c.Action},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(closeModal)
+
+	a.modals.Show(c.Page, modal, focus)
+}
+
+// typedConfirmMatches reports whether the typed confirmation, if the mode requires
+// one, matches expected.
+func typedConfirmMatches(mode string, values map[string]any, expected string) bool {
+	if mode != config.ConfirmTyped {
+		return true
+	}
+	typed, _ := values["confirm"].(string)
+	return strings.TrimSpace(typed) == expected
+}
@@ -0,0 +1,241 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+)
+
+// Profiles stay connected after switching away from them, so switching back is
+// instant and workflows can be looked up across clusters. a.connections holds every
+// open connection by profile name, including the active one.
+
+// pooledConnection returns the open connection for a profile if it is still
+// connected with the given config. A connection with a stale config (the profile
+// was edited) is closed and dropped.
+func (a *App) pooledConnection(name string, connConfig temporal.ConnectionConfig) temporal.Provider {
+	conn, ok := a.connections[name]
+	if !ok {
+		return nil
+	}
+	if conn.IsConnected() && reflect.DeepEqual(conn.Config(), connConfig) {
+		return conn
+	}
+	a.closeConnection(name)
+	return nil
+}
+
+// dialProfile opens a new connection for a profile in the background and switches
+// to it. The current connection stays active until the new one is up.
+func (a *App) dialProfile(name string, connConfig temporal.ConnectionConfig) {
+	a.setProfile(name + " (connecting...)")
+	a.setConnected(false)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		conn, err := temporal.NewProvider(ctx, connConfig)
+		cancel()
+
+		a.app.QueueUpdateDraw(func() {
+			if err != nil {
+				a.setProfile(a.activeProfile)
+				a.setConnected(a.provider.IsConnected())
+				ShowErrorModal(a.modals, "Connection Failed", fmt.Sprintf("Could not connect with profile %q: %v", name, err))
+				return
+			}
+			a.connections[name] = conn
+			a.activateConnection(name, conn)
+		})
+	}()
+}
+
+// activateConnection makes an open connection the active one and resets the views.
+func (a *App) activateConnection(name string, conn temporal.Provider) {
+	if current := a.app.Pages().Current(); current != nil {
+		current.Stop()
+	}
+
+	a.providerMu.Lock()
+	a.provider = conn
+	a.providerMu.Unlock()
+
+	a.activeProfile = name
+	a.currentNS = conn.Config().Namespace
+	_ = a.config.SetActiveProfile(name)
+	_ = a.config.Save()
+
+	a.setProfile(name)
+	a.setConnected(conn.IsConnected())
+	a.setNamespace(a.currentNS)
+	a.setStuckQueues(nil)
+	a.setStatusSegment(config.SegmentVersion)
+	a.loadServerVersion(conn)
+
+	// Workflows and namespaces offered by the palette belong to the old cluster
+	a.recentWorkflows = nil
+	a.commandNamespaces = nil
+	go a.loadCommandNamespaces(nil)
+
+	a.resetTabs()
+	a.reinitializeViews()
+	a.warnInvalidNamespacePatterns()
+	go a.checkStuckQueues()
+}
+
+// closeConnection closes a background connection. The active connection is never closed.
+func (a *App) closeConnection(name string) {
+	conn, ok := a.connections[name]
+	if !ok || conn == a.provider {
+		return
+	}
+	delete(a.connections, name)
+	go conn.Close()
+}
+
+// closeConnections closes every open connection on exit.
+func (a *App) closeConnections() {
+	for name, conn := range a.connections {
+		conn.Close()
+		delete(a.connections, name)
+	}
+}
+
+// openProfiles returns the names of profiles with an open connection.
+func (a *App) openProfiles() map[string]bool {
+	open := make(map[string]bool, len(a.connections))
+	for name, conn := range a.connections {
+		open[name] = conn.IsConnected()
+	}
+	return open
+}
+
+// HasOtherConnections returns whether connections besides the active one are open.
+func (a *App) HasOtherConnections() bool {
+	return len(a.connections) > 1
+}
+
+// crossReference is a workflow found on another open connection.
+type crossReference struct {
+	Profile  string
+	Workflow *temporal.Workflow
+	Err      error
+}
+
+// ShowCrossReference looks up a workflow ID in the same namespace on every other
+// open connection, e.g. to compare a workflow across replicated clusters.
+func (a *App) ShowCrossReference(namespace, workflowID string) {
+	var names []string
+	for name := range a.connections {
+		if name != a.activeProfile {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		a.toasts.Warning("No other connections are open; switch to another profile first")
+		return
+	}
+	sort.Strings(names)
+
+	conns := make([]temporal.Provider, len(names))
+	for i, name := range names {
+		conns[i] = a.connections[name]
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		refs := make([]crossReference, len(names))
+		var wg sync.WaitGroup
+		for i, name := range names {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				wf, err := conns[i].GetWorkflow(ctx, namespace, workflowID, "")
+				refs[i] = crossReference{Profile: name, Workflow: wf, Err: err}
+			}()
+		}
+		wg.Wait()
+
+		a.app.QueueUpdateDraw(func() {
+			a.showCrossReferencePicker(namespace, workflowID, refs)
+		})
+	}()
+}
+
+func (a *App) showCrossReferencePicker(namespace, workflowID string, refs []crossReference) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:     fmt.Sprintf("%s %s on Other Connections", theme.IconWorkflow, truncateStr(workflowID, 40)),
+		Width:     100,
+		Height:    16,
+		MinHeight: 8,
+		Backdrop:  true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders("PROFILE", "STATUS", "RUN ID", "STARTED")
+	table.SetBackgroundColor(theme.Bg())
+	for _, ref := range refs {
+		if ref.Err != nil {
+			table.AddRowWithColor(theme.FgDim(), ref.Profile, "-", truncateStr(ref.Err.Error(), 50), "")
+			continue
+		}
+		table.AddRow(ref.Profile, ref.Workflow.Status, ref.Workflow.RunID, formatTimestamp(ref.Workflow.StartTime))
+		table.GetCell(table.GetRowCount()-1, 1).SetTextColor(theme.StatusColor(ref.Workflow.Status))
+	}
+	table.SelectRow(0)
+
+	closePicker := func() {
+		a.modals.Close("xref-picker")
+	}
+	open := func() {
+		row := table.SelectedRow()
+		if row < 0 || row >= len(refs) || refs[row].Err != nil {
+			return
+		}
+		ref := refs[row]
+		conn, ok := a.connections[ref.Profile]
+		if !ok {
+			return
+		}
+		closePicker()
+		a.activateConnection(ref.Profile, conn)
+		a.NavigateToWorkflows(namespace)
+		a.NavigateToWorkflowDetail(ref.Workflow.ID, ref.Workflow.RunID)
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			open()
+			return nil
+		case tcell.KeyEscape:
+			closePicker()
+			return nil
+		}
+		if event.Rune() == 'q' {
+			closePicker()
+			return nil
+		}
+		return event
+	})
+
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "Enter", Description: "Switch & open"},
+		{Key: "Esc", Description: "Close"},
+	})
+	modal.SetOnCancel(closePicker)
+
+	a.modals.Show("xref-picker", modal, table)
+}
@@ -0,0 +1,76 @@
+package view
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// crumbSeparator separates breadcrumb segments.
+const crumbSeparator = " > "
+
+// setupCrumbs makes the breadcrumbs clickable: clicking a segment goes back to
+// the view it names. Clicks are ignored while a modal is open.
+func (a *App) setupCrumbs() {
+	crumbs := a.app.Crumbs()
+	if crumbs == nil {
+		return
+	}
+	crumbs.SetSeparator(crumbSeparator)
+	crumbs.SetMouseCapture(func(action tview.MouseAction, event *tcell.EventMouse) (tview.MouseAction, *tcell.EventMouse) {
+		if action != tview.MouseLeftClick {
+			return action, event
+		}
+		if a.modals.Len() > 0 {
+			return action, nil // Going back would pop the modal's page, not a view
+		}
+		x, _ := event.Position()
+		left, _, _, _ := crumbs.GetInnerRect()
+		segment := crumbAt(crumbs.GetPath(), x-left)
+		if segment < 0 {
+			return action, nil
+		}
+		if a.tabCrumb() != "" {
+			if segment == 0 {
+				a.NextTab()
+				return action, nil
+			}
+			segment--
+		}
+		a.JumpToCrumb(segment)
+		return action, nil
+	})
+}
+
+// crumbAt returns the index of the segment drawn at column x, or -1 for a
+// separator or the space after the last segment.
+func crumbAt(path []string, x int) int {
+	pos := 0
+	for i, segment := range path {
+		width := tview.TaggedStringWidth(segment)
+		if x >= pos && x < pos+width {
+			return i
+		}
+		pos += width + tview.TaggedStringWidth(crumbSeparator)
+	}
+	return -1
+}
+
+// JumpToCrumb goes back to the view named by breadcrumb segment (zero-based, not
+// counting the tab label): the shallowest view on the stack whose breadcrumbs
+// include that segment.
+func (a *App) JumpToCrumb(segment int) {
+	current := a.app.Pages().Current()
+	if current == nil {
+		return
+	}
+	path := a.crumbPath(current)
+	if segment < 0 || segment >= len(path) {
+		return
+	}
+	for level, view := range a.viewStack() {
+		if p := a.crumbPath(view); len(p) > segment && p[segment] == path[segment] {
+			a.goBackTo(level)
+			return
+		}
+	}
+}
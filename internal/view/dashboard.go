@@ -0,0 +1,351 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	// dashboardRefreshInterval is how often the dashboard reloads while visible.
+	dashboardRefreshInterval = 30 * time.Second
+	// dashboardConcurrency caps how many namespaces are loaded at once.
+	dashboardConcurrency = 4
+)
+
+// namespaceHealth is a namespace's row on the dashboard.
+type namespaceHealth struct {
+	Name             string
+	Running          int64
+	FailedLastHour   int64
+	BackloggedQueues int
+	StuckQueues      int
+	Loaded           bool
+	Err              error
+}
+
+// DashboardView shows key workflow and task queue stats for every active namespace.
+type DashboardView struct {
+	*tview.Flex
+	app           *App
+	table         *components.Table
+	panel         *components.Panel
+	rows          []namespaceHealth
+	generation    int // Incremented per load so late results from older loads are dropped
+	lastRefresh   time.Time
+	refreshTicker *time.Ticker
+	stopRefresh   chan struct{}
+}
+
+// NewDashboardView creates a new multi-namespace dashboard view.
+func NewDashboardView(app *App) *DashboardView {
+	d := &DashboardView{
+		Flex:        tview.NewFlex().SetDirection(tview.FlexRow),
+		app:         app,
+		table:       components.NewTable(),
+		stopRefresh: make(chan struct{}),
+	}
+	d.setup()
+	return d
+}
+
+func (d *DashboardView) setup() {
+	d.SetBackgroundColor(theme.Bg())
+
+	d.table.SetHeaders("NAMESPACE", "RUNNING", "FAILED (1H)", "BACKLOGGED QUEUES", "STUCK QUEUES")
+	d.table.SetBorder(false)
+	d.table.SetBackgroundColor(theme.Bg())
+
+	d.panel = components.NewPanel().SetTitle(fmt.Sprintf("%s Dashboard", theme.IconNamespace))
+	d.panel.SetContent(d.table)
+
+	d.table.SetOnSelect(func(row int) {
+		if row >= 0 && row < len(d.rows) {
+			d.app.NavigateToWorkflows(d.rows[row].Name)
+		}
+	})
+
+	d.AddItem(d.panel, 0, 1, true)
+}
+
+func (d *DashboardView) loadData() {
+	provider := d.app.Provider()
+	if provider == nil {
+		d.loadMockData()
+		return
+	}
+
+	d.generation++
+	generation := d.generation
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		namespaces, err := provider.ListNamespaces(ctx)
+		cancel()
+
+		d.app.JigApp().QueueUpdateDraw(func() {
+			if generation != d.generation {
+				return
+			}
+			if err != nil {
+				d.showError(err)
+				return
+			}
+			// Keep the previous stats on screen until each namespace reloads
+			previous := make(map[string]namespaceHealth, len(d.rows))
+			for _, row := range d.rows {
+				previous[row.Name] = row
+			}
+			d.rows = nil
+			for _, ns := range namespaces {
+				if ns.State != temporal.NamespaceStateActive || !d.app.NamespaceAllowed(ns.Name) {
+					continue
+				}
+				row, ok := previous[ns.Name]
+				if !ok {
+					row = namespaceHealth{Name: ns.Name}
+				}
+				d.rows = append(d.rows, row)
+			}
+			d.render()
+			d.loadHealth(generation)
+		})
+	}()
+}
+
+// loadHealth loads each namespace's stats concurrently, updating its row as soon
+// as it completes.
+func (d *DashboardView) loadHealth(generation int) {
+	provider := d.app.Provider()
+	names := make([]string, len(d.rows))
+	for i, row := range d.rows {
+		names[i] = row.Name
+	}
+
+	go func() {
+		sem := make(chan struct{}, dashboardConcurrency)
+		var wg sync.WaitGroup
+		for i, name := range names {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				health := loadNamespaceHealth(provider, name, time.Now())
+
+				d.app.JigApp().QueueUpdateDraw(func() {
+					if generation != d.generation || i >= len(d.rows) {
+						return
+					}
+					d.rows[i] = health
+					d.render()
+				})
+			}()
+		}
+		wg.Wait()
+
+		d.app.JigApp().QueueUpdateDraw(func() {
+			if generation == d.generation {
+				d.lastRefresh = time.Now()
+				d.updatePanelTitle()
+			}
+		})
+	}()
+}
+
+// loadNamespaceHealth counts running and recently failed workflows and checks the
+// task queues of recent workflows for backlogs.
+func loadNamespaceHealth(provider temporal.Provider, namespace string, now time.Time) namespaceHealth {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	health := namespaceHealth{Name: namespace, Loaded: true}
+	var err error
+	if health.Running, err = provider.CountWorkflows(ctx, namespace, `ExecutionStatus = "Running"`); err != nil {
+		health.Err = err
+		return health
+	}
+	failedQuery := fmt.Sprintf(`ExecutionStatus = "Failed" AND CloseTime >= "%s"`, now.Add(-time.Hour).UTC().Format(time.RFC3339))
+	if health.FailedLastHour, err = provider.CountWorkflows(ctx, namespace, failedQuery); err != nil {
+		health.Err = err
+		return health
+	}
+
+	workflows, _, err := provider.ListWorkflows(ctx, namespace, temporal.ListOptions{PageSize: taskQueueDiscoveryLimit})
+	if err != nil {
+		health.Err = err
+		return health
+	}
+	for _, q := range describeTaskQueues(ctx, provider, namespace, discoverTaskQueues(workflows)) {
+		if q.Err == nil && q.Backlog > 0 {
+			health.BackloggedQueues++
+		}
+		if q.stuck(now) {
+			health.StuckQueues++
+		}
+	}
+	return health
+}
+
+func (d *DashboardView) loadMockData() {
+	d.rows = []namespaceHealth{
+		{Name: "default", Running: 12, Loaded: true},
+		{Name: "production", Running: 342, FailedLastHour: 7, BackloggedQueues: 2, StuckQueues: 1, Loaded: true},
+		{Name: "staging", Running: 18, BackloggedQueues: 1, Loaded: true},
+		{Name: "development", Running: 3, Loaded: true},
+	}
+	d.lastRefresh = time.Now()
+	d.render()
+	d.updatePanelTitle()
+}
+
+func (d *DashboardView) showError(err error) {
+	d.table.ClearRows()
+	d.table.SetHeaders("NAMESPACE", "RUNNING", "FAILED (1H)", "BACKLOGGED QUEUES", "STUCK QUEUES")
+	d.table.AddRowWithColor(theme.Error(), theme.IconError+" Error loading namespaces", err.Error(), "", "", "")
+}
+
+func (d *DashboardView) render() {
+	currentRow := d.table.SelectedRow()
+	d.table.ClearRows()
+	d.table.SetHeaders("NAMESPACE", "RUNNING", "FAILED (1H)", "BACKLOGGED QUEUES", "STUCK QUEUES")
+
+	for _, h := range d.rows {
+		tableRow := d.table.Table.GetRowCount()
+		switch {
+		case h.Err != nil:
+			d.table.AddRowWithColor(theme.Error(), theme.IconDatabase+" "+h.Name, theme.IconError+" "+h.Err.Error(), "", "", "")
+			continue
+		case !h.Loaded:
+			d.table.AddRowWithColor(theme.FgDim(), theme.IconDatabase+" "+h.Name, "…", "…", "…", "…")
+			continue
+		}
+
+		d.table.AddRow(theme.IconDatabase+" "+h.Name,
+			strconv.FormatInt(h.Running, 10),
+			strconv.FormatInt(h.FailedLastHour, 10),
+			strconv.Itoa(h.BackloggedQueues),
+			strconv.Itoa(h.StuckQueues))
+		if h.FailedLastHour > 0 {
+			d.table.GetCell(tableRow, 2).SetTextColor(theme.StatusColor("Failed"))
+		}
+		if h.BackloggedQueues > 0 {
+			d.table.GetCell(tableRow, 3).SetTextColor(theme.Warning())
+		}
+		if h.StuckQueues > 0 {
+			d.table.GetCell(tableRow, 4).SetTextColor(theme.Error())
+		}
+	}
+
+	if len(d.rows) == 0 {
+		d.table.AddRowWithColor(theme.FgDim(), "(no active namespaces)", "", "", "", "")
+	} else if currentRow >= 0 && currentRow < len(d.rows) {
+		d.table.SelectRow(currentRow)
+	} else {
+		d.table.SelectRow(0)
+	}
+}
+
+func (d *DashboardView) updatePanelTitle() {
+	title := fmt.Sprintf("%s Dashboard (%d namespaces)", theme.IconNamespace, len(d.rows))
+	if !d.lastRefresh.IsZero() {
+		title += fmt.Sprintf(" • updated %s", inTimezone(d.lastRefresh).Format("15:04:05"))
+	}
+	d.panel.SetTitle(title)
+}
+
+func (d *DashboardView) startAutoRefresh() {
+	ticker := time.NewTicker(dashboardRefreshInterval)
+	d.refreshTicker = ticker
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				d.app.JigApp().QueueUpdateDraw(func() {
+					d.loadData()
+				})
+			case <-d.stopRefresh:
+				return
+			}
+		}
+	}()
+}
+
+func (d *DashboardView) stopAutoRefresh() {
+	if d.refreshTicker != nil {
+		d.refreshTicker.Stop()
+		d.refreshTicker = nil
+	}
+	select {
+	case d.stopRefresh <- struct{}{}:
+	default:
+	}
+}
+
+// RefreshTheme updates all component colors after a theme change.
+func (d *DashboardView) RefreshTheme() {
+	bg := theme.Bg()
+	d.SetBackgroundColor(bg)
+	d.table.SetBackgroundColor(bg)
+	d.render()
+}
+
+// Name returns the view name.
+func (d *DashboardView) Name() string {
+	return "dashboard"
+}
+
+// Start is called when the view becomes active.
+func (d *DashboardView) Start() {
+	d.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 'r':
+			d.loadData()
+			return nil
+		case 'i':
+			if row := d.table.SelectedRow(); row >= 0 && row < len(d.rows) {
+				d.app.NavigateToNamespaceDetail(d.rows[row].Name)
+			}
+			return nil
+		}
+		return event
+	})
+	d.loadData()
+	d.startAutoRefresh()
+}
+
+// Stop is called when the view is deactivated.
+func (d *DashboardView) Stop() {
+	d.table.SetInputCapture(nil)
+	d.stopAutoRefresh()
+}
+
+// Hints returns keybinding hints for this view.
+func (d *DashboardView) Hints() []KeyHint {
+	return []KeyHint{
+		{Key: "enter", Description: "Workflows"},
+		{Key: "i", Description: "Info"},
+		{Key: "r", Description: "Refresh"},
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "esc", Description: "Back"},
+	}
+}
+
+// Focus sets focus to the table.
+func (d *DashboardView) Focus(delegate func(p tview.Primitive)) {
+	delegate(d.table)
+}
+
+// Draw applies theme colors dynamically and draws the view.
+func (d *DashboardView) Draw(screen tcell.Screen) {
+	d.SetBackgroundColor(theme.Bg())
+	d.Flex.Draw(screen)
+}
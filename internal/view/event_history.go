@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -21,6 +23,7 @@ const (
 	ViewModeList EventViewMode = iota
 	ViewModeTree
 	ViewModeTimeline
+	ViewModeGraph
 )
 
 // EventHistory displays workflow event history with multiple view modes.
@@ -34,7 +37,7 @@ type EventHistory struct {
 	viewMode EventViewMode
 
 	// List view components (original)
-	table *components.Table
+	table *VirtualTable
 
 	// Tree view components
 	treeView  *EventTreeView
@@ -43,6 +46,17 @@ type EventHistory struct {
 	// Timeline view components
 	timelineView *TimelineView
 
+	// Graph view components
+	graphView    *GraphView
+	parentID     string
+	parentRunID  string
+	parentLoaded bool
+
+	// Auto-refresh of running workflows
+	autoRefresh   bool
+	refreshTicker *time.Ticker
+	stopRefresh   chan struct{}
+
 	// Shared components
 	leftPanel   *components.Panel
 	rightPanel  *components.Panel
@@ -63,12 +77,16 @@ func NewEventHistory(app *App, workflowID, runID string) *EventHistory {
 		workflowID:   workflowID,
 		runID:        runID,
 		viewMode:     ViewModeTree, // Default to tree view
-		table:        components.NewTable(),
+		table:        NewVirtualTable(),
 		treeView:     NewEventTreeView(),
 		timelineView: NewTimelineView(),
+		graphView:    NewGraphView(),
 		sidePanel:    tview.NewTextView(),
 		sidePanelOn:  true,
+		autoRefresh:  true,
+		stopRefresh:  make(chan struct{}),
 	}
+	eh.treeView.SetLatencyThreshold(app.HistorySettings().GetLatencyThreshold())
 	eh.setup()
 	return eh
 }
@@ -92,17 +110,17 @@ func (eh *EventHistory) setup() {
 	eh.rightPanel.SetContent(eh.sidePanel)
 
 	// List view selection handlers
-	eh.table.SetSelectionChangedFunc(func(row, col int) {
-		if eh.viewMode == ViewModeList && eh.sidePanelOn && row > 0 {
-			eh.updateSidePanelFromList(row - 1)
+	eh.table.SetSelectionChangedFunc(func(row int) {
+		if eh.viewMode == ViewModeList && eh.sidePanelOn && row >= 0 {
+			eh.updateSidePanelFromList(row)
 		}
 	})
 
-	eh.table.SetSelectedFunc(func(row, col int) {
-		if row > 0 {
+	eh.table.SetSelectedFunc(func(row int) {
+		if row >= 0 {
 			eh.toggleSidePanel()
 			if eh.sidePanelOn {
-				eh.updateSidePanelFromList(row - 1)
+				eh.updateSidePanelFromList(row)
 			}
 		}
 	})
@@ -133,6 +151,23 @@ func (eh *EventHistory) setup() {
 		}
 	})
 
+	// Timeline time cursor shows what was running at that moment
+	eh.timelineView.SetOnCursorChange(func(t time.Time, active []TimelineLane) {
+		if eh.sidePanelOn {
+			eh.updateSidePanelTimeCursor(t, active)
+		}
+	})
+
+	// Graph view handlers
+	eh.graphView.SetOnSelectionChange(func(node *GraphNode) {
+		if eh.viewMode == ViewModeGraph && eh.sidePanelOn {
+			eh.updateSidePanelFromGraph(node)
+		}
+	})
+	eh.graphView.SetOnOpen(func(node *GraphNode) {
+		eh.app.NavigateToWorkflowDetail(node.WorkflowID, node.RunID)
+	})
+
 	eh.buildLayout()
 }
 
@@ -148,8 +183,11 @@ func (eh *EventHistory) buildLayout() {
 		eh.leftPanel.SetTitle(fmt.Sprintf("%s Events (Tree)", theme.IconEvent))
 		eh.leftPanel.SetContent(eh.treeView)
 	case ViewModeTimeline:
-		eh.leftPanel.SetTitle(fmt.Sprintf("%s Events (Timeline)", theme.IconEvent))
+		eh.leftPanel.SetTitle(eh.timelineTitle())
 		eh.leftPanel.SetContent(eh.timelineView)
+	case ViewModeGraph:
+		eh.leftPanel.SetTitle(fmt.Sprintf("%s Events (Graph)", theme.IconEvent))
+		eh.leftPanel.SetContent(eh.graphView)
 	}
 
 	if eh.sidePanelOn {
@@ -168,10 +206,28 @@ func (eh *EventHistory) buildLayout() {
 			eh.app.JigApp().SetFocus(eh.treeView)
 		case ViewModeTimeline:
 			eh.app.JigApp().SetFocus(eh.timelineView)
+		case ViewModeGraph:
+			eh.app.JigApp().SetFocus(eh.graphView)
 		}
 	}
 }
 
+// timelineTitle returns the panel title for the timeline, noting how workflow
+// tasks are shown when they are not hidden.
+func (eh *EventHistory) timelineTitle() string {
+	parts := []string{"Timeline"}
+	if filter := eh.timelineView.LaneFilter(); filter != "" {
+		parts = append(parts, "only "+filter)
+	}
+	if eh.timelineView.HideCompleted() {
+		parts = append(parts, "hiding completed")
+	}
+	if mode := eh.timelineView.WorkflowTasks(); mode != WorkflowTasksHidden {
+		parts = append(parts, "workflow tasks "+mode.String())
+	}
+	return fmt.Sprintf("%s Events (%s)", theme.IconEvent, strings.Join(parts, ", "))
+}
+
 func (eh *EventHistory) setViewMode(mode EventViewMode) {
 	if eh.viewMode == mode {
 		return
@@ -183,7 +239,7 @@ func (eh *EventHistory) setViewMode(mode EventViewMode) {
 }
 
 func (eh *EventHistory) cycleViewMode() {
-	nextMode := (eh.viewMode + 1) % 3
+	nextMode := (eh.viewMode + 1) % 4
 	eh.setViewMode(nextMode)
 }
 
@@ -195,6 +251,8 @@ func (eh *EventHistory) refreshCurrentView() {
 		eh.populateTreeView()
 	case ViewModeTimeline:
 		eh.populateTimelineView()
+	case ViewModeGraph:
+		eh.populateGraphView()
 	}
 }
 
@@ -229,14 +287,16 @@ func (eh *EventHistory) loadData() {
 	}
 
 	eh.setLoading(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := eh.app.startLoading(eh.leftPanel, "Loading history", cancel)
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
 		// Load enhanced events for tree/timeline views
 		enhancedEvents, err := provider.GetEnhancedWorkflowHistory(ctx, eh.app.CurrentNamespace(), eh.workflowID, eh.runID)
 
 		eh.app.JigApp().QueueUpdateDraw(func() {
+			done()
 			eh.setLoading(false)
 			if err != nil {
 				eh.showError(err)
@@ -261,10 +321,91 @@ func (eh *EventHistory) loadData() {
 
 			// Populate current view
 			eh.refreshCurrentView()
+
+			// Keep following the history while the workflow runs
+			if eh.autoRefresh && workflowRunning(eh.treeNodes) {
+				eh.startAutoRefresh()
+			} else {
+				eh.stopAutoRefresh()
+			}
+		})
+
+		// The graph links to the parent workflow; it is not recorded in this history
+		if eh.parentLoaded {
+			return
+		}
+		wf, err := provider.GetWorkflow(ctx, eh.app.CurrentNamespace(), eh.workflowID, eh.runID)
+		if err != nil {
+			return
+		}
+		eh.parentLoaded = true
+		if wf.ParentID == nil {
+			return
+		}
+		eh.app.JigApp().QueueUpdateDraw(func() {
+			eh.parentID = *wf.ParentID
+			eh.parentRunID = wf.ParentRunID
+			if eh.viewMode == ViewModeGraph {
+				eh.graphView.SetNodes(eh.treeNodes, eh.parentID, eh.parentRunID)
+			}
 		})
 	}()
 }
 
+// workflowRunning reports whether a history has no workflow close event yet.
+func workflowRunning(nodes []*temporal.EventTreeNode) bool {
+	for _, node := range nodes {
+		if node.Type == temporal.GroupWorkflow && node.EndTime != nil {
+			return false
+		}
+	}
+	return len(nodes) > 0
+}
+
+func (eh *EventHistory) toggleAutoRefresh() {
+	eh.autoRefresh = !eh.autoRefresh
+	if eh.autoRefresh && workflowRunning(eh.treeNodes) {
+		eh.startAutoRefresh()
+	} else {
+		eh.stopAutoRefresh()
+	}
+}
+
+// startAutoRefresh re-fetches the history every few seconds so the timeline
+// grows in real time. Other view modes are left alone to keep their selection.
+func (eh *EventHistory) startAutoRefresh() {
+	if eh.refreshTicker != nil {
+		return
+	}
+	eh.refreshTicker = time.NewTicker(5 * time.Second)
+	ticker := eh.refreshTicker
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				eh.app.JigApp().QueueUpdateDraw(func() {
+					if eh.viewMode == ViewModeTimeline && !eh.loading {
+						eh.loadData()
+					}
+				})
+			case <-eh.stopRefresh:
+				return
+			}
+		}
+	}()
+}
+
+func (eh *EventHistory) stopAutoRefresh() {
+	if eh.refreshTicker != nil {
+		eh.refreshTicker.Stop()
+		eh.refreshTicker = nil
+	}
+	select {
+	case eh.stopRefresh <- struct{}{}:
+	default:
+	}
+}
+
 func (eh *EventHistory) loadMockData() {
 	now := time.Now()
 
@@ -309,20 +450,8 @@ func (eh *EventHistory) populateTable() {
 	currentRow := eh.table.SelectedRow()
 
 	eh.table.ClearRows()
-	eh.table.SetHeaders("ID", "TIME", "TYPE", "NAME", "DETAILS")
-
-	for _, ev := range eh.enhancedEvents {
-		icon := eventIcon(ev.Type)
-		color := eventColor(ev.Type)
-		name := getEventName(&ev)
-		eh.table.AddRowWithColor(color,
-			fmt.Sprintf("%d", ev.ID),
-			ev.Time.Format("15:04:05"),
-			icon+" "+ev.Type,
-			name,
-			truncate(ev.Details, 40),
-		)
-	}
+	eh.table.SetRowFunc(eh.eventRow)
+	eh.table.SetRowCount(len(eh.enhancedEvents))
 
 	if eh.table.RowCount() > 0 {
 		// Restore previous selection if valid, otherwise select first row
@@ -338,6 +467,19 @@ func (eh *EventHistory) populateTable() {
 	}
 }
 
+// eventRow renders event i for the list view, which only asks for the rows in
+// view.
+func (eh *EventHistory) eventRow(i int) (tcell.Color, []string) {
+	ev := &eh.enhancedEvents[i]
+	return eventColor(ev.Type), []string{
+		fmt.Sprintf("%d", ev.ID),
+		inTimezone(ev.Time).Format("15:04:05"),
+		eventIcon(ev.Type) + " " + ev.Type,
+		getEventName(ev),
+		truncate(ev.Details, 40),
+	}
+}
+
 // getEventName returns the activity type, timer ID, or child workflow type for an event.
 func getEventName(ev *temporal.EnhancedHistoryEvent) string {
 	if ev.ActivityType != "" {
@@ -363,16 +505,19 @@ func (eh *EventHistory) populateTimelineView() {
 	eh.timelineView.SetNodes(eh.treeNodes)
 }
 
+func (eh *EventHistory) populateGraphView() {
+	eh.graphView.SetNodes(eh.treeNodes, eh.parentID, eh.parentRunID)
+	if node := eh.graphView.SelectedNode(); node != nil {
+		eh.updateSidePanelFromGraph(node)
+	}
+}
+
 func (eh *EventHistory) showError(err error) {
 	eh.table.ClearRows()
-	eh.table.SetHeaders("ID", "TIME", "TYPE", "NAME", "DETAILS")
-	eh.table.AddRowWithColor(theme.Error(),
-		"",
-		"",
-		theme.IconError+" Error loading events",
-		"",
-		err.Error(),
-	)
+	eh.table.SetRowFunc(func(int) (tcell.Color, []string) {
+		return theme.Error(), []string{"", "", theme.IconError + " Error loading events", "", err.Error()}
+	})
+	eh.table.SetRowCount(1)
 }
 
 func (eh *EventHistory) toggleSidePanel() {
@@ -380,6 +525,32 @@ func (eh *EventHistory) toggleSidePanel() {
 	eh.buildLayout()
 }
 
+// updateSidePanelFromGraph shows the selected graph event, or the linked workflow.
+func (eh *EventHistory) updateSidePanelFromGraph(node *GraphNode) {
+	if node.Event == nil {
+		eh.sidePanel.SetText(fmt.Sprintf(`
+[%s::b]Linked Workflow[-:-:-]
+[%s]%s[-]
+
+[%s::b]Run ID[-:-:-]
+[%s]%s[-]
+
+[%s]Press enter to open[-]`,
+			theme.TagAccent(),
+			theme.TagFg(), tview.Escape(node.WorkflowID),
+			theme.TagAccent(),
+			theme.TagFg(), tview.Escape(node.RunID),
+			theme.TagFgDim()))
+		return
+	}
+	for i := range eh.enhancedEvents {
+		if eh.enhancedEvents[i].ID == node.Event.ID {
+			eh.updateSidePanelFromList(i)
+			return
+		}
+	}
+}
+
 func (eh *EventHistory) updateSidePanelFromList(index int) {
 	if index < 0 || index >= len(eh.enhancedEvents) {
 		return
@@ -421,13 +592,82 @@ func (eh *EventHistory) updateSidePanelFromList(index int) {
 		theme.TagAccent(),
 		colorTag, icon, ev.Type, nameSection,
 		theme.TagAccent(),
-		theme.TagFg(), ev.Time.Format("2006-01-02 15:04:05.000"),
+		theme.TagFg(), inTimezone(ev.Time).Format("2006-01-02 15:04:05.000"),
 		theme.TagAccent(),
 		formattedDetails,
 	)
 	eh.sidePanel.SetText(text)
 }
 
+// updateSidePanelCriticalPath shows how much of the workflow's duration each
+// lane on the critical path accounts for.
+func (eh *EventHistory) updateSidePanelCriticalPath() {
+	cp := eh.timelineView.CriticalPath()
+	if cp == nil || len(cp.Nodes) == 0 {
+		eh.sidePanel.SetText(fmt.Sprintf("\n[%s]No critical path: no activities, timers or child workflows[-]", theme.TagFgDim()))
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("\n[%s::b]Critical Path[-:-:-]\n[%s]%d steps, %s total[-]\n",
+		theme.TagAccent(), theme.TagFg(), len(cp.Nodes), temporal.FormatDuration(cp.Total)))
+
+	lanes := append([]temporal.CriticalPathLane(nil), cp.Lanes...)
+	sort.SliceStable(lanes, func(i, j int) bool { return lanes[i].Duration > lanes[j].Duration })
+
+	b.WriteString(fmt.Sprintf("\n[%s::b]Time per Lane[-:-:-]", theme.TagAccent()))
+	for _, lane := range lanes {
+		name := lane.Name
+		if lane.Count > 1 {
+			name = fmt.Sprintf("%s ×%d", name, lane.Count)
+		}
+		b.WriteString(fmt.Sprintf("\n[%s]%-8s %5s[-] [%s]%s[-]",
+			theme.TagFg(), temporal.FormatDuration(lane.Duration), percentOf(lane.Duration, cp.Total),
+			theme.TagFgDim(), tview.Escape(name)))
+	}
+	if cp.Other > 0 {
+		b.WriteString(fmt.Sprintf("\n[%s]%-8s %5s[-] [%s]workflow tasks and idle[-]",
+			theme.TagFg(), temporal.FormatDuration(cp.Other), percentOf(cp.Other, cp.Total), theme.TagFgDim()))
+	}
+
+	b.WriteString(fmt.Sprintf("\n\n[%s::b]Steps[-:-:-]", theme.TagAccent()))
+	for _, node := range cp.Nodes {
+		b.WriteString(fmt.Sprintf("\n[%s]%s %s[-]", theme.StatusColorTag(node.Status), theme.StatusIcon(node.Status), tview.Escape(node.Name)))
+	}
+
+	eh.sidePanel.SetText(b.String())
+}
+
+// updateSidePanelTimeCursor shows the time under the timeline cursor and the
+// lanes that were active then.
+func (eh *EventHistory) updateSidePanelTimeCursor(t time.Time, active []TimelineLane) {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("\n[%s::b]Time Cursor[-:-:-]\n[%s]%s[-]\n[%s]%s UTC[-]\n",
+		theme.TagAccent(),
+		theme.TagFg(), inTimezone(t).Format("2006-01-02 15:04:05.000 MST"),
+		theme.TagFgDim(), t.UTC().Format("2006-01-02T15:04:05.000Z")))
+
+	b.WriteString(fmt.Sprintf("\n[%s::b]Active Lanes (%d)[-:-:-]", theme.TagAccent(), len(active)))
+	if len(active) == 0 {
+		b.WriteString(fmt.Sprintf("\n[%s]Nothing running[-]", theme.TagFgDim()))
+	}
+	for _, lane := range active {
+		b.WriteString(fmt.Sprintf("\n[%s]%s %s[-] [%s]+%s[-]",
+			theme.StatusColorTag(lane.Status), theme.StatusIcon(lane.Status), tview.Escape(strings.TrimSpace(lane.Name)),
+			theme.TagFgDim(), formatRelativeDuration(t.Sub(lane.StartTime))))
+	}
+
+	eh.sidePanel.SetText(b.String())
+}
+
+// percentOf formats part as a percentage of total.
+func percentOf(part, total time.Duration) string {
+	if total <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d%%", int(float64(part)/float64(total)*100+0.5))
+}
+
 func (eh *EventHistory) updateSidePanelFromTree(node *temporal.EventTreeNode) {
 	if node == nil {
 		return
@@ -447,6 +687,26 @@ func (eh *EventHistory) updateSidePanelFromTree(node *temporal.EventTreeNode) {
 	if node.Attempts > 1 {
 		attemptsStr = fmt.Sprintf("\n\n[%s::b]Attempts[-:-:-]\n[%s]%d[-]", theme.TagAccent(), theme.TagFg(), node.Attempts)
 	}
+	if node.Identity != "" {
+		attemptsStr += fmt.Sprintf("\n\n[%s::b]Worker[-:-:-]\n[%s]%s[-]", theme.TagAccent(), theme.TagFg(), node.Identity)
+	}
+	if node.ScheduleToStart > 0 || node.StartToClose > 0 {
+		attemptsStr += fmt.Sprintf("\n\n[%s::b]Latency[-:-:-]", theme.TagAccent())
+		if node.ScheduleToStart > 0 {
+			tag := theme.TagFg()
+			if eh.treeView.isLatencyOutlier(node) {
+				tag = theme.TagWarning()
+			}
+			attemptsStr += fmt.Sprintf("\n[%s]Schedule-to-start:[-] [%s]%s[-]", theme.TagFgDim(), tag, temporal.FormatDuration(node.ScheduleToStart))
+		}
+		if node.StartToClose > 0 {
+			attemptsStr += fmt.Sprintf("\n[%s]Start-to-close:[-] [%s]%s[-]", theme.TagFgDim(), theme.TagFg(), temporal.FormatDuration(node.StartToClose))
+		}
+	}
+	if len(node.Events) == 0 && node.Failure != "" {
+		// Summarized attempts have no events of their own; show the last failure
+		attemptsStr += fmt.Sprintf("\n\n[%s::b]Last Failure[-:-:-]\n[%s]%s[-]", theme.TagAccent(), theme.TagError(), formatSidePanelDetails(node.Failure))
+	}
 
 	// Extract result/failure from events
 	var dataStr string
@@ -490,7 +750,7 @@ func (eh *EventHistory) updateSidePanelFromTree(node *temporal.EventTreeNode) {
 		theme.TagAccent(),
 		theme.TagFg(), durationStr,
 		theme.TagAccent(),
-		theme.TagFg(), node.StartTime.Format("2006-01-02 15:04:05.000"),
+		theme.TagFg(), inTimezone(node.StartTime).Format("2006-01-02 15:04:05.000"),
 		attemptsStr,
 		dataStr,
 		eventsStr,
@@ -516,6 +776,7 @@ func (eh *EventHistory) setupInputCapture() {
 	eh.table.SetInputCapture(nil)
 	eh.treeView.SetInputCapture(nil)
 	eh.timelineView.SetInputCapture(nil)
+	eh.graphView.SetInputCapture(nil)
 
 	// Common input handler for all modes
 	inputHandler := func(event *tcell.EventKey) *tcell.EventKey {
@@ -532,6 +793,9 @@ func (eh *EventHistory) setupInputCapture() {
 		case '3':
 			eh.setViewMode(ViewModeTimeline)
 			return nil
+		case '4':
+			eh.setViewMode(ViewModeGraph)
+			return nil
 		case 'p':
 			eh.toggleSidePanel()
 			return nil
@@ -544,12 +808,38 @@ func (eh *EventHistory) setupInputCapture() {
 		case 'd':
 			eh.showDetailModal()
 			return nil
+		case wrapKey:
+			eh.app.toggleDetailWrap()
+			eh.app.JigApp().Menu().SetHints(eh.Hints())
+			return nil
+		case 'z':
+			eh.toggleZen()
+			return nil
 		}
 
 		// View-specific handlers
 		switch eh.viewMode {
+		case ViewModeList:
+			switch event.Rune() {
+			case 'h':
+				if eh.sidePanelOn && eh.app.scrollDetail(eh.sidePanel, -detailScrollStep) {
+					return nil
+				}
+			case 'l':
+				if eh.sidePanelOn && eh.app.scrollDetail(eh.sidePanel, detailScrollStep) {
+					return nil
+				}
+			}
 		case ViewModeTree:
 			switch event.Rune() {
+			case 'h':
+				if eh.sidePanelOn && eh.app.scrollDetail(eh.sidePanel, -detailScrollStep) {
+					return nil
+				}
+			case 'l':
+				if eh.sidePanelOn && eh.app.scrollDetail(eh.sidePanel, detailScrollStep) {
+					return nil
+				}
 			case 'e':
 				eh.treeView.ExpandAll()
 				return nil
@@ -561,7 +851,46 @@ func (eh *EventHistory) setupInputCapture() {
 				return nil
 			}
 		case ViewModeTimeline:
-			// Timeline handles its own input via InputHandler
+			// Timeline handles navigation via its own InputHandler
+			switch event.Rune() {
+			case 'c':
+				if eh.timelineView.ToggleCriticalPath() {
+					eh.updateSidePanelCriticalPath()
+				}
+				return nil
+			case 'E':
+				eh.showTimelineExport()
+				return nil
+			case 'a':
+				eh.toggleAutoRefresh()
+				return nil
+			case 'f':
+				eh.timelineView.CycleLaneFilter()
+				eh.leftPanel.SetTitle(eh.timelineTitle())
+				return nil
+			case 'x':
+				eh.timelineView.ToggleHideCompleted()
+				eh.leftPanel.SetTitle(eh.timelineTitle())
+				return nil
+			case 't':
+				if !eh.timelineView.ToggleTimeCursor() {
+					eh.refreshSidePanel()
+				}
+				return nil
+			case 'w':
+				eh.timelineView.CycleWorkflowTasks()
+				eh.leftPanel.SetTitle(eh.timelineTitle())
+				return nil
+			}
+		case ViewModeGraph:
+			switch event.Rune() {
+			case 'e':
+				eh.graphView.SetAllCollapsed(false)
+				return nil
+			case 'c':
+				eh.graphView.SetAllCollapsed(true)
+				return nil
+			}
 		}
 
 		return event
@@ -575,30 +904,43 @@ func (eh *EventHistory) setupInputCapture() {
 		eh.treeView.SetInputCapture(inputHandler)
 	case ViewModeTimeline:
 		eh.timelineView.SetInputCapture(inputHandler)
+	case ViewModeGraph:
+		eh.graphView.SetInputCapture(inputHandler)
 	}
 }
 
 // Stop is called when the view is deactivated.
 func (eh *EventHistory) Stop() {
+	eh.stopAutoRefresh()
 	eh.table.SetInputCapture(nil)
 	eh.treeView.SetInputCapture(nil)
 	eh.timelineView.SetInputCapture(nil)
+	eh.graphView.SetInputCapture(nil)
 }
 
 // Hints returns keybinding hints for this view.
 func (eh *EventHistory) Hints() []KeyHint {
 	hints := []KeyHint{
 		{Key: "v", Description: "Cycle View"},
-		{Key: "1/2/3", Description: "List/Tree/Timeline"},
+		{Key: "1-4", Description: "List/Tree/Timeline/Graph"},
 		{Key: "d", Description: "Detail"},
 		{Key: "y", Description: "Yank"},
 		{Key: "p", Description: "Preview"},
+		{Key: "z", Description: "Maximize"},
 		{Key: "r", Description: "Refresh"},
+		eh.app.wrapHint(),
 	}
 
 	// Add view-specific hints
 	switch eh.viewMode {
+	case ViewModeList:
+		if !eh.app.detailWrap() {
+			hints = append(hints, KeyHint{Key: "h/l", Description: "Scroll Detail"})
+		}
 	case ViewModeTree:
+		if !eh.app.detailWrap() {
+			hints = append(hints, KeyHint{Key: "h/l", Description: "Scroll Detail"})
+		}
 		hints = append(hints,
 			KeyHint{Key: "e", Description: "Expand All"},
 			KeyHint{Key: "c", Description: "Collapse All"},
@@ -608,6 +950,21 @@ func (eh *EventHistory) Hints() []KeyHint {
 		hints = append(hints,
 			KeyHint{Key: "+/-", Description: "Zoom"},
 			KeyHint{Key: "h/l", Description: "Scroll"},
+			KeyHint{Key: "c", Description: "Critical Path"},
+			KeyHint{Key: "E", Description: "Export"},
+			KeyHint{Key: "w", Description: "Workflow Tasks"},
+			KeyHint{Key: "t", Description: "Time Cursor"},
+			KeyHint{Key: "a", Description: "Auto-refresh"},
+			KeyHint{Key: "f", Description: "Filter Lanes"},
+			KeyHint{Key: "x", Description: "Hide Completed"},
+			KeyHint{Key: "[/]", Description: "Move Cursor"},
+		)
+	case ViewModeGraph:
+		hints = append(hints,
+			KeyHint{Key: "h/l", Description: "Step"},
+			KeyHint{Key: "space", Description: "Fold Branch"},
+			KeyHint{Key: "e/c", Description: "Expand/Collapse All"},
+			KeyHint{Key: "enter", Description: "Open Linked Workflow"},
 		)
 	}
 
@@ -629,16 +986,34 @@ func (eh *EventHistory) Focus(delegate func(p tview.Primitive)) {
 		delegate(eh.treeView)
 	case ViewModeTimeline:
 		delegate(eh.timelineView)
+	case ViewModeGraph:
+		delegate(eh.graphView)
 	default:
 		delegate(eh.table)
 	}
 }
 
+// toggleZen maximizes the events panel in its current mode, keeping the
+// mode's keys.
+func (eh *EventHistory) toggleZen() {
+	var focus tview.Primitive
+	eh.Focus(func(p tview.Primitive) { focus = p })
+
+	var hints []KeyHint
+	for _, hint := range eh.Hints() {
+		if hint.Key != "z" && hint.Key != "T" && hint.Key != "esc" {
+			hints = append(hints, hint)
+		}
+	}
+	eh.app.toggleZen(eh.leftPanel, focus, hints...)
+}
+
 // Draw applies theme colors dynamically and draws the view.
 func (eh *EventHistory) Draw(screen tcell.Screen) {
 	bg := theme.Bg()
 	eh.SetBackgroundColor(bg)
 	eh.sidePanel.SetBackgroundColor(bg)
+	eh.sidePanel.SetWrap(eh.app.detailWrap())
 	eh.Flex.Draw(screen)
 }
 
@@ -735,6 +1110,10 @@ func (eh *EventHistory) getSelectedEventData() (string, string) {
 			ev := lane.Node.Events[len(lane.Node.Events)-1]
 			return ev.Type, eh.formatEventDataRaw(ev)
 		}
+	case ViewModeGraph:
+		if node := eh.graphView.SelectedNode(); node != nil && node.Event != nil {
+			return node.Event.Type, eh.formatEventDataRaw(node.Event)
+		}
 	}
 	return "", ""
 }
@@ -765,30 +1144,7 @@ func (eh *EventHistory) yankEventData() {
 	if data == "" {
 		return
 	}
-
-	if err := copyToClipboard(data); err != nil {
-		eh.sidePanel.SetText(fmt.Sprintf("[%s]%s Failed to copy: %s[-]",
-			theme.TagError(), theme.IconError, err.Error()))
-		return
-	}
-
-	// Show success feedback
-	eh.sidePanel.SetText(fmt.Sprintf(`[%s::b]Copied to clipboard[-:-:-]
-
-[%s]%s[-]
-
-[%s]%s[-]`,
-		theme.TagAccent(),
-		theme.TagAccent(), eventType,
-		theme.StatusColorTag("Completed"), "Event data copied!"))
-
-	// Restore preview after a brief delay
-	go func() {
-		time.Sleep(1500 * time.Millisecond)
-		eh.app.JigApp().QueueUpdateDraw(func() {
-			eh.refreshSidePanel()
-		})
-	}()
+	eh.app.CopyToClipboard(data, eventType+" data")
 }
 
 // refreshSidePanel updates the side panel based on current selection.
@@ -809,6 +1165,10 @@ func (eh *EventHistory) refreshSidePanel() {
 		if lane != nil && lane.Node != nil {
 			eh.updateSidePanelFromTree(lane.Node)
 		}
+	case ViewModeGraph:
+		if node := eh.graphView.SelectedNode(); node != nil {
+			eh.updateSidePanelFromGraph(node)
+		}
 	}
 }
 
@@ -830,7 +1190,7 @@ func (eh *EventHistory) showDetailModal() {
 	textView := tview.NewTextView().
 		SetDynamicColors(true).
 		SetScrollable(true).
-		SetWrap(true)
+		SetWrap(eh.app.detailWrap())
 	textView.SetBackgroundColor(theme.Bg())
 	textView.SetTextColor(theme.Fg())
 
@@ -839,11 +1199,15 @@ func (eh *EventHistory) showDetailModal() {
 	textView.SetText(formattedData)
 
 	modal.SetContent(textView)
-	modal.SetHints([]components.KeyHint{
-		{Key: "j/k", Description: "Scroll"},
-		{Key: "y", Description: "Copy"},
-		{Key: "esc", Description: "Close"},
-	})
+	hints := func() []components.KeyHint {
+		hints := []components.KeyHint{{Key: "j/k", Description: "Scroll"}}
+		hints = append(hints, eh.app.wrapModalHints()...)
+		return append(hints,
+			components.KeyHint{Key: "y", Description: "Copy"},
+			components.KeyHint{Key: "esc", Description: "Close"},
+		)
+	}
+	modal.SetHints(hints())
 	modal.SetOnCancel(func() {
 		eh.closeDetailModal()
 	})
@@ -866,13 +1230,19 @@ func (eh *EventHistory) showDetailModal() {
 					textView.ScrollTo(row-1, col)
 				}
 				return nil
+			case wrapKey:
+				eh.app.toggleDetailWrap()
+				textView.SetWrap(eh.app.detailWrap())
+				modal.SetHints(hints())
+				return nil
+			case 'h':
+				eh.app.scrollDetail(textView, -detailScrollStep)
+				return nil
+			case 'l':
+				eh.app.scrollDetail(textView, detailScrollStep)
+				return nil
 			case 'y':
-				if err := copyToClipboard(data); err == nil {
-					// Brief feedback
-					originalText := textView.GetText(false)
-					textView.SetText(fmt.Sprintf("[%s]Copied to clipboard![-]\n\n%s",
-						theme.StatusColorTag("Completed"), originalText))
-				}
+				eh.app.CopyToClipboard(data, "Event data")
 				return nil
 			case 'q':
 				eh.closeDetailModal()
@@ -882,8 +1252,7 @@ func (eh *EventHistory) showDetailModal() {
 		return event
 	})
 
-	eh.app.JigApp().Pages().AddPage("event-detail", modal, true, true)
-	eh.app.JigApp().SetFocus(textView)
+	eh.app.Modals().Show("event-detail", modal, textView)
 }
 
 // truncateEventType shortens long event type names for the title.
@@ -903,8 +1272,7 @@ func formatSidePanelDetails(details string) string {
 	// First try to pretty print if it's pure JSON
 	trimmed := strings.TrimSpace(details)
 	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
-		formatted := prettyPrintJSON(details)
-		return highlightFormattedJSON(formatted)
+		return highlightDetail(prettyPrintJSON(details))
 	}
 
 	// Handle key-value format like "WorkflowType: Foo, TaskQueue: bar, Input: {...}"
@@ -934,31 +1302,14 @@ func formatKeyValueDetails(details string) string {
 			value := strings.TrimSpace(part[colonIdx+1:])
 
 			// Write the key in accent color
-			result.WriteString(fmt.Sprintf("[%s]%s:[-] ", theme.TagAccent(), key))
-
-			// Check if value is JSON
-			if strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[") {
-				formatted := prettyPrintJSON(value)
-				if formatted != value {
-					// JSON was successfully formatted - indent it
-					lines := strings.Split(formatted, "\n")
-					for j, line := range lines {
-						if j == 0 {
-							result.WriteString(highlightJSONValueLine(line))
-						} else {
-							result.WriteString("\n  ")
-							result.WriteString(highlightJSONValueLine(line))
-						}
-					}
-				} else {
-					result.WriteString(highlightJSONValueLine(value))
-				}
-			} else {
-				result.WriteString(highlightJSONValueLine(value))
-			}
+			result.WriteString(fmt.Sprintf("[%s]%s:[-] ", theme.TagAccent(), tview.Escape(key)))
+
+			// Pretty print JSON values, indenting them under the key
+			highlighted := highlightDetail(prettyPrintJSON(value))
+			result.WriteString(strings.ReplaceAll(highlighted, "\n", "\n  "))
 		} else {
 			// No key-value structure, just highlight as value
-			result.WriteString(highlightJSONValueLine(part))
+			result.WriteString(highlightDetail(part))
 		}
 	}
 
@@ -998,49 +1349,9 @@ func splitPreservingJSON(s string) []string {
 	return parts
 }
 
-// highlightFormattedJSON applies syntax highlighting to already-formatted JSON.
-func highlightFormattedJSON(formatted string) string {
-	lines := strings.Split(formatted, "\n")
-	var result []string
-	for _, line := range lines {
-		result = append(result, highlightJSONValueLine(line))
-	}
-	return strings.Join(result, "\n")
-}
-
-// highlightJSONValueLine highlights a single line of JSON content.
-func highlightJSONValueLine(line string) string {
-	// Check for key: value pattern
-	if colonIdx := strings.Index(line, ":"); colonIdx > 0 {
-		prefix := line[:colonIdx]
-		suffix := line[colonIdx+1:]
-
-		trimmed := strings.TrimSpace(prefix)
-		if strings.HasPrefix(trimmed, "\"") && strings.HasSuffix(trimmed, "\"") {
-			// JSON key with quotes - use accent color
-			return fmt.Sprintf("[%s]%s[-]:[%s]%s[-]", theme.TagAccent(), prefix, theme.TagFg(), highlightValues(suffix))
-		}
-	}
-
-	return highlightValues(line)
-}
-
-// highlightValues highlights JSON values (booleans, null, numbers).
-func highlightValues(s string) string {
-	result := s
-	result = strings.ReplaceAll(result, "true", fmt.Sprintf("[%s]true[-]", theme.StatusColorTag("Completed")))
-	result = strings.ReplaceAll(result, "false", fmt.Sprintf("[%s]false[-]", theme.StatusColorTag("Failed")))
-	result = strings.ReplaceAll(result, "null", fmt.Sprintf("[%s]null[-]", theme.TagFgDim()))
-	return result
-}
-
 // closeDetailModal closes the detail modal.
 func (eh *EventHistory) closeDetailModal() {
-	eh.app.JigApp().Pages().RemovePage("event-detail")
-	// Restore focus to current view through the view's Focus method
-	eh.Focus(func(p tview.Primitive) {
-		eh.app.JigApp().SetFocus(p)
-	})
+	eh.app.Modals().Close("event-detail")
 }
 
 // prettyPrintJSON attempts to format a string as pretty JSON.
@@ -1069,58 +1380,20 @@ func prettyPrintJSON(s string) string {
 	return string(pretty)
 }
 
-// formatDetailWithHighlighting adds color tags for JSON syntax highlighting.
-func formatDetailWithHighlighting(data string) string {
-	lines := strings.Split(data, "\n")
-	var result []string
-
-	for _, line := range lines {
-		highlighted := highlightJSONLine(line)
-		result = append(result, highlighted)
-	}
-
-	return strings.Join(result, "\n")
-}
+// detailSectionLabel matches the label starting a section of event data, e.g.
+// "Result: ".
+var detailSectionLabel = regexp.MustCompile(`^([A-Z]\w*): `)
 
-// highlightJSONLine adds tview color tags to a single line for JSON-like content.
-func highlightJSONLine(line string) string {
-	// Simple highlighting:
-	// - Keys (before colon) in accent color
-	// - Strings in green
-	// - Numbers in yellow
-	// - true/false/null in purple
-
-	// If line contains a colon that looks like a JSON key
-	if idx := strings.Index(line, ":"); idx > 0 {
-		prefix := line[:idx]
-		suffix := line[idx:]
-
-		// Check if prefix looks like a key (has quotes or is a simple word)
-		trimmed := strings.TrimSpace(prefix)
-		if strings.HasPrefix(trimmed, "\"") || strings.HasPrefix(trimmed, "'") {
-			// JSON key with quotes
-			return fmt.Sprintf("[%s]%s[-]%s", theme.TagAccent(), prefix, highlightJSONValue(suffix))
-		} else if !strings.Contains(trimmed, " ") && len(trimmed) > 0 {
-			// Simple key without quotes (like "Details:", "Result:")
-			return fmt.Sprintf("[%s::b]%s[-:-:-]%s", theme.TagAccent(), prefix, highlightJSONValue(suffix))
+// formatDetailWithHighlighting highlights event data: each blank-line separated
+// section is highlighted on its own, with its label in bold.
+func formatDetailWithHighlighting(data string) string {
+	sections := strings.Split(data, "\n\n")
+	for i, section := range sections {
+		if m := detailSectionLabel.FindStringSubmatch(section); m != nil {
+			sections[i] = fmt.Sprintf("[%s::b]%s:[-:-:-] %s", theme.TagAccent(), m[1], highlightDetail(section[len(m[0]):]))
+		} else {
+			sections[i] = highlightDetail(section)
 		}
 	}
-
-	return highlightJSONValue(line)
-}
-
-// highlightJSONValue highlights JSON values (strings, numbers, booleans).
-func highlightJSONValue(s string) string {
-	// Replace common JSON patterns with highlighted versions
-	result := s
-
-	// Highlight string values (simple approach)
-	// This is a basic implementation - a full JSON parser would be more robust
-
-	// Highlight booleans and null
-	result = strings.ReplaceAll(result, "true", fmt.Sprintf("[%s]true[-]", theme.StatusColorTag("Completed")))
-	result = strings.ReplaceAll(result, "false", fmt.Sprintf("[%s]false[-]", theme.StatusColorTag("Failed")))
-	result = strings.ReplaceAll(result, "null", fmt.Sprintf("[%s]null[-]", theme.TagFgDim()))
-
-	return result
+	return strings.Join(sections, "\n\n")
 }
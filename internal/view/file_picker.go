@@ -0,0 +1,245 @@
+package view
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// filePickerPage is the page name of the file picker.
+const filePickerPage = "file-picker"
+
+// fileEntry is a file or directory listed by the file picker.
+type fileEntry struct {
+	name string
+	dir  bool
+}
+
+// readDirEntries lists dir with directories first, each group sorted by name.
+// Dotfiles are left out unless hidden is set.
+func readDirEntries(dir string, hidden bool) ([]fileEntry, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []fileEntry
+	for _, e := range entries {
+		if !hidden && strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		isDir := e.IsDir()
+		if e.Type()&os.ModeSymlink != 0 {
+			if info, err := os.Stat(filepath.Join(dir, e.Name())); err == nil {
+				isDir = info.IsDir()
+			}
+		}
+		files = append(files, fileEntry{name: e.Name(), dir: isDir})
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		if files[i].dir != files[j].dir {
+			return files[i].dir
+		}
+		return files[i].name < files[j].name
+	})
+	return files, nil
+}
+
+// pickerStartDir returns the directory the file picker opens in: the one
+// holding path, or the home directory.
+func pickerStartDir(path string) string {
+	if path = expandHome(strings.TrimSpace(path)); path != "" {
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			return path
+		}
+		if info, err := os.Stat(filepath.Dir(path)); err == nil && info.IsDir() {
+			return filepath.Dir(path)
+		}
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		return home
+	}
+	return "."
+}
+
+// completePath completes the last element of path like a shell: to the
+// longest prefix shared by the entries that start with it, with a trailing
+// slash for a directory. A leading "~" is expanded, since paths in profiles
+// are not. It also returns the names of the matching entries.
+func completePath(path string) (string, []string) {
+	path = expandHome(path)
+	dir, base := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+	entries, err := readDirEntries(dir, strings.HasPrefix(base, "."))
+	if err != nil {
+		return path, nil
+	}
+
+	var matches []string
+	var matchDir bool
+	for _, e := range entries {
+		if strings.HasPrefix(e.name, base) {
+			matches = append(matches, e.name)
+			matchDir = e.dir
+		}
+	}
+	if len(matches) == 0 {
+		return path, nil
+	}
+
+	prefix := matches[0]
+	for _, m := range matches[1:] {
+		for !strings.HasPrefix(m, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	completed := strings.TrimSuffix(path, base) + prefix
+	if len(matches) == 1 && matchDir {
+		completed += string(filepath.Separator)
+	}
+	return completed, matches
+}
+
+// showFilePicker browses the filesystem from the directory of path and calls
+// onPick with the absolute path of the chosen file.
+func (a *App) showFilePicker(title, path string, onPick func(string)) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:     fmt.Sprintf("%s %s", theme.IconInfo, title),
+		Width:     80,
+		Height:    24,
+		MinHeight: 10,
+		Backdrop:  true,
+	})
+
+	dirView := tview.NewTextView().SetDynamicColors(true)
+	dirView.SetBackgroundColor(theme.Bg())
+
+	table := components.NewTable()
+	table.SetHeaders("NAME")
+	table.SetBorder(false)
+
+	dir := pickerStartDir(path)
+	if abs, err := filepath.Abs(dir); err == nil {
+		dir = abs
+	}
+	var entries []fileEntry
+	hidden := false
+
+	// open lists next, selecting the entry named selected if it is there.
+	open := func(next, selected string) {
+		files, err := readDirEntries(next, hidden)
+		if err != nil {
+			a.toasts.Error(fmt.Sprintf("Cannot open %s: %s", next, err))
+			return
+		}
+		dir, entries = next, files
+		dirView.SetText(fmt.Sprintf("[%s]%s[-]", theme.TagAccent(), tview.Escape(dir)))
+
+		table.ClearRows()
+		selectedRow := 0
+		for i, e := range entries {
+			if e.dir {
+				table.AddRowWithColor(theme.Accent(), e.name+string(filepath.Separator))
+			} else {
+				table.AddRow(e.name)
+			}
+			if e.name == selected {
+				selectedRow = i
+			}
+		}
+		if len(entries) == 0 {
+			table.AddRowWithColor(theme.FgDim(), "(empty)")
+		}
+		table.SelectRow(selectedRow)
+	}
+	up := func() {
+		if parent := filepath.Dir(dir); parent != dir {
+			open(parent, filepath.Base(dir))
+		}
+	}
+	closePicker := func() {
+		a.modals.Close(filePickerPage)
+	}
+
+	// enter opens the selected directory, or picks the selected file if pick
+	// is set.
+	enter := func(pick bool) {
+		row := table.SelectedRow()
+		if row < 0 || row >= len(entries) {
+			return
+		}
+		entry := entries[row]
+		switch {
+		case entry.dir:
+			open(filepath.Join(dir, entry.name), "")
+		case pick:
+			closePicker()
+			onPick(filepath.Join(dir, entry.name))
+		}
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			enter(true)
+			return nil
+		case tcell.KeyRight:
+			enter(false)
+			return nil
+		case tcell.KeyBackspace, tcell.KeyBackspace2, tcell.KeyLeft:
+			up()
+			return nil
+		}
+		switch event.Rune() {
+		case 'l':
+			enter(false)
+			return nil
+		case 'h':
+			up()
+			return nil
+		case '~':
+			if home, err := os.UserHomeDir(); err == nil {
+				open(home, "")
+			}
+			return nil
+		case '.':
+			hidden = !hidden
+			selected := ""
+			if row := table.SelectedRow(); row >= 0 && row < len(entries) {
+				selected = entries[row].name
+			}
+			open(dir, selected)
+			return nil
+		case 'q':
+			closePicker()
+			return nil
+		}
+		return event
+	})
+
+	open(dir, filepath.Base(expandHome(strings.TrimSpace(path))))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(dirView, 1, 0, false).
+		AddItem(table, 0, 1, true)
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "Enter", Description: "Open/Pick"},
+		{Key: "h/l", Description: "Up/Into"},
+		{Key: "~", Description: "Home"},
+		{Key: ".", Description: "Hidden files"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnCancel(closePicker)
+
+	a.modals.Show(filePickerPage, modal, table)
+}
@@ -0,0 +1,282 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	// globalSearchConcurrency bounds the namespaces searched at once.
+	globalSearchConcurrency = 8
+	// globalSearchPageSize is the number of workflows fetched per namespace.
+	globalSearchPageSize = 50
+)
+
+// visibilityQueryPattern detects input that is a visibility query rather than a workflow ID.
+var visibilityQueryPattern = regexp.MustCompile(`(?i)[=<>]|\s(and|or|between|in|is|starts_with)\s`)
+
+// globalSearchQuery turns search input into a visibility query: queries are used
+// as is, anything else is looked up as a workflow ID.
+func globalSearchQuery(input string) string {
+	if visibilityQueryPattern.MatchString(input) {
+		return input
+	}
+	return fmt.Sprintf("WorkflowId = %q", input)
+}
+
+// GlobalSearchView searches for workflows across every namespace the profile
+// allows, showing the merged results with their namespace.
+type GlobalSearchView struct {
+	*tview.Flex
+	app        *App
+	input      string
+	results    []temporal.Workflow
+	failed     []string // Namespaces whose search failed
+	searched   int      // Namespaces searched
+	loading    bool
+	generation int
+	table      *components.Table
+	panel      *components.Panel
+}
+
+// NewGlobalSearchView creates a global search view, searching for input right
+// away when it is set.
+func NewGlobalSearchView(app *App, input string) *GlobalSearchView {
+	gs := &GlobalSearchView{
+		Flex:  tview.NewFlex().SetDirection(tview.FlexRow),
+		app:   app,
+		input: strings.TrimSpace(input),
+		table: components.NewTable(),
+	}
+	gs.setup()
+	return gs
+}
+
+func (gs *GlobalSearchView) setup() {
+	gs.SetBackgroundColor(theme.Bg())
+
+	gs.table.SetHeaders("NAMESPACE", "WORKFLOW ID", "TYPE", "STATUS", "STARTED")
+	gs.table.SetBorder(false)
+	gs.table.SetBackgroundColor(theme.Bg())
+	gs.table.SetOnSelect(func(row int) {
+		if row >= 0 && row < len(gs.results) {
+			wf := gs.results[row]
+			gs.app.SetNamespace(wf.Namespace)
+			gs.app.NavigateToWorkflowDetail(wf.ID, wf.RunID)
+		}
+	})
+
+	gs.panel = components.NewPanel()
+	gs.panel.SetContent(gs.table)
+	gs.AddItem(gs.panel, 0, 1, true)
+	gs.render()
+}
+
+// search runs the current input against every allowed, active namespace.
+func (gs *GlobalSearchView) search() {
+	if gs.input == "" {
+		gs.render()
+		return
+	}
+	provider := gs.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	gs.generation++
+	generation := gs.generation
+	gs.loading = true
+	gs.render()
+
+	query := globalSearchQuery(gs.input)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := gs.app.startLoading(gs.panel, "Searching namespaces", cancel)
+	go func() {
+		defer cancel()
+
+		namespaces, err := provider.ListNamespaces(ctx)
+		if err != nil {
+			gs.app.JigApp().QueueUpdateDraw(func() {
+				done()
+				if generation == gs.generation {
+					gs.loading = false
+					gs.showError(err)
+				}
+			})
+			return
+		}
+
+		var (
+			mu      sync.Mutex
+			wg      sync.WaitGroup
+			results []temporal.Workflow
+			failed  []string
+			count   int
+		)
+		sem := make(chan struct{}, globalSearchConcurrency)
+		for _, ns := range namespaces {
+			if ns.State != temporal.NamespaceStateActive || !gs.app.NamespaceAllowed(ns.Name) {
+				continue
+			}
+			count++
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				workflows, _, err := provider.ListWorkflows(ctx, ns.Name, temporal.ListOptions{PageSize: globalSearchPageSize, Query: query})
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					failed = append(failed, ns.Name)
+					return
+				}
+				for _, wf := range workflows {
+					wf.Namespace = ns.Name
+					results = append(results, wf)
+				}
+			}()
+		}
+		wg.Wait()
+
+		sort.Slice(results, func(i, j int) bool { return results[i].StartTime.After(results[j].StartTime) })
+		sort.Strings(failed)
+
+		gs.app.JigApp().QueueUpdateDraw(func() {
+			done()
+			if generation != gs.generation {
+				return
+			}
+			gs.loading = false
+			gs.results = results
+			gs.failed = failed
+			gs.searched = count
+			gs.render()
+		})
+	}()
+}
+
+func (gs *GlobalSearchView) showError(err error) {
+	gs.table.ClearRows()
+	gs.table.SetHeaders("NAMESPACE", "WORKFLOW ID", "TYPE", "STATUS", "STARTED")
+	gs.table.AddRowWithColor(theme.Error(), theme.IconError+" Error listing namespaces", err.Error(), "", "", "")
+}
+
+func (gs *GlobalSearchView) render() {
+	currentRow := gs.table.SelectedRow()
+	gs.table.ClearRows()
+	gs.table.SetHeaders("NAMESPACE", "WORKFLOW ID", "TYPE", "STATUS", "STARTED")
+
+	title := fmt.Sprintf("%s Global Search", theme.IconWorkflow)
+	switch {
+	case gs.input == "":
+		gs.panel.SetTitle(title)
+		gs.table.AddRowWithColor(theme.FgDim(), "(press / to search all namespaces by workflow ID or query)", "", "", "", "")
+		return
+	case gs.loading:
+		gs.panel.SetTitle(fmt.Sprintf("%s: %s", title, truncateStr(gs.input, 50)))
+		return
+	}
+
+	summary := fmt.Sprintf("%d in %d namespaces", len(gs.results), gs.searched)
+	if len(gs.failed) > 0 {
+		summary += fmt.Sprintf(", failed: %s", strings.Join(gs.failed, ", "))
+	}
+	gs.panel.SetTitle(fmt.Sprintf("%s: %s (%s)", title, truncateStr(gs.input, 50), summary))
+
+	if len(gs.results) == 0 {
+		gs.table.AddRowWithColor(theme.FgDim(), "(no matching workflows)", "", "", "", "")
+		return
+	}
+	for _, wf := range gs.results {
+		gs.table.AddRow(wf.Namespace, wf.ID, wf.Type, wf.Status, formatTimestamp(wf.StartTime))
+		gs.table.GetCell(gs.table.GetRowCount()-1, 3).SetTextColor(theme.StatusColor(wf.Status))
+	}
+	if currentRow < 0 || currentRow >= len(gs.results) {
+		currentRow = 0
+	}
+	gs.table.SelectRow(currentRow)
+}
+
+func (gs *GlobalSearchView) showSearchInput() {
+	gs.app.ShowFilterMode(gs.input, FilterModeCallbacks{
+		Placeholder: "Workflow ID or visibility query, searched in all namespaces...",
+		OnSubmit: func(text string) {
+			gs.input = strings.TrimSpace(text)
+			gs.search()
+		},
+	})
+}
+
+// RefreshTheme updates colors after a theme change.
+func (gs *GlobalSearchView) RefreshTheme() {
+	bg := theme.Bg()
+	gs.SetBackgroundColor(bg)
+	gs.table.SetBackgroundColor(bg)
+	gs.render()
+}
+
+// Name returns the view name.
+func (gs *GlobalSearchView) Name() string {
+	return "global-search"
+}
+
+// Start is called when the view becomes active.
+func (gs *GlobalSearchView) Start() {
+	gs.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case '/':
+			gs.showSearchInput()
+			return nil
+		case 'r':
+			gs.search()
+			return nil
+		}
+		return event
+	})
+	if gs.results == nil && !gs.loading {
+		if gs.input == "" {
+			gs.showSearchInput()
+		} else {
+			gs.search()
+		}
+	}
+}
+
+// Stop is called when the view is deactivated.
+func (gs *GlobalSearchView) Stop() {
+	gs.table.SetInputCapture(nil)
+}
+
+// Hints returns keybinding hints for this view.
+func (gs *GlobalSearchView) Hints() []KeyHint {
+	return []KeyHint{
+		{Key: "enter", Description: "Open"},
+		{Key: "/", Description: "Search"},
+		{Key: "r", Description: "Rerun"},
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "esc", Description: "Back"},
+	}
+}
+
+// Focus sets focus to the results table.
+func (gs *GlobalSearchView) Focus(delegate func(p tview.Primitive)) {
+	delegate(gs.table)
+}
+
+// Draw applies theme colors dynamically and draws the view.
+func (gs *GlobalSearchView) Draw(screen tcell.Screen) {
+	gs.SetBackgroundColor(theme.Bg())
+	gs.Flex.Draw(screen)
+}
@@ -0,0 +1,498 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// GraphNode is a selectable node in the event graph: a history event, or a link
+// to a related workflow execution.
+type GraphNode struct {
+	Event      *temporal.EnhancedHistoryEvent // Nil for workflow links
+	Label      string
+	Status     string
+	WorkflowID string // Set for links to parent/child workflows
+	RunID      string
+}
+
+// GraphBranch is a row of the event graph: a chain of events connected by their
+// scheduled/started/completed references, hanging off the workflow trunk.
+type GraphBranch struct {
+	Name      string
+	Status    string
+	Nodes     []GraphNode
+	Trunk     bool // Workflow-level events drawn on the trunk itself
+	Collapsed bool
+}
+
+// GraphView renders a workflow history as a DAG: the workflow execution is the
+// trunk, each activity, timer, child workflow and workflow task is a branch, and
+// child and parent workflows are linked as external nodes.
+type GraphView struct {
+	*tview.Box
+	branches          []*GraphBranch
+	selectedBranch    int
+	selectedNode      int
+	scrollX           int
+	scrollY           int
+	collapsed         map[int64]bool // Branch collapse state by first event ID
+	onSelectionChange func(node *GraphNode)
+	onOpen            func(node *GraphNode)
+}
+
+// NewGraphView creates a new event graph view.
+func NewGraphView() *GraphView {
+	gv := &GraphView{
+		Box:       tview.NewBox(),
+		collapsed: make(map[int64]bool),
+	}
+	gv.SetBackgroundColor(tcell.ColorDefault)
+	gv.SetBorder(false)
+	return gv
+}
+
+// SetNodes builds the graph from event tree nodes. parentID and parentRunID link
+// the graph to the parent workflow, when there is one.
+func (gv *GraphView) SetNodes(nodes []*temporal.EventTreeNode, parentID, parentRunID string) {
+	gv.branches = nil
+	for _, node := range nodes {
+		if len(node.Events) == 0 {
+			continue
+		}
+		branch := &GraphBranch{
+			Name:   node.Name,
+			Status: node.Status,
+			Trunk:  node.Type == temporal.GroupWorkflow,
+		}
+		for i, ev := range node.Events {
+			// Steps followed by another event are done; the last carries the branch status
+			status := node.Status
+			if i < len(node.Events)-1 {
+				status = "Completed"
+			}
+			branch.Nodes = append(branch.Nodes, GraphNode{
+				Event:  ev,
+				Label:  fmt.Sprintf("%s %d", graphEventLabel(ev.Type), ev.ID),
+				Status: status,
+			})
+		}
+
+		if node.Type == temporal.GroupChildWorkflow {
+			if ev := node.Events[0]; ev.ChildWorkflowID != "" {
+				var runID string
+				for _, e := range node.Events {
+					if e.ChildRunID != "" {
+						runID = e.ChildRunID
+					}
+				}
+				branch.Nodes = append(branch.Nodes, GraphNode{
+					Label:      "child " + ev.ChildWorkflowID,
+					Status:     node.Status,
+					WorkflowID: ev.ChildWorkflowID,
+					RunID:      runID,
+				})
+			}
+		}
+
+		if branch.Trunk && node.Events[0].Type == "WorkflowExecutionStarted" && parentID != "" {
+			branch.Nodes = append([]GraphNode{{
+				Label:      "parent " + parentID,
+				WorkflowID: parentID,
+				RunID:      parentRunID,
+			}}, branch.Nodes...)
+		}
+
+		// Workflow tasks start collapsed; toggled branches keep their state across refreshes
+		if collapsed, ok := gv.collapsed[node.Events[0].ID]; ok {
+			branch.Collapsed = collapsed
+		} else {
+			branch.Collapsed = node.Type == temporal.GroupWorkflowTask
+		}
+		gv.branches = append(gv.branches, branch)
+	}
+
+	if gv.selectedBranch >= len(gv.branches) {
+		gv.selectedBranch = 0
+		gv.selectedNode = 0
+	}
+	gv.clampNode()
+}
+
+// graphEventLabel shortens an event type to the step it represents in its branch.
+func graphEventLabel(eventType string) string {
+	for _, prefix := range []string{"StartChildWorkflowExecution", "ChildWorkflowExecution", "WorkflowExecution", "WorkflowTask", "ActivityTask", "Timer"} {
+		if strings.HasPrefix(eventType, prefix) && len(eventType) > len(prefix) {
+			return eventType[len(prefix):]
+		}
+	}
+	return eventType
+}
+
+// visibleNodes returns the indexes of a branch's nodes that are drawn: collapsed
+// branches show only their first and last node.
+func (b *GraphBranch) visibleNodes() []int {
+	if !b.Collapsed || len(b.Nodes) <= 2 {
+		idx := make([]int, len(b.Nodes))
+		for i := range idx {
+			idx[i] = i
+		}
+		return idx
+	}
+	return []int{0, len(b.Nodes) - 1}
+}
+
+// graphSegment is a run of text in a graph row; node is the index of the branch
+// node it draws, or -1 for names and edges.
+type graphSegment struct {
+	text  string
+	style tcell.Style
+	node  int
+}
+
+// rowSegments lays out a branch as trunk connector, name and chain of nodes.
+func (gv *GraphView) rowSegments(row int) []graphSegment {
+	branch := gv.branches[row]
+	edgeStyle := tcell.StyleDefault.Foreground(theme.Border()).Background(theme.Bg())
+	edge := func(text string) graphSegment { return graphSegment{text: text, style: edgeStyle, node: -1} }
+
+	last := row == len(gv.branches)-1
+	var segments []graphSegment
+	switch {
+	case branch.Trunk && last:
+		segments = append(segments, edge("└─● "))
+	case branch.Trunk:
+		segments = append(segments, edge("├─● "))
+	case last:
+		segments = append(segments, edge("└── "))
+	default:
+		segments = append(segments, edge("├── "))
+	}
+	segments = append(segments,
+		graphSegment{text: branch.Name, style: tcell.StyleDefault.Foreground(theme.StatusColor(branch.Status)).Background(theme.Bg()).Bold(true), node: -1},
+		edge(" "),
+	)
+
+	visible := branch.visibleNodes()
+	for i, idx := range visible {
+		node := branch.Nodes[idx]
+		switch {
+		case i == 0:
+			segments = append(segments, edge("─▶ "))
+		case idx-visible[i-1] > 1:
+			segments = append(segments, edge(fmt.Sprintf(" ─⋯%d⋯▶ ", idx-visible[i-1]-1)))
+		case node.WorkflowID != "" || branch.Nodes[idx-1].WorkflowID != "":
+			segments = append(segments, edge(" ═▶ "))
+		default:
+			segments = append(segments, edge(" ─▶ "))
+		}
+
+		style := tcell.StyleDefault.Foreground(theme.StatusColor(node.Status)).Background(theme.Bg())
+		label := "[" + statusLabel(node.Status, node.Label) + "]"
+		if node.WorkflowID != "" {
+			label = "◆ " + statusLabel(node.Status, node.Label)
+			style = style.Underline(true)
+		}
+		if row == gv.selectedBranch && idx == gv.selectedNode {
+			style = tcell.StyleDefault.Foreground(theme.SelectionFg()).Background(theme.SelectionBg()).Bold(true)
+		}
+		segments = append(segments, graphSegment{text: label, style: style, node: idx})
+	}
+	return segments
+}
+
+// Draw renders the graph.
+func (gv *GraphView) Draw(screen tcell.Screen) {
+	gv.SetBackgroundColor(theme.Bg())
+	gv.Box.DrawForSubclass(screen, gv)
+
+	x, y, width, height := gv.GetInnerRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+	if len(gv.branches) == 0 {
+		tview.Print(screen, "No events", x, y, width, tview.AlignLeft, theme.FgDim())
+		return
+	}
+
+	gv.ensureVisible(width, height)
+
+	for row := gv.scrollY; row < len(gv.branches) && row-gv.scrollY < height; row++ {
+		lineY := y + row - gv.scrollY
+		col := 0
+		for _, seg := range gv.rowSegments(row) {
+			for _, r := range seg.text {
+				if sx := col - gv.scrollX; sx >= 0 && sx < width {
+					screen.SetContent(x+sx, lineY, r, nil, seg.style)
+				}
+				col++
+			}
+		}
+	}
+}
+
+// nodeColumns returns the column span of the selected node in its row.
+func (gv *GraphView) nodeColumns() (start, end int) {
+	col := 0
+	for _, seg := range gv.rowSegments(gv.selectedBranch) {
+		n := len([]rune(seg.text))
+		if seg.node == gv.selectedNode {
+			return col, col + n
+		}
+		col += n
+	}
+	return 0, 0
+}
+
+// ensureVisible scrolls so the selected node is on screen.
+func (gv *GraphView) ensureVisible(width, height int) {
+	if gv.selectedBranch < gv.scrollY {
+		gv.scrollY = gv.selectedBranch
+	}
+	if gv.selectedBranch >= gv.scrollY+height {
+		gv.scrollY = gv.selectedBranch - height + 1
+	}
+
+	start, end := gv.nodeColumns()
+	if end-gv.scrollX > width {
+		gv.scrollX = end - width
+	}
+	if start < gv.scrollX {
+		gv.scrollX = start
+	}
+	if gv.selectedNode == 0 {
+		gv.scrollX = 0
+	}
+}
+
+// InputHandler handles keyboard input.
+func (gv *GraphView) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return gv.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		switch event.Key() {
+		case tcell.KeyUp:
+			gv.moveBranch(-1)
+		case tcell.KeyDown:
+			gv.moveBranch(1)
+		case tcell.KeyLeft:
+			gv.moveNode(-1)
+		case tcell.KeyRight:
+			gv.moveNode(1)
+		case tcell.KeyEnter:
+			gv.activate()
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'k':
+				gv.moveBranch(-1)
+			case 'j':
+				gv.moveBranch(1)
+			case 'h':
+				gv.moveNode(-1)
+			case 'l':
+				gv.moveNode(1)
+			case ' ':
+				gv.toggleBranch()
+			case 'g':
+				gv.selectBranch(0)
+			case 'G':
+				gv.selectBranch(len(gv.branches) - 1)
+			}
+		}
+	})
+}
+
+// MouseHandler selects nodes on click, opens links or folds branches on double
+// click, and scrolls with the wheel.
+func (gv *GraphView) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+	return gv.WrapMouseHandler(func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+		mx, my := event.Position()
+		if !gv.InRect(mx, my) {
+			return false, nil
+		}
+		x, y, _, _ := gv.GetInnerRect()
+
+		switch action {
+		case tview.MouseLeftClick, tview.MouseLeftDoubleClick:
+			setFocus(gv)
+			row := my - y + gv.scrollY
+			if row < 0 || row >= len(gv.branches) {
+				return true, nil
+			}
+			gv.selectBranch(row)
+
+			// Select the node under the cursor, if any
+			col := 0
+			for _, seg := range gv.rowSegments(row) {
+				n := len([]rune(seg.text))
+				if c := mx - x + gv.scrollX; seg.node >= 0 && c >= col && c < col+n {
+					if seg.node != gv.selectedNode {
+						gv.selectedNode = seg.node
+						gv.notify()
+					}
+					break
+				}
+				col += n
+			}
+
+			if action == tview.MouseLeftDoubleClick {
+				gv.activate()
+			}
+			return true, nil
+		case tview.MouseScrollUp:
+			gv.moveBranch(-1)
+			return true, nil
+		case tview.MouseScrollDown:
+			gv.moveBranch(1)
+			return true, nil
+		case tview.MouseScrollLeft:
+			gv.moveNode(-1)
+			return true, nil
+		case tview.MouseScrollRight:
+			gv.moveNode(1)
+			return true, nil
+		}
+		return false, nil
+	})
+}
+
+// activate opens the selected workflow link, or toggles the branch otherwise.
+func (gv *GraphView) activate() {
+	node := gv.SelectedNode()
+	if node != nil && node.WorkflowID != "" {
+		if gv.onOpen != nil {
+			gv.onOpen(node)
+		}
+		return
+	}
+	gv.toggleBranch()
+}
+
+// toggleBranch collapses or expands the selected branch.
+func (gv *GraphView) toggleBranch() {
+	if gv.selectedBranch >= len(gv.branches) {
+		return
+	}
+	branch := gv.branches[gv.selectedBranch]
+	branch.Collapsed = !branch.Collapsed
+	gv.rememberCollapsed(branch)
+	gv.clampNode()
+	gv.notify()
+}
+
+// SetAllCollapsed collapses or expands every branch.
+func (gv *GraphView) SetAllCollapsed(collapsed bool) {
+	for _, b := range gv.branches {
+		b.Collapsed = collapsed
+		gv.rememberCollapsed(b)
+	}
+	gv.clampNode()
+	gv.notify()
+}
+
+// rememberCollapsed records a branch's collapse state so it survives refreshes.
+func (gv *GraphView) rememberCollapsed(b *GraphBranch) {
+	for _, n := range b.Nodes {
+		if n.Event != nil {
+			gv.collapsed[n.Event.ID] = b.Collapsed
+			return
+		}
+	}
+}
+
+func (gv *GraphView) moveBranch(delta int) {
+	gv.selectBranch(gv.selectedBranch + delta)
+}
+
+func (gv *GraphView) selectBranch(index int) {
+	if len(gv.branches) == 0 {
+		return
+	}
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(gv.branches) {
+		index = len(gv.branches) - 1
+	}
+	if index == gv.selectedBranch {
+		return
+	}
+	gv.selectedBranch = index
+	gv.clampNode()
+	gv.notify()
+}
+
+// moveNode moves the selection along the visible nodes of the current branch.
+func (gv *GraphView) moveNode(delta int) {
+	if gv.selectedBranch >= len(gv.branches) {
+		return
+	}
+	visible := gv.branches[gv.selectedBranch].visibleNodes()
+	for i, idx := range visible {
+		if idx != gv.selectedNode {
+			continue
+		}
+		if next := i + delta; next >= 0 && next < len(visible) {
+			gv.selectedNode = visible[next]
+			gv.notify()
+		}
+		return
+	}
+}
+
+// clampNode keeps the selected node on a visible node of the selected branch.
+func (gv *GraphView) clampNode() {
+	if gv.selectedBranch >= len(gv.branches) {
+		gv.selectedNode = 0
+		return
+	}
+	visible := gv.branches[gv.selectedBranch].visibleNodes()
+	if len(visible) == 0 {
+		gv.selectedNode = 0
+		return
+	}
+	for _, idx := range visible {
+		if idx == gv.selectedNode {
+			return
+		}
+	}
+	gv.selectedNode = visible[len(visible)-1]
+	for _, idx := range visible {
+		if idx >= gv.selectedNode {
+			gv.selectedNode = idx
+			break
+		}
+	}
+}
+
+func (gv *GraphView) notify() {
+	if gv.onSelectionChange != nil {
+		if node := gv.SelectedNode(); node != nil {
+			gv.onSelectionChange(node)
+		}
+	}
+}
+
+// SelectedNode returns the selected graph node, or nil if the graph is empty.
+func (gv *GraphView) SelectedNode() *GraphNode {
+	if gv.selectedBranch >= len(gv.branches) {
+		return nil
+	}
+	branch := gv.branches[gv.selectedBranch]
+	if gv.selectedNode >= len(branch.Nodes) {
+		return nil
+	}
+	return &branch.Nodes[gv.selectedNode]
+}
+
+// SetOnSelectionChange sets the callback for when the selected node changes.
+func (gv *GraphView) SetOnSelectionChange(fn func(node *GraphNode)) {
+	gv.onSelectionChange = fn
+}
+
+// SetOnOpen sets the callback for opening a linked parent or child workflow.
+func (gv *GraphView) SetOnOpen(fn func(node *GraphNode)) {
+	gv.onOpen = fn
+}
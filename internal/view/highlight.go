@@ -0,0 +1,141 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/atterpac/jig/theme"
+	"github.com/rivo/tview"
+)
+
+// jsonLexer tokenizes JSON documents.
+var jsonLexer = chroma.Coalesce(lexers.Get("json"))
+
+// stackTraceLexer tokenizes stack traces: Go goroutine dumps as returned by the
+// __stack_trace query, and the Java, JavaScript and Python traces other SDKs
+// put in failures.
+var stackTraceLexer = chroma.Coalesce(chroma.MustNewLexer(
+	&chroma.Config{Name: "Stack trace"},
+	func() chroma.Rules {
+		return chroma.Rules{
+			"root": {
+				{Pattern: `goroutine \d+ \[[^\]\n]*\]:?`, Type: chroma.GenericHeading},
+				{Pattern: `(Caused by|Traceback \(most recent call last\))(:?)`, Type: chroma.ByGroups(chroma.GenericHeading, chroma.Punctuation)},
+				{Pattern: `(created by )(\S+)`, Type: chroma.ByGroups(chroma.Keyword, chroma.NameFunction)},
+				{Pattern: `^(\S[^\n]*)(\([^()\n]*\))$`, Type: chroma.ByGroups(chroma.NameFunction, chroma.Comment)},       // Go frame
+				{Pattern: `(\s+)(at )([^\s(]+)`, Type: chroma.ByGroups(chroma.Text, chroma.Keyword, chroma.NameFunction)}, // Java and JavaScript frame
+				{Pattern: `(File )("[^"\n]*")(, line )(\d+)`, Type: chroma.ByGroups(chroma.Keyword, chroma.NameNamespace, chroma.Text, chroma.LiteralNumberInteger)},
+				{Pattern: `([\w./\\@<>-]+\.[A-Za-z]+)(:)(\d+)`, Type: chroma.ByGroups(chroma.NameNamespace, chroma.Punctuation, chroma.LiteralNumberInteger)},
+				{Pattern: `\+0x[0-9a-fA-F]+`, Type: chroma.Comment},
+				{Pattern: `\s+`, Type: chroma.Text},
+				{Pattern: `\w+`, Type: chroma.Text},
+				{Pattern: `.`, Type: chroma.Text},
+			},
+		}
+	},
+))
+
+// protoTextLexer tokenizes protobuf text format, e.g. name:"x" spec { id: 3 }.
+var protoTextLexer = chroma.Coalesce(chroma.MustNewLexer(
+	&chroma.Config{Name: "Protobuf text"},
+	func() chroma.Rules {
+		return chroma.Rules{
+			"root": {
+				{Pattern: `#[^\n]*`, Type: chroma.CommentSingle},
+				{Pattern: `([A-Za-z_][\w.]*)(\s*)(:|\{|<)`, Type: chroma.ByGroups(chroma.NameAttribute, chroma.Text, chroma.Punctuation)},
+				{Pattern: `(\[)([\w./]+)(\])`, Type: chroma.ByGroups(chroma.Punctuation, chroma.NameAttribute, chroma.Punctuation)},
+				{Pattern: `"(\\\\|\\"|[^"\n])*"`, Type: chroma.LiteralStringDouble},
+				{Pattern: `'(\\\\|\\'|[^'\n])*'`, Type: chroma.LiteralStringSingle},
+				{Pattern: `(true|false)\b`, Type: chroma.KeywordConstant},
+				{Pattern: `-?0[xX][0-9a-fA-F]+\b`, Type: chroma.LiteralNumberHex},
+				{Pattern: `-?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?[fFuU]?\b`, Type: chroma.LiteralNumber},
+				{Pattern: `(?i)-?(inf|infinity|nan)\b`, Type: chroma.LiteralNumberFloat},
+				{Pattern: `[A-Za-z_]\w*`, Type: chroma.NameConstant}, // Enum value
+				{Pattern: `[{}<>\[\],;:]`, Type: chroma.Punctuation},
+				{Pattern: `\s+`, Type: chroma.Text},
+				{Pattern: `.`, Type: chroma.Text},
+			},
+		}
+	},
+))
+
+var (
+	// stackTracePattern matches a line of a Go, Java, JavaScript or Python
+	// stack trace.
+	stackTracePattern = regexp.MustCompile(`(?m)^(goroutine \d+ \[|\s+\S+\.go:\d+|\s+at \S+.*:\d+|\s*File ".*", line \d+)`)
+	// protoTextPattern matches text that starts with a protobuf text format
+	// field.
+	protoTextPattern = regexp.MustCompile(`^\s*([A-Za-z_][\w.]*\s*(:\s*["'\w\-\[{<]|\{)|\[[\w./]+\]\s*:)`)
+)
+
+// highlightDetail highlights s as JSON, a stack trace or protobuf text,
+// whichever it looks like, or escapes it as plain text.
+func highlightDetail(s string) string {
+	trimmed := strings.TrimSpace(s)
+	switch {
+	case trimmed != "" && json.Valid([]byte(trimmed)):
+		return highlightCode(jsonLexer, s)
+	case stackTracePattern.MatchString(s):
+		return highlightCode(stackTraceLexer, s)
+	case protoTextPattern.MatchString(s):
+		return highlightCode(protoTextLexer, s)
+	}
+	return tview.Escape(s)
+}
+
+// highlightCode tokenizes s with lexer and renders it with tview color tags in
+// the theme's syntax colors.
+func highlightCode(lexer chroma.Lexer, s string) string {
+	tokens, err := chroma.Tokenise(lexer, nil, s)
+	if err != nil {
+		return tview.Escape(s)
+	}
+
+	var b strings.Builder
+	// Untagged tokens are escaped together, since brackets split across
+	// tokens could otherwise form a color tag.
+	var plain strings.Builder
+	for _, token := range tokens {
+		tag := syntaxTag(token.Type, token.Value)
+		if tag == "" || strings.TrimSpace(token.Value) == "" {
+			plain.WriteString(token.Value)
+			continue
+		}
+		b.WriteString(tview.Escape(plain.String()))
+		plain.Reset()
+		b.WriteString(fmt.Sprintf("[%s]%s[-:-:-]", tag, tview.Escape(token.Value)))
+	}
+	b.WriteString(tview.Escape(plain.String()))
+	return b.String()
+}
+
+// syntaxTag returns the tview style of a token, or "" for the text color.
+func syntaxTag(t chroma.TokenType, value string) string {
+	switch {
+	case t == chroma.GenericHeading:
+		return theme.TagAccent() + "::b"
+	case t == chroma.NameTag || t == chroma.NameAttribute:
+		return theme.TagAccent()
+	case t == chroma.KeywordConstant:
+		switch value {
+		case "true":
+			return theme.StatusColorTag("Completed")
+		case "false":
+			return theme.StatusColorTag("Failed")
+		}
+		return theme.TagFgDim() // null
+	case t.InCategory(chroma.Keyword):
+		return theme.TagAccent()
+	case t.InCategory(chroma.Comment), t == chroma.NameNamespace:
+		return theme.TagFgDim()
+	case t.InSubCategory(chroma.LiteralNumber), t == chroma.NameConstant:
+		return theme.TagInfo()
+	case t.InSubCategory(chroma.LiteralString), t == chroma.NameFunction:
+		return theme.TagFg()
+	}
+	return ""
+}
@@ -0,0 +1,279 @@
+package view
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/nav"
+	"github.com/atterpac/jig/theme"
+	"github.com/gdamore/tcell/v2"
+)
+
+// maxHistory is the number of locations remembered per tab.
+const maxHistory = 50
+
+// route identifies a location by its kind of view and what that view shows.
+type route struct {
+	kind      string // View name, e.g. "workflow-detail"
+	namespace string
+	id        string // Workflow, task queue, namespace or search the view shows
+	runID     string
+}
+
+// historyEntry is a location visited in a tab. Entries hold no views: going
+// forward or jumping to one opens a fresh view of the route.
+type historyEntry struct {
+	route route
+	label string
+	open  func() nav.Component
+}
+
+// historyEntryOf describes the location a view shows. It reports false for views
+// that can't be opened again from a route.
+func (a *App) historyEntryOf(c nav.Component) (historyEntry, bool) {
+	r := route{namespace: a.currentNS}
+	var open func() nav.Component
+	switch v := c.(type) {
+	case *NamespaceList:
+		r.kind, r.namespace = "namespaces", ""
+		open = func() nav.Component {
+			a.namespaceList = NewNamespaceList(a)
+			return a.namespaceList
+		}
+	case *DashboardView:
+		r.kind, r.namespace = "dashboard", ""
+		open = func() nav.Component { return NewDashboardView(a) }
+	case *NexusEndpointsView:
+		r.kind, r.namespace = "nexus-endpoints", ""
+		open = func() nav.Component { return NewNexusEndpointsView(a) }
+	case *GlobalSearchView:
+		r.kind, r.namespace, r.id = "global-search", "", v.input
+		open = func() nav.Component { return NewGlobalSearchView(a, r.id) }
+	case *WorkflowList:
+		r.kind, r.namespace, r.id = "workflows", v.namespace, v.visibilityQuery
+		open = func() nav.Component {
+			if r.id == "" {
+				return NewWorkflowList(a, r.namespace)
+			}
+			return NewWorkflowListWithQuery(a, r.namespace, r.id)
+		}
+	case *WorkflowDetail:
+		r.kind, r.id, r.runID = "workflow-detail", v.workflowID, v.runID
+		open = func() nav.Component { return NewWorkflowDetail(a, r.id, r.runID) }
+	case *EventHistory:
+		r.kind, r.id, r.runID = "events", v.workflowID, v.runID
+		open = func() nav.Component { return NewEventHistory(a, r.id, r.runID) }
+	case *TaskQueueView:
+		r.kind = "task-queues"
+		open = func() nav.Component { return NewTaskQueueView(a) }
+	case *TaskQueueDetail:
+		r.kind, r.id = "task-queue-detail", v.taskQueue
+		open = func() nav.Component { return NewTaskQueueDetail(a, r.id) }
+	case *VersioningRulesView:
+		r.kind, r.id = "versioning-rules", v.taskQueue
+		open = func() nav.Component { return NewVersioningRulesView(a, r.id) }
+	case *WorkerDeploymentsView:
+		r.kind = "worker-deployments"
+		open = func() nav.Component { return NewWorkerDeploymentsView(a) }
+	case *ScheduleList:
+		r.kind, r.namespace = "schedules", v.namespace
+		open = func() nav.Component { return NewScheduleList(a, r.namespace) }
+	case *NamespaceDetail:
+		r.kind, r.id = "namespace-detail", v.namespace
+		open = func() nav.Component { return NewNamespaceDetail(a, r.id) }
+	case *WorkflowDiff:
+		r.kind, r.namespace = "workflow-diff", v.namespace
+		workflowA, workflowB := v.workflowA, v.workflowB
+		if workflowA != nil && workflowB != nil {
+			r.id = workflowA.ID + "/" + workflowA.RunID
+			r.runID = workflowB.ID + "/" + workflowB.RunID
+		}
+		open = func() nav.Component {
+			if workflowA == nil || workflowB == nil {
+				return NewWorkflowDiff(a, r.namespace)
+			}
+			return NewWorkflowDiffWithWorkflows(a, r.namespace, workflowA, workflowB)
+		}
+	default:
+		return historyEntry{}, false
+	}
+	return historyEntry{route: r, label: a.historyLabel(c), open: open}, true
+}
+
+// navigate pushes a view as a new location, which drops the forward history
+// like following a link in a browser does.
+func (a *App) navigate(c nav.Component) {
+	a.tabs[a.activeTab].forward = nil
+	a.app.Pages().Push(c)
+	a.recordHistory(c)
+}
+
+// recordHistory adds a view's location to the active tab's history, moving a
+// revisited location to the end.
+func (a *App) recordHistory(c nav.Component) {
+	e, ok := a.historyEntryOf(c)
+	if !ok {
+		return
+	}
+	t := a.tabs[a.activeTab]
+	t.history = slices.DeleteFunc(t.history, func(h historyEntry) bool { return h.route == e.route })
+	t.history = append(t.history, e)
+	if len(t.history) > maxHistory {
+		t.history = t.history[len(t.history)-maxHistory:]
+	}
+}
+
+// historyLabel describes a view by its breadcrumbs and, for views of a single
+// workflow or task queue, its ID.
+func (a *App) historyLabel(c nav.Component) string {
+	label := strings.Join(a.crumbPath(c), " > ")
+	switch v := c.(type) {
+	case *WorkflowDetail:
+		label += ": " + v.workflowID
+	case *EventHistory:
+		label += ": " + v.workflowID
+	case *TaskQueueDetail:
+		label += ": " + v.taskQueue
+	case *VersioningRulesView:
+		label += ": " + v.taskQueue
+	case *WorkflowList:
+		if q := v.visibilityQuery; q != "" {
+			label += ": " + q
+		}
+	}
+	return label
+}
+
+// GoBack pops the current view, keeping its location as the next forward one.
+func (a *App) GoBack() bool {
+	if a.stackDepth() <= 1 {
+		return false
+	}
+	t := a.tabs[a.activeTab]
+	if e, ok := a.historyEntryOf(a.app.Pages().Current()); ok {
+		t.forward = append(t.forward, e)
+		if len(t.forward) > maxHistory {
+			t.forward = t.forward[len(t.forward)-maxHistory:]
+		}
+	}
+	a.popView()
+	if current := a.app.Pages().Current(); current != nil {
+		a.app.SetFocus(current)
+	}
+	return true
+}
+
+// goBackTo goes back until the view at stack index level is on top.
+func (a *App) goBackTo(level int) {
+	for a.stackDepth() > level+1 {
+		a.GoBack()
+	}
+}
+
+// GoForward reopens the location most recently left with GoBack.
+func (a *App) GoForward() {
+	t := a.tabs[a.activeTab]
+	if len(t.forward) == 0 {
+		a.toasts.Info("No forward history")
+		return
+	}
+	e := t.forward[len(t.forward)-1]
+	t.forward = t.forward[:len(t.forward)-1]
+	a.reopen(e)
+}
+
+// reopen pushes a fresh view of a previously visited location.
+func (a *App) reopen(e historyEntry) {
+	if e.route.namespace != "" && e.route.namespace != a.currentNS {
+		a.SetNamespace(e.route.namespace)
+	}
+	view := e.open()
+	a.app.Pages().Push(view)
+	a.recordHistory(view)
+	a.app.SetFocus(view)
+}
+
+// stackIndex returns the stack index of the view showing a route, or -1.
+func (a *App) stackIndex(r route) int {
+	return slices.IndexFunc(a.viewStack(), func(c nav.Component) bool {
+		e, ok := a.historyEntryOf(c)
+		return ok && e.route == r
+	})
+}
+
+// jumpTo goes to a history entry: locations still on the stack are reached by
+// going back to them, others are reopened on top of the current view.
+func (a *App) jumpTo(e historyEntry) {
+	if i := a.stackIndex(e.route); i >= 0 {
+		a.goBackTo(i)
+		return
+	}
+	t := a.tabs[a.activeTab]
+	t.forward = slices.DeleteFunc(t.forward, func(f historyEntry) bool { return f.route == e.route })
+	a.reopen(e)
+}
+
+// ShowHistory lists the locations visited in the active tab, newest first, and
+// jumps to the selected one.
+func (a *App) ShowHistory() {
+	t := a.tabs[a.activeTab]
+	entries := slices.Clone(t.history)
+	slices.Reverse(entries)
+	current, _ := a.historyEntryOf(a.app.Pages().Current())
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:     fmt.Sprintf("%s History", theme.IconInfo),
+		Width:     90,
+		Height:    22,
+		MinHeight: 10,
+		Backdrop:  true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders("", "LOCATION", "NAMESPACE")
+	table.SetBackgroundColor(theme.Bg())
+	for _, e := range entries {
+		marker := ""
+		if e.route == current.route {
+			marker = "●"
+		}
+		table.AddRow(marker, e.label, e.route.namespace)
+	}
+	if len(entries) > 0 {
+		table.SelectRow(0)
+	}
+
+	closeHistory := func() {
+		a.modals.Close("history-modal")
+	}
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			row := table.SelectedRow()
+			if row < 0 || row >= len(entries) {
+				return nil
+			}
+			closeHistory()
+			if entries[row].route != current.route {
+				a.jumpTo(entries[row])
+			}
+			return nil
+		case tcell.KeyEscape:
+			closeHistory()
+			return nil
+		}
+		return event
+	})
+
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "Enter", Description: "Go"},
+		{Key: "Esc", Description: "Close"},
+	})
+	modal.SetOnCancel(closeHistory)
+
+	a.modals.Show("history-modal", modal, table)
+}
@@ -0,0 +1,154 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// jsonPreviewHeight is the height of the payload preview under an input form.
+const jsonPreviewHeight = 9
+
+// jsonInput checks the JSON payload field of an input modal as it is typed:
+// syntax errors show under the field, and the payload is pretty-printed in a
+// preview under the form. Submitting an invalid payload is held back unless
+// forced with Ctrl+F, for handlers that take payloads other than JSON.
+type jsonInput struct {
+	app     *App
+	field   *components.TextField
+	preview *tview.TextView
+	layout  *tview.Flex
+}
+
+// newJSONInput checks the text field name of form and lays the form out with
+// the preview. submit is called with force set when Ctrl+F is pressed.
+func newJSONInput(app *App, form *components.Form, name string, submit func(force bool)) *jsonInput {
+	j := &jsonInput{
+		app:     app,
+		preview: tview.NewTextView().SetDynamicColors(true).SetWrap(false),
+	}
+	j.preview.SetBackgroundColor(theme.Bg())
+	previewPanel := components.NewPanel().SetTitle(fmt.Sprintf("%s Payload", theme.IconInfo))
+	previewPanel.SetContent(j.preview)
+
+	j.field, _ = form.GetTextField(name)
+	if j.field != nil {
+		j.field.SetValidator(jsonPayloadError)
+		j.field.SetOnChange(func(string) {
+			j.render()
+		})
+	}
+
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyCtrlF {
+			submit(true)
+			return nil
+		}
+		return event
+	})
+
+	j.layout = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(form, 0, 1, true).
+		AddItem(previewPanel, jsonPreviewHeight, 0, false)
+	j.render()
+	return j
+}
+
+// render shows the payload pretty-printed, or where it stops being JSON.
+func (j *jsonInput) render() {
+	if j.field == nil {
+		return
+	}
+	value := strings.TrimSpace(j.field.GetValue())
+	if value == "" {
+		j.preview.SetText(fmt.Sprintf("[%s]No payload[-]", theme.TagFgDim()))
+		return
+	}
+
+	var syntaxErr *json.SyntaxError
+	if err := json.Unmarshal([]byte(value), new(any)); errors.As(err, &syntaxErr) {
+		line, col := jsonErrorPosition(value, syntaxErr)
+		j.preview.SetText(fmt.Sprintf("[%s]%s[-]\n\n%s",
+			theme.TagError(), tview.Escape(jsonPayloadError(value).Error()), jsonErrorContext(value, line, col)))
+		return
+	}
+
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, []byte(value), "", "  "); err != nil {
+		j.preview.SetText(tview.Escape(value))
+		return
+	}
+	j.preview.SetText(highlightCode(jsonLexer, pretty.String()))
+	j.preview.ScrollToBeginning()
+}
+
+// valid reports whether the payload can be sent. An invalid payload is held
+// back with a warning naming the override, unless force is set.
+func (j *jsonInput) valid(force bool) bool {
+	if j.field == nil || force {
+		return true
+	}
+	err := j.field.Validate()
+	if err == nil {
+		return true
+	}
+	j.app.toasts.Warning(fmt.Sprintf("%s. Fix it, or press Ctrl+F to send it anyway", err))
+	return false
+}
+
+// jsonPayloadError returns where s stops being JSON, or nil if it is JSON or
+// empty.
+func jsonPayloadError(s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	err := json.Unmarshal([]byte(s), new(any))
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		line, col := jsonErrorPosition(s, syntaxErr)
+		return fmt.Errorf("invalid JSON at line %d, column %d: %s", line, col, syntaxErr)
+	}
+	return err
+}
+
+// jsonErrorPosition returns the line and column of a syntax error in s, both
+// counted from 1. The decoder reports the offset after the offending
+// character, or the length of s when the input ends early.
+func jsonErrorPosition(s string, err *json.SyntaxError) (line, col int) {
+	end := len(s)
+	if err.Offset > 0 && int(err.Offset) <= len(s) && !strings.HasSuffix(err.Error(), "end of JSON input") {
+		end = int(err.Offset) - 1
+	}
+	before := s[:end]
+	line = strings.Count(before, "\n") + 1
+	col = utf8.RuneCountInString(before[strings.LastIndex(before, "\n")+1:]) + 1
+	return line, col
+}
+
+// jsonErrorContext returns the line of s holding an error at line and col,
+// cut to fit the preview, with a caret under the column.
+func jsonErrorContext(s string, line, col int) string {
+	const width = 60
+
+	lines := strings.Split(s, "\n")
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+	text := []rune(lines[line-1])
+	start := max(0, min(col-1-width/2, len(text)-width))
+	end := min(len(text), start+width)
+	caret := col - 1 - start
+
+	return fmt.Sprintf("[%s]%s[-]\n[%s]%s^[-]",
+		theme.TagFg(), tview.Escape(string(text[start:end])),
+		theme.TagError(), strings.Repeat(" ", max(caret, 0)))
+}
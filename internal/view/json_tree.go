@@ -0,0 +1,821 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/atterpac/jig/theme"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// jsonKind is the type of a JSON value.
+type jsonKind int
+
+const (
+	jsonObject jsonKind = iota
+	jsonArray
+	jsonString
+	jsonNumber
+	jsonBool
+	jsonNull
+)
+
+// jsonNode is a parsed JSON value. Unlike a decoded map it keeps object keys in
+// the order they were written.
+type jsonNode struct {
+	kind      jsonKind
+	key       string // Key in the parent object
+	index     int    // Index in the parent array, or -1
+	raw       string // Scalar value as JSON, e.g. "\"text\"" or 42
+	children  []*jsonNode
+	parent    *jsonNode
+	collapsed bool
+}
+
+// container reports whether the node is an object or array.
+func (n *jsonNode) container() bool {
+	return n.kind == jsonObject || n.kind == jsonArray
+}
+
+// path returns the jq-style path of the node, e.g. .items[2].name.
+func (n *jsonNode) path() string {
+	var parts []string
+	for node := n; node.parent != nil; node = node.parent {
+		switch {
+		case node.parent.kind == jsonArray:
+			parts = append(parts, fmt.Sprintf("[%d]", node.index))
+		case jsonIdentifier.MatchString(node.key):
+			parts = append(parts, "."+node.key)
+		default:
+			parts = append(parts, "["+strconv.Quote(node.key)+"]")
+		}
+	}
+	if len(parts) == 0 {
+		return "."
+	}
+	var b strings.Builder
+	for i := len(parts) - 1; i >= 0; i-- {
+		b.WriteString(parts[i])
+	}
+	path := b.String()
+	if strings.HasPrefix(path, "[") {
+		path = "." + path
+	}
+	return path
+}
+
+// value returns the node as indented JSON.
+func (n *jsonNode) value() string {
+	var b strings.Builder
+	n.write(&b, "")
+	return b.String()
+}
+
+func (n *jsonNode) write(b *strings.Builder, indent string) {
+	if !n.container() {
+		b.WriteString(n.raw)
+		return
+	}
+	open, close := jsonBrackets(n.kind)
+	if len(n.children) == 0 {
+		b.WriteString(open + close)
+		return
+	}
+	b.WriteString(open + "\n")
+	for i, child := range n.children {
+		b.WriteString(indent + "  ")
+		if n.kind == jsonObject {
+			b.WriteString(strconv.Quote(child.key) + ": ")
+		}
+		child.write(b, indent+"  ")
+		if i < len(n.children)-1 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(indent + close)
+}
+
+// setCollapsed folds or unfolds the node and every container below it.
+func (n *jsonNode) setCollapsed(collapsed bool) {
+	if !n.container() {
+		return
+	}
+	n.collapsed = collapsed
+	for _, child := range n.children {
+		child.setCollapsed(collapsed)
+	}
+}
+
+// jsonIdentifier matches object keys written as .key in paths.
+var jsonIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func jsonBrackets(kind jsonKind) (string, string) {
+	if kind == jsonArray {
+		return "[", "]"
+	}
+	return "{", "}"
+}
+
+// parseJSONNodes parses one JSON value, or several separated by whitespace as
+// payload lists are, keeping object keys in order.
+func parseJSONNodes(s string) ([]*jsonNode, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var nodes []*jsonNode
+	for {
+		node, err := decodeJSONNode(dec)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	if len(nodes) == 0 {
+		return nil, errors.New("no JSON value")
+	}
+	return nodes, nil
+}
+
+// decodeJSONNode reads the next value from dec.
+func decodeJSONNode(dec *json.Decoder) (*jsonNode, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	node := &jsonNode{index: -1}
+	switch v := tok.(type) {
+	case json.Delim:
+		node.kind = jsonObject
+		if v == '[' {
+			node.kind = jsonArray
+		}
+		for dec.More() {
+			key := ""
+			if node.kind == jsonObject {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				key, _ = keyTok.(string)
+			}
+			child, err := decodeJSONNode(dec)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			child.key = key
+			if node.kind == jsonArray {
+				child.index = len(node.children)
+			}
+			child.parent = node
+			node.children = append(node.children, child)
+		}
+		if _, err := dec.Token(); err != nil { // Closing bracket
+			return nil, unexpectedEOF(err)
+		}
+	case string:
+		node.kind = jsonString
+		node.raw = quoteJSONString(v)
+	case json.Number:
+		node.kind = jsonNumber
+		node.raw = v.String()
+	case bool:
+		node.kind = jsonBool
+		node.raw = strconv.FormatBool(v)
+	case nil:
+		node.kind = jsonNull
+		node.raw = "null"
+	}
+	return node, nil
+}
+
+// unexpectedEOF turns io.EOF inside a value into io.ErrUnexpectedEOF, so a
+// truncated document isn't mistaken for the end of the stream.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// quoteJSONString encodes s as a JSON string without escaping HTML characters.
+func quoteJSONString(s string) string {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// jsonPathStep is one step of a path filter: an object key, an array index, or
+// every element or value.
+type jsonPathStep struct {
+	key   string
+	index int
+	all   bool
+	isKey bool
+}
+
+// parseJSONPath parses a jq-style path such as .items[].name, .["a key"] or
+// .matrix[0][1]. The identity path "." has no steps.
+func parseJSONPath(expr string) ([]jsonPathStep, error) {
+	expr = strings.TrimSpace(expr)
+	if !strings.HasPrefix(expr, ".") {
+		return nil, fmt.Errorf("path must start with '.'")
+	}
+	var steps []jsonPathStep
+	i := 0
+	for i < len(expr) {
+		switch {
+		case expr[i] == '.':
+			i++
+			if i < len(expr) && expr[i] != '[' && expr[i] != '.' {
+				j := i
+				for j < len(expr) && expr[j] != '.' && expr[j] != '[' {
+					j++
+				}
+				steps = append(steps, jsonPathStep{key: expr[i:j], isKey: true})
+				i = j
+			}
+		case expr[i] == '[':
+			end := strings.IndexByte(expr[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("missing ']' in %q", expr[i:])
+			}
+			inner := strings.TrimSpace(expr[i+1 : i+end])
+			switch {
+			case inner == "":
+				steps = append(steps, jsonPathStep{all: true})
+			case strings.HasPrefix(inner, `"`):
+				key, err := strconv.Unquote(inner)
+				if err != nil {
+					return nil, fmt.Errorf("bad key %s", inner)
+				}
+				steps = append(steps, jsonPathStep{key: key, isKey: true})
+			default:
+				index, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("bad index %q", inner)
+				}
+				steps = append(steps, jsonPathStep{index: index})
+			}
+			i += end + 1
+		default:
+			return nil, fmt.Errorf("unexpected %q at %d", expr[i], i)
+		}
+	}
+	return steps, nil
+}
+
+// applyJSONPath returns the nodes a path selects from roots. Negative indexes
+// count from the end of an array.
+func applyJSONPath(roots []*jsonNode, steps []jsonPathStep) []*jsonNode {
+	nodes := roots
+	for _, step := range steps {
+		var next []*jsonNode
+		for _, node := range nodes {
+			switch {
+			case step.all:
+				next = append(next, node.children...)
+			case step.isKey && node.kind == jsonObject:
+				for _, child := range node.children {
+					if child.key == step.key {
+						next = append(next, child)
+					}
+				}
+			case !step.isKey && node.kind == jsonArray:
+				index := step.index
+				if index < 0 {
+					index += len(node.children)
+				}
+				if index >= 0 && index < len(node.children) {
+					next = append(next, node.children[index])
+				}
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// jsonLine is one line of a rendered JSON tree.
+type jsonLine struct {
+	node    *jsonNode
+	depth   int
+	closing bool // Closing bracket of an expanded container
+	last    bool // Last element of its parent, so no trailing comma
+	label   string
+}
+
+// renderJSONLines lays out the visible lines of nodes. Each root is labelled
+// with its path when showPaths is set, as filter results are.
+func renderJSONLines(nodes []*jsonNode, showPaths bool) []jsonLine {
+	var lines []jsonLine
+	var walk func(n *jsonNode, depth int, last bool, label string)
+	walk = func(n *jsonNode, depth int, last bool, label string) {
+		lines = append(lines, jsonLine{node: n, depth: depth, last: last, label: label})
+		if !n.container() || n.collapsed || len(n.children) == 0 {
+			return
+		}
+		for i, child := range n.children {
+			childLabel := ""
+			if n.kind == jsonObject {
+				childLabel = strconv.Quote(child.key)
+			}
+			walk(child, depth+1, i == len(n.children)-1, childLabel)
+		}
+		lines = append(lines, jsonLine{node: n, depth: depth, closing: true, last: last})
+	}
+	for _, n := range nodes {
+		label := ""
+		if showPaths {
+			label = n.path()
+		}
+		walk(n, 0, true, label)
+	}
+	return lines
+}
+
+// format renders the line with color tags, with fold markers when the lines
+// are shown in a tree.
+func (l jsonLine) format(markers bool) string {
+	var b strings.Builder
+	b.WriteString(strings.Repeat("  ", l.depth))
+	n := l.node
+	open, close := jsonBrackets(n.kind)
+
+	if l.closing {
+		if markers {
+			b.WriteString("  ")
+		}
+		b.WriteString(close)
+	} else {
+		switch {
+		case !markers:
+		case !n.container():
+			b.WriteString("  ")
+		case n.collapsed:
+			b.WriteString(fmt.Sprintf("[%s]▸[-] ", theme.TagAccent()))
+		default:
+			b.WriteString(fmt.Sprintf("[%s]▾[-] ", theme.TagFgDim()))
+		}
+		if l.label != "" {
+			b.WriteString(fmt.Sprintf("[%s]%s[-]: ", theme.TagAccent(), tview.Escape(l.label)))
+		}
+		switch {
+		case !n.container():
+			b.WriteString(jsonScalarTag(n))
+		case len(n.children) == 0:
+			b.WriteString(open + close)
+		case n.collapsed:
+			b.WriteString(fmt.Sprintf("%s…%s [%s]%s[-]", open, close, theme.TagFgDim(), jsonSize(n)))
+		default:
+			b.WriteString(open)
+		}
+	}
+	if !l.last && (l.closing || !n.container() || n.collapsed || len(n.children) == 0) {
+		b.WriteString(",")
+	}
+	return b.String()
+}
+
+// jsonScalarTag colors a scalar value like highlightCode colors JSON tokens.
+func jsonScalarTag(n *jsonNode) string {
+	token := chroma.LiteralStringDouble
+	switch n.kind {
+	case jsonNumber:
+		token = chroma.LiteralNumber
+	case jsonBool, jsonNull:
+		token = chroma.KeywordConstant
+	}
+	return fmt.Sprintf("[%s]%s[-:-:-]", syntaxTag(token, n.raw), tview.Escape(n.raw))
+}
+
+// jsonSize describes how many children a folded container has.
+func jsonSize(n *jsonNode) string {
+	noun := "keys"
+	if n.kind == jsonArray {
+		noun = "items"
+	}
+	if len(n.children) == 1 {
+		noun = strings.TrimSuffix(noun, "s")
+	}
+	return fmt.Sprintf("%d %s", len(n.children), noun)
+}
+
+// highlightJSONText renders s as indented, highlighted JSON, keeping its key
+// order, or escapes it as plain text when it isn't JSON.
+func highlightJSONText(s string) string {
+	nodes, err := parseJSONNodes(s)
+	if err != nil {
+		return tview.Escape(s)
+	}
+	lines := renderJSONLines(nodes, false)
+	text := make([]string, len(lines))
+	for i, line := range lines {
+		text[i] = line.format(false)
+	}
+	return strings.Join(text, "\n")
+}
+
+// JSONTree shows a JSON document as a tree whose objects and arrays fold, with
+// the path of the selected node and a jq-style path filter below it. Content
+// that isn't JSON is shown as plain text.
+type JSONTree struct {
+	*tview.Flex
+	body     *jsonTreeBody
+	status   *tview.TextView
+	filter   *tview.InputField
+	setFocus func(tview.Primitive)
+
+	roots []*jsonNode // Parsed document, nil when the content isn't JSON
+	shown []*jsonNode // Roots in view: the document or the filter results
+	text  string      // Content that isn't JSON
+	query string      // Active path filter
+	err   string      // Why the query didn't apply
+}
+
+// NewJSONTree creates an empty JSON tree. setFocus moves focus between the tree
+// and its filter input.
+func NewJSONTree(setFocus func(tview.Primitive)) *JSONTree {
+	t := &JSONTree{
+		Flex:     tview.NewFlex().SetDirection(tview.FlexRow),
+		status:   tview.NewTextView().SetDynamicColors(true),
+		filter:   tview.NewInputField().SetLabel("path "),
+		setFocus: setFocus,
+	}
+	t.body = &jsonTreeBody{Box: tview.NewBox(), tree: t}
+	t.status.SetBackgroundColor(theme.Bg())
+	t.filter.SetBackgroundColor(theme.Bg())
+	t.filter.SetFieldBackgroundColor(theme.Bg())
+	t.filter.SetFieldTextColor(theme.Fg())
+	t.filter.SetLabelColor(theme.Accent())
+	t.filter.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEnter {
+			t.SetFilter(t.filter.GetText())
+		}
+		t.CloseFilter()
+	})
+
+	t.Flex.SetBackgroundColor(theme.Bg())
+	t.Flex.AddItem(t.body, 0, 1, true)
+	t.Flex.AddItem(t.status, 1, 0, false)
+	t.Flex.AddItem(t.filter, 0, 0, false)
+	return t
+}
+
+// SetText sets the content, replacing any filter.
+func (t *JSONTree) SetText(s string) *JSONTree {
+	t.roots, t.text, t.query, t.err = nil, "", "", ""
+	if nodes, err := parseJSONNodes(s); err == nil {
+		t.roots = nodes
+	} else {
+		t.text = s
+	}
+	t.shown = t.roots
+	t.body.relayout(0)
+	return t
+}
+
+// SetFilter shows only the nodes a jq-style path selects, or the whole document
+// when expr is empty or ".".
+func (t *JSONTree) SetFilter(expr string) {
+	expr = strings.TrimSpace(expr)
+	t.err = ""
+	if expr == "" || expr == "." || t.roots == nil {
+		t.query = ""
+		t.shown = t.roots
+		t.body.relayout(0)
+		return
+	}
+	steps, err := parseJSONPath(expr)
+	if err != nil {
+		t.err = err.Error()
+		t.updateStatus()
+		return
+	}
+	t.query = expr
+	t.shown = applyJSONPath(t.roots, steps)
+	t.body.relayout(0)
+}
+
+// IsFiltering reports whether the filter input has focus.
+func (t *JSONTree) IsFiltering() bool {
+	return t.filter.HasFocus()
+}
+
+// SetInputCapture sets the key capture of the tree itself, which isn't called
+// while the filter input has focus.
+func (t *JSONTree) SetInputCapture(capture func(event *tcell.EventKey) *tcell.EventKey) *tview.Box {
+	return t.body.SetInputCapture(capture)
+}
+
+// Selected returns the selected node's value as indented JSON and its path, or
+// the whole content when it isn't JSON.
+func (t *JSONTree) Selected() (value, path string) {
+	if line, ok := t.body.selectedLine(); ok {
+		return line.node.value(), line.node.path()
+	}
+	return t.text, ""
+}
+
+func (t *JSONTree) openFilter() {
+	text := t.query
+	if text == "" {
+		text = "."
+	}
+	t.filter.SetText(text)
+	t.ResizeItem(t.filter, 1, 0)
+	t.setFocus(t.filter)
+}
+
+// CloseFilter hides the filter input, keeping the filter that was last applied.
+// Modals call it on Esc, which they handle before the input sees it.
+func (t *JSONTree) CloseFilter() {
+	t.ResizeItem(t.filter, 0, 0)
+	t.setFocus(t.body)
+}
+
+// updateStatus shows the selected node's path and the filter below the tree.
+func (t *JSONTree) updateStatus() {
+	var parts []string
+	if line, ok := t.body.selectedLine(); ok {
+		parts = append(parts, fmt.Sprintf("[%s]%s[-]", theme.TagAccent(), tview.Escape(line.node.path())))
+	}
+	if t.query != "" {
+		parts = append(parts, fmt.Sprintf("[%s]filter %s: %d matches[-]", theme.TagFgDim(), tview.Escape(t.query), len(t.shown)))
+	}
+	if t.err != "" {
+		parts = append(parts, fmt.Sprintf("[%s]%s[-]", theme.TagError(), tview.Escape(t.err)))
+	}
+	t.status.SetText(strings.Join(parts, "  "))
+}
+
+// jsonTreeBody draws the visible lines of a JSONTree and handles its keys.
+type jsonTreeBody struct {
+	*tview.Box
+	tree     *JSONTree
+	lines    []jsonLine
+	plain    []string // Lines of content that isn't JSON
+	selected int
+	offset   int
+	height   int
+}
+
+// relayout rebuilds the lines after the document, filter or folding changed,
+// keeping the selection on the given line.
+func (b *jsonTreeBody) relayout(selected int) {
+	t := b.tree
+	b.plain = nil
+	if t.roots == nil {
+		b.lines = nil
+		b.plain = strings.Split(tview.Escape(t.text), "\n")
+	} else {
+		b.lines = renderJSONLines(t.shown, t.query != "")
+	}
+	b.selected = max(0, min(selected, b.count()-1))
+	t.updateStatus()
+}
+
+func (b *jsonTreeBody) count() int {
+	if b.plain != nil {
+		return len(b.plain)
+	}
+	return len(b.lines)
+}
+
+func (b *jsonTreeBody) selectedLine() (jsonLine, bool) {
+	if b.plain != nil || b.selected >= len(b.lines) {
+		return jsonLine{}, false
+	}
+	return b.lines[b.selected], true
+}
+
+// Draw renders the lines in view.
+func (b *jsonTreeBody) Draw(screen tcell.Screen) {
+	b.SetBackgroundColor(theme.Bg())
+	b.Box.DrawForSubclass(screen, b)
+	x, y, width, height := b.GetInnerRect()
+	b.height = height
+	if height <= 0 {
+		return
+	}
+
+	if b.selected < b.offset {
+		b.offset = b.selected
+	} else if b.selected >= b.offset+height {
+		b.offset = b.selected - height + 1
+	}
+	b.offset = max(0, min(b.offset, b.count()-height))
+
+	if b.count() == 0 {
+		tview.Print(screen, fmt.Sprintf("[%s]No matches[-]", theme.TagFgDim()), x+1, y, width-1, tview.AlignLeft, theme.Fg())
+		return
+	}
+	for row := 0; row < height && b.offset+row < b.count(); row++ {
+		i := b.offset + row
+		text := ""
+		if b.plain != nil {
+			text = b.plain[i]
+		} else {
+			text = b.lines[i].format(true)
+		}
+		fg := theme.Fg()
+		if i == b.selected && b.plain == nil && b.HasFocus() {
+			style := theme.SelectionStyle()
+			for col := x; col < x+width; col++ {
+				screen.SetContent(col, y+row, ' ', nil, style)
+			}
+			fg, _, _ = style.Decompose()
+		}
+		tview.Print(screen, text, x, y+row, width, tview.AlignLeft, fg)
+	}
+}
+
+func (b *jsonTreeBody) move(delta int) {
+	if b.count() == 0 {
+		return
+	}
+	b.selected = max(0, min(b.selected+delta, b.count()-1))
+	if b.plain != nil {
+		b.offset = max(0, min(b.offset+delta, b.count()-b.height))
+		b.selected = b.offset
+	}
+	b.tree.updateStatus()
+}
+
+// setFolded folds or unfolds the selected node's container, keeping the
+// selection on it.
+func (b *jsonTreeBody) setFolded(node *jsonNode, collapsed bool) {
+	if !node.container() || len(node.children) == 0 || node.collapsed == collapsed {
+		return
+	}
+	node.collapsed = collapsed
+	b.relayout(b.lineOf(node))
+}
+
+// lineOf returns the opening line of node.
+func (b *jsonTreeBody) lineOf(node *jsonNode) int {
+	for i, line := range b.lines {
+		if line.node == node && !line.closing {
+			return i
+		}
+	}
+	return 0
+}
+
+// InputHandler handles navigation, folding and the filter.
+func (b *jsonTreeBody) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return b.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		page := max(1, b.height-1)
+		switch event.Key() {
+		case tcell.KeyUp:
+			b.move(-1)
+		case tcell.KeyDown:
+			b.move(1)
+		case tcell.KeyPgUp, tcell.KeyCtrlB:
+			b.move(-page)
+		case tcell.KeyPgDn, tcell.KeyCtrlF:
+			b.move(page)
+		case tcell.KeyHome:
+			b.move(-b.count())
+		case tcell.KeyEnd:
+			b.move(b.count())
+		case tcell.KeyLeft:
+			b.collapseOrParent()
+		case tcell.KeyRight:
+			b.expand()
+		case tcell.KeyEnter:
+			b.toggle()
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'k':
+				b.move(-1)
+			case 'j':
+				b.move(1)
+			case 'g':
+				b.move(-b.count())
+			case 'G':
+				b.move(b.count())
+			case 'h':
+				b.collapseOrParent()
+			case 'l':
+				b.expand()
+			case ' ':
+				b.toggle()
+			case '+', '-':
+				b.foldAll(event.Rune() == '-')
+			case '.', 'f':
+				if b.tree.roots != nil {
+					b.tree.openFilter()
+				}
+			}
+		}
+	})
+}
+
+func (b *jsonTreeBody) toggle() {
+	if line, ok := b.selectedLine(); ok {
+		b.setFolded(line.node, !line.node.collapsed)
+	}
+}
+
+// expand unfolds the selected container, or moves into it when it's open.
+func (b *jsonTreeBody) expand() {
+	line, ok := b.selectedLine()
+	if !ok || !line.node.container() {
+		return
+	}
+	if line.node.collapsed {
+		b.setFolded(line.node, false)
+	} else if !line.closing && len(line.node.children) > 0 {
+		b.move(1)
+	}
+}
+
+// collapseOrParent folds the selected container, or moves to its parent when
+// it's folded or a scalar.
+func (b *jsonTreeBody) collapseOrParent() {
+	line, ok := b.selectedLine()
+	if !ok {
+		return
+	}
+	node := line.node
+	if node.container() && !node.collapsed && len(node.children) > 0 {
+		b.setFolded(node, true)
+		return
+	}
+	for _, root := range b.tree.shown {
+		if root == node {
+			return
+		}
+	}
+	if node.parent != nil {
+		b.selected = b.lineOf(node.parent)
+		b.tree.updateStatus()
+	}
+}
+
+// foldAll folds or unfolds every container in view.
+func (b *jsonTreeBody) foldAll(collapsed bool) {
+	line, ok := b.selectedLine()
+	for _, root := range b.tree.shown {
+		root.setCollapsed(collapsed)
+		if collapsed {
+			root.collapsed = false // Keep the top level open
+		}
+	}
+	if !ok {
+		b.relayout(0)
+		return
+	}
+	// Select the nearest node still in view
+	node := line.node
+	for node.parent != nil && node.parent.collapsed {
+		node = node.parent
+	}
+	b.relayout(0)
+	b.selected = b.lineOf(node)
+	b.tree.updateStatus()
+}
+
+// MouseHandler selects the clicked line, toggles folds on double click and
+// scrolls with the wheel.
+func (b *jsonTreeBody) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+	return b.WrapMouseHandler(func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+		if !b.InRect(event.Position()) {
+			return false, nil
+		}
+		switch action {
+		case tview.MouseLeftClick, tview.MouseLeftDoubleClick:
+			setFocus(b)
+			_, top, _, _ := b.GetInnerRect()
+			_, my := event.Position()
+			if row := b.offset + my - top; row >= 0 && row < b.count() {
+				b.selected = row
+				b.tree.updateStatus()
+				if action == tview.MouseLeftDoubleClick {
+					b.toggle()
+				}
+			}
+			return true, nil
+		case tview.MouseScrollUp:
+			b.move(-1)
+			return true, nil
+		case tview.MouseScrollDown:
+			b.move(1)
+			return true, nil
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,173 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/gdamore/tcell/v2"
+)
+
+// keyChord identifies a key press: a special key, or a rune with an optional Alt modifier.
+type keyChord struct {
+	key  tcell.Key
+	r    rune
+	mods tcell.ModMask
+}
+
+// dropKey is a remap target that swallows the key.
+var dropKey = keyChord{key: tcell.KeyNUL}
+
+func chordOf(event *tcell.EventKey) keyChord {
+	if event.Key() == tcell.KeyRune {
+		return keyChord{key: tcell.KeyRune, r: event.Rune(), mods: event.Modifiers() & tcell.ModAlt}
+	}
+	return keyChord{key: event.Key()}
+}
+
+var namedKeys = map[string]tcell.Key{
+	"up":        tcell.KeyUp,
+	"down":      tcell.KeyDown,
+	"left":      tcell.KeyLeft,
+	"right":     tcell.KeyRight,
+	"pgup":      tcell.KeyPgUp,
+	"pgdn":      tcell.KeyPgDn,
+	"home":      tcell.KeyHome,
+	"end":       tcell.KeyEnd,
+	"enter":     tcell.KeyEnter,
+	"esc":       tcell.KeyEscape,
+	"tab":       tcell.KeyTab,
+	"backspace": tcell.KeyBackspace2,
+}
+
+// parseKeyChord parses a key name such as "j", "G", "ctrl+n", "alt+v", "pgdn" or
+// "none" (swallow the key).
+func parseKeyChord(name string) (keyChord, error) {
+	lower := strings.ToLower(name)
+	if lower == "none" {
+		return dropKey, nil
+	}
+	if key, ok := namedKeys[lower]; ok {
+		return keyChord{key: key}, nil
+	}
+	if rest, ok := strings.CutPrefix(lower, "ctrl+"); ok && len(rest) == 1 && rest[0] >= 'a' && rest[0] <= 'z' {
+		return keyChord{key: tcell.KeyCtrlA + tcell.Key(rest[0]-'a')}, nil
+	}
+	if rest, ok := strings.CutPrefix(name, "alt+"); ok && len([]rune(rest)) == 1 {
+		return keyChord{key: tcell.KeyRune, r: []rune(rest)[0], mods: tcell.ModAlt}, nil
+	}
+	if runes := []rune(name); len(runes) == 1 {
+		return keyChord{key: tcell.KeyRune, r: runes[0]}, nil
+	}
+	return keyChord{}, fmt.Errorf("unknown key %q", name)
+}
+
+// keymapPreset is a named set of remaps with the label shown for list navigation hints.
+type keymapPreset struct {
+	remaps  map[string]string
+	navHint string
+}
+
+var keymapPresets = map[string]keymapPreset{
+	config.KeymapVim: {navHint: "j/k"},
+	config.KeymapEmacs: {
+		navHint: "C-n/C-p",
+		remaps: map[string]string{
+			"ctrl+n": "down",
+			"ctrl+p": "up",
+			"ctrl+f": "right",
+			"ctrl+b": "left",
+			"ctrl+v": "pgdn",
+			"alt+v":  "pgup",
+			"alt+<":  "home",
+			"alt+>":  "end",
+			"ctrl+g": "esc",
+			"ctrl+s": "/",
+		},
+	},
+	// Arrows drops the vim list motions only: the g prefix (gt, g1) is read
+	// before remapping and h/l keep scrolling unwrapped detail panes.
+	config.KeymapArrows: {
+		navHint: "↑/↓",
+		remaps: map[string]string{
+			"j": "none",
+			"k": "none",
+			"g": "none",
+			"G": "none",
+		},
+	},
+}
+
+// keyRemapper translates key presses before the views see them. It layers the
+// user's key_remaps over a keymap preset.
+type keyRemapper struct {
+	remaps  map[keyChord]keyChord
+	navHint string
+}
+
+// newKeyRemapper builds the remapper for a preset plus custom remaps. Invalid
+// entries are skipped and reported in the returned error.
+func newKeyRemapper(preset string, custom map[string]string) (*keyRemapper, error) {
+	p, ok := keymapPresets[preset]
+	if !ok {
+		p = keymapPresets[config.KeymapVim]
+	}
+	km := &keyRemapper{remaps: make(map[keyChord]keyChord), navHint: p.navHint}
+
+	var invalid []string
+	add := func(from, to string) {
+		f, err := parseKeyChord(from)
+		if err != nil {
+			invalid = append(invalid, from)
+			return
+		}
+		t, err := parseKeyChord(to)
+		if err != nil {
+			invalid = append(invalid, to)
+			return
+		}
+		km.remaps[f] = t
+	}
+	for from, to := range p.remaps {
+		add(from, to)
+	}
+	for from, to := range custom {
+		add(from, to)
+	}
+
+	if len(invalid) > 0 {
+		return km, fmt.Errorf("ignoring invalid key remaps: %s", strings.Join(invalid, ", "))
+	}
+	return km, nil
+}
+
+// remap returns the translated event, the event itself when it isn't remapped,
+// or nil when the key is swallowed.
+func (km *keyRemapper) remap(event *tcell.EventKey) *tcell.EventKey {
+	if km == nil {
+		return event
+	}
+	to, ok := km.remaps[chordOf(event)]
+	switch {
+	case !ok:
+		return event
+	case to == dropKey:
+		return nil
+	}
+	return tcell.NewEventKey(to.key, to.r, to.mods)
+}
+
+// hints rewrites the list navigation hint for the active keymap.
+func (km *keyRemapper) hints(hints []KeyHint) []KeyHint {
+	if km == nil || km.navHint == "j/k" {
+		return hints
+	}
+	out := make([]KeyHint, len(hints))
+	for i, h := range hints {
+		if h.Key == "j/k" {
+			h.Key = km.navHint
+		}
+		out[i] = h
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// loadingDelay is how long a request runs before its spinner appears, so quick
+// loads and auto-refreshes don't flicker.
+const loadingDelay = 250 * time.Millisecond
+
+// loadingView is a spinner with the elapsed time, shown in a panel in place of
+// its content while a provider call is in flight.
+type loadingView struct {
+	*tview.Box
+	spinner     *components.Spinner
+	label       string
+	started     time.Time
+	cancellable bool
+	content     tview.Primitive // Panel content to restore
+}
+
+// Draw renders the spinner, label and elapsed time.
+func (lv *loadingView) Draw(screen tcell.Screen) {
+	lv.SetBackgroundColor(theme.Bg())
+	lv.Box.DrawForSubclass(screen, lv)
+	x, y, width, height := lv.GetInnerRect()
+	if width < 4 || height < 2 {
+		return
+	}
+
+	label := fmt.Sprintf("%s %.1fs", lv.label, time.Since(lv.started).Seconds())
+	if lv.cancellable {
+		label += "  (Ctrl+X cancels)"
+	}
+	lv.spinner.SetLabel(label)
+	lv.spinner.SetRect(x+1, y+1, width-2, 1)
+	lv.spinner.Draw(screen)
+}
+
+// startLoading shows a spinner labelled label in panel while a request runs and
+// returns the func to call, on the UI thread, once it finishes. cancel, if set,
+// is called when the user presses Ctrl+X.
+func (a *App) startLoading(panel *components.Panel, label string, cancel context.CancelFunc) (done func()) {
+	lv := &loadingView{
+		Box:         tview.NewBox(),
+		spinner:     components.NewSpinner(),
+		label:       label,
+		started:     time.Now(),
+		cancellable: cancel != nil,
+	}
+	if a.loads == nil {
+		a.loads = make(map[*loadingView]context.CancelFunc)
+	}
+	a.loads[lv] = cancel
+
+	finished := false
+	time.AfterFunc(loadingDelay, func() {
+		a.app.QueueUpdateDraw(func() {
+			if finished {
+				return
+			}
+			lv.content = panel.GetContent()
+			if prev, ok := lv.content.(*loadingView); ok {
+				lv.content = prev.content
+			}
+			panel.SetContent(lv)
+			lv.spinner.Start()
+		})
+	})
+
+	return func() {
+		if finished {
+			return
+		}
+		finished = true
+		delete(a.loads, lv)
+		lv.spinner.Stop()
+		if panel.GetContent() == lv {
+			panel.SetContent(lv.content)
+		}
+	}
+}
+
+// cancelLoading cancels the requests in flight, returning whether there were any.
+func (a *App) cancelLoading() bool {
+	cancelled := false
+	for _, cancel := range a.loads {
+		if cancel != nil {
+			cancel()
+			cancelled = true
+		}
+	}
+	if cancelled {
+		a.toasts.Info("Cancelled loading")
+	}
+	return cancelled
+}
@@ -3,13 +3,15 @@ package view
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/atterpac/jig/components"
-	"github.com/atterpac/jig/layout"
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/theme/themes"
 	"github.com/atterpac/jig/util"
 	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/galaxy-io/tempo/internal/ui"
 	"github.com/galaxy-io/tempo/internal/update"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -95,96 +97,215 @@ func (m *SplashModal) InputHandler() func(*tcell.EventKey, func(tview.Primitive)
 	})
 }
 
-// HelpModal displays help information with view-specific keybindings.
+// globalHelpHints are the bindings that work in every view.
+var globalHelpHints = []KeyHint{
+	{Key: "?", Description: "Show help"},
+	{Key: ":", Description: "Command bar (Tab completes)"},
+	{Key: "Ctrl+P", Description: "Command palette (Ctrl+K in emacs keymap)"},
+	{Key: "Ctrl+E", Description: "Switch namespace"},
+	{Key: "Ctrl+X", Description: "Cancel slow requests (while a spinner shows)"},
+	{Key: "Ctrl+T", Description: "New tab (Ctrl+W closes)"},
+	{Key: "gt/gT", Description: "Next / previous tab (Alt+1..9 jumps to a tab)"},
+	{Key: "Alt+←/→", Description: "Back / forward"},
+	{Key: "Alt+H", Description: "History of visited locations"},
+	{Key: "Alt+T", Description: "Cycle times: relative, local, UTC"},
+	{Key: "g1..g9", Description: "Go back to a breadcrumb (or click it)"},
+	{Key: "</>", Description: "Resize panels (= resets)"},
+	{Key: "T", Description: "Change theme"},
+	{Key: "P", Description: "Switch profile"},
+	{Key: "O", Description: "Settings (lists, keymap)"},
+	{Key: "esc", Description: "Go back / Close modal"},
+	{Key: "q", Description: "Quit application"},
+}
+
+// navigationHelpHints are the movement bindings shared by lists and tables.
+var navigationHelpHints = []KeyHint{
+	{Key: "j/↓", Description: "Move down"},
+	{Key: "k/↑", Description: "Move up"},
+	{Key: "g", Description: "Go to top"},
+	{Key: "G", Description: "Go to bottom"},
+	{Key: "Enter", Description: "Select / Open"},
+	{Key: "Tab", Description: "Switch panel (where applicable)"},
+}
+
+// helpSection is a group of bindings in the help modal.
+type helpSection struct {
+	title     string
+	hints     []KeyHint
+	collapsed bool
+}
+
+// helpRow is a line of the help modal: a section header, or one of its bindings.
+type helpRow struct {
+	section *helpSection
+	hint    *KeyHint // nil for the section header
+}
+
+// HelpModal lists the bindings of every view in collapsible sections, with /
+// to filter them.
 type HelpModal struct {
 	*components.Modal
-	viewName  string
-	viewHints []KeyHint
-	content   *tview.TextView
+	sections []*helpSection
+	filter   *tview.InputField
+	table    *components.Table
+	rows     []helpRow
+	setFocus func(p tview.Primitive)
+	onClose  func()
 }
 
-func NewHelpModal() *HelpModal {
+// NewHelpModal creates a help modal showing sections, in order. setFocus moves
+// the focus between the filter and the bindings.
+func NewHelpModal(sections []*helpSection, setFocus func(p tview.Primitive)) *HelpModal {
 	m := &HelpModal{
 		Modal: components.NewModal(components.ModalConfig{
 			Title:    fmt.Sprintf("%s Help", theme.IconInfo),
-			Width:    65,
-			Height:   25,
+			Width:    80,
+			Height:   30,
 			Backdrop: true,
 		}),
+		sections: sections,
+		setFocus: setFocus,
 	}
 	m.setup()
 	return m
 }
 
 func (m *HelpModal) setup() {
-	m.content = tview.NewTextView().SetDynamicColors(true)
-	m.content.SetBackgroundColor(theme.Bg())
-	m.content.SetScrollable(true)
-	m.Modal.SetContent(m.content)
-	m.Modal.SetHints([]components.KeyHint{
-		{Key: "j/k", Description: "Scroll"},
-		{Key: "Esc", Description: "Close"},
+	m.filter = tview.NewInputField().
+		SetLabel("/ ").
+		SetPlaceholder("Press / to filter bindings...")
+	m.filter.SetBackgroundColor(theme.Bg())
+	m.filter.SetFieldBackgroundColor(theme.Bg())
+	m.filter.SetLabelColor(theme.Accent())
+	m.filter.SetChangedFunc(func(string) { m.render() })
+	m.filter.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEscape {
+			m.filter.SetText("")
+		}
+		m.setFocus(m.table)
 	})
-}
-
-func (m *HelpModal) SetViewHints(name string, hints []KeyHint) {
-	m.viewName = name
-	m.viewHints = hints
-	m.updateContent()
-}
 
-func (m *HelpModal) updateContent() {
-	var text string
-
-	// Global keybindings
-	text = fmt.Sprintf(`[%s::b]Global Keybindings[-:-:-]
+	m.table = components.NewTable()
+	m.table.SetHeaders("KEY", "ACTION")
+	m.table.SetBackgroundColor(theme.Bg())
+	m.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			m.toggleSection()
+			return nil
+		case tcell.KeyEscape:
+			if m.filter.GetText() != "" {
+				m.filter.SetText("")
+				return nil
+			}
+			if m.onClose != nil {
+				m.onClose()
+			}
+			return nil
+		}
+		switch event.Rune() {
+		case '/':
+			m.setFocus(m.filter)
+			return nil
+		case ' ':
+			m.toggleSection()
+			return nil
+		}
+		return event
+	})
 
-[%s]?[-]          Show help
-[%s]T[-]          Change theme
-[%s]P[-]          Switch profile
-[%s]esc[-]        Go back / Close modal
-[%s]q[-]          Quit application
+	content := tview.NewFlex().SetDirection(tview.FlexRow)
+	content.SetBackgroundColor(theme.Bg())
+	content.AddItem(m.filter, 1, 0, false)
+	content.AddItem(m.table, 0, 1, true)
 
-`, theme.TagAccent(),
-		theme.TagAccent(),
-		theme.TagAccent(),
-		theme.TagAccent(),
-		theme.TagAccent(),
-		theme.TagAccent())
+	m.Modal.SetContent(content)
+	m.Modal.SetFocusOnShow(m.table)
+	m.Modal.SetHints([]components.KeyHint{
+		{Key: "/", Description: "Filter"},
+		{Key: "Enter", Description: "Expand/Collapse"},
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "Esc", Description: "Close"},
+	})
+	m.render()
+}
 
-	// View-specific hints
-	if len(m.viewHints) > 0 {
-		text += fmt.Sprintf(`[%s::b]%s Keybindings[-:-:-]
+// render lists the sections with a binding, key or title matching the filter.
+// Filtering expands the sections it matches.
+func (m *HelpModal) render() {
+	selected := m.selectedRow()
+	query := strings.ToLower(m.filter.GetText())
 
-`, theme.TagAccent(), m.viewName)
+	m.table.ClearRows()
+	m.table.SetHeaders("KEY", "ACTION")
+	m.rows = nil
+	for _, s := range m.sections {
+		var matches []int
+		for i, hint := range s.hints {
+			text := strings.ToLower(s.title + " " + hint.Key + " " + hint.Description)
+			if query == "" || strings.Contains(text, query) {
+				matches = append(matches, i)
+			}
+		}
+		if len(matches) == 0 {
+			continue
+		}
 
-		for _, hint := range m.viewHints {
-			text += fmt.Sprintf("[%s]%-12s[-] %s\n", theme.TagAccent(), hint.Key, hint.Description)
+		open := !s.collapsed || query != ""
+		marker := "▸"
+		if open {
+			marker = "▾"
+		}
+		m.table.AddRowWithColor(theme.Accent(), marker+" "+s.title, fmt.Sprintf("%d bindings", len(matches)))
+		m.rows = append(m.rows, helpRow{section: s})
+		if !open {
+			continue
+		}
+		for _, i := range matches {
+			m.table.AddRowWithColor(theme.Fg(), "  "+s.hints[i].Key, s.hints[i].Description)
+			m.rows = append(m.rows, helpRow{section: s, hint: &s.hints[i]})
 		}
 	}
 
-	// Navigation tips
-	text += fmt.Sprintf(`
-[%s::b]Navigation[-:-:-]
+	// Keep the selection on the same line where it is still shown
+	row := 0
+	for i, r := range m.rows {
+		if r == selected {
+			row = i
+			break
+		}
+	}
+	if len(m.rows) > 0 {
+		m.table.SelectRow(row)
+	}
+}
 
-[%s]j/↓[-]        Move down
-[%s]k/↑[-]        Move up
-[%s]g[-]          Go to top
-[%s]G[-]          Go to bottom
-[%s]Enter[-]      Select / Open
-[%s]Tab[-]        Switch panel (where applicable)
-`, theme.TagAccent(),
-		theme.TagAccent(),
-		theme.TagAccent(),
-		theme.TagAccent(),
-		theme.TagAccent(),
-		theme.TagAccent(),
-		theme.TagAccent())
+// selectedRow returns the line under the cursor.
+func (m *HelpModal) selectedRow() helpRow {
+	if row := m.table.SelectedRow(); row >= 0 && row < len(m.rows) {
+		return m.rows[row]
+	}
+	return helpRow{}
+}
 
-	m.content.SetText(text)
+// toggleSection collapses or expands the section under the cursor.
+func (m *HelpModal) toggleSection() {
+	r := m.selectedRow()
+	if r.section == nil {
+		return
+	}
+	r.section.collapsed = !r.section.collapsed
+	m.render()
+	for i, row := range m.rows {
+		if row.section == r.section && row.hint == nil {
+			m.table.SelectRow(i)
+			break
+		}
+	}
 }
 
 func (m *HelpModal) SetOnClose(fn func()) {
+	m.onClose = fn
 	m.Modal.SetOnClose(fn)
 	m.Modal.SetOnCancel(fn)
 }
@@ -285,14 +406,15 @@ func (m *ThemeSelectorModal) Focus(delegate func(p tview.Primitive)) {
 // ProfileModal manages connection profiles.
 type ProfileModal struct {
 	*components.Modal
-	table    *components.Table
-	profiles []string
-	active   string
-	onSelect func(string)
-	onNew    func()
-	onEdit   func(string)
-	onDelete func(string)
-	onClose  func()
+	table        *components.Table
+	profiles     []string
+	active       string
+	onSelect     func(string)
+	onNew        func()
+	onEdit       func(string)
+	onDelete     func(string)
+	onDisconnect func(string)
+	onClose      func()
 }
 
 func NewProfileModal() *ProfileModal {
@@ -340,6 +462,12 @@ func (m *ProfileModal) setup() {
 				m.onDelete(m.profiles[row])
 			}
 			return nil
+		case 'x':
+			row := m.table.SelectedRow()
+			if row >= 0 && row < len(m.profiles) && m.profiles[row] != m.active && m.onDisconnect != nil {
+				m.onDisconnect(m.profiles[row])
+			}
+			return nil
 		}
 		return event
 	})
@@ -350,6 +478,7 @@ func (m *ProfileModal) setup() {
 		{Key: "n", Description: "New"},
 		{Key: "e", Description: "Edit"},
 		{Key: "d", Description: "Delete"},
+		{Key: "x", Description: "Disconnect"},
 		{Key: "Esc", Description: "Close"},
 	})
 	m.Modal.SetOnCancel(func() {
@@ -359,7 +488,9 @@ func (m *ProfileModal) setup() {
 	})
 }
 
-func (m *ProfileModal) SetProfiles(profiles []string, active string) {
+// SetProfiles lists the profiles, marking the active one with ● and profiles
+// connected in the background with ○.
+func (m *ProfileModal) SetProfiles(profiles []string, active string, open map[string]bool) {
 	m.profiles = profiles
 	m.active = active
 	m.table.ClearRows()
@@ -372,6 +503,8 @@ func (m *ProfileModal) SetProfiles(profiles []string, active string) {
 		if name == active {
 			marker = "●"
 			currentIdx = i
+		} else if open[name] {
+			marker = "○"
 		}
 		address := ""
 		if cfg != nil {
@@ -387,24 +520,43 @@ func (m *ProfileModal) SetProfiles(profiles []string, active string) {
 	}
 }
 
-func (m *ProfileModal) SetOnSelect(fn func(string)) { m.onSelect = fn }
-func (m *ProfileModal) SetOnNew(fn func())          { m.onNew = fn }
-func (m *ProfileModal) SetOnEdit(fn func(string))   { m.onEdit = fn }
-func (m *ProfileModal) SetOnDelete(fn func(string)) { m.onDelete = fn }
-func (m *ProfileModal) SetOnClose(fn func())        { m.onClose = fn }
+func (m *ProfileModal) SetOnSelect(fn func(string))     { m.onSelect = fn }
+func (m *ProfileModal) SetOnNew(fn func())              { m.onNew = fn }
+func (m *ProfileModal) SetOnEdit(fn func(string))       { m.onEdit = fn }
+func (m *ProfileModal) SetOnDelete(fn func(string))     { m.onDelete = fn }
+func (m *ProfileModal) SetOnDisconnect(fn func(string)) { m.onDisconnect = fn }
+func (m *ProfileModal) SetOnClose(fn func())            { m.onClose = fn }
 
 func (m *ProfileModal) Focus(delegate func(p tview.Primitive)) {
 	delegate(m.table)
 }
 
+// confirmDefault is the confirmation option that leaves the mode unset: typed on
+// prod-tagged profiles and standard elsewhere.
+const confirmDefault = "default"
+
+// customAddress is the cloud region option that keeps the entered server address.
+const customAddress = "Custom address"
+
 // ProfileForm for creating/editing profiles.
 type ProfileForm struct {
 	*components.Modal
-	form       *components.Form
-	isEdit     bool
-	editName   string
-	onSave     func(string, config.ConnectionConfig)
-	onCancel   func()
+	form     *components.Form
+	isEdit   bool
+	editName string
+	original config.ConnectionConfig // Profile being edited, for settings the form doesn't show
+	lastTab  string                  // Path field value when Tab last listed completions
+	onSave   func(string, config.ConnectionConfig)
+	onCancel func()
+	onBrowse func(title, path string, onPick func(string))
+}
+
+// profilePathFields are the form fields holding file paths, with the titles of
+// their file pickers.
+var profilePathFields = []struct{ name, title string }{
+	{"tlsCert", "TLS Client Certificate"},
+	{"tlsKey", "TLS Client Key"},
+	{"tlsCA", "TLS CA Certificate"},
 }
 
 func NewProfileForm() *ProfileForm {
@@ -412,7 +564,7 @@ func NewProfileForm() *ProfileForm {
 		Modal: components.NewModal(components.ModalConfig{
 			Title:    fmt.Sprintf("%s New Profile", theme.IconInfo),
 			Width:    60,
-			Height:   22,
+			Height:   44,
 			Backdrop: true,
 		}),
 	}
@@ -421,82 +573,295 @@ func NewProfileForm() *ProfileForm {
 }
 
 func (f *ProfileForm) setup() {
+	f.buildForm("", config.ConnectionConfig{
+		Address:   "localhost:7233",
+		Namespace: "default",
+	})
+
+	f.Modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field/Complete path"},
+		{Key: "Ctrl+O", Description: "Browse files"},
+		{Key: "Enter", Description: "Save"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	f.Modal.SetOnSubmit(func() {
+		f.submit(f.form.GetValues())
+	})
+	f.Modal.SetOnCancel(func() {
+		if f.onCancel != nil {
+			f.onCancel()
+		}
+	})
+}
+
+// buildForm (re)creates the form fields populated from cfg.
+func (f *ProfileForm) buildForm(name string, cfg config.ConnectionConfig) {
+	yesNo := map[bool]string{true: "Yes", false: "No"}
+	f.original = cfg
+
 	f.form = components.NewForm()
 	f.form.AddTextField("name", "Profile Name", "")
 	f.form.AddTextField("address", "Server Address", "localhost:7233")
+	f.form.AddSelect("cloudRegion", "Temporal Cloud Region (sets address)", append([]string{customAddress}, temporal.CloudRegions...))
 	f.form.AddTextField("namespace", "Default Namespace", "default")
+	f.form.AddTextField("apiKey", "Temporal Cloud API Key (optional)", "")
 	f.form.AddTextField("tlsCert", "TLS Cert Path (optional)", "")
 	f.form.AddTextField("tlsKey", "TLS Key Path (optional)", "")
+	f.form.AddTextField("tlsKeyPassphrase", "TLS Key Passphrase (optional)", "")
 	f.form.AddTextField("tlsCA", "TLS CA Path (optional)", "")
 	f.form.AddTextField("tlsServerName", "TLS Server Name (optional)", "")
 	f.form.AddSelect("tlsSkipVerify", "Skip TLS Verify", []string{"No", "Yes"})
+	f.form.AddTextField("proxy", "Proxy URL: http:// or socks5:// (optional)", "")
+	f.form.AddTextField("codecEndpoint", "Codec Server URL (optional)", "")
+	f.form.AddTextField("codecAuth", "Codec Auth Header (optional)", "")
+	f.form.AddSelect("codecPassNamespace", "Send Namespace to Codec", []string{"No", "Yes"})
+	f.form.AddTextField("codecPlugin", "Decoder Plugin Command (optional)", "")
+	f.form.AddTextField("tags", "Tags, comma separated (prod: typed confirms)", "")
+	f.form.AddTextField("namespaces", "Allowed Namespaces: names or /regex/ (optional)", "")
+	f.form.AddSelect("confirmMode", "Confirmations", []string{confirmDefault, config.ConfirmStandard, config.ConfirmTyped, config.ConfirmSkip})
 
-	f.form.SetOnSubmit(func(values map[string]any) {
-		name := values["name"].(string)
-		if name == "" {
-			return
-		}
-
-		skipVerify := values["tlsSkipVerify"].(string) == "Yes"
-
-		cfg := config.ConnectionConfig{
-			Address:   values["address"].(string),
-			Namespace: values["namespace"].(string),
-			TLS: config.TLSConfig{
-				Cert:       values["tlsCert"].(string),
-				Key:        values["tlsKey"].(string),
-				CA:         values["tlsCA"].(string),
-				ServerName: values["tlsServerName"].(string),
-				SkipVerify: skipVerify,
-			},
-		}
+	// Set actual values for editing (placeholders are just hints, values are the actual data)
+	_ = f.form.SetValues(map[string]any{
+		"name":               name,
+		"address":            cfg.Address,
+		"cloudRegion":        valueOrEmpty(temporal.CloudRegionForAddress(cfg.Address), customAddress),
+		"namespace":          cfg.Namespace,
+		"apiKey":             cfg.APIKey,
+		"tlsCert":            cfg.TLS.Cert,
+		"tlsKey":             cfg.TLS.Key,
+		"tlsKeyPassphrase":   cfg.TLS.KeyPassphrase,
+		"tlsCA":              cfg.TLS.CA,
+		"tlsServerName":      cfg.TLS.ServerName,
+		"tlsSkipVerify":      yesNo[cfg.TLS.SkipVerify],
+		"proxy":              cfg.Proxy,
+		"codecEndpoint":      cfg.Codec.Endpoint,
+		"codecAuth":          cfg.Codec.Auth,
+		"codecPassNamespace": yesNo[cfg.Codec.PassNamespace],
+		"codecPlugin":        joinCommandLine(cfg.Codec.Plugin, cfg.Codec.PluginArgs),
+		"tags":               strings.Join(cfg.Tags, ", "),
+		"namespaces":         strings.Join(cfg.Namespaces, ", "),
+		"confirmMode":        valueOrEmpty(cfg.Confirm.Mode, confirmDefault),
+	})
 
-		if f.onSave != nil {
-			f.onSave(name, cfg)
-		}
+	f.form.SetOnSubmit(func(values map[string]any) {
+		f.submit(values)
 	})
 	f.form.SetOnCancel(func() {
 		if f.onCancel != nil {
 			f.onCancel()
 		}
 	})
+	f.form.SetInputCapture(f.handlePathKeys)
 
 	f.Modal.SetContent(f.form)
-	f.Modal.SetHints([]components.KeyHint{
-		{Key: "Tab", Description: "Next field"},
-		{Key: "Enter", Description: "Save"},
-		{Key: "Esc", Description: "Cancel"},
-	})
-	f.Modal.SetOnSubmit(func() {
-		values := f.form.GetValues()
-		name := values["name"].(string)
-		if name == "" {
-			return
+}
+
+// handlePathKeys completes the focused path field on Tab and opens a file
+// picker for it on Ctrl+O. Tab moves to the next field when there is nothing
+// to complete, or when pressed again after listing ambiguous completions.
+func (f *ProfileForm) handlePathKeys(event *tcell.EventKey) *tcell.EventKey {
+	if event.Key() != tcell.KeyTab && event.Key() != tcell.KeyCtrlO {
+		return event
+	}
+	var field *components.TextField
+	var title string
+	for _, pf := range profilePathFields {
+		if tf, ok := f.form.GetTextField(pf.name); ok && tf.HasFocus() {
+			field, title = tf, pf.title
 		}
+	}
+	if field == nil {
+		return event
+	}
 
-		skipVerify := values["tlsSkipVerify"].(string) == "Yes"
+	if event.Key() == tcell.KeyCtrlO {
+		if f.onBrowse != nil {
+			f.onBrowse(title, field.GetValue(), func(path string) {
+				field.SetValue(path)
+			})
+		}
+		return nil
+	}
 
-		cfg := config.ConnectionConfig{
-			Address:   values["address"].(string),
-			Namespace: values["namespace"].(string),
-			TLS: config.TLSConfig{
-				Cert:       values["tlsCert"].(string),
-				Key:        values["tlsKey"].(string),
-				CA:         values["tlsCA"].(string),
-				ServerName: values["tlsServerName"].(string),
-				SkipVerify: skipVerify,
-			},
+	value := field.GetValue()
+	if value == "" || value == f.lastTab {
+		f.lastTab = ""
+		return event
+	}
+	completed, matches := completePath(value)
+	if completed != value {
+		field.SetValue(completed)
+		f.lastTab = ""
+		return nil
+	}
+	if len(matches) > 1 {
+		// List the choices under the field until the next edit, like a shell
+		field.SetValidator(func(string) error {
+			field.SetValidator(nil)
+			return fmt.Errorf("%s", strings.Join(matches, "  "))
+		})
+		_ = field.Validate()
+		f.lastTab = value
+		return nil
+	}
+	return event
+}
+
+// submit validates the form values and passes the profile to onSave.
+func (f *ProfileForm) submit(values map[string]any) {
+	name := values["name"].(string)
+	if f.isEdit {
+		// Don't allow renaming existing profiles
+		name = f.editName
+	}
+	if name == "" {
+		return
+	}
+
+	// The plugin command is entered as a single line: executable followed by
+	// arguments, quoted as in a shell
+	plugin, pluginArgs, err := splitCommandLine(values["codecPlugin"].(string))
+	if err != nil {
+		if field, ok := f.form.GetTextField("codecPlugin"); ok {
+			field.SetValidator(func(string) error {
+				field.SetValidator(nil)
+				return err
+			})
+			_ = field.Validate()
 		}
+		return
+	}
+
+	confirm := config.ConfirmSettings{Destructive: f.original.Confirm.Destructive}
+	if mode := values["confirmMode"].(string); mode != confirmDefault {
+		confirm.Mode = mode
+	}
 
-		if f.onSave != nil {
-			f.onSave(name, cfg)
+	address := values["address"].(string)
+	if region := values["cloudRegion"].(string); region != customAddress {
+		address, _ = temporal.CloudRegionAddress(region)
+	}
+
+	cfg := config.ConnectionConfig{
+		Address:   address,
+		Namespace: values["namespace"].(string),
+		APIKey:    strings.TrimSpace(values["apiKey"].(string)),
+		Proxy:     strings.TrimSpace(values["proxy"].(string)),
+		TLS: config.TLSConfig{
+			Cert:          values["tlsCert"].(string),
+			Key:           values["tlsKey"].(string),
+			KeyPassphrase: values["tlsKeyPassphrase"].(string),
+			CA:            values["tlsCA"].(string),
+			ServerName:    values["tlsServerName"].(string),
+			SkipVerify:    values["tlsSkipVerify"].(string) == "Yes",
+		},
+		Codec: config.CodecConfig{
+			Endpoint:      values["codecEndpoint"].(string),
+			Auth:          values["codecAuth"].(string),
+			PassNamespace: values["codecPassNamespace"].(string) == "Yes",
+			Plugin:        plugin,
+			PluginArgs:    pluginArgs,
+		},
+		Tags:       splitCommaList(values["tags"].(string)),
+		Confirm:    confirm,
+		Namespaces: splitCommaList(values["namespaces"].(string)),
+
+		// Settings the form doesn't show are kept as configured
+		WebUI:       f.original.WebUI,
+		Backend:     f.original.Backend,
+		BackendPath: f.original.BackendPath,
+		RPC:         f.original.RPC,
+	}
+
+	if f.onSave != nil {
+		f.onSave(name, cfg)
+	}
+}
+
+// splitCommaList splits a comma separated form value, dropping empty entries.
+func splitCommaList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
 		}
-	})
-	f.Modal.SetOnCancel(func() {
-		if f.onCancel != nil {
-			f.onCancel()
+	}
+	return items
+}
+
+// joinCommandLine formats a command and its arguments as one line, quoting
+// any word a shell would split or expand so splitCommandLine reads it back.
+func joinCommandLine(command string, args []string) string {
+	if command == "" {
+		return ""
+	}
+	words := make([]string, 0, 1+len(args))
+	for _, word := range append([]string{command}, args...) {
+		if word == "" || strings.ContainsAny(word, " \t\n'\"\\$`|&;<>()*?[]#~") {
+			word = "'" + strings.ReplaceAll(word, "'", `'\''`) + "'"
 		}
-	})
+		words = append(words, word)
+	}
+	return strings.Join(words, " ")
+}
+
+// splitCommandLine splits a command line into the command and its arguments
+// the way a shell would, honouring single and double quotes and backslash
+// escapes. Nothing is expanded.
+func splitCommandLine(line string) (string, []string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			if quote == '"' && !strings.ContainsRune("\\\"$`", r) {
+				word.WriteRune('\\')
+			}
+			word.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\\':
+			escaped, inWord = true, true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	switch {
+	case quote != 0:
+		return "", nil, fmt.Errorf("unterminated %c quote", quote)
+	case escaped:
+		return "", nil, fmt.Errorf("trailing backslash")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	if len(words) == 0 {
+		return "", nil, nil
+	}
+	return words[0], words[1:], nil
 }
 
 func (f *ProfileForm) SetProfile(name string, cfg config.ConnectionConfig) {
@@ -509,64 +874,72 @@ func (f *ProfileForm) SetProfile(name string, cfg config.ConnectionConfig) {
 		f.Modal.SetTitle(fmt.Sprintf("%s New Profile", theme.IconInfo))
 	}
 
-	// Rebuild form with new values
-	f.form = components.NewForm()
-	if f.isEdit {
-		// Don't allow editing the name for existing profiles
-		f.form.AddTextField("name", "Profile Name", name)
-	} else {
-		f.form.AddTextField("name", "Profile Name", "")
-	}
-	f.form.AddTextField("address", "Server Address", "localhost:7233")
-	f.form.AddTextField("namespace", "Default Namespace", "default")
-	f.form.AddTextField("tlsCert", "TLS Cert Path (optional)", "")
-	f.form.AddTextField("tlsKey", "TLS Key Path (optional)", "")
-	f.form.AddTextField("tlsCA", "TLS CA Path (optional)", "")
-	f.form.AddTextField("tlsServerName", "TLS Server Name (optional)", "")
+	f.buildForm(name, cfg)
+}
 
-	f.form.AddSelect("tlsSkipVerify", "Skip TLS Verify", []string{"No", "Yes"})
+func (f *ProfileForm) SetOnSave(fn func(string, config.ConnectionConfig)) { f.onSave = fn }
+func (f *ProfileForm) SetOnCancel(fn func())                              { f.onCancel = fn }
 
-	// Set actual values for editing (placeholders are just hints, values are the actual data)
-	values := map[string]any{
-		"address":       cfg.Address,
-		"namespace":     cfg.Namespace,
-		"tlsCert":       cfg.TLS.Cert,
-		"tlsKey":        cfg.TLS.Key,
-		"tlsCA":         cfg.TLS.CA,
-		"tlsServerName": cfg.TLS.ServerName,
-		"tlsSkipVerify": map[bool]string{true: "Yes", false: "No"}[cfg.TLS.SkipVerify],
-	}
-	if f.isEdit {
-		values["name"] = name
-	}
-	_ = f.form.SetValues(values)
+// SetOnBrowse sets the function that opens a file picker for a path field.
+func (f *ProfileForm) SetOnBrowse(fn func(title, path string, onPick func(string))) {
+	f.onBrowse = fn
+}
 
-	f.form.SetOnSubmit(func(values map[string]any) {
-		saveName := name
-		if !f.isEdit {
-			saveName = values["name"].(string)
-		}
-		if saveName == "" {
-			return
-		}
+func (f *ProfileForm) Focus(delegate func(p tview.Primitive)) {
+	f.form.Focus(delegate)
+}
 
-		skipVerify := values["tlsSkipVerify"].(string) == "Yes"
+// orderingLabels maps list orderings to their display labels.
+var orderingLabels = map[string]string{
+	config.OrderServerDefault: "Server default",
+	config.OrderStartTimeDesc: "Start time (newest first)",
+	config.OrderStartTimeAsc:  "Start time (oldest first)",
+	config.OrderCloseTimeDesc: "Close time (newest first)",
+	config.OrderCloseTimeAsc:  "Close time (oldest first)",
+}
 
-		newCfg := config.ConnectionConfig{
-			Address:   values["address"].(string),
-			Namespace: values["namespace"].(string),
-			TLS: config.TLSConfig{
-				Cert:       values["tlsCert"].(string),
-				Key:        values["tlsKey"].(string),
-				CA:         values["tlsCA"].(string),
-				ServerName: values["tlsServerName"].(string),
-				SkipVerify: skipVerify,
-			},
-		}
+// SettingsForm edits runtime list settings and the keymap preset.
+type SettingsForm struct {
+	*components.Modal
+	form     *components.Form
+	onSave   func(config.ListSettings, string)
+	onCancel func()
+}
 
-		if f.onSave != nil {
-			f.onSave(saveName, newCfg)
-		}
+func NewSettingsForm(settings config.ListSettings, keymap string) *SettingsForm {
+	f := &SettingsForm{
+		Modal: components.NewModal(components.ModalConfig{
+			Title:    fmt.Sprintf("%s Settings", theme.IconInfo),
+			Width:    60,
+			Height:   16,
+			Backdrop: true,
+		}),
+	}
+	f.setup(settings, keymap)
+	return f
+}
+
+func (f *SettingsForm) setup(settings config.ListSettings, keymap string) {
+	orderings := config.ListOrderings()
+	labels := make([]string, len(orderings))
+	for i, o := range orderings {
+		labels[i] = orderingLabels[o]
+	}
+
+	f.form = components.NewForm()
+	f.form.AddTextField("pageSize", "Page Size", "")
+	f.form.AddTextField("maxResults", "Max Results", "")
+	f.form.AddSelect("orderBy", "Default Ordering", labels)
+	f.form.AddSelect("keymap", "Keymap", config.Keymaps())
+	_ = f.form.SetValues(map[string]any{
+		"pageSize":   strconv.Itoa(settings.GetPageSize()),
+		"maxResults": strconv.Itoa(settings.GetMaxResults()),
+		"orderBy":    orderingLabels[settings.OrderBy],
+		"keymap":     keymap,
+	})
+
+	f.form.SetOnSubmit(func(values map[string]any) {
+		f.submit(values)
 	})
 	f.form.SetOnCancel(func() {
 		if f.onCancel != nil {
@@ -575,12 +948,72 @@ func (f *ProfileForm) SetProfile(name string, cfg config.ConnectionConfig) {
 	})
 
 	f.Modal.SetContent(f.form)
+	f.Modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Save"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	f.Modal.SetOnSubmit(func() {
+		f.submit(f.form.GetValues())
+	})
+	f.Modal.SetOnCancel(func() {
+		if f.onCancel != nil {
+			f.onCancel()
+		}
+	})
 }
 
-func (f *ProfileForm) SetOnSave(fn func(string, config.ConnectionConfig)) { f.onSave = fn }
-func (f *ProfileForm) SetOnCancel(fn func())                              { f.onCancel = fn }
+// submit validates the numeric fields, showing the error under the offending
+// field, and passes the settings to onSave.
+func (f *SettingsForm) submit(values map[string]any) {
+	pageSize, ok := f.positiveInt(values, "pageSize")
+	if !ok {
+		return
+	}
+	maxResults, ok := f.positiveInt(values, "maxResults")
+	if !ok {
+		return
+	}
 
-func (f *ProfileForm) Focus(delegate func(p tview.Primitive)) {
+	orderBy := config.OrderServerDefault
+	label, _ := values["orderBy"].(string)
+	for value, l := range orderingLabels {
+		if l == label {
+			orderBy = value
+			break
+		}
+	}
+
+	if f.onSave != nil {
+		f.onSave(config.ListSettings{
+			PageSize:   pageSize,
+			MaxResults: maxResults,
+			OrderBy:    orderBy,
+		}, values["keymap"].(string))
+	}
+}
+
+// positiveInt parses the named field as a positive integer, marking the field
+// with the error when it isn't one.
+func (f *SettingsForm) positiveInt(values map[string]any, name string) (int, bool) {
+	n, err := strconv.Atoi(strings.TrimSpace(values[name].(string)))
+	if err == nil && n > 0 {
+		return n, true
+	}
+	if field, ok := f.form.GetTextField(name); ok {
+		field.SetValidator(func(string) error {
+			field.SetValidator(nil)
+			return fmt.Errorf("must be a positive whole number")
+		})
+		_ = field.Validate()
+	}
+	return 0, false
+}
+
+func (f *SettingsForm) SetOnSave(fn func(config.ListSettings, string)) { f.onSave = fn }
+func (f *SettingsForm) SetOnCancel(fn func())                          { f.onCancel = fn }
+
+func (f *SettingsForm) Focus(delegate func(p tview.Primitive)) {
 	f.form.Focus(delegate)
 }
 
@@ -770,29 +1203,21 @@ func (m *InfoModal) InputHandler() func(*tcell.EventKey, func(tview.Primitive))
 }
 
 // ShowErrorModal displays an error modal and handles cleanup on close.
-func ShowErrorModal(app *layout.App, title, message string) {
+func ShowErrorModal(modals *ui.Modals, title, message string) {
 	modal := NewErrorModal(title, message)
 	modal.SetOnClose(func() {
-		app.Pages().RemovePage("error-modal")
-		if current := app.Pages().Current(); current != nil {
-			app.SetFocus(current)
-		}
+		modals.Close("error-modal")
 	})
-	app.Pages().AddPage("error-modal", modal, true, true)
-	app.SetFocus(modal)
+	modals.Show("error-modal", modal, nil)
 }
 
 // ShowInfoModal displays an info modal and handles cleanup on close.
-func ShowInfoModal(app *layout.App, title, message string) {
+func ShowInfoModal(modals *ui.Modals, title, message string) {
 	modal := NewInfoModal(title, message)
 	modal.SetOnClose(func() {
-		app.Pages().RemovePage("info-modal")
-		if current := app.Pages().Current(); current != nil {
-			app.SetFocus(current)
-		}
+		modals.Close("info-modal")
 	})
-	app.Pages().AddPage("info-modal", modal, true, true)
-	app.SetFocus(modal)
+	modals.Show("info-modal", modal, nil)
 }
 
 // SplashTestView displays a full-screen splash for testing themes and gradients.
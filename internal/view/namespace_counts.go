@@ -0,0 +1,115 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// namespaceCountsTTL is how long preview counts are reused before being fetched again.
+const namespaceCountsTTL = 30 * time.Second
+
+// namespaceCounts summarizes recent workflow activity in a namespace.
+type namespaceCounts struct {
+	Running         int64
+	FailedToday     int64
+	StartedThisWeek int64
+	Err             error
+	FetchedAt       time.Time
+}
+
+// countNamespaceWorkflows runs the summary count queries for a namespace concurrently.
+// "Today" starts at local midnight.
+func countNamespaceWorkflows(ctx context.Context, provider temporal.Provider, namespace string, now time.Time) namespaceCounts {
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	queries := []string{
+		`ExecutionStatus = "Running"`,
+		fmt.Sprintf(`ExecutionStatus = "Failed" AND CloseTime >= "%s"`, midnight.UTC().Format(time.RFC3339)),
+		fmt.Sprintf(`StartTime >= "%s"`, now.AddDate(0, 0, -7).UTC().Format(time.RFC3339)),
+	}
+
+	results := make([]int64, len(queries))
+	errs := make([]error, len(queries))
+	var wg sync.WaitGroup
+	for i, query := range queries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = provider.CountWorkflows(ctx, namespace, query)
+		}()
+	}
+	wg.Wait()
+
+	counts := namespaceCounts{
+		Running:         results[0],
+		FailedToday:     results[1],
+		StartedThisWeek: results[2],
+		FetchedAt:       now,
+	}
+	for _, err := range errs {
+		if err != nil {
+			counts.Err = err
+			break
+		}
+	}
+	return counts
+}
+
+// loadCounts fetches workflow counts for a namespace unless fresh ones are cached,
+// then refreshes the preview if the namespace is still selected.
+func (nl *NamespaceList) loadCounts(namespace string) {
+	provider := nl.app.Provider()
+	if provider == nil || nl.countsLoading[namespace] {
+		return
+	}
+	if c, ok := nl.counts[namespace]; ok && time.Since(c.FetchedAt) < namespaceCountsTTL {
+		return
+	}
+
+	nl.countsLoading[namespace] = true
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		counts := countNamespaceWorkflows(ctx, provider, namespace, time.Now())
+
+		nl.app.JigApp().QueueUpdateDraw(func() {
+			delete(nl.countsLoading, namespace)
+			nl.counts[namespace] = counts
+			if ns := nl.getSelectedNamespace(); ns != nil && ns.Name == namespace {
+				nl.updatePreview(*ns)
+			}
+		})
+	}()
+}
+
+// formatNamespaceCounts renders the workflow counts section of the preview.
+func (nl *NamespaceList) formatNamespaceCounts(namespace string) string {
+	if nl.app.Provider() == nil {
+		return ""
+	}
+
+	header := fmt.Sprintf("\n\n[%s::b]Workflows[-:-:-]\n", theme.TagFgDim())
+	counts, ok := nl.counts[namespace]
+	switch {
+	case !ok:
+		return header + fmt.Sprintf("  [%s]Loading...[-]", theme.TagFgDim())
+	case counts.Err != nil:
+		return header + fmt.Sprintf("  [%s]%s[-]", theme.TagError(), counts.Err.Error())
+	}
+
+	failedColor := theme.TagFg()
+	if counts.FailedToday > 0 {
+		failedColor = theme.StatusColorTag("Failed")
+	}
+	return header + fmt.Sprintf(`  [%s]%d[-] [%s]running[-]
+  [%s]%d[-] [%s]failed today[-]
+  [%s]%d[-] [%s]started this week[-]`,
+		theme.StatusColorTag("Running"), counts.Running, theme.TagFgDim(),
+		failedColor, counts.FailedToday, theme.TagFgDim(),
+		theme.TagFg(), counts.StartedThisWeek, theme.TagFgDim())
+}
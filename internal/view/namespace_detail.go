@@ -3,9 +3,8 @@ package view
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"sort"
 	"strings"
-	"time"
 
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/theme"
@@ -87,9 +86,6 @@ func (nd *NamespaceDetail) setup() {
 	// Main layout
 	nd.AddItem(leftFlex, 0, 1, true)
 	nd.AddItem(rightFlex, 0, 1, false)
-
-	// Show loading state initially
-	nd.infoView.SetText(fmt.Sprintf("\n [%s]Loading...[-]", theme.TagFgDim()))
 }
 
 func (nd *NamespaceDetail) loadData() {
@@ -100,13 +96,15 @@ func (nd *NamespaceDetail) loadData() {
 	}
 
 	nd.loading = true
+	ctx, cancel := context.WithCancel(context.Background())
+	done := nd.app.startLoading(nd.infoPanel, "Loading namespace", cancel)
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
 		detail, err := provider.DescribeNamespace(ctx, nd.namespace)
 
 		nd.app.JigApp().QueueUpdateDraw(func() {
+			done()
 			nd.loading = false
 			if err != nil {
 				nd.showError(err)
@@ -133,6 +131,9 @@ func (nd *NamespaceDetail) loadMockData() {
 		HistoryArchival:    "Disabled",
 		VisibilityArchival: "Disabled",
 		Clusters:           []string{"active"},
+		ActiveCluster:      "active",
+		ReplicationState:   "Normal",
+		Data:               map[string]string{"team": "platform"},
 	}
 	nd.render()
 }
@@ -184,6 +185,18 @@ func (nd *NamespaceDetail) render() {
 		theme.TagFgDim(), theme.TagFg(), nd.valueOrNA(d.OwnerEmail),
 		theme.TagFgDim(), theme.TagFgDim(), nd.valueOrNA(d.ID),
 	)
+	if len(d.Data) > 0 {
+		keys := make([]string, 0, len(d.Data))
+		for k := range d.Data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		infoText += fmt.Sprintf("\n\n[%s::b]Custom Data[-:-:-]", theme.TagFgDim())
+		for _, k := range keys {
+			infoText += fmt.Sprintf("\n  [%s]%s[-]  [%s]%s[-]",
+				theme.TagFgDim(), tview.Escape(k), theme.TagFg(), tview.Escape(d.Data[k]))
+		}
+	}
 	nd.infoView.SetText(infoText)
 
 	// Archival configuration
@@ -204,18 +217,33 @@ func (nd *NamespaceDetail) render() {
 		globalStr = "Yes"
 	}
 
-	clustersStr := "None"
+	clustersStr := "  None"
 	if len(d.Clusters) > 0 {
-		clustersStr = strings.Join(d.Clusters, ", ")
+		lines := make([]string, len(d.Clusters))
+		for i, cluster := range d.Clusters {
+			if cluster == d.ActiveCluster {
+				lines[i] = fmt.Sprintf("  [%s]● %s (active)[-]", theme.StatusColorTag("Running"), tview.Escape(cluster))
+			} else {
+				lines[i] = fmt.Sprintf("  [%s]○ %s[-]", theme.TagFg(), tview.Escape(cluster))
+			}
+		}
+		clustersStr = strings.Join(lines, "\n")
 	}
 
 	clusterText := fmt.Sprintf(`
-[%s::b]Global Namespace[-:-:-]  [%s]%s[-]
-[%s::b]Failover Version[-:-:-]  [%s]%d[-]
-[%s::b]Clusters[-:-:-]          [%s]%s[-]`,
+[%s::b]Global Namespace[-:-:-]   [%s]%s[-]
+[%s::b]Active Cluster[-:-:-]     [%s]%s[-]
+[%s::b]Replication State[-:-:-]  [%s]%s[-]
+[%s::b]Failover Version[-:-:-]   [%s]%d[-]
+
+[%s::b]Clusters[-:-:-]
+%s`,
 		theme.TagFgDim(), theme.TagFg(), globalStr,
+		theme.TagFgDim(), theme.TagFg(), nd.valueOrNA(d.ActiveCluster),
+		theme.TagFgDim(), theme.TagFg(), nd.valueOrNA(d.ReplicationState),
 		theme.TagFgDim(), theme.TagFg(), d.FailoverVersion,
-		theme.TagFgDim(), theme.TagFg(), clustersStr,
+		theme.TagFgDim(),
+		clustersStr,
 	)
 	nd.clusterView.SetText(clusterText)
 }
@@ -271,6 +299,11 @@ func (nd *NamespaceDetail) Start() {
 		case 'D':
 			nd.showDeprecateConfirm()
 			return nil
+		case 'F':
+			if nd.detail != nil {
+				showFailoverNamespaceForm(nd.app, nd.detail, nd.loadData)
+			}
+			return nil
 		}
 		return event
 	})
@@ -293,6 +326,9 @@ func (nd *NamespaceDetail) Hints() []KeyHint {
 	if nd.detail != nil && nd.detail.State == "Active" {
 		hints = append(hints, KeyHint{Key: "D", Description: "Deprecate"})
 	}
+	if nd.detail != nil && nd.detail.IsGlobalNamespace && len(nd.detail.Clusters) > 1 {
+		hints = append(hints, KeyHint{Key: "F", Description: "Failover"})
+	}
 
 	hints = append(hints,
 		KeyHint{Key: "T", Description: "Theme"},
@@ -317,244 +353,20 @@ func (nd *NamespaceDetail) Draw(screen tcell.Screen) {
 	nd.Flex.Draw(screen)
 }
 
-// Edit functionality - implemented using jig components
-
 func (nd *NamespaceDetail) showEditForm() {
 	if nd.detail == nil {
 		return
 	}
-
-	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Edit Namespace", theme.IconNamespace),
-		Width:    70,
-		Height:   18,
-		Backdrop: true,
-	})
-
-	// Parse current retention period (e.g., "72h0m0s" -> 3)
-	currentRetention := 3
-	if nd.detail.RetentionPeriod != "" {
-		if dur, err := time.ParseDuration(nd.detail.RetentionPeriod); err == nil {
-			currentRetention = int(dur.Hours() / 24)
-		}
-	}
-
-	form := components.NewForm()
-	form.AddTextField("description", "Description", nd.detail.Description)
-	form.AddTextField("ownerEmail", "Owner Email", nd.detail.OwnerEmail)
-	form.AddTextField("retention", "Retention (days)", strconv.Itoa(currentRetention))
-
-	form.SetOnSubmit(func(values map[string]any) {
-		retentionStr := values["retention"].(string)
-		retentionDays, err := strconv.Atoi(retentionStr)
-		if err != nil || retentionDays < 1 {
-			return // Invalid retention
-		}
-
-		updateReq := temporal.NamespaceUpdateRequest{
-			Name:          nd.namespace,
-			Description:   values["description"].(string),
-			OwnerEmail:    values["ownerEmail"].(string),
-			RetentionDays: retentionDays,
-		}
-		nd.closeModal("edit-form")
-		nd.showUpdateConfirm(updateReq)
-	})
-	form.SetOnCancel(func() {
-		nd.closeModal("edit-form")
-	})
-
-	modal.SetContent(form)
-	modal.SetHints([]components.KeyHint{
-		{Key: "Tab", Description: "Next field"},
-		{Key: "Enter", Description: "Save"},
-		{Key: "Esc", Description: "Cancel"},
-	})
-	modal.SetOnSubmit(func() {
-		values := form.GetValues()
-		retentionStr := values["retention"].(string)
-		retentionDays, err := strconv.Atoi(retentionStr)
-		if err != nil || retentionDays < 1 {
-			return
-		}
-
-		updateReq := temporal.NamespaceUpdateRequest{
-			Name:          nd.namespace,
-			Description:   values["description"].(string),
-			OwnerEmail:    values["ownerEmail"].(string),
-			RetentionDays: retentionDays,
-		}
-		nd.closeModal("edit-form")
-		nd.showUpdateConfirm(updateReq)
-	})
-	modal.SetOnCancel(func() {
-		nd.closeModal("edit-form")
-	})
-
-	nd.app.JigApp().Pages().AddPage("edit-form", modal, true, true)
-	nd.app.JigApp().SetFocus(form)
-}
-
-func (nd *NamespaceDetail) showUpdateConfirm(req temporal.NamespaceUpdateRequest) {
-	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Confirm Update", theme.IconWarning),
-		Width:    65,
-		Height:   14,
-		Backdrop: true,
-	})
-
-	// Show what will be updated
-	contentFlex := tview.NewFlex().SetDirection(tview.FlexRow)
-	contentFlex.SetBackgroundColor(theme.Bg())
-
-	changesText := tview.NewTextView().
-		SetDynamicColors(true).
-		SetTextAlign(tview.AlignLeft)
-	changesText.SetBackgroundColor(theme.Bg())
-	changesText.SetText(fmt.Sprintf(`[%s]Update namespace:[-] [%s]%s[-]
-
-[%s]Description:[-]   [%s]%s[-]
-[%s]Owner Email:[-]   [%s]%s[-]
-[%s]Retention:[-]     [%s]%d days[-]`,
-		theme.TagAccent(), theme.TagFg(), req.Name,
-		theme.TagFgDim(), theme.TagFg(), req.Description,
-		theme.TagFgDim(), theme.TagFg(), req.OwnerEmail,
-		theme.TagFgDim(), theme.TagFg(), req.RetentionDays))
-
-	contentFlex.AddItem(changesText, 0, 1, true)
-
-	modal.SetContent(contentFlex)
-	modal.SetHints([]components.KeyHint{
-		{Key: "Enter", Description: "Update"},
-		{Key: "Esc", Description: "Cancel"},
-	})
-	modal.SetOnSubmit(func() {
-		nd.closeModal("update-confirm")
-		nd.executeUpdate(req)
-	})
-	modal.SetOnCancel(func() {
-		nd.closeModal("update-confirm")
-	})
-
-	nd.app.JigApp().Pages().AddPage("update-confirm", modal, true, true)
-}
-
-func (nd *NamespaceDetail) executeUpdate(req temporal.NamespaceUpdateRequest) {
-	provider := nd.app.Provider()
-	if provider == nil {
-		return
-	}
-
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		err := provider.UpdateNamespace(ctx, req)
-
-		nd.app.JigApp().QueueUpdateDraw(func() {
-			if err != nil {
-				nd.showError(err)
-				return
-			}
-			nd.loadData() // Refresh to show updated values
-		})
-	}()
+	showEditNamespaceForm(nd.app, nd.detail.Namespace, nd.loadData)
 }
 
 func (nd *NamespaceDetail) showDeprecateConfirm() {
 	if nd.detail == nil {
 		return
 	}
-
-	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Deprecate Namespace", theme.IconError),
-		Width:    70,
-		Height:   16,
-		Backdrop: true,
-	})
-
-	contentFlex := tview.NewFlex().SetDirection(tview.FlexRow)
-	contentFlex.SetBackgroundColor(theme.Bg())
-
-	warningText := tview.NewTextView().
-		SetDynamicColors(true).
-		SetTextAlign(tview.AlignLeft)
-	warningText.SetBackgroundColor(theme.Bg())
-	warningText.SetText(fmt.Sprintf(`[%s]Warning: Deprecating a namespace has the following effects:[-]
-
-• New workflows cannot be started in this namespace
-• Existing workflows will continue to run normally
-• This action may be difficult to reverse
-
-[%s]Namespace:[-] [%s]%s[-]`,
-		theme.TagError(),
-		theme.TagFgDim(), theme.TagFg(), nd.namespace))
-
-	form := components.NewForm()
-	form.AddTextField("confirm", "Type namespace name to confirm", "")
-	form.SetOnSubmit(func(values map[string]any) {
-		confirm := values["confirm"].(string)
-		if confirm != nd.namespace {
-			return // Must match namespace name
-		}
-		nd.closeModal("deprecate-confirm")
-		nd.executeDeprecate()
-	})
-	form.SetOnCancel(func() {
-		nd.closeModal("deprecate-confirm")
-	})
-
-	contentFlex.AddItem(warningText, 8, 0, false)
-	contentFlex.AddItem(form, 0, 1, true)
-
-	modal.SetContent(contentFlex)
-	modal.SetHints([]components.KeyHint{
-		{Key: "Enter", Description: "Deprecate"},
-		{Key: "Esc", Description: "Cancel"},
-	})
-	modal.SetOnSubmit(func() {
-		values := form.GetValues()
-		confirm := values["confirm"].(string)
-		if confirm != nd.namespace {
-			return
-		}
-		nd.closeModal("deprecate-confirm")
-		nd.executeDeprecate()
-	})
-	modal.SetOnCancel(func() {
-		nd.closeModal("deprecate-confirm")
-	})
-
-	nd.app.JigApp().Pages().AddPage("deprecate-confirm", modal, true, true)
-	nd.app.JigApp().SetFocus(form)
-}
-
-func (nd *NamespaceDetail) executeDeprecate() {
-	provider := nd.app.Provider()
-	if provider == nil {
-		return
-	}
-
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		err := provider.DeprecateNamespace(ctx, nd.namespace)
-
-		nd.app.JigApp().QueueUpdateDraw(func() {
-			if err != nil {
-				nd.showError(err)
-				return
-			}
-			nd.loadData() // Refresh to show updated state
-		})
-	}()
+	showDeprecateNamespaceConfirm(nd.app, nd.namespace, nd.loadData)
 }
 
 func (nd *NamespaceDetail) closeModal(name string) {
-	nd.app.JigApp().Pages().RemovePage(name)
-	// Restore focus to current view
-	if current := nd.app.JigApp().Pages().Current(); current != nil {
-		nd.app.JigApp().SetFocus(current)
-	}
+	nd.app.Modals().Close(name)
 }
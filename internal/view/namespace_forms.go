@@ -0,0 +1,365 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// defaultNamespaceRetentionDays is the retention offered for new namespaces.
+const defaultNamespaceRetentionDays = 3
+
+// namespaceRetentionDays parses a retention period as listed by the provider, such
+// as "3 days" or "1 day". It reports false for periods that aren't whole days.
+func namespaceRetentionDays(retention string) (int, bool) {
+	fields := strings.Fields(retention)
+	if len(fields) == 2 && strings.HasPrefix(fields[1], "day") {
+		if days, err := strconv.Atoi(fields[0]); err == nil && days > 0 {
+			return days, true
+		}
+	}
+	return 0, false
+}
+
+// closeAppModal closes a modal page, restoring the focus it opened over.
+func closeAppModal(app *App, name string) {
+	app.Modals().Close(name)
+}
+
+// showCreateNamespaceForm shows a form for registering a namespace, then calls onDone.
+func showCreateNamespaceForm(app *App, onDone func()) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Create Namespace", theme.IconNamespace),
+		Width:    70,
+		Height:   18,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("name", "Name", "")
+	form.AddTextField("description", "Description", "")
+	form.AddTextField("ownerEmail", "Owner Email", "")
+	form.AddTextField("retention", "Retention (days)", "")
+	_ = form.SetValues(map[string]any{
+		"retention": strconv.Itoa(defaultNamespaceRetentionDays),
+	})
+
+	submit := func(values map[string]any) {
+		name := strings.TrimSpace(values["name"].(string))
+		if name == "" {
+			app.ShowToastError("Namespace name is required")
+			return
+		}
+		retentionDays, err := strconv.Atoi(strings.TrimSpace(values["retention"].(string)))
+		if err != nil || retentionDays < 1 {
+			app.ShowToastError("Retention must be at least 1 day")
+			return
+		}
+		req := temporal.NamespaceCreateRequest{
+			Name:          name,
+			Description:   strings.TrimSpace(values["description"].(string)),
+			OwnerEmail:    strings.TrimSpace(values["ownerEmail"].(string)),
+			RetentionDays: retentionDays,
+		}
+		closeAppModal(app, "namespace-form")
+		runNamespaceOperation(app, "Create Namespace Failed", fmt.Sprintf("Created namespace %s", name),
+			func(ctx context.Context, provider temporal.Provider) error {
+				return provider.CreateNamespace(ctx, req)
+			}, onDone)
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		closeAppModal(app, "namespace-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Create"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		closeAppModal(app, "namespace-form")
+	})
+
+	app.Modals().Show("namespace-form", modal, form)
+}
+
+// showEditNamespaceForm shows a form prefilled with a namespace's description, owner
+// and retention, and asks for confirmation before updating it.
+func showEditNamespaceForm(app *App, ns temporal.Namespace, onDone func()) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Edit Namespace: %s", theme.IconNamespace, ns.Name),
+		Width:    70,
+		Height:   18,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("description", "Description", "")
+	form.AddTextField("ownerEmail", "Owner Email", "")
+	// A retention that isn't whole days can't be shown in the field, so it is
+	// left empty, which keeps the retention as it is
+	retention := ""
+	if days, ok := namespaceRetentionDays(ns.RetentionPeriod); ok {
+		retention = strconv.Itoa(days)
+	}
+	form.AddTextField("retention", "Retention (days)", "Unchanged: "+ns.RetentionPeriod)
+	_ = form.SetValues(map[string]any{
+		"description": ns.Description,
+		"ownerEmail":  ns.OwnerEmail,
+		"retention":   retention,
+	})
+
+	submit := func(values map[string]any) {
+		retentionDays := 0
+		if value := strings.TrimSpace(values["retention"].(string)); value != "" {
+			days, err := strconv.Atoi(value)
+			if err != nil || days < 1 {
+				app.ShowToastError("Retention must be at least 1 day")
+				return
+			}
+			retentionDays = days
+		}
+		req := temporal.NamespaceUpdateRequest{
+			Name:          ns.Name,
+			Description:   strings.TrimSpace(values["description"].(string)),
+			OwnerEmail:    strings.TrimSpace(values["ownerEmail"].(string)),
+			RetentionDays: retentionDays,
+		}
+		closeAppModal(app, "namespace-form")
+		showNamespaceUpdateConfirm(app, req, onDone)
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		closeAppModal(app, "namespace-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Save"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		closeAppModal(app, "namespace-form")
+	})
+
+	app.Modals().Show("namespace-form", modal, form)
+}
+
+func showNamespaceUpdateConfirm(app *App, req temporal.NamespaceUpdateRequest, onDone func()) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Confirm Update", theme.IconWarning),
+		Width:    65,
+		Height:   14,
+		Backdrop: true,
+	})
+
+	changesText := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignLeft)
+	changesText.SetBackgroundColor(theme.Bg())
+	retention := "unchanged"
+	if req.RetentionDays > 0 {
+		retention = fmt.Sprintf("%d days", req.RetentionDays)
+	}
+	changesText.SetText(fmt.Sprintf(`[%s]Update namespace:[-] [%s]%s[-]
+
+[%s]Description:[-]   [%s]%s[-]
+[%s]Owner Email:[-]   [%s]%s[-]
+[%s]Retention:[-]     [%s]%s[-]`,
+		theme.TagAccent(), theme.TagFg(), tview.Escape(req.Name),
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(req.Description),
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(req.OwnerEmail),
+		theme.TagFgDim(), theme.TagFg(), retention))
+
+	modal.SetContent(changesText)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Update"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		closeAppModal(app, "update-confirm")
+		runNamespaceOperation(app, "Update Namespace Failed", fmt.Sprintf("Updated namespace %s", req.Name),
+			func(ctx context.Context, provider temporal.Provider) error {
+				return provider.UpdateNamespace(ctx, req)
+			}, onDone)
+	})
+	modal.SetOnCancel(func() {
+		closeAppModal(app, "update-confirm")
+	})
+
+	app.Modals().Show("update-confirm", modal, nil)
+}
+
+// showDeprecateNamespaceConfirm confirms deprecating a namespace, then calls
+// onDone.
+func showDeprecateNamespaceConfirm(app *App, name string, onDone func()) {
+	warning := fmt.Sprintf(`[%s]Warning: Deprecating a namespace has the following effects:[-]
+
+• New workflows cannot be started in this namespace
+• Existing workflows will continue to run normally
+• This action may be difficult to reverse
+
+[%s]Namespace:[-] [%s]%s[-]`,
+		theme.TagError(),
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(name))
+
+	showTypedNamespaceConfirm(app, config.OpNamespaceDeprecate, "deprecate-confirm", "Deprecate Namespace", warning, "Deprecate", name, func() {
+		runNamespaceOperation(app, "Deprecate Namespace Failed", fmt.Sprintf("Deprecated namespace %s", name),
+			func(ctx context.Context, provider temporal.Provider) error {
+				return provider.DeprecateNamespace(ctx, name)
+			}, onDone)
+	})
+}
+
+// showDeleteNamespaceConfirm confirms deleting a namespace through the operator
+// API, then calls onDone.
+func showDeleteNamespaceConfirm(app *App, name string, onDone func()) {
+	warning := fmt.Sprintf(`[%s]Warning: Deleting a namespace is permanent:[-]
+
+• All workflows in the namespace are terminated and removed
+• Schedules, task queues and history are deleted
+• This action cannot be undone
+
+[%s]Namespace:[-] [%s]%s[-]`,
+		theme.TagError(),
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(name))
+
+	showTypedNamespaceConfirm(app, config.OpNamespaceDelete, "delete-confirm", "Delete Namespace", warning, "Delete", name, func() {
+		runNamespaceOperation(app, "Delete Namespace Failed", fmt.Sprintf("Deleting namespace %s", name),
+			func(ctx context.Context, provider temporal.Provider) error {
+				return provider.DeleteNamespace(ctx, name)
+			}, onDone)
+	})
+}
+
+// showTypedNamespaceConfirm shows a warning and runs onConfirm as the profile's
+// confirmation policy for op says, asking for the namespace name in typed mode.
+func showTypedNamespaceConfirm(app *App, op, page, title, warning, action, name string, onConfirm func()) {
+	app.confirmDestructive(destructiveConfirm{
+		Op:            op,
+		Page:          page,
+		Title:         fmt.Sprintf("%s %s", theme.IconError, title),
+		Width:         70,
+		Warning:       warning,
+		WarningHeight: 8,
+		Action:        action,
+		TypedLabel:    "Type namespace name to confirm",
+		Expected:      name,
+	}, onConfirm)
+}
+
+// runNamespaceOperation runs op against the provider, reporting failures in a
+// modal and success in a toast before calling onDone.
+func runNamespaceOperation(app *App, failureTitle, success string, op func(context.Context, temporal.Provider) error, onDone func()) {
+	provider := app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err := op(ctx, provider)
+
+		app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(app.Modals(), failureTitle, err.Error())
+				return
+			}
+			app.ShowToastSuccess(success)
+			if onDone != nil {
+				onDone()
+			}
+		})
+	}()
+}
+
+// showFailoverNamespaceForm picks the cluster to make active for a global namespace
+// and asks for the namespace name to be typed before failing over, then calls onDone.
+func showFailoverNamespaceForm(app *App, ns *temporal.NamespaceDetail, onDone func()) {
+	var targets []string
+	for _, cluster := range ns.Clusters {
+		if cluster != ns.ActiveCluster {
+			targets = append(targets, cluster)
+		}
+	}
+	if !ns.IsGlobalNamespace || len(targets) == 0 {
+		app.ShowToastWarning("Failover needs a global namespace replicated to another cluster")
+		return
+	}
+	if len(targets) == 1 {
+		showFailoverNamespaceConfirm(app, ns, targets[0], onDone)
+		return
+	}
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Failover Namespace: %s", theme.IconWarning, ns.Name),
+		Width:    70,
+		Height:   10,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddSelect("cluster", "New Active Cluster", targets)
+	submit := func(values map[string]any) {
+		closeAppModal(app, "failover-form")
+		showFailoverNamespaceConfirm(app, ns, values["cluster"].(string), onDone)
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		closeAppModal(app, "failover-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Continue"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		closeAppModal(app, "failover-form")
+	})
+
+	app.Modals().Show("failover-form", modal, form)
+}
+
+func showFailoverNamespaceConfirm(app *App, ns *temporal.NamespaceDetail, cluster string, onDone func()) {
+	name := ns.Name
+	from := valueOrEmpty(ns.ActiveCluster, "the current cluster")
+	warning := fmt.Sprintf(`[%s::b]FAILOVER: %s → %s[-:-:-]
+[%s]Workers and clients in %s stop processing this namespace's tasks.
+Workflows in flight may be delayed while replication catches up.[-]
+
+[%s]Namespace:[-] [%s]%s[-]
+[%s]CLI:[-] [%s]%s[-]`,
+		theme.TagError(), tview.Escape(from), tview.Escape(cluster),
+		theme.TagError(), tview.Escape(from),
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(name),
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(temporal.NamespaceFailoverCommand(name, cluster)))
+
+	showTypedNamespaceConfirm(app, config.OpNamespaceFailover, "failover-confirm", "Failover Namespace", warning, "Failover", name, func() {
+		runNamespaceOperation(app, "Failover Failed", fmt.Sprintf("Failed over %s to %s", name, cluster),
+			func(ctx context.Context, provider temporal.Provider) error {
+				return provider.FailoverNamespace(ctx, name, cluster)
+			}, onDone)
+	})
+}
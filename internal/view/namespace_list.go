@@ -27,18 +27,22 @@ type NamespaceList struct {
 	showPreview   bool
 	refreshTicker *time.Ticker
 	stopRefresh   chan struct{}
+	counts        map[string]namespaceCounts // Workflow counts by namespace
+	countsLoading map[string]bool
 }
 
 // NewNamespaceList creates a new namespace list view.
 func NewNamespaceList(app *App) *NamespaceList {
 	nl := &NamespaceList{
-		Flex:        tview.NewFlex().SetDirection(tview.FlexColumn),
-		table:       components.NewTable(),
-		preview:     tview.NewTextView(),
-		app:         app,
-		namespaces:  []temporal.Namespace{},
-		showPreview: true,
-		stopRefresh: make(chan struct{}),
+		Flex:          tview.NewFlex().SetDirection(tview.FlexColumn),
+		table:         components.NewTable(),
+		preview:       tview.NewTextView(),
+		app:           app,
+		namespaces:    []temporal.Namespace{},
+		showPreview:   true,
+		stopRefresh:   make(chan struct{}),
+		counts:        make(map[string]namespaceCounts),
+		countsLoading: make(map[string]bool),
 	}
 	nl.setup()
 	return nl
@@ -91,13 +95,20 @@ func (nl *NamespaceList) setup() {
 func (nl *NamespaceList) buildLayout() {
 	nl.Clear()
 	if nl.showPreview {
-		nl.AddItem(nl.leftPanel, 0, 3, true)
-		nl.AddItem(nl.rightPanel, 0, 2, false)
+		ratio := nl.app.panelRatio(nl.Name(), namespaceListRatio)
+		nl.AddItem(nl.leftPanel, 0, ratio, true)
+		nl.AddItem(nl.rightPanel, 0, 100-ratio, false)
 	} else {
 		nl.AddItem(nl.leftPanel, 0, 1, true)
 	}
 }
 
+// resizePanels moves the divider between the list and the preview.
+func (nl *NamespaceList) resizePanels(key rune) {
+	nl.app.resizePanels(nl.Name(), namespaceListRatio, panelResizeDelta(key))
+	nl.buildLayout()
+}
+
 func (nl *NamespaceList) togglePreview() {
 	nl.showPreview = !nl.showPreview
 	nl.buildLayout()
@@ -154,7 +165,8 @@ func (nl *NamespaceList) updatePreview(ns temporal.Namespace) {
 		theme.TagFgDim(),
 		theme.TagFg(), valueOrEmpty(ns.OwnerEmail, "No owner"),
 	)
-	nl.preview.SetText(text)
+	nl.preview.SetText(text + nl.formatNamespaceCounts(ns.Name))
+	nl.loadCounts(ns.Name)
 }
 
 func valueOrEmpty(s, fallback string) string {
@@ -176,19 +188,21 @@ func (nl *NamespaceList) loadData() {
 	}
 
 	nl.setLoading(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := nl.app.startLoading(nl.leftPanel, "Loading namespaces", cancel)
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
 		namespaces, err := provider.ListNamespaces(ctx)
 
 		nl.app.JigApp().QueueUpdateDraw(func() {
+			done()
 			nl.setLoading(false)
 			if err != nil {
 				nl.showError(err)
 				return
 			}
-			nl.namespaces = namespaces
+			nl.namespaces = nl.app.allowedNamespaces(namespaces)
 			nl.populateTable()
 		})
 	}()
@@ -302,11 +316,17 @@ func (nl *NamespaceList) Start() {
 			nl.toggleAutoRefresh()
 			return nil
 		case 'r':
+			nl.counts = make(map[string]namespaceCounts)
 			nl.loadData()
 			return nil
 		case 'p':
 			nl.togglePreview()
 			return nil
+		case '<', '>', '=':
+			if nl.showPreview {
+				nl.resizePanels(event.Rune())
+			}
+			return nil
 		case 'i':
 			ns := nl.getSelectedNamespace()
 			if ns != nil {
@@ -314,16 +334,31 @@ func (nl *NamespaceList) Start() {
 			}
 			return nil
 		case 'n':
-			// TODO: Create namespace form
+			showCreateNamespaceForm(nl.app, nl.loadData)
 			return nil
 		case 'e':
-			// TODO: Edit namespace form
+			if ns := nl.getSelectedNamespace(); ns != nil {
+				showEditNamespaceForm(nl.app, *ns, nl.loadData)
+			}
 			return nil
 		case 'D':
-			// TODO: Deprecate confirm
+			if ns := nl.getSelectedNamespace(); ns != nil && ns.State != "Deprecated" {
+				showDeprecateNamespaceConfirm(nl.app, ns.Name, nl.loadData)
+			}
 			return nil
 		case 'X':
-			// TODO: Delete confirm
+			if ns := nl.getSelectedNamespace(); ns != nil && ns.State == "Deprecated" {
+				showDeleteNamespaceConfirm(nl.app, ns.Name, nl.loadData)
+			}
+			return nil
+		case 'd':
+			nl.app.NavigateToDashboard()
+			return nil
+		case 'N':
+			nl.app.NavigateToNexusEndpoints()
+			return nil
+		case '/':
+			nl.app.NavigateToGlobalSearch("")
 			return nil
 		case 'S':
 			ns := nl.getSelectedNamespace()
@@ -331,6 +366,12 @@ func (nl *NamespaceList) Start() {
 				nl.showSignalWithStart(ns.Name)
 			}
 			return nil
+		case 'm':
+			nl.showActionMenu()
+			return nil
+		case 'z':
+			nl.app.toggleZen(nl.rightPanel, nl.preview, nl.app.zenTextHints()...)
+			return nil
 		}
 		return event
 	})
@@ -361,7 +402,13 @@ func (nl *NamespaceList) Hints() []KeyHint {
 
 	hints = append(hints,
 		KeyHint{Key: "S", Description: "Signal+Start"},
+		KeyHint{Key: "m", Description: "Menu"},
+		KeyHint{Key: "d", Description: "Dashboard"},
+		KeyHint{Key: "N", Description: "Nexus"},
+		KeyHint{Key: "/", Description: "Search all"},
 		KeyHint{Key: "p", Description: "Preview"},
+		KeyHint{Key: "z", Description: "Maximize Preview"},
+		KeyHint{Key: "</>", Description: "Resize"},
 		KeyHint{Key: "r", Description: "Refresh"},
 		KeyHint{Key: "a", Description: "Auto-refresh"},
 		KeyHint{Key: "T", Description: "Theme"},
@@ -398,6 +445,34 @@ func (nl *NamespaceList) getSelectedNamespace() *temporal.Namespace {
 	return nil
 }
 
+// showActionMenu opens the action menu of the selected namespace.
+func (nl *NamespaceList) showActionMenu() {
+	ns := nl.getSelectedNamespace()
+	if ns == nil {
+		return
+	}
+	name := ns.Name
+
+	actions := []rowAction{
+		{Label: "Workflows", Key: "enter", Run: func() { nl.app.NavigateToWorkflows(name) }},
+		{Label: "Describe", Key: "i", Run: func() { nl.app.NavigateToNamespaceDetail(name) }},
+		{Label: "Edit", Key: "e", Run: func() { showEditNamespaceForm(nl.app, *ns, nl.loadData) }},
+	}
+	if ns.State == "Deprecated" {
+		actions = append(actions, rowAction{Label: "Delete", Key: "X", Run: func() { showDeleteNamespaceConfirm(nl.app, name, nl.loadData) }})
+	} else {
+		actions = append(actions, rowAction{Label: "Deprecate", Key: "D", Run: func() { showDeprecateNamespaceConfirm(nl.app, name, nl.loadData) }})
+	}
+	actions = append(actions,
+		rowAction{Label: "Signal+Start", Key: "S", Run: func() { nl.showSignalWithStart(name) }},
+		rowAction{Label: "Copy Name", Run: func() { nl.app.CopyToClipboard(name, "Namespace") }},
+		rowAction{Label: "Open in Web UI", Run: func() {
+			nl.app.OpenInWebUI(nl.app.webProfile().NamespaceWebURL(name))
+		}},
+	)
+	nl.app.showActionMenu("Namespace "+name, actions)
+}
+
 // showSignalWithStart displays a modal for SignalWithStart operation.
 func (nl *NamespaceList) showSignalWithStart(namespace string) {
 	modal := components.NewModal(components.ModalConfig{
@@ -460,8 +535,7 @@ func (nl *NamespaceList) showSignalWithStart(namespace string) {
 		nl.closeModal("signal-with-start")
 	})
 
-	nl.app.JigApp().Pages().AddPage("signal-with-start", modal, true, true)
-	nl.app.JigApp().SetFocus(form)
+	nl.app.Modals().Show("signal-with-start", modal, form)
 }
 
 // executeSignalWithStart performs the SignalWithStart operation asynchronously.
@@ -472,7 +546,7 @@ func (nl *NamespaceList) executeSignalWithStart(namespace, workflowID, workflowT
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		req := temporal.SignalWithStartRequest{
@@ -493,11 +567,11 @@ func (nl *NamespaceList) executeSignalWithStart(namespace, workflowID, workflowT
 
 		nl.app.JigApp().QueueUpdateDraw(func() {
 			if err != nil {
-				ShowErrorModal(nl.app.JigApp(), "SignalWithStart Failed", err.Error())
+				ShowErrorModal(nl.app.Modals(), "SignalWithStart Failed", err.Error())
 				return
 			}
 
-			ShowInfoModal(nl.app.JigApp(), "SignalWithStart Successful",
+			ShowInfoModal(nl.app.Modals(), "SignalWithStart Successful",
 				fmt.Sprintf("Workflow: %s\nRun ID: %s", workflowID, runID))
 		})
 	}()
@@ -505,8 +579,5 @@ func (nl *NamespaceList) executeSignalWithStart(namespace, workflowID, workflowT
 
 // closeModal removes a modal page and restores focus to the current view.
 func (nl *NamespaceList) closeModal(name string) {
-	nl.app.JigApp().Pages().RemovePage(name)
-	if current := nl.app.JigApp().Pages().Current(); current != nil {
-		nl.app.JigApp().SetFocus(current)
-	}
+	nl.app.Modals().Close(name)
 }
@@ -0,0 +1,71 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/atterpac/jig/theme"
+)
+
+// ShowNamespaceSwitcher opens a picker that fuzzy-filters namespaces and
+// switches the current view to the chosen one.
+func (a *App) ShowNamespaceSwitcher() {
+	a.showFuzzyPicker(fuzzyPicker{
+		page:        "namespace-picker",
+		title:       fmt.Sprintf("%s Switch Namespace", theme.IconInfo),
+		placeholder: "Type to filter namespaces...",
+		headers:     []string{"", "NAMESPACE"},
+		row: func(item paletteItem) []string {
+			return []string{item.Detail, item.Label}
+		},
+		items: a.namespaceSwitcherItems,
+	})
+}
+
+// namespaceSwitcherItems lists the namespaces offered by the switcher, marking
+// the current one.
+func (a *App) namespaceSwitcherItems() []paletteItem {
+	items := make([]paletteItem, 0, len(a.commandNamespaces))
+	for _, ns := range a.commandNamespaces {
+		item := paletteItem{Label: ns, Run: func() { a.switchNamespace(ns) }}
+		if ns == a.currentNS {
+			item.Detail = "●"
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// switchNamespace shows the current namespace-scoped view (workflows, task
+// queues, schedules or deployments) for another namespace, on top of the home
+// view. Other views switch to the namespace's workflows.
+func (a *App) switchNamespace(ns string) {
+	if !a.NamespaceAllowed(ns) {
+		a.toasts.Warning(fmt.Sprintf("Namespace %q is not allowed by profile %q", ns, a.activeProfile))
+		return
+	}
+
+	name := ""
+	if named, ok := a.app.Pages().Current().(interface{ Name() string }); ok {
+		name = named.Name()
+	}
+
+	// Drop the views of the old namespace, keeping the home view
+	if stack := a.viewStack(); len(stack) > 1 {
+		a.clearPages()
+		a.app.Pages().Push(stack[0])
+	}
+
+	switch name {
+	case "task-queues":
+		a.SetNamespace(ns)
+		a.NavigateToTaskQueues()
+	case "schedules":
+		a.SetNamespace(ns)
+		a.NavigateToSchedules()
+	case "worker-deployments":
+		a.SetNamespace(ns)
+		a.NavigateToWorkerDeployments()
+	default:
+		a.NavigateToWorkflows(ns)
+	}
+}
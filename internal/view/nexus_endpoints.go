@@ -0,0 +1,447 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// Nexus endpoint target types offered in the endpoint form.
+const (
+	nexusTargetWorker   = "Worker"
+	nexusTargetExternal = "External URL"
+)
+
+// NexusEndpointsView lists the cluster's Nexus endpoints with details of the
+// selected one, and creates, edits and deletes them.
+type NexusEndpointsView struct {
+	*tview.Flex
+	app         *App
+	endpoints   []temporal.NexusEndpoint
+	loading     bool
+	table       *components.Table
+	detail      *tview.TextView
+	listPanel   *components.Panel
+	detailPanel *components.Panel
+}
+
+// NewNexusEndpointsView creates a new Nexus endpoints view.
+func NewNexusEndpointsView(app *App) *NexusEndpointsView {
+	nv := &NexusEndpointsView{
+		Flex:   tview.NewFlex().SetDirection(tview.FlexColumn),
+		app:    app,
+		table:  components.NewTable(),
+		detail: tview.NewTextView(),
+	}
+	nv.setup()
+	return nv
+}
+
+func (nv *NexusEndpointsView) setup() {
+	nv.SetBackgroundColor(theme.Bg())
+
+	nv.table.SetHeaders("NAME", "TARGET", "MODIFIED")
+	nv.table.SetBorder(false)
+	nv.table.SetBackgroundColor(theme.Bg())
+
+	nv.detail.SetDynamicColors(true)
+	nv.detail.SetWordWrap(true)
+	nv.detail.SetBackgroundColor(theme.Bg())
+
+	nv.listPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Nexus Endpoints", theme.IconServer))
+	nv.listPanel.SetContent(nv.table)
+
+	nv.detailPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Details", theme.IconInfo))
+	nv.detailPanel.SetContent(nv.detail)
+
+	nv.table.SetSelectionChangedFunc(func(row, col int) {
+		if row > 0 && row-1 < len(nv.endpoints) {
+			nv.renderDetail(nv.endpoints[row-1])
+		}
+	})
+
+	nv.AddItem(nv.listPanel, 0, 3, true)
+	nv.AddItem(nv.detailPanel, 0, 2, false)
+}
+
+func (nv *NexusEndpointsView) loadData() {
+	provider := nv.app.Provider()
+	if provider == nil {
+		nv.loadMockData()
+		return
+	}
+	if nv.loading {
+		return
+	}
+
+	nv.loading = true
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		endpoints, err := provider.ListNexusEndpoints(ctx)
+
+		nv.app.JigApp().QueueUpdateDraw(func() {
+			nv.loading = false
+			if err != nil {
+				nv.showError(err)
+				return
+			}
+			nv.endpoints = endpoints
+			nv.render()
+		})
+	}()
+}
+
+func (nv *NexusEndpointsView) loadMockData() {
+	now := time.Now()
+	nv.endpoints = []temporal.NexusEndpoint{
+		{ID: "mock-1", Version: 3, Name: "payments", Description: "Payment operations", TargetNamespace: "payments", TargetTaskQueue: "payments-nexus", URLPrefix: "/nexus/endpoints/mock-1", CreateTime: now.Add(-30 * 24 * time.Hour), LastModifiedTime: now.Add(-2 * time.Hour)},
+		{ID: "mock-2", Version: 1, Name: "shipping_partner", TargetURL: "https://nexus.partner.example.com", URLPrefix: "/nexus/endpoints/mock-2", CreateTime: now.Add(-7 * 24 * time.Hour)},
+	}
+	nv.render()
+}
+
+func (nv *NexusEndpointsView) showError(err error) {
+	nv.table.ClearRows()
+	nv.table.SetHeaders("NAME", "TARGET", "MODIFIED")
+	nv.table.AddRowWithColor(theme.Error(), theme.IconError+" Error loading endpoints", err.Error(), "")
+	nv.detail.SetText("")
+}
+
+func (nv *NexusEndpointsView) render() {
+	now := time.Now()
+	currentRow := nv.table.SelectedRow()
+	nv.table.ClearRows()
+	nv.table.SetHeaders("NAME", "TARGET", "MODIFIED")
+
+	for _, e := range nv.endpoints {
+		modified := e.LastModifiedTime
+		if modified.IsZero() {
+			modified = e.CreateTime
+		}
+		nv.table.AddRow(e.Name, e.Target(), formatTime(now, modified))
+	}
+	nv.listPanel.SetTitle(fmt.Sprintf("%s Nexus Endpoints (%d)", theme.IconServer, len(nv.endpoints)))
+
+	if len(nv.endpoints) == 0 {
+		nv.table.AddRowWithColor(theme.FgDim(), "(no nexus endpoints, press n to create one)", "", "")
+		nv.detail.SetText("")
+		return
+	}
+	if currentRow < 0 || currentRow >= len(nv.endpoints) {
+		currentRow = 0
+	}
+	nv.table.SelectRow(currentRow)
+	nv.renderDetail(nv.endpoints[currentRow])
+}
+
+func (nv *NexusEndpointsView) renderDetail(e temporal.NexusEndpoint) {
+	target := fmt.Sprintf(`[%s]Namespace[-]   [%s]%s[-]
+[%s]Task Queue[-]  [%s]%s[-]`,
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(e.TargetNamespace),
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(e.TargetTaskQueue))
+	if e.TargetURL != "" {
+		target = fmt.Sprintf("[%s]URL[-]  [%s]%s[-]", theme.TagFgDim(), theme.TagFg(), tview.Escape(e.TargetURL))
+	}
+
+	modified := "-"
+	if !e.LastModifiedTime.IsZero() {
+		modified = formatTimestamp(e.LastModifiedTime)
+	}
+	created := "-"
+	if !e.CreateTime.IsZero() {
+		created = formatTimestamp(e.CreateTime)
+	}
+
+	nv.detail.SetText(fmt.Sprintf(`[%s::b]Endpoint[-:-:-]
+[%s]%s[-]
+
+[%s::b]Target[-:-:-]
+%s
+
+[%s::b]Description[-:-:-]
+[%s]%s[-]
+
+[%s]ID[-]          [%s]%s[-]
+[%s]Version[-]     [%s]%d[-]
+[%s]URL Prefix[-]  [%s]%s[-]
+[%s]Created[-]     [%s]%s[-]
+[%s]Modified[-]    [%s]%s[-]`,
+		theme.TagAccent(),
+		theme.TagFg(), tview.Escape(e.Name),
+		theme.TagAccent(),
+		target,
+		theme.TagAccent(),
+		theme.TagFg(), tview.Escape(valueOrEmpty(e.Description, "No description")),
+		theme.TagFgDim(), theme.TagFgDim(), e.ID,
+		theme.TagFgDim(), theme.TagFg(), e.Version,
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(valueOrDash(e.URLPrefix)),
+		theme.TagFgDim(), theme.TagFg(), created,
+		theme.TagFgDim(), theme.TagFg(), modified))
+	nv.detail.ScrollToBeginning()
+}
+
+func (nv *NexusEndpointsView) getSelectedEndpoint() *temporal.NexusEndpoint {
+	row := nv.table.SelectedRow()
+	if row >= 0 && row < len(nv.endpoints) {
+		return &nv.endpoints[row]
+	}
+	return nil
+}
+
+// showEndpointForm shows a form for creating an endpoint, or editing existing when set.
+func (nv *NexusEndpointsView) showEndpointForm(existing *temporal.NexusEndpoint) {
+	title := fmt.Sprintf("%s New Nexus Endpoint", theme.IconServer)
+	if existing != nil {
+		title = fmt.Sprintf("%s Edit Nexus Endpoint: %s", theme.IconServer, existing.Name)
+	}
+	modal := components.NewModal(components.ModalConfig{
+		Title:    title,
+		Width:    80,
+		Height:   20,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("name", "Name", "")
+	form.AddTextField("description", "Description (Markdown, optional)", "")
+	form.AddSelect("targetType", "Target", []string{nexusTargetWorker, nexusTargetExternal})
+	form.AddTextField("targetNamespace", "Target Namespace (worker target)", "")
+	form.AddTextField("targetTaskQueue", "Target Task Queue (worker target)", "")
+	form.AddTextField("targetUrl", "URL (external target)", "")
+
+	values := map[string]any{
+		"targetType":      nexusTargetWorker,
+		"targetNamespace": nv.app.CurrentNamespace(),
+	}
+	if existing != nil {
+		values = map[string]any{
+			"name":            existing.Name,
+			"description":     existing.Description,
+			"targetType":      nexusTargetWorker,
+			"targetNamespace": existing.TargetNamespace,
+			"targetTaskQueue": existing.TargetTaskQueue,
+			"targetUrl":       existing.TargetURL,
+		}
+		if existing.TargetURL != "" {
+			values["targetType"] = nexusTargetExternal
+		}
+	}
+	_ = form.SetValues(values)
+
+	submit := func(values map[string]any) {
+		req := temporal.NexusEndpointRequest{
+			Name:        strings.TrimSpace(values["name"].(string)),
+			Description: strings.TrimSpace(values["description"].(string)),
+		}
+		if values["targetType"] == nexusTargetExternal {
+			req.TargetURL = strings.TrimSpace(values["targetUrl"].(string))
+			if req.TargetURL == "" {
+				nv.app.ShowToastError("URL is required for an external target")
+				return
+			}
+		} else {
+			req.TargetNamespace = strings.TrimSpace(values["targetNamespace"].(string))
+			req.TargetTaskQueue = strings.TrimSpace(values["targetTaskQueue"].(string))
+		}
+		if err := req.Validate(); err != nil {
+			nv.app.ShowToastError(err.Error())
+			return
+		}
+		nv.closeModal("nexus-form")
+		if existing != nil {
+			if existing.Name != req.Name {
+				nv.app.ShowToastWarning("Renaming an endpoint breaks callers that reference the old name")
+			}
+			nv.executeSave(req, existing.ID, existing.Version)
+		} else {
+			nv.executeSave(req, "", 0)
+		}
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		nv.closeModal("nexus-form")
+	})
+
+	action := "Create"
+	if existing != nil {
+		action = "Save"
+	}
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: action},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		nv.closeModal("nexus-form")
+	})
+
+	nv.app.Modals().Show("nexus-form", modal, form)
+}
+
+// executeSave creates the endpoint, or updates it when id is set.
+func (nv *NexusEndpointsView) executeSave(req temporal.NexusEndpointRequest, id string, version int64) {
+	provider := nv.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var err error
+		if id == "" {
+			err = provider.CreateNexusEndpoint(ctx, req)
+		} else {
+			err = provider.UpdateNexusEndpoint(ctx, id, version, req)
+		}
+
+		nv.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(nv.app.Modals(), "Save Nexus Endpoint Failed", err.Error())
+				return
+			}
+			nv.app.ShowToastSuccess(fmt.Sprintf("Saved nexus endpoint %s", req.Name))
+			nv.loadData()
+		})
+	}()
+}
+
+// showDeleteConfirm asks for the endpoint name to be typed before deleting it.
+func (nv *NexusEndpointsView) showDeleteConfirm() {
+	selected := nv.getSelectedEndpoint()
+	if selected == nil {
+		return
+	}
+	endpoint := *selected
+
+	nv.app.confirmDestructive(destructiveConfirm{
+		Op:    config.OpNexusDelete,
+		Page:  "nexus-delete-confirm",
+		Title: fmt.Sprintf("%s Delete Nexus Endpoint", theme.IconError),
+		Width: 80,
+		Warning: fmt.Sprintf(`[%s]Warning: Workflows calling this endpoint will fail to start operations.
+This action cannot be undone.[-]
+
+[%s]Endpoint:[-] [%s]%s[-] [%s]→ %s[-]
+[%s]CLI:[-] [%s]%s[-]`,
+			theme.TagError(),
+			theme.TagFgDim(), theme.TagFg(), tview.Escape(endpoint.Name), theme.TagFgDim(), tview.Escape(endpoint.Target()),
+			theme.TagFgDim(), theme.TagFg(), tview.Escape(temporal.NexusEndpointDeleteCommand(endpoint.Name))),
+		WarningHeight: 6,
+		Action:        "Delete",
+		TypedLabel:    "Type endpoint name to confirm",
+		Expected:      endpoint.Name,
+	}, func() {
+		nv.executeDelete(endpoint)
+	})
+}
+
+func (nv *NexusEndpointsView) executeDelete(endpoint temporal.NexusEndpoint) {
+	provider := nv.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err := provider.DeleteNexusEndpoint(ctx, endpoint.ID, endpoint.Version)
+
+		nv.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(nv.app.Modals(), "Delete Nexus Endpoint Failed", err.Error())
+				return
+			}
+			nv.app.ShowToastSuccess(fmt.Sprintf("Deleted nexus endpoint %s", endpoint.Name))
+			nv.loadData()
+		})
+	}()
+}
+
+func (nv *NexusEndpointsView) closeModal(name string) {
+	nv.app.Modals().Close(name)
+}
+
+// RefreshTheme updates all component colors after a theme change.
+func (nv *NexusEndpointsView) RefreshTheme() {
+	bg := theme.Bg()
+	nv.SetBackgroundColor(bg)
+	nv.table.SetBackgroundColor(bg)
+	nv.detail.SetBackgroundColor(bg)
+	nv.render()
+}
+
+// Name returns the view name.
+func (nv *NexusEndpointsView) Name() string {
+	return "nexus-endpoints"
+}
+
+// Start is called when the view becomes active.
+func (nv *NexusEndpointsView) Start() {
+	nv.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 'r':
+			nv.loadData()
+			return nil
+		case 'n':
+			nv.showEndpointForm(nil)
+			return nil
+		case 'e':
+			if endpoint := nv.getSelectedEndpoint(); endpoint != nil {
+				nv.showEndpointForm(endpoint)
+			}
+			return nil
+		case 'D':
+			nv.showDeleteConfirm()
+			return nil
+		}
+		return event
+	})
+	nv.loadData()
+}
+
+// Stop is called when the view is deactivated.
+func (nv *NexusEndpointsView) Stop() {
+	nv.table.SetInputCapture(nil)
+}
+
+// Hints returns keybinding hints for this view.
+func (nv *NexusEndpointsView) Hints() []KeyHint {
+	return []KeyHint{
+		{Key: "n", Description: "New"},
+		{Key: "e", Description: "Edit"},
+		{Key: "D", Description: "Delete"},
+		{Key: "r", Description: "Refresh"},
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "esc", Description: "Back"},
+	}
+}
+
+// Focus sets focus to the endpoint table.
+func (nv *NexusEndpointsView) Focus(delegate func(p tview.Primitive)) {
+	delegate(nv.table)
+}
+
+// Draw applies theme colors dynamically and draws the view.
+func (nv *NexusEndpointsView) Draw(screen tcell.Screen) {
+	bg := theme.Bg()
+	nv.SetBackgroundColor(bg)
+	nv.detail.SetBackgroundColor(bg)
+	nv.Flex.Draw(screen)
+}
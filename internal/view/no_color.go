@@ -0,0 +1,27 @@
+package view
+
+import (
+	"os"
+
+	"github.com/rivo/tview"
+)
+
+// noColor returns whether no-color mode is on: colors are dropped, statuses get
+// text markers and borders are drawn with ASCII.
+func (a *App) noColor() bool {
+	if a.config == nil {
+		return os.Getenv("NO_COLOR") != ""
+	}
+	return a.config.NoColorEnabled()
+}
+
+// useASCIIBorders draws tview borders with ASCII characters instead of box
+// drawing, which screen readers announce and basic terminals lack.
+func useASCIIBorders() {
+	b := &tview.Borders
+	b.Horizontal, b.HorizontalFocus = '-', '='
+	b.Vertical, b.VerticalFocus = '|', '|'
+	b.TopLeft, b.TopRight, b.BottomLeft, b.BottomRight = '+', '+', '+', '+'
+	b.TopLeftFocus, b.TopRightFocus, b.BottomLeftFocus, b.BottomRightFocus = '+', '+', '+', '+'
+	b.LeftT, b.RightT, b.TopT, b.BottomT, b.Cross = '+', '+', '+', '+', '+'
+}
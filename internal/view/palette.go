@@ -0,0 +1,275 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// maxRecentWorkflows is the number of recently viewed workflows offered in the palette.
+const maxRecentWorkflows = 15
+
+// recentWorkflow is a workflow opened in the detail view this session.
+type recentWorkflow struct {
+	Namespace  string
+	WorkflowID string
+	RunID      string
+}
+
+// recordRecentWorkflow remembers a viewed workflow, most recent first.
+func (a *App) recordRecentWorkflow(namespace, workflowID, runID string) {
+	recent := []recentWorkflow{{Namespace: namespace, WorkflowID: workflowID, RunID: runID}}
+	for _, r := range a.recentWorkflows {
+		if r.Namespace == namespace && r.WorkflowID == workflowID {
+			continue
+		}
+		recent = append(recent, r)
+	}
+	if len(recent) > maxRecentWorkflows {
+		recent = recent[:maxRecentWorkflows]
+	}
+	a.recentWorkflows = recent
+}
+
+// paletteItem is an entry in the command palette.
+type paletteItem struct {
+	Kind   string
+	Label  string
+	Detail string
+	Run    func()
+}
+
+// paletteItems collects the palette entries: recent workflows, views, actions,
+// profiles, aliases, namespaces and saved filters.
+func (a *App) paletteItems() []paletteItem {
+	var items []paletteItem
+	for _, r := range a.recentWorkflows {
+		items = append(items, paletteItem{Kind: "Workflow", Label: r.WorkflowID, Detail: r.Namespace, Run: func() {
+			a.NavigateToWorkflows(r.Namespace)
+			if a.currentNS != r.Namespace {
+				return // Refused, with a toast saying why
+			}
+			a.NavigateToWorkflowDetail(r.WorkflowID, r.RunID)
+		}})
+	}
+
+	items = append(items,
+		paletteItem{Kind: "View", Label: "Namespaces", Detail: ":ns", Run: a.reinitializeViews},
+		paletteItem{Kind: "View", Label: "Workflows", Detail: ":wf", Run: func() { a.NavigateToWorkflows(a.currentNS) }},
+		paletteItem{Kind: "View", Label: "Global Search", Detail: ":search", Run: func() { a.NavigateToGlobalSearch("") }},
+		paletteItem{Kind: "View", Label: "Task Queues", Detail: ":tq", Run: a.NavigateToTaskQueues},
+		paletteItem{Kind: "View", Label: "Schedules", Detail: ":schedules", Run: a.NavigateToSchedules},
+		paletteItem{Kind: "View", Label: "Worker Deployments", Detail: ":deployments", Run: a.NavigateToWorkerDeployments},
+		paletteItem{Kind: "View", Label: "Dashboard", Detail: ":dashboard", Run: a.NavigateToDashboard},
+		paletteItem{Kind: "View", Label: "Nexus Endpoints", Detail: ":nexus", Run: a.NavigateToNexusEndpoints},
+		paletteItem{Kind: "Action", Label: "Switch Namespace", Detail: "Ctrl+E", Run: a.ShowNamespaceSwitcher},
+		paletteItem{Kind: "Action", Label: "History", Detail: "Alt+H", Run: a.ShowHistory},
+		paletteItem{Kind: "Action", Label: "Go Forward", Detail: "Alt+→", Run: a.GoForward},
+		paletteItem{Kind: "Action", Label: "New Tab", Detail: "Ctrl+T", Run: a.NewTab},
+		paletteItem{Kind: "Action", Label: "Close Tab", Detail: "Ctrl+W", Run: a.CloseTab},
+		paletteItem{Kind: "Action", Label: "Change Theme", Detail: "T", Run: a.showThemeSelector},
+		paletteItem{Kind: "Action", Label: "Switch Profile", Detail: "P", Run: a.ShowProfileSelector},
+		paletteItem{Kind: "Action", Label: "New Profile", Detail: ":profile new", Run: func() { a.showProfileForm("") }},
+		paletteItem{Kind: "Action", Label: "Edit Profile", Detail: ":profile edit", Run: func() { a.showProfileForm(a.activeProfile) }},
+		paletteItem{Kind: "Action", Label: "Settings", Detail: "O", Run: a.showSettings},
+		paletteItem{Kind: "Action", Label: "Toggle Mouse", Detail: ":mouse", Run: a.toggleMouse},
+		paletteItem{Kind: "Action", Label: "Cycle Time Display", Detail: "Alt+T", Run: a.cycleTimeDisplay},
+		paletteItem{Kind: "Action", Label: "Command Bar", Detail: ":", Run: a.showCommandBar},
+		paletteItem{Kind: "Action", Label: "Help", Detail: "?", Run: a.showHelp},
+	)
+
+	if a.config != nil {
+		for _, name := range a.config.ListProfiles() {
+			if name != a.activeProfile {
+				items = append(items, paletteItem{Kind: "Profile", Label: name, Detail: a.config.Profiles[name].Address, Run: func() { a.SwitchProfile(name) }})
+			}
+		}
+		for _, name := range a.aliasNames() {
+			items = append(items, paletteItem{Kind: "Alias", Label: name, Detail: a.config.Aliases[name], Run: func() { a.handleCommand(name) }})
+		}
+	}
+	for _, ns := range a.commandNamespaces {
+		items = append(items, paletteItem{Kind: "Namespace", Label: ns, Run: func() { a.NavigateToWorkflows(ns) }})
+	}
+	if a.config != nil {
+		for _, filter := range a.config.GetSavedFilters() {
+			items = append(items, paletteItem{Kind: "Filter", Label: filter.Name, Detail: filter.Query, Run: func() { a.NavigateToWorkflowsWithQuery(filter.Query) }})
+		}
+	}
+	return items
+}
+
+// fuzzyMatch reports whether the characters of pattern appear in order in text,
+// ignoring case. Matches at word starts and consecutive matches score higher;
+// shorter texts win ties.
+func fuzzyMatch(pattern, text string) (int, bool) {
+	p := []rune(strings.ToLower(pattern))
+	t := []rune(strings.ToLower(text))
+	if len(p) == 0 {
+		return 0, true
+	}
+
+	score, pi, prev := 0, 0, -2
+	for ti := 0; ti < len(t) && pi < len(p); ti++ {
+		if t[ti] != p[pi] {
+			continue
+		}
+		score++
+		if ti == prev+1 {
+			score += 3
+		}
+		if ti == 0 || !unicode.IsLetter(t[ti-1]) && !unicode.IsDigit(t[ti-1]) {
+			score += 2
+		}
+		prev = ti
+		pi++
+	}
+	if pi < len(p) {
+		return 0, false
+	}
+	return score*100 - len(t), true
+}
+
+// ShowCommandPalette opens a palette that fuzzy-matches views, actions, profiles,
+// namespaces, saved filters and recently viewed workflows, running the selection
+// on Enter.
+func (a *App) ShowCommandPalette() {
+	a.showFuzzyPicker(fuzzyPicker{
+		page:        "palette-modal",
+		title:       fmt.Sprintf("%s Command Palette", theme.IconInfo),
+		placeholder: "Type to search views, actions, namespaces, filters, workflows...",
+		headers:     []string{"TYPE", "NAME", "DETAIL"},
+		row: func(item paletteItem) []string {
+			return []string{item.Kind, item.Label, truncateStr(item.Detail, 40)}
+		},
+		items: a.paletteItems,
+	})
+}
+
+// fuzzyPicker configures a modal that fuzzy-filters items as you type.
+type fuzzyPicker struct {
+	page        string // Modal page name
+	title       string
+	placeholder string
+	headers     []string
+	row         func(paletteItem) []string // Table cells for an item; the first is dimmed
+	items       func() []paletteItem
+}
+
+// showFuzzyPicker opens a fuzzy picker and runs the selected item on Enter.
+// Namespaces are fetched on demand, so the items are rebuilt once they load.
+func (a *App) showFuzzyPicker(p fuzzyPicker) {
+	items := p.items()
+	var matches []paletteItem
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:     p.title,
+		Width:     90,
+		Height:    22,
+		MinHeight: 10,
+		Backdrop:  true,
+	})
+
+	input := tview.NewInputField().SetLabel("> ")
+	input.SetFieldBackgroundColor(theme.Bg())
+	input.SetBackgroundColor(theme.Bg())
+	input.SetLabelColor(theme.Accent())
+	input.SetPlaceholder(p.placeholder)
+	input.SetPlaceholderTextColor(theme.FgMuted())
+
+	table := components.NewTable()
+	table.SetHeaders(p.headers...)
+	table.SetBackgroundColor(theme.Bg())
+
+	refresh := func() {
+		pattern := strings.TrimSpace(input.GetText())
+		type scored struct {
+			item  paletteItem
+			score int
+		}
+		var found []scored
+		for _, item := range items {
+			if score, ok := fuzzyMatch(pattern, item.Label+" "+item.Kind); ok {
+				found = append(found, scored{item, score})
+			}
+		}
+		if pattern != "" {
+			sort.SliceStable(found, func(i, j int) bool { return found[i].score > found[j].score })
+		}
+
+		matches = matches[:0]
+		table.ClearRows()
+		for _, f := range found {
+			matches = append(matches, f.item)
+			table.AddRow(p.row(f.item)...)
+			table.GetCell(table.GetRowCount()-1, 0).SetTextColor(theme.FgDim())
+		}
+		if len(matches) > 0 {
+			table.SelectRow(0)
+		}
+	}
+	input.SetChangedFunc(func(string) { refresh() })
+
+	closePicker := func() {
+		a.modals.Close(p.page)
+	}
+	move := func(delta int) {
+		if len(matches) == 0 {
+			return
+		}
+		row := (table.SelectedRow() + delta + len(matches)) % len(matches)
+		table.SelectRow(row)
+	}
+
+	input.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyUp, tcell.KeyCtrlP, tcell.KeyBacktab:
+			move(-1)
+			return nil
+		case tcell.KeyDown, tcell.KeyCtrlN, tcell.KeyTab:
+			move(1)
+			return nil
+		case tcell.KeyEnter:
+			row := table.SelectedRow()
+			if row < 0 || row >= len(matches) {
+				return nil
+			}
+			closePicker()
+			matches[row].Run()
+			return nil
+		case tcell.KeyEscape:
+			closePicker()
+			return nil
+		}
+		return event
+	})
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow)
+	content.SetBackgroundColor(theme.Bg())
+	content.AddItem(input, 1, 0, true)
+	content.AddItem(table, 0, 1, false)
+
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "↑/↓", Description: "Navigate"},
+		{Key: "Enter", Description: "Select"},
+		{Key: "Esc", Description: "Close"},
+	})
+	modal.SetOnCancel(closePicker)
+
+	refresh()
+	a.modals.Show(p.page, modal, input)
+
+	go a.loadCommandNamespaces(func() {
+		if a.modals.IsOpen(p.page) {
+			items = p.items()
+			refresh()
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/galaxy-io/tempo/internal/config"
+)
+
+// panelResizeStep is how far, in percent, < and > move the divider of a split
+// view.
+const panelResizeStep = 5
+
+// Default share, in percent, of the first panel of each split view.
+const (
+	namespaceListRatio  = 60 // Namespaces vs preview
+	workflowListRatio   = 60 // Workflows vs preview
+	workflowDetailRatio = 40 // Workflow and event detail vs events
+)
+
+// panelRatio returns the share, in percent, of the first panel of a split view:
+// the one saved in the config, or def.
+func (a *App) panelRatio(view string, def int) int {
+	if a.config == nil {
+		return def
+	}
+	return a.config.PanelRatio(view, def)
+}
+
+// resizePanels moves the divider of a split view by delta percent, or back to
+// def when delta is zero, and saves the new share of the first panel.
+func (a *App) resizePanels(view string, def, delta int) int {
+	ratio := def
+	if delta != 0 {
+		ratio = min(max(a.panelRatio(view, def)+delta, config.MinPanelRatio), config.MaxPanelRatio)
+	}
+	if a.config == nil {
+		return ratio
+	}
+	a.config.SetPanelRatio(view, ratio)
+	if err := a.config.Save(); err != nil {
+		a.toasts.Error(fmt.Sprintf("Failed to save panel sizes: %v", err))
+	}
+	return ratio
+}
+
+// panelResizeDelta maps the resize keys to how far they move a divider: < and
+// > shrink and grow the first panel, = restores the default.
+func panelResizeDelta(key rune) int {
+	switch key {
+	case '<':
+		return -panelResizeStep
+	case '>':
+		return panelResizeStep
+	}
+	return 0
+}
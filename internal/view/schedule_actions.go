@@ -0,0 +1,127 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// showRecentActions loads the selected schedule's recent actions and lists them.
+func (sl *ScheduleList) showRecentActions() {
+	schedule := sl.getSelectedSchedule()
+	provider := sl.app.Provider()
+	if schedule == nil || provider == nil {
+		return
+	}
+	scheduleID := schedule.ID
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		detail, err := provider.GetSchedule(ctx, sl.namespace, scheduleID)
+
+		sl.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(sl.app.Modals(), "Failed to Load Schedule", err.Error())
+				return
+			}
+			sl.showActionsPicker(scheduleID, detail.Actions)
+		})
+	}()
+}
+
+func (sl *ScheduleList) showActionsPicker(scheduleID string, actions []temporal.ScheduleAction) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:     fmt.Sprintf("%s Recent Actions of %s (%d)", theme.IconSchedule, truncateStr(scheduleID, 40), len(actions)),
+		Width:     100,
+		Height:    20,
+		MinHeight: 10,
+		Backdrop:  true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders("SCHEDULED", "STARTED", "WORKFLOW ID", "RUN ID")
+	table.SetBackgroundColor(theme.Bg())
+	for _, action := range actions {
+		table.AddRow(
+			formatTimestamp(action.ScheduleTime),
+			formatTimestamp(action.ActualTime),
+			action.WorkflowID,
+			action.RunID,
+		)
+	}
+
+	openWorkflow := func() {
+		row := table.SelectedRow()
+		if row < 0 || row >= len(actions) {
+			return
+		}
+		sl.closeModal("actions-picker")
+		sl.app.NavigateToWorkflowDetail(actions[row].WorkflowID, actions[row].RunID)
+	}
+	listWorkflows := func() {
+		sl.closeModal("actions-picker")
+		sl.app.NavigateToWorkflowsWithQuery(temporal.ScheduledWorkflowsQuery(scheduleID, time.Time{}, time.Time{}))
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			openWorkflow()
+			return nil
+		case tcell.KeyEscape:
+			sl.closeModal("actions-picker")
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'w':
+				listWorkflows()
+				return nil
+			case 'q':
+				sl.closeModal("actions-picker")
+				return nil
+			}
+		}
+		return event
+	})
+
+	focus := tview.Primitive(table)
+	if len(actions) == 0 {
+		empty := tview.NewTextView().SetDynamicColors(true)
+		empty.SetBackgroundColor(theme.Bg())
+		empty.SetText(fmt.Sprintf("\n [%s]This schedule has not started any workflows recently. Press w to search all of its workflows.[-]", theme.TagFgDim()))
+		empty.SetInputCapture(table.GetInputCapture())
+		modal.SetContent(empty)
+		focus = empty
+	} else {
+		modal.SetContent(table)
+		table.SelectRow(0)
+	}
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "Enter", Description: "Open workflow"},
+		{Key: "w", Description: "All workflows"},
+		{Key: "Esc", Description: "Close"},
+	})
+	modal.SetOnCancel(func() {
+		sl.closeModal("actions-picker")
+	})
+
+	sl.app.Modals().Show("actions-picker", modal, focus)
+}
+
+// showScheduledWorkflows lists every workflow started by the selected schedule.
+func (sl *ScheduleList) showScheduledWorkflows() {
+	schedule := sl.getSelectedSchedule()
+	if schedule == nil {
+		return
+	}
+	sl.app.NavigateToWorkflowsWithQuery(temporal.ScheduledWorkflowsQuery(schedule.ID, time.Time{}, time.Time{}))
+}
@@ -0,0 +1,178 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// backfillTimeLayout is the local time format used in backfill forms.
+const backfillTimeLayout = "2006-01-02 15:04"
+
+// scheduleDefaultOverlap labels the choice that keeps the schedule's own overlap policy.
+const scheduleDefaultOverlap = "Schedule default"
+
+// showBackfillForm asks for the time range and overlap policy to backfill the
+// selected schedule with.
+func (sl *ScheduleList) showBackfillForm() {
+	schedule := sl.getSelectedSchedule()
+	if schedule == nil {
+		return
+	}
+	scheduleID := schedule.ID
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Backfill Schedule: %s", theme.IconSchedule, scheduleID),
+		Width:    75,
+		Height:   15,
+		Backdrop: true,
+	})
+
+	now := time.Now()
+	form := components.NewForm()
+	form.AddTextField("start", "Start (YYYY-MM-DD HH:MM, RFC3339 or -24h)", "")
+	form.AddTextField("end", "End", "")
+	form.AddSelect("overlap", "Overlap Policy", append([]string{scheduleDefaultOverlap}, temporal.ScheduleOverlapPolicies...))
+	_ = form.SetValues(map[string]any{
+		"start": now.Add(-24 * time.Hour).Format(backfillTimeLayout),
+		"end":   now.Format(backfillTimeLayout),
+	})
+
+	submit := func(values map[string]any) {
+		start, err := parseTimeInput(values["start"].(string), now)
+		if err != nil {
+			sl.app.ShowToastError(err.Error())
+			return
+		}
+		end, err := parseTimeInput(values["end"].(string), now)
+		if err != nil {
+			sl.app.ShowToastError(err.Error())
+			return
+		}
+		if !end.After(start) {
+			sl.app.ShowToastError("Backfill end must be after start")
+			return
+		}
+		overlap := values["overlap"].(string)
+		if overlap == scheduleDefaultOverlap {
+			overlap = ""
+		}
+
+		sl.closeModal("backfill-form")
+		sl.confirmScheduleAction(
+			fmt.Sprintf("%s Backfill Schedule", theme.IconSchedule),
+			fmt.Sprintf("Backfill %s from %s to %s?", scheduleID,
+				start.Format(backfillTimeLayout), end.Format(backfillTimeLayout)),
+			temporal.ScheduleBackfillCommand(sl.namespace, scheduleID, start, end, overlap),
+			"Backfill",
+			func() {
+				sl.executeBackfill(scheduleID, start, end, overlap)
+			})
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		sl.closeModal("backfill-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Preview"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		sl.closeModal("backfill-form")
+	})
+
+	sl.app.Modals().Show("backfill-form", modal, form)
+}
+
+// parseTimeInput parses a local "YYYY-MM-DD HH:MM" or "YYYY-MM-DD" time, an RFC3339
+// time, "now", or a negative duration relative to now such as "-6h".
+func parseTimeInput(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case s == "" || strings.EqualFold(s, "now"):
+		return now, nil
+	case strings.HasPrefix(s, "-"):
+		if d, err := time.ParseDuration(s); err == nil {
+			return now.Add(d), nil
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{backfillTimeLayout, "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
+
+func (sl *ScheduleList) executeBackfill(scheduleID string, start, end time.Time, overlap string) {
+	provider := sl.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err := provider.BackfillSchedule(ctx, sl.namespace, scheduleID, start, end, overlap)
+
+		sl.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(sl.app.Modals(), "Backfill Failed", err.Error())
+				return
+			}
+			sl.showBackfillResult(scheduleID, start, end)
+			sl.loadData()
+		})
+	}()
+}
+
+// showBackfillResult confirms the backfill and offers to list the workflows it starts.
+func (sl *ScheduleList) showBackfillResult(scheduleID string, start, end time.Time) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Backfill Requested", theme.IconCompleted),
+		Width:    70,
+		Height:   10,
+		Backdrop: true,
+	})
+
+	text := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWordWrap(true)
+	text.SetBackgroundColor(theme.Bg())
+	text.SetText(fmt.Sprintf(`[%s]%s will start workflows for %s to %s.[-]
+
+[%s]The server starts backfilled runs asynchronously, so they may take a moment to appear.[-]`,
+		theme.TagFg(), tview.Escape(scheduleID), start.Format(backfillTimeLayout), end.Format(backfillTimeLayout),
+		theme.TagFgDim()))
+
+	modal.SetContent(text)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "View Workflows"},
+		{Key: "Esc", Description: "Close"},
+	})
+	modal.SetOnSubmit(func() {
+		sl.closeModal("backfill-result")
+		sl.app.NavigateToWorkflowsWithQuery(temporal.ScheduledWorkflowsQuery(scheduleID, start, end))
+	})
+	modal.SetOnCancel(func() {
+		sl.closeModal("backfill-result")
+	})
+
+	sl.app.Modals().Show("backfill-result", modal, nil)
+}
@@ -0,0 +1,63 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// showDeleteScheduleConfirm confirms deleting the schedule, then calls onDeleted.
+// Workflows the schedule already started keep running.
+func showDeleteScheduleConfirm(app *App, namespace string, schedule temporal.Schedule, onDeleted func()) {
+	app.confirmDestructive(destructiveConfirm{
+		Op:    config.OpScheduleDelete,
+		Page:  "delete-confirm",
+		Title: fmt.Sprintf("%s Delete Schedule", theme.IconError),
+		Width: 80,
+		Warning: fmt.Sprintf(`[%s]Warning: This will permanently delete the schedule.
+This action cannot be undone. Running workflows are not affected.[-]
+
+[%s]Schedule:[-] [%s]%s[-]
+[%s]Workflow:[-] [%s]%s[-]
+[%s]CLI:[-] [%s]%s[-]`,
+			theme.TagError(),
+			theme.TagFgDim(), theme.TagFg(), tview.Escape(schedule.ID),
+			theme.TagFgDim(), theme.TagFg(), tview.Escape(schedule.WorkflowType),
+			theme.TagFgDim(), theme.TagFg(), tview.Escape(temporal.ScheduleDeleteCommand(namespace, schedule.ID))),
+		WarningHeight: 7,
+		Action:        "Delete",
+		TypedLabel:    "Type schedule ID to confirm",
+		Expected:      schedule.ID,
+	}, func() {
+		deleteSchedule(app, namespace, schedule.ID, onDeleted)
+	})
+}
+
+func deleteSchedule(app *App, namespace, scheduleID string, onDeleted func()) {
+	provider := app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err := provider.DeleteSchedule(ctx, namespace, scheduleID)
+
+		app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(app.Modals(), "Delete Schedule Failed", err.Error())
+				return
+			}
+			app.ShowToastSuccess(fmt.Sprintf("Deleted schedule %s", scheduleID))
+			if onDeleted != nil {
+				onDeleted()
+			}
+		})
+	}()
+}
@@ -0,0 +1,257 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// scheduleSpecHelp explains the accepted spec formats in schedule forms.
+const scheduleSpecHelp = `Spec (e.g. "every 1h" or "0 9 * * MON-FRI")`
+
+// showCreateScheduleForm shows a form for creating a schedule that starts a workflow.
+func (sl *ScheduleList) showCreateScheduleForm() {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s New Schedule", theme.IconSchedule),
+		Width:    80,
+		Height:   26,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("id", "Schedule ID", "")
+	form.AddTextField("spec", scheduleSpecHelp, "every 1h")
+	form.AddTextField("workflowType", "Workflow Type", "")
+	form.AddTextField("workflowId", "Workflow ID (defaults to the schedule ID)", "")
+	form.AddTextField("taskQueue", "Task Queue", "")
+	form.AddTextField("input", "Input (JSON, optional)", "")
+	addSchedulePolicyFields(form)
+	form.AddTextField("notes", "Notes", "")
+	form.AddSelect("paused", "Start Paused", []string{"No", "Yes"})
+
+	submit := func(values map[string]any) {
+		catchup, err := parseCatchupWindow(values["catchupWindow"].(string))
+		if err != nil {
+			sl.app.ShowToastError(err.Error())
+			return
+		}
+		req := temporal.ScheduleCreateRequest{
+			ScheduleID:     strings.TrimSpace(values["id"].(string)),
+			Spec:           strings.TrimSpace(values["spec"].(string)),
+			WorkflowType:   strings.TrimSpace(values["workflowType"].(string)),
+			WorkflowID:     strings.TrimSpace(values["workflowId"].(string)),
+			TaskQueue:      strings.TrimSpace(values["taskQueue"].(string)),
+			OverlapPolicy:  values["overlap"].(string),
+			CatchupWindow:  catchup,
+			PauseOnFailure: values["pauseOnFailure"] == "Yes",
+			Notes:          strings.TrimSpace(values["notes"].(string)),
+			Paused:         values["paused"] == "Yes",
+		}
+		if input := strings.TrimSpace(values["input"].(string)); input != "" {
+			req.Input = []byte(input)
+		}
+		if err := req.Validate(); err != nil {
+			sl.app.ShowToastError(err.Error())
+			return
+		}
+		sl.showUpcomingModal(req.ScheduleID, req.Spec, time.Now(), func() {
+			sl.closeModal("schedule-form")
+			sl.executeCreateSchedule(req)
+		})
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		sl.closeModal("schedule-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Preview"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		sl.closeModal("schedule-form")
+	})
+
+	sl.app.Modals().Show("schedule-form", modal, form)
+}
+
+// showEditScheduleForm loads the selected schedule's spec and policies and shows
+// a form for changing them.
+func (sl *ScheduleList) showEditScheduleForm() {
+	schedule := sl.getSelectedSchedule()
+	provider := sl.app.Provider()
+	if schedule == nil || provider == nil {
+		return
+	}
+	scheduleID := schedule.ID
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		detail, err := provider.GetSchedule(ctx, sl.namespace, scheduleID)
+
+		sl.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(sl.app.Modals(), "Failed to Load Schedule", err.Error())
+				return
+			}
+			sl.showEditForm(detail)
+		})
+	}()
+}
+
+func (sl *ScheduleList) showEditForm(s *temporal.Schedule) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Edit Schedule: %s", theme.IconSchedule, s.ID),
+		Width:    80,
+		Height:   17,
+		Backdrop: true,
+	})
+
+	specLabel := scheduleSpecHelp
+	if s.EditableSpec == "" {
+		specLabel = fmt.Sprintf("Spec (empty keeps %s)", s.Spec)
+	}
+
+	form := components.NewForm()
+	form.AddTextField("spec", specLabel, "")
+	addSchedulePolicyFields(form)
+
+	values := map[string]any{
+		"spec":           s.EditableSpec,
+		"pauseOnFailure": "No",
+	}
+	if s.OverlapPolicy != "" {
+		values["overlap"] = s.OverlapPolicy
+	}
+	if s.CatchupWindow > 0 {
+		values["catchupWindow"] = s.CatchupWindow.String()
+	}
+	if s.PauseOnFailure {
+		values["pauseOnFailure"] = "Yes"
+	}
+	_ = form.SetValues(values)
+
+	submit := func(values map[string]any) {
+		catchup, err := parseCatchupWindow(values["catchupWindow"].(string))
+		if err != nil {
+			sl.app.ShowToastError(err.Error())
+			return
+		}
+		req := temporal.ScheduleUpdateRequest{
+			ScheduleID:     s.ID,
+			Spec:           strings.TrimSpace(values["spec"].(string)),
+			OverlapPolicy:  values["overlap"].(string),
+			CatchupWindow:  catchup,
+			PauseOnFailure: values["pauseOnFailure"] == "Yes",
+		}
+		if err := req.Validate(); err != nil {
+			sl.app.ShowToastError(err.Error())
+			return
+		}
+		if req.Spec == "" {
+			sl.closeModal("schedule-form")
+			sl.executeUpdateSchedule(req)
+			return
+		}
+		sl.showUpcomingModal(s.ID, req.Spec, time.Now(), func() {
+			sl.closeModal("schedule-form")
+			sl.executeUpdateSchedule(req)
+		})
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		sl.closeModal("schedule-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Preview"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		sl.closeModal("schedule-form")
+	})
+
+	sl.app.Modals().Show("schedule-form", modal, form)
+}
+
+// addSchedulePolicyFields adds the overlap, catchup and pause-on-failure fields
+// shared by the create and edit forms.
+func addSchedulePolicyFields(form *components.Form) {
+	form.AddSelect("overlap", "Overlap Policy", temporal.ScheduleOverlapPolicies)
+	form.AddTextField("catchupWindow", "Catchup Window (e.g. 10m, empty for default)", "")
+	form.AddSelect("pauseOnFailure", "Pause On Failure", []string{"No", "Yes"})
+}
+
+// parseCatchupWindow parses an optional catchup window duration.
+func parseCatchupWindow(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid catchup window %q", s)
+	}
+	return d, nil
+}
+
+func (sl *ScheduleList) executeCreateSchedule(req temporal.ScheduleCreateRequest) {
+	provider := sl.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err := provider.CreateSchedule(ctx, sl.namespace, req)
+
+		sl.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(sl.app.Modals(), "Create Schedule Failed", err.Error())
+				return
+			}
+			sl.loadData()
+		})
+	}()
+}
+
+func (sl *ScheduleList) executeUpdateSchedule(req temporal.ScheduleUpdateRequest) {
+	provider := sl.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err := provider.UpdateSchedule(ctx, sl.namespace, req)
+
+		sl.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(sl.app.Modals(), "Update Schedule Failed", err.Error())
+				return
+			}
+			sl.loadData()
+		})
+	}()
+}
@@ -3,6 +3,7 @@ package view
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/atterpac/jig/components"
@@ -113,12 +114,12 @@ func (sl *ScheduleList) updatePreview(s temporal.Schedule) {
 
 	nextRun := "-"
 	if s.NextRunTime != nil {
-		nextRun = formatRelativeTime(time.Now(), *s.NextRunTime)
+		nextRun = formatTime(time.Now(), *s.NextRunTime)
 	}
 
 	lastRun := "-"
 	if s.LastRunTime != nil {
-		lastRun = formatRelativeTime(time.Now(), *s.LastRunTime)
+		lastRun = formatTime(time.Now(), *s.LastRunTime)
 	}
 
 	text := fmt.Sprintf(`[%s::b]Schedule[-:-:-]
@@ -173,7 +174,7 @@ func (sl *ScheduleList) loadData() {
 
 	sl.loading = true
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		schedules, _, err := provider.ListSchedules(ctx, sl.namespace, temporal.ListOptions{PageSize: 100})
@@ -246,7 +247,7 @@ func (sl *ScheduleList) populateTable() {
 
 		nextRun := "-"
 		if s.NextRunTime != nil {
-			nextRun = formatRelativeTime(time.Now(), *s.NextRunTime)
+			nextRun = formatTime(time.Now(), *s.NextRunTime)
 		}
 
 		sl.table.AddRowWithColor(statusColor,
@@ -294,20 +295,26 @@ func (sl *ScheduleList) getSelectedSchedule() *temporal.Schedule {
 
 // Mutation methods - implemented using jig components
 
-func (sl *ScheduleList) showPauseConfirm() {
+// showPauseForm asks for a note to pause or unpause the selected schedule with.
+func (sl *ScheduleList) showPauseForm() {
 	schedule := sl.getSelectedSchedule()
 	if schedule == nil {
 		return
 	}
-
-	// If already paused, show unpause confirmation instead
-	if schedule.Paused {
-		sl.showUnpauseConfirm(schedule)
-		return
+	s := *schedule
+	pause := !s.Paused
+
+	title := fmt.Sprintf("%s Pause Schedule", theme.IconWarning)
+	note := "Paused via tempo"
+	action := "Pause"
+	if !pause {
+		title = fmt.Sprintf("%s Unpause Schedule", theme.IconInfo)
+		note = "Unpaused via tempo"
+		action = "Unpause"
 	}
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Pause Schedule", theme.IconWarning),
+		Title:    title,
 		Width:    60,
 		Height:   12,
 		Backdrop: true,
@@ -321,18 +328,34 @@ func (sl *ScheduleList) showPauseConfirm() {
 		SetTextAlign(tview.AlignLeft)
 	infoText.SetBackgroundColor(theme.Bg())
 	infoText.SetText(fmt.Sprintf("[%s]Schedule:[-] [%s]%s[-]\n[%s]Workflow:[-] [%s]%s[-]",
-		theme.TagFgDim(), theme.TagFg(), schedule.ID,
-		theme.TagFgDim(), theme.TagFg(), schedule.WorkflowType))
+		theme.TagFgDim(), theme.TagFg(), s.ID,
+		theme.TagFgDim(), theme.TagFg(), s.WorkflowType))
 
 	form := components.NewForm()
-	form.AddTextField("reason", "Reason", "Paused via tempo")
-	form.SetOnSubmit(func(values map[string]any) {
-		reason := values["reason"].(string)
-		sl.closeModal("pause-confirm")
-		sl.executePauseSchedule(schedule.ID, reason)
+	form.AddTextField("note", "Note", "")
+	_ = form.SetValues(map[string]any{
+		"note": note,
 	})
+
+	submit := func(values map[string]any) {
+		note := strings.TrimSpace(values["note"].(string))
+		sl.closeModal("pause-form")
+		sl.confirmScheduleAction(
+			title,
+			fmt.Sprintf("%s schedule %s?", action, s.ID),
+			temporal.ScheduleToggleCommand(sl.namespace, s.ID, pause, note),
+			action,
+			func() {
+				if pause {
+					sl.executePauseSchedule(s.ID, note)
+				} else {
+					sl.executeUnpauseSchedule(s.ID, note)
+				}
+			})
+	}
+	form.SetOnSubmit(submit)
 	form.SetOnCancel(func() {
-		sl.closeModal("pause-confirm")
+		sl.closeModal("pause-form")
 	})
 
 	contentFlex.AddItem(infoText, 3, 0, false)
@@ -340,73 +363,55 @@ func (sl *ScheduleList) showPauseConfirm() {
 
 	modal.SetContent(contentFlex)
 	modal.SetHints([]components.KeyHint{
-		{Key: "Enter", Description: "Pause"},
+		{Key: "Enter", Description: "Preview"},
 		{Key: "Esc", Description: "Cancel"},
 	})
 	modal.SetOnSubmit(func() {
-		values := form.GetValues()
-		reason := values["reason"].(string)
-		sl.closeModal("pause-confirm")
-		sl.executePauseSchedule(schedule.ID, reason)
+		submit(form.GetValues())
 	})
 	modal.SetOnCancel(func() {
-		sl.closeModal("pause-confirm")
+		sl.closeModal("pause-form")
 	})
 
-	sl.app.JigApp().Pages().AddPage("pause-confirm", modal, true, true)
-	sl.app.JigApp().SetFocus(form)
+	sl.app.Modals().Show("pause-form", modal, form)
 }
 
-func (sl *ScheduleList) showUnpauseConfirm(s *temporal.Schedule) {
+// confirmScheduleAction previews a schedule action and its CLI equivalent before
+// running it.
+func (sl *ScheduleList) confirmScheduleAction(title, question, command, action string, onConfirm func()) {
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Unpause Schedule", theme.IconInfo),
-		Width:    60,
+		Title:    title,
+		Width:    80,
 		Height:   12,
 		Backdrop: true,
 	})
 
-	contentFlex := tview.NewFlex().SetDirection(tview.FlexRow)
-	contentFlex.SetBackgroundColor(theme.Bg())
-
-	infoText := tview.NewTextView().
+	text := tview.NewTextView().
 		SetDynamicColors(true).
-		SetTextAlign(tview.AlignLeft)
-	infoText.SetBackgroundColor(theme.Bg())
-	infoText.SetText(fmt.Sprintf("[%s]Schedule:[-] [%s]%s[-]\n[%s]Workflow:[-] [%s]%s[-]",
-		theme.TagFgDim(), theme.TagFg(), s.ID,
-		theme.TagFgDim(), theme.TagFg(), s.WorkflowType))
-
-	form := components.NewForm()
-	form.AddTextField("reason", "Reason", "Unpaused via tempo")
-	form.SetOnSubmit(func(values map[string]any) {
-		reason := values["reason"].(string)
-		sl.closeModal("unpause-confirm")
-		sl.executeUnpauseSchedule(s.ID, reason)
-	})
-	form.SetOnCancel(func() {
-		sl.closeModal("unpause-confirm")
-	})
+		SetWordWrap(true)
+	text.SetBackgroundColor(theme.Bg())
+	text.SetText(fmt.Sprintf(`[%s]%s[-]
 
-	contentFlex.AddItem(infoText, 3, 0, false)
-	contentFlex.AddItem(form, 0, 1, true)
+[%s]CLI equivalent:[-]
+[%s]%s[-]`,
+		theme.TagAccent(), tview.Escape(question),
+		theme.TagFgDim(),
+		theme.TagFg(), tview.Escape(command)))
 
-	modal.SetContent(contentFlex)
+	modal.SetContent(text)
 	modal.SetHints([]components.KeyHint{
-		{Key: "Enter", Description: "Unpause"},
+		{Key: "Enter", Description: action},
 		{Key: "Esc", Description: "Cancel"},
 	})
 	modal.SetOnSubmit(func() {
-		values := form.GetValues()
-		reason := values["reason"].(string)
-		sl.closeModal("unpause-confirm")
-		sl.executeUnpauseSchedule(s.ID, reason)
+		sl.closeModal("schedule-action-confirm")
+		onConfirm()
 	})
 	modal.SetOnCancel(func() {
-		sl.closeModal("unpause-confirm")
+		sl.closeModal("schedule-action-confirm")
 	})
 
-	sl.app.JigApp().Pages().AddPage("unpause-confirm", modal, true, true)
-	sl.app.JigApp().SetFocus(form)
+	sl.app.Modals().Show("schedule-action-confirm", modal, nil)
 }
 
 func (sl *ScheduleList) executePauseSchedule(scheduleID, reason string) {
@@ -416,7 +421,7 @@ func (sl *ScheduleList) executePauseSchedule(scheduleID, reason string) {
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		err := provider.PauseSchedule(ctx, sl.namespace, scheduleID, reason)
@@ -438,7 +443,7 @@ func (sl *ScheduleList) executeUnpauseSchedule(scheduleID, reason string) {
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		err := provider.UnpauseSchedule(ctx, sl.namespace, scheduleID, reason)
@@ -458,45 +463,16 @@ func (sl *ScheduleList) showTriggerConfirm() {
 	if schedule == nil {
 		return
 	}
-
-	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Trigger Schedule", theme.IconSignal),
-		Width:    60,
-		Height:   12,
-		Backdrop: true,
-	})
-
-	contentFlex := tview.NewFlex().SetDirection(tview.FlexRow)
-	contentFlex.SetBackgroundColor(theme.Bg())
-
-	infoText := tview.NewTextView().
-		SetDynamicColors(true).
-		SetTextAlign(tview.AlignLeft)
-	infoText.SetBackgroundColor(theme.Bg())
-	infoText.SetText(fmt.Sprintf(`[%s]Trigger schedule immediately?[-]
-
-[%s]Schedule:[-] [%s]%s[-]
-[%s]Workflow:[-] [%s]%s[-]`,
-		theme.TagAccent(),
-		theme.TagFgDim(), theme.TagFg(), schedule.ID,
-		theme.TagFgDim(), theme.TagFg(), schedule.WorkflowType))
-
-	contentFlex.AddItem(infoText, 0, 1, true)
-
-	modal.SetContent(contentFlex)
-	modal.SetHints([]components.KeyHint{
-		{Key: "Enter", Description: "Trigger"},
-		{Key: "Esc", Description: "Cancel"},
-	})
-	modal.SetOnSubmit(func() {
-		sl.closeModal("trigger-confirm")
-		sl.executeTriggerSchedule(schedule.ID)
-	})
-	modal.SetOnCancel(func() {
-		sl.closeModal("trigger-confirm")
-	})
-
-	sl.app.JigApp().Pages().AddPage("trigger-confirm", modal, true, true)
+	scheduleID := schedule.ID
+
+	sl.confirmScheduleAction(
+		fmt.Sprintf("%s Trigger Schedule", theme.IconSignal),
+		fmt.Sprintf("Start a %s run of schedule %s now?", schedule.WorkflowType, scheduleID),
+		temporal.ScheduleTriggerCommand(sl.namespace, scheduleID),
+		"Trigger",
+		func() {
+			sl.executeTriggerSchedule(scheduleID)
+		})
 }
 
 func (sl *ScheduleList) executeTriggerSchedule(scheduleID string) {
@@ -506,7 +482,7 @@ func (sl *ScheduleList) executeTriggerSchedule(scheduleID string) {
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		err := provider.TriggerSchedule(ctx, sl.namespace, scheduleID)
@@ -516,6 +492,7 @@ func (sl *ScheduleList) executeTriggerSchedule(scheduleID string) {
 				sl.showError(err)
 				return
 			}
+			sl.app.ShowToastSuccess(fmt.Sprintf("Triggered schedule %s", scheduleID))
 			sl.loadData() // Refresh to show updated status
 		})
 	}()
@@ -526,96 +503,39 @@ func (sl *ScheduleList) showDeleteConfirm() {
 	if schedule == nil {
 		return
 	}
-
-	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Delete Schedule", theme.IconError),
-		Width:    65,
-		Height:   14,
-		Backdrop: true,
-	})
-
-	contentFlex := tview.NewFlex().SetDirection(tview.FlexRow)
-	contentFlex.SetBackgroundColor(theme.Bg())
-
-	warningText := tview.NewTextView().
-		SetDynamicColors(true).
-		SetTextAlign(tview.AlignLeft)
-	warningText.SetBackgroundColor(theme.Bg())
-	warningText.SetText(fmt.Sprintf(`[%s]Warning: This will permanently delete the schedule.
-This action cannot be undone.[-]
-
-[%s]Schedule:[-] [%s]%s[-]
-[%s]Workflow:[-] [%s]%s[-]`,
-		theme.TagError(),
-		theme.TagFgDim(), theme.TagFg(), schedule.ID,
-		theme.TagFgDim(), theme.TagFg(), schedule.WorkflowType))
-
-	form := components.NewForm()
-	form.AddTextField("confirm", "Type schedule ID to confirm", "")
-	form.SetOnSubmit(func(values map[string]any) {
-		confirm := values["confirm"].(string)
-		if confirm != schedule.ID {
-			return // Must match schedule ID
-		}
-		sl.closeModal("delete-confirm")
-		sl.executeDeleteSchedule(schedule.ID)
-	})
-	form.SetOnCancel(func() {
-		sl.closeModal("delete-confirm")
-	})
-
-	contentFlex.AddItem(warningText, 6, 0, false)
-	contentFlex.AddItem(form, 0, 1, true)
-
-	modal.SetContent(contentFlex)
-	modal.SetHints([]components.KeyHint{
-		{Key: "Enter", Description: "Delete"},
-		{Key: "Esc", Description: "Cancel"},
-	})
-	modal.SetOnSubmit(func() {
-		values := form.GetValues()
-		confirm := values["confirm"].(string)
-		if confirm != schedule.ID {
-			return
-		}
-		sl.closeModal("delete-confirm")
-		sl.executeDeleteSchedule(schedule.ID)
-	})
-	modal.SetOnCancel(func() {
-		sl.closeModal("delete-confirm")
-	})
-
-	sl.app.JigApp().Pages().AddPage("delete-confirm", modal, true, true)
-	sl.app.JigApp().SetFocus(form)
+	showDeleteScheduleConfirm(sl.app, sl.namespace, *schedule, sl.loadData)
 }
 
-func (sl *ScheduleList) executeDeleteSchedule(scheduleID string) {
-	provider := sl.app.Provider()
-	if provider == nil {
+// showActionMenu opens the action menu of the selected schedule.
+func (sl *ScheduleList) showActionMenu() {
+	schedule := sl.getSelectedSchedule()
+	if schedule == nil {
 		return
 	}
+	id := schedule.ID
+	pause := "Pause"
+	if schedule.Paused {
+		pause = "Unpause"
+	}
 
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		err := provider.DeleteSchedule(ctx, sl.namespace, scheduleID)
-
-		sl.app.JigApp().QueueUpdateDraw(func() {
-			if err != nil {
-				sl.showError(err)
-				return
-			}
-			sl.loadData() // Refresh to remove deleted schedule
-		})
-	}()
+	sl.app.showActionMenu("Schedule "+id, []rowAction{
+		{Label: "Workflows", Key: "w", Run: sl.showScheduledWorkflows},
+		{Label: "Recent Actions", Key: "a", Run: sl.showRecentActions},
+		{Label: "Upcoming Runs", Key: "u", Run: sl.showUpcomingRuns},
+		{Label: "Trigger", Key: "t", Run: sl.showTriggerConfirm},
+		{Label: pause, Key: "P", Run: sl.showPauseForm},
+		{Label: "Edit", Key: "e", Run: sl.showEditScheduleForm},
+		{Label: "Backfill", Key: "b", Run: sl.showBackfillForm},
+		{Label: "Delete", Key: "D", Run: sl.showDeleteConfirm},
+		{Label: "Copy Schedule ID", Run: func() { sl.app.CopyToClipboard(id, "Schedule ID") }},
+		{Label: "Open in Web UI", Run: func() {
+			sl.app.OpenInWebUI(sl.app.webProfile().ScheduleWebURL(sl.namespace, id))
+		}},
+	})
 }
 
 func (sl *ScheduleList) closeModal(name string) {
-	sl.app.JigApp().Pages().RemovePage(name)
-	if current := sl.app.JigApp().Pages().Current(); current != nil {
-		sl.app.JigApp().SetFocus(current)
-	}
+	sl.app.Modals().Close(name)
 }
 
 // Name returns the view name.
@@ -634,7 +554,7 @@ func (sl *ScheduleList) Start() {
 			sl.togglePreview()
 			return nil
 		case 'P': // Pause/Unpause toggle
-			sl.showPauseConfirm()
+			sl.showPauseForm()
 			return nil
 		case 't': // Trigger
 			sl.showTriggerConfirm()
@@ -642,6 +562,30 @@ func (sl *ScheduleList) Start() {
 		case 'D': // Delete
 			sl.showDeleteConfirm()
 			return nil
+		case 'n': // New
+			sl.showCreateScheduleForm()
+			return nil
+		case 'e': // Edit spec and policies
+			sl.showEditScheduleForm()
+			return nil
+		case 'b': // Backfill
+			sl.showBackfillForm()
+			return nil
+		case 'u': // Upcoming runs
+			sl.showUpcomingRuns()
+			return nil
+		case 'a': // Recent actions
+			sl.showRecentActions()
+			return nil
+		case 'w': // Workflows started by the schedule
+			sl.showScheduledWorkflows()
+			return nil
+		case 'm': // Action menu
+			sl.showActionMenu()
+			return nil
+		case 'z': // Maximize preview
+			sl.app.toggleZen(sl.rightPanel, sl.preview, sl.app.zenTextHints()...)
+			return nil
 		}
 		return event
 	})
@@ -659,9 +603,17 @@ func (sl *ScheduleList) Hints() []KeyHint {
 		{Key: "r", Description: "Refresh"},
 		{Key: "j/k", Description: "Navigate"},
 		{Key: "p", Description: "Preview"},
+		{Key: "z", Description: "Maximize Preview"},
+		{Key: "n", Description: "New"},
+		{Key: "e", Description: "Edit"},
 		{Key: "P", Description: "Pause/Unpause"},
 		{Key: "t", Description: "Trigger"},
+		{Key: "b", Description: "Backfill"},
+		{Key: "u", Description: "Upcoming"},
+		{Key: "a", Description: "Actions"},
+		{Key: "w", Description: "Workflows"},
 		{Key: "D", Description: "Delete"},
+		{Key: "m", Description: "Menu"},
 		{Key: "T", Description: "Theme"},
 		{Key: "esc", Description: "Back"},
 	}
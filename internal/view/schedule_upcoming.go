@@ -0,0 +1,167 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+const (
+	// upcomingRunsDays is how many days the upcoming-runs agenda covers.
+	upcomingRunsDays = 7
+	// upcomingRunsLimit caps how many fire times are projected for the agenda.
+	upcomingRunsLimit = 10000
+	// upcomingRunsPerDay is how many times are listed per day before summarizing.
+	upcomingRunsPerDay = 6
+)
+
+// showUpcomingRuns shows the selected schedule's projected fire times over the next
+// week. Specs tempo cannot evaluate, such as calendars, fall back to the next times
+// reported by the server.
+func (sl *ScheduleList) showUpcomingRuns() {
+	schedule := sl.getSelectedSchedule()
+	provider := sl.app.Provider()
+	if schedule == nil || provider == nil {
+		return
+	}
+	scheduleID := schedule.ID
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		detail, err := provider.GetSchedule(ctx, sl.namespace, scheduleID)
+
+		sl.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(sl.app.Modals(), "Failed to Load Schedule", err.Error())
+				return
+			}
+			now := time.Now()
+			if detail.EditableSpec != "" {
+				sl.showUpcomingModal(scheduleID, detail.EditableSpec, now, nil)
+				return
+			}
+			text := fmt.Sprintf("[%s]%s[-]\n[%s]Showing the next %d runs reported by the server.[-]\n\n%s",
+				theme.TagFg(), tview.Escape(detail.Spec), theme.TagFgDim(), len(detail.UpcomingRuns),
+				formatUpcomingRuns(detail.UpcomingRuns, now, upcomingRunsDays, len(detail.UpcomingRuns) > 0))
+			sl.showUpcomingText(scheduleID, text, nil)
+		})
+	}()
+}
+
+// showUpcomingModal projects spec over the next week. With onConfirm set it acts as
+// the last step of a schedule form: Enter saves and Esc returns to the form.
+func (sl *ScheduleList) showUpcomingModal(scheduleID, spec string, now time.Time, onConfirm func()) {
+	until := now.AddDate(0, 0, upcomingRunsDays)
+	times, err := temporal.UpcomingScheduleTimes(spec, now, until, upcomingRunsLimit)
+	if err != nil {
+		sl.app.ShowToastError(err.Error())
+		return
+	}
+
+	summary := fmt.Sprintf("%d runs in the next %d days", len(times), upcomingRunsDays)
+	if len(times) == upcomingRunsLimit {
+		summary = fmt.Sprintf("More than %d runs in the next %d days", upcomingRunsLimit, upcomingRunsDays)
+	}
+	text := fmt.Sprintf("[%s]%s[-]\n[%s]%s, shown in %s.[-]\n\n%s",
+		theme.TagFg(), tview.Escape(spec), theme.TagFgDim(), summary, timezoneLabel(now),
+		formatUpcomingRuns(times, now, upcomingRunsDays, len(times) == upcomingRunsLimit))
+	sl.showUpcomingText(scheduleID, text, onConfirm)
+}
+
+func (sl *ScheduleList) showUpcomingText(scheduleID, text string, onConfirm func()) {
+	title := fmt.Sprintf("%s Upcoming Runs", theme.IconSchedule)
+	if scheduleID != "" {
+		title += ": " + scheduleID
+	}
+	modal := components.NewModal(components.ModalConfig{
+		Title:    title,
+		Width:    75,
+		Height:   upcomingRunsDays + 10,
+		Backdrop: true,
+	})
+
+	view := tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true)
+	view.SetBackgroundColor(theme.Bg())
+	view.SetText(text)
+
+	modal.SetContent(view)
+	if onConfirm != nil {
+		modal.SetHints([]components.KeyHint{
+			{Key: "Enter", Description: "Save"},
+			{Key: "Esc", Description: "Back"},
+		})
+		modal.SetOnSubmit(func() {
+			sl.closeModal("upcoming-modal")
+			onConfirm()
+		})
+	} else {
+		modal.SetHints([]components.KeyHint{
+			{Key: "Esc", Description: "Close"},
+		})
+		modal.SetOnSubmit(func() {
+			sl.closeModal("upcoming-modal")
+		})
+	}
+	modal.SetOnCancel(func() {
+		sl.closeModal("upcoming-modal")
+	})
+
+	sl.app.Modals().Show("upcoming-modal", modal, nil)
+}
+
+// formatUpcomingRuns renders fire times as an agenda with one line per day in the
+// configured timezone, starting today. Days past the end of a truncated projection are left out.
+func formatUpcomingRuns(times []time.Time, now time.Time, days int, truncated bool) string {
+	byDay := make(map[string][]time.Time)
+	for _, t := range times {
+		key := inTimezone(t).Format("2006-01-02")
+		byDay[key] = append(byDay[key], inTimezone(t))
+	}
+
+	var sb strings.Builder
+	day := inTimezone(now)
+	for i := 0; i < days; i++ {
+		runs := byDay[day.Format("2006-01-02")]
+		if truncated && len(runs) == 0 && len(times) > 0 && day.After(times[len(times)-1]) {
+			break
+		}
+
+		sb.WriteString(fmt.Sprintf("[%s]%-12s[-] ", theme.TagAccent(), day.Format("Mon Jan 02")))
+		if len(runs) == 0 {
+			sb.WriteString(fmt.Sprintf("[%s]no runs[-]", theme.TagFgDim()))
+		} else {
+			shown := runs
+			if len(shown) > upcomingRunsPerDay {
+				shown = shown[:upcomingRunsPerDay]
+			}
+			layout := "15:04"
+			for _, t := range runs {
+				if t.Second() != 0 {
+					layout = "15:04:05"
+					break
+				}
+			}
+			labels := make([]string, len(shown))
+			for j, t := range shown {
+				labels[j] = t.Format(layout)
+			}
+			sb.WriteString(fmt.Sprintf("[%s]%s[-]", theme.TagFg(), strings.Join(labels, "  ")))
+			if extra := len(runs) - len(shown); extra > 0 {
+				sb.WriteString(fmt.Sprintf(" [%s]+%d more[-]", theme.TagFgDim(), extra))
+			}
+		}
+		sb.WriteString("\n")
+		day = day.AddDate(0, 0, 1)
+	}
+	return sb.String()
+}
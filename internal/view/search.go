@@ -0,0 +1,71 @@
+package view
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// searchTagPattern matches tview color and region tags so search highlighting
+// only touches visible text.
+var searchTagPattern = regexp.MustCompile(`\[([a-zA-Z]+|#[0-9a-fA-F]{6}|-)?(:([a-zA-Z]+|#[0-9a-fA-F]{6}|-)?(:([lbidrus]+|-)?)?)?\]|\["[^"\[\]]*"\]`)
+
+// highlightSearchMatches wraps case-insensitive matches of query in text with tview
+// regions ("search-0", "search-1", ...) and returns the region IDs in order.
+// The text view must have regions enabled for the highlights to render.
+func highlightSearchMatches(text, query string) (string, []string) {
+	if query == "" {
+		return text, nil
+	}
+	needle := strings.ToLower(query)
+
+	var b strings.Builder
+	var regions []string
+	mark := func(segment string) {
+		lower := strings.ToLower(segment)
+		for {
+			idx := strings.Index(lower, needle)
+			// Lowercasing can change byte lengths for some runes; fall back to no highlight
+			if idx < 0 || len(lower) != len(segment) {
+				b.WriteString(segment)
+				return
+			}
+			id := fmt.Sprintf("search-%d", len(regions))
+			regions = append(regions, id)
+			b.WriteString(segment[:idx])
+			b.WriteString(fmt.Sprintf(`["%s"]%s[""]`, id, segment[idx:idx+len(needle)]))
+			segment = segment[idx+len(needle):]
+			lower = lower[idx+len(needle):]
+		}
+	}
+
+	last := 0
+	for _, loc := range searchTagPattern.FindAllStringIndex(text, -1) {
+		mark(text[last:loc[0]])
+		b.WriteString(text[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	mark(text[last:])
+
+	return b.String(), regions
+}
+
+// eventMatchesSearch reports whether an event's type, name, details or payloads contain query.
+func eventMatchesSearch(ev *temporal.EnhancedHistoryEvent, query string) bool {
+	if query == "" {
+		return false
+	}
+	needle := strings.ToLower(query)
+	fields := []string{ev.Type, getEventNameDetail(ev), ev.Details, ev.Failure, ev.Result}
+	for _, p := range ev.Payloads {
+		fields = append(fields, p.Data)
+	}
+	for _, f := range fields {
+		if strings.Contains(strings.ToLower(f), needle) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/layout"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+)
+
+// clockFormat is how the clock segment shows the time.
+const clockFormat = "15:04"
+
+// statusBarSettings returns the status bar settings from the config.
+func (a *App) statusBarSettings() config.StatusBarSettings {
+	if a.config == nil {
+		return config.StatusBarSettings{}
+	}
+	return a.config.StatusBar
+}
+
+// setStatusSegment sets the sections of a status bar segment, removing it when
+// sections is empty, and redraws the bar's contents in the configured order.
+func (a *App) setStatusSegment(segment string, sections ...layout.StatusSection) {
+	if a.statusSegments == nil {
+		a.statusSegments = make(map[string][]layout.StatusSection)
+	}
+	if len(sections) == 0 {
+		delete(a.statusSegments, segment)
+	} else {
+		a.statusSegments[segment] = sections
+	}
+
+	left, right := a.statusBarSettings().Segments()
+	a.statusBar.SetSections(a.statusSections(left))
+	a.statusBar.SetRightSections(a.statusSections(right))
+}
+
+// statusSections returns the sections of the given segments, in order.
+func (a *App) statusSections(segments []string) []layout.StatusSection {
+	sections := []layout.StatusSection{}
+	for _, segment := range segments {
+		sections = append(sections, a.statusSegments[segment]...)
+	}
+	return sections
+}
+
+// warnInvalidStatusSegments reports configured status bar segments that don't
+// exist.
+func (a *App) warnInvalidStatusSegments() {
+	if invalid := a.statusBarSettings().InvalidSegments(); len(invalid) > 0 {
+		a.toasts.Warning(fmt.Sprintf("Unknown status bar segments ignored: %s (valid: %s)",
+			strings.Join(invalid, ", "), strings.Join(config.StatusBarSegments(), ", ")))
+	}
+}
+
+// clockMonitor keeps the clock segment current, updating it as each minute
+// starts.
+func (a *App) clockMonitor() {
+	for {
+		now := time.Now()
+		a.app.QueueUpdateDraw(func() {
+			a.setStatusSegment(config.SegmentClock, layout.StatusSection{
+				Icon: theme.IconClock,
+				Text: inTimezone(now).Format(clockFormat),
+			})
+		})
+
+		select {
+		case <-a.stopMonitor:
+			return
+		case <-time.After(now.Truncate(time.Minute).Add(time.Minute).Sub(now)):
+		}
+	}
+}
+
+// loadServerVersion shows the version of provider's server in the version
+// segment, if the status bar shows it.
+func (a *App) loadServerVersion(provider temporal.Provider) {
+	if provider == nil || !a.statusBarSettings().Shows(config.SegmentVersion) {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), connectionCheckTimeout)
+		version, err := provider.ServerVersion(ctx)
+		cancel()
+		if err != nil || version == "" {
+			return // Older servers and some proxies don't report it
+		}
+		a.app.QueueUpdateDraw(func() {
+			if a.provider == provider {
+				a.setStatusSegment(config.SegmentVersion, layout.StatusSection{
+					Text:      "server " + version,
+					ColorFunc: theme.FgDim,
+				})
+			}
+		})
+	}()
+}
+
+// compactStatusBar draws the status bar on a single line, without the border
+// the status bar draws around its contents.
+type compactStatusBar struct {
+	*layout.StatusBar
+}
+
+// Draw renders the status bar's contents on the line it is given. The bar is
+// laid out with its border just outside the line, and the border is dropped
+// rather than drawn, so nothing around the line is touched.
+func (c *compactStatusBar) Draw(screen tcell.Screen) {
+	x, y, width, height := c.GetRect()
+	c.StatusBar.SetRect(x-1, y-1, width+2, height+2)
+	c.StatusBar.Draw(&borderlessScreen{Screen: screen, x: x, y: y, width: width, height: height})
+	c.StatusBar.SetRect(x, y, width, height)
+}
+
+// borderlessScreen drops what is drawn on the one-cell ring around a rect,
+// where a bordered primitive laid out around the rect draws its border.
+type borderlessScreen struct {
+	tcell.Screen
+	x, y, width, height int
+}
+
+// SetContent draws a cell unless it is on the border ring.
+func (s *borderlessScreen) SetContent(x, y int, primary rune, combining []rune, style tcell.Style) {
+	inRows := y >= s.y-1 && y <= s.y+s.height
+	inCols := x >= s.x-1 && x <= s.x+s.width
+	onRing := inRows && inCols && (y == s.y-1 || y == s.y+s.height || x == s.x-1 || x == s.x+s.width)
+	if !onRing {
+		s.Screen.SetContent(x, y, primary, combining, style)
+	}
+}
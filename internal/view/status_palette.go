@@ -0,0 +1,59 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+)
+
+// registerStatuses registers the status styles with the configured palette and
+// markers, which no-color mode always turns on.
+func (a *App) registerStatuses() {
+	if a.config == nil {
+		temporal.RegisterStatuses(temporal.ThemeStatusColors(), a.noColor())
+		return
+	}
+	colors := temporal.ThemeStatusColors()
+	if palette, ok := config.StatusPalettes[a.config.GetStatusPalette()]; ok && !a.noColor() {
+		colors = temporal.StatusColors{
+			Running: staticColor(palette.Running),
+			Success: staticColor(palette.Success),
+			Failure: staticColor(palette.Failure),
+			Warning: staticColor(palette.Warning),
+		}
+	}
+	// Without color, markers are all that tell statuses apart
+	temporal.RegisterStatuses(colors, a.config.StatusMarkers || a.noColor())
+}
+
+// staticColor returns a color func for a fixed hex color.
+func staticColor(hex string) theme.ColorFunc {
+	color := tcell.GetColor(hex)
+	return func() tcell.Color { return color }
+}
+
+// warnUnknownStatusPalette reports a configured status palette that doesn't
+// exist.
+func (a *App) warnUnknownStatusPalette() {
+	if a.config == nil {
+		return
+	}
+	name := a.config.GetStatusPalette()
+	if _, ok := config.StatusPalettes[name]; ok || name == config.StatusPaletteTheme {
+		return
+	}
+	a.toasts.Warning(fmt.Sprintf("Unknown status palette %q ignored (valid: %s, %s)",
+		name, config.StatusPaletteTheme, strings.Join(config.StatusPaletteNames(), ", ")))
+}
+
+// statusLabel prefixes text with the status marker, if status markers are on.
+func statusLabel(status, text string) string {
+	if marker := temporal.StatusMarker(status); marker != "" {
+		return marker + " " + text
+	}
+	return text
+}
@@ -0,0 +1,113 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/layout"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// How often the current namespace's task queues are checked for stuck backlogs.
+const stuckQueueCheckInterval = time.Minute
+
+// stuck reports whether the queue has a backlog that no live worker is draining:
+// there are no pollers, or none have polled recently.
+func (e taskQueueEntry) stuck(now time.Time) bool {
+	if e.Err != nil || e.Backlog == 0 {
+		return false
+	}
+	return e.PollerCount == 0 || e.LastPollTime.IsZero() || now.Sub(e.LastPollTime) > pollerStaleThreshold
+}
+
+// stuckQueueNames returns the sorted names of stuck queues.
+func stuckQueueNames(queues []taskQueueEntry) []string {
+	now := time.Now()
+	var names []string
+	for _, q := range queues {
+		if q.stuck(now) {
+			names = append(names, q.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// stuckQueueMonitor periodically checks the current namespace for stuck task queues.
+func (a *App) stuckQueueMonitor() {
+	ticker := time.NewTicker(stuckQueueCheckInterval)
+	defer ticker.Stop()
+
+	a.checkStuckQueues()
+	for {
+		select {
+		case <-a.stopMonitor:
+			return
+		case <-ticker.C:
+			a.checkStuckQueues()
+		}
+	}
+}
+
+// checkStuckQueues discovers and describes the namespace's task queues and updates
+// the stuck queue warning. Failures are ignored; the connection monitor reports them.
+func (a *App) checkStuckQueues() {
+	provider := a.Provider()
+	if provider == nil || !provider.IsConnected() {
+		return
+	}
+
+	namespace := a.currentNS
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	workflows, _, err := provider.ListWorkflows(ctx, namespace, temporal.ListOptions{PageSize: taskQueueDiscoveryLimit})
+	if err != nil {
+		return
+	}
+	names := stuckQueueNames(describeTaskQueues(ctx, provider, namespace, discoverTaskQueues(workflows)))
+
+	a.app.QueueUpdateDraw(func() {
+		// Drop results for a namespace that was switched away from mid-check
+		if namespace == a.currentNS {
+			a.setStuckQueues(names)
+		}
+	})
+}
+
+// setStuckQueues updates the stuck queue badge in the status bar, warning with a
+// toast when a queue becomes stuck.
+func (a *App) setStuckQueues(names []string) {
+	var newlyStuck []string
+	for _, name := range names {
+		if !slices.Contains(a.stuckQueues, name) {
+			newlyStuck = append(newlyStuck, name)
+		}
+	}
+	a.stuckQueues = names
+
+	if len(newlyStuck) > 0 {
+		a.toasts.Warning(fmt.Sprintf("Task queue stuck with no active pollers: %s", strings.Join(newlyStuck, ", ")))
+	}
+
+	if len(names) == 0 {
+		a.setStatusSegment(config.SegmentAlerts)
+		return
+	}
+
+	text := fmt.Sprintf("%d stuck queues", len(names))
+	if len(names) == 1 {
+		text = "stuck queue: " + names[0]
+	}
+	a.setStatusSegment(config.SegmentAlerts, layout.StatusSection{
+		Icon:      theme.IconWarning,
+		Text:      text,
+		ColorFunc: theme.Warning,
+	})
+}
@@ -0,0 +1,194 @@
+package view
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/atterpac/jig/nav"
+	"github.com/gdamore/tcell/v2"
+)
+
+// maxTabs is the number of tabs, one per Alt+1..9 key.
+const maxTabs = 9
+
+// gPrefixTimeout is how long a g waits for a second key before the view gets
+// it on its own, e.g. to jump to the top of a list.
+const gPrefixTimeout = 500 * time.Millisecond
+
+// tab is a workspace with its own view stack and namespace. Only the active
+// tab's views are in the page stack; the other tabs park theirs here.
+type tab struct {
+	stack     []nav.Component
+	below     []nav.Component // Views under the page stack, restored when gone back to
+	namespace string
+	forward   []historyEntry // Locations left with GoBack, most recent last
+	history   []historyEntry // Locations visited, oldest first
+}
+
+// NewTab opens a tab on the home view of the current namespace.
+func (a *App) NewTab() {
+	if len(a.tabs) >= maxTabs {
+		a.toasts.Warning(fmt.Sprintf("At most %d tabs can be open", maxTabs))
+		return
+	}
+	a.parkTab()
+	a.tabs = append(a.tabs, &tab{namespace: a.currentNS})
+	a.activeTab = len(a.tabs) - 1
+	a.reinitializeViews()
+}
+
+// CloseTab closes the active tab and returns to the one before it.
+func (a *App) CloseTab() {
+	if len(a.tabs) <= 1 {
+		a.toasts.Warning("Can't close the last tab")
+		return
+	}
+	a.clearPages()
+	a.tabs = append(a.tabs[:a.activeTab], a.tabs[a.activeTab+1:]...)
+	a.restoreTab(max(a.activeTab-1, 0))
+}
+
+// SwitchTab makes tab i (zero-based) active.
+func (a *App) SwitchTab(i int) {
+	if i == a.activeTab || i < 0 || i >= len(a.tabs) {
+		return
+	}
+	a.parkTab()
+	a.restoreTab(i)
+}
+
+// NextTab switches to the next tab, wrapping around.
+func (a *App) NextTab() {
+	a.SwitchTab((a.activeTab + 1) % len(a.tabs))
+}
+
+// PrevTab switches to the previous tab, wrapping around.
+func (a *App) PrevTab() {
+	a.SwitchTab((a.activeTab - 1 + len(a.tabs)) % len(a.tabs))
+}
+
+// parkTab moves the active tab's views out of the page stack, stopping them.
+func (a *App) parkTab() {
+	t := a.tabs[a.activeTab]
+	t.stack = a.viewStack()
+	t.namespace = a.currentNS
+	a.clearPages()
+}
+
+// restoreTab makes tab i active and pushes its top view back. The views under
+// it stay parked until gone back to, so only the visible one is restarted.
+func (a *App) restoreTab(i int) {
+	a.activeTab = i
+	t := a.tabs[i]
+	stack := t.stack
+	t.stack = nil
+
+	a.SetNamespace(t.namespace)
+	if n := len(stack); n > 0 {
+		a.app.Pages().Push(stack[n-1])
+		t.below = stack[:n-1]
+	}
+	if current := a.app.Pages().Current(); current != nil {
+		a.app.SetFocus(current)
+	}
+}
+
+// viewStack returns the active tab's views, bottom first, including the ones
+// still parked under the page stack.
+func (a *App) viewStack() []nav.Component {
+	return append(slices.Clone(a.tabs[a.activeTab].below), a.app.Pages().GetStack()...)
+}
+
+// stackDepth is the number of views in the active tab.
+func (a *App) stackDepth() int {
+	return len(a.tabs[a.activeTab].below) + a.app.Pages().StackDepth()
+}
+
+// popView removes the current view, restoring the parked view under it once the
+// page stack is down to one view.
+func (a *App) popView() bool {
+	if a.app.Pages().Pop() {
+		return true
+	}
+	t := a.tabs[a.activeTab]
+	if len(t.below) == 0 {
+		return false
+	}
+	prev := t.below[len(t.below)-1]
+	t.below = t.below[:len(t.below)-1]
+	a.app.Pages().Replace(prev)
+	return true
+}
+
+// resetTabs drops every tab but the active one, e.g. after a profile switch
+// leaves the parked views pointing at the old connection.
+func (a *App) resetTabs() {
+	a.tabs = []*tab{{}}
+	a.activeTab = 0
+}
+
+// tabCrumb labels the active tab in the breadcrumbs when more than one is open.
+func (a *App) tabCrumb() string {
+	if len(a.tabs) <= 1 {
+		return ""
+	}
+	return fmt.Sprintf("Tab %d/%d", a.activeTab+1, len(a.tabs))
+}
+
+// handleGPrefix reads gt/gT (switch tabs) and g1..g9 (jump to a breadcrumb). A
+// g is held back until the next key or gPrefixTimeout and handed to the view
+// when no sequence follows, so the second key never reaches the view as well.
+// It reports whether the event was consumed.
+func (a *App) handleGPrefix(event *tcell.EventKey) bool {
+	if event == a.replayedG {
+		a.replayedG = nil
+		return false
+	}
+
+	plain := event.Key() == tcell.KeyRune && event.Modifiers()&tcell.ModAlt == 0
+	if a.pendingG {
+		a.pendingG = false
+		a.gTimer.Stop()
+		switch r := event.Rune(); {
+		case plain && (r == 't' || r == 'T'):
+			if len(a.tabs) > 1 && r == 't' {
+				a.NextTab()
+			} else if len(a.tabs) > 1 {
+				a.PrevTab()
+			}
+			return true
+		case plain && r >= '1' && r <= '9':
+			a.JumpToCrumb(int(r - '1'))
+			return true
+		}
+		// No sequence: the view gets the g, then this key
+		a.replayG()
+		a.app.GetApplication().QueueEvent(event)
+		return true
+	}
+
+	if plain && event.Rune() == 'g' {
+		a.pendingG = true
+		var timer *time.Timer
+		timer = time.AfterFunc(gPrefixTimeout, func() {
+			a.app.QueueUpdate(func() {
+				if a.pendingG && a.gTimer == timer {
+					a.pendingG = false
+					if a.modals.Len() == 0 {
+						a.replayG()
+					}
+				}
+			})
+		})
+		a.gTimer = timer
+		return true
+	}
+	return false
+}
+
+// replayG queues a g that skips the prefix check.
+func (a *App) replayG() {
+	a.replayedG = tcell.NewEventKey(tcell.KeyRune, 'g', tcell.ModNone)
+	a.app.GetApplication().QueueEvent(a.replayedG)
+}
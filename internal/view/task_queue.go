@@ -3,6 +3,9 @@ package view
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/atterpac/jig/components"
@@ -12,12 +15,27 @@ import (
 	"github.com/rivo/tview"
 )
 
+// Number of recent workflows scanned to discover task queues.
+const taskQueueDiscoveryLimit = 200
+
+// Pollers that haven't polled for this long are treated as stale. Workers re-poll
+// at least once a minute while idle.
+const pollerStaleThreshold = 2 * time.Minute
+
+// taskQueueColumns are the queue table headers.
+var taskQueueColumns = []string{"NAME", "TYPE", "POLLERS", "BACKLOG", "ADD/S", "DISPATCH/S", "BACKLOG TREND", "LAST POLL"}
+
 // taskQueueEntry represents a task queue in the list.
 type taskQueueEntry struct {
-	Name        string
-	Type        string
-	PollerCount int
-	Backlog     int
+	Name         string
+	Type         string
+	PollerCount  int
+	Backlog      int
+	BacklogAge   time.Duration
+	AddRate      float64
+	DispatchRate float64
+	LastPollTime time.Time
+	Err          error // Set when describing the queue failed
 }
 
 // TaskQueueView displays task queue information.
@@ -32,18 +50,23 @@ type TaskQueueView struct {
 	pollers        []temporal.Poller
 	selectedQueue  string
 	loading        bool
-	suppressSelect bool // Prevent recursive selection handling
+	suppressSelect bool         // Prevent recursive selection handling
+	history        statsHistory // Backlog samples per queue name
+	sampleTicker   *time.Ticker
+	stopSampling   chan struct{}
 }
 
 // NewTaskQueueView creates a new task queue view.
 func NewTaskQueueView(app *App) *TaskQueueView {
 	tq := &TaskQueueView{
-		Flex:        tview.NewFlex().SetDirection(tview.FlexColumn),
-		app:         app,
-		queueTable:  components.NewTable(),
-		pollerTable: components.NewTable(),
-		queues:      []taskQueueEntry{},
-		pollers:     []temporal.Poller{},
+		Flex:         tview.NewFlex().SetDirection(tview.FlexColumn),
+		app:          app,
+		queueTable:   components.NewTable(),
+		pollerTable:  components.NewTable(),
+		queues:       []taskQueueEntry{},
+		pollers:      []temporal.Poller{},
+		history:      statsHistory{},
+		stopSampling: make(chan struct{}),
 	}
 	tq.setup()
 	return tq
@@ -53,7 +76,7 @@ func (tq *TaskQueueView) setup() {
 	tq.SetBackgroundColor(theme.Bg())
 
 	// Task queues table
-	tq.queueTable.SetHeaders("NAME", "TYPE", "POLLERS", "BACKLOG")
+	tq.queueTable.SetHeaders(taskQueueColumns...)
 	tq.queueTable.SetBorder(false)
 	tq.queueTable.SetBackgroundColor(theme.Bg())
 
@@ -81,8 +104,8 @@ func (tq *TaskQueueView) setup() {
 	})
 
 	// Two-column layout
-	tq.AddItem(tq.queuePanel, 0, 1, true)
-	tq.AddItem(tq.pollerPanel, 0, 1, false)
+	tq.AddItem(tq.queuePanel, 0, 3, true)
+	tq.AddItem(tq.pollerPanel, 0, 2, false)
 }
 
 func (tq *TaskQueueView) setLoading(loading bool) {
@@ -114,41 +137,33 @@ func (tq *TaskQueueView) loadData() {
 		return
 	}
 
-	// Get task queues by listing workflows and extracting unique queue names
+	// Get task queues by listing recent workflows and extracting unique queue names,
+	// then describe each one for pollers and backlog
 	tq.setLoading(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := tq.app.startLoading(tq.queuePanel, "Discovering task queues", cancel)
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		// List workflows to discover task queues
-		workflows, _, err := provider.ListWorkflows(ctx, tq.app.CurrentNamespace(), temporal.ListOptions{PageSize: 100})
+		namespace := tq.app.CurrentNamespace()
+		workflows, _, err := provider.ListWorkflows(ctx, namespace, temporal.ListOptions{PageSize: taskQueueDiscoveryLimit})
+
+		var queues []taskQueueEntry
+		if err == nil {
+			queues = describeTaskQueues(ctx, provider, namespace, discoverTaskQueues(workflows))
+		}
 
 		tq.app.JigApp().QueueUpdateDraw(func() {
+			done()
 			tq.setLoading(false)
 			if err != nil {
 				tq.showQueueError(err)
 				return
 			}
 
-			// Extract unique task queue names
-			queueSet := make(map[string]bool)
-			for _, wf := range workflows {
-				if wf.TaskQueue != "" {
-					queueSet[wf.TaskQueue] = true
-				}
-			}
-
-			// Build queue entries
-			tq.queues = []taskQueueEntry{}
-			for name := range queueSet {
-				tq.queues = append(tq.queues, taskQueueEntry{
-					Name:        name,
-					Type:        "Combined",
-					PollerCount: 0,
-					Backlog:     0,
-				})
-			}
-
+			tq.queues = queues
+			tq.recordSamples()
+			tq.app.setStuckQueues(stuckQueueNames(queues))
 			if len(tq.queues) == 0 {
 				tq.queues = append(tq.queues, taskQueueEntry{
 					Name:        "(no task queues found)",
@@ -160,32 +175,167 @@ func (tq *TaskQueueView) loadData() {
 
 			tq.populateQueueTable()
 
-			// Load details for first queue
-			if len(tq.queues) > 0 && tq.queues[0].Name != "(no task queues found)" {
-				tq.loadPollers(0)
+			// Load pollers for the selected queue
+			if tq.queues[0].Name != "(no task queues found)" {
+				tq.refreshCurrentQueue()
 			}
 		})
 	}()
 }
 
+// sampleQueues re-describes the listed queues to extend their backlog trends.
+// Queue discovery is left to a full refresh.
+func (tq *TaskQueueView) sampleQueues() {
+	provider := tq.app.Provider()
+	if provider == nil || tq.loading {
+		return
+	}
+
+	var names []string
+	for _, q := range tq.queues {
+		if q.Name != "(no task queues found)" {
+			names = append(names, q.Name)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+
+	tq.setLoading(true)
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		queues := describeTaskQueues(ctx, provider, tq.app.CurrentNamespace(), names)
+
+		tq.app.JigApp().QueueUpdateDraw(func() {
+			tq.setLoading(false)
+			byName := make(map[string]taskQueueEntry, len(queues))
+			for _, q := range queues {
+				byName[q.Name] = q
+			}
+			for i, q := range tq.queues {
+				if sampled, ok := byName[q.Name]; ok {
+					tq.queues[i] = sampled
+				}
+			}
+			tq.recordSamples()
+			tq.app.setStuckQueues(stuckQueueNames(tq.queues))
+			tq.populateQueueTable()
+		})
+	}()
+}
+
+// recordSamples adds the current backlog of each described queue to its trend.
+func (tq *TaskQueueView) recordSamples() {
+	for _, q := range tq.queues {
+		if q.Err == nil {
+			tq.history.add(q.Name, float64(q.Backlog))
+		}
+	}
+}
+
+func (tq *TaskQueueView) startSampling() {
+	if tq.sampleTicker != nil {
+		return
+	}
+	tq.sampleTicker = time.NewTicker(taskQueueSampleInterval)
+	ticker := tq.sampleTicker
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				tq.app.JigApp().QueueUpdateDraw(func() {
+					tq.sampleQueues()
+				})
+			case <-tq.stopSampling:
+				return
+			}
+		}
+	}()
+}
+
+func (tq *TaskQueueView) stopSamplingStats() {
+	if tq.sampleTicker != nil {
+		tq.sampleTicker.Stop()
+		tq.sampleTicker = nil
+	}
+	select {
+	case tq.stopSampling <- struct{}{}:
+	default:
+	}
+}
+
+// discoverTaskQueues returns the sorted, unique task queue names used by workflows.
+func discoverTaskQueues(workflows []temporal.Workflow) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, wf := range workflows {
+		if wf.TaskQueue != "" && !seen[wf.TaskQueue] {
+			seen[wf.TaskQueue] = true
+			names = append(names, wf.TaskQueue)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// describeTaskQueues describes each named queue concurrently. Queues that fail to
+// describe are kept with their error so the rest of the list still loads.
+func describeTaskQueues(ctx context.Context, provider temporal.Provider, namespace string, names []string) []taskQueueEntry {
+	queues := make([]taskQueueEntry, len(names))
+	sem := make(chan struct{}, 8)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			queues[i] = taskQueueEntry{Name: name, Type: "Combined"}
+			info, _, err := provider.DescribeTaskQueue(ctx, namespace, name)
+			if err != nil {
+				queues[i].Err = err
+				return
+			}
+			queues[i].apply(info)
+		}(i, name)
+	}
+	wg.Wait()
+	return queues
+}
+
+// apply copies described queue status into the entry.
+func (e *taskQueueEntry) apply(info *temporal.TaskQueueInfo) {
+	e.PollerCount = info.PollerCount
+	e.Backlog = info.Backlog
+	e.BacklogAge = info.BacklogAge
+	e.AddRate = info.AddRate
+	e.DispatchRate = info.DispatchRate
+	e.LastPollTime = info.LastPollTime
+	e.Err = nil
+}
+
 func (tq *TaskQueueView) showQueueError(err error) {
 	tq.queueTable.ClearRows()
-	tq.queueTable.SetHeaders("NAME", "TYPE", "POLLERS", "BACKLOG")
+	tq.queueTable.SetHeaders(taskQueueColumns...)
 	tq.queueTable.AddRowWithColor(theme.Error(),
 		"Error loading task queues",
 		err.Error(),
-		"",
-		"",
+		"", "", "", "", "", "",
 	)
 }
 
 func (tq *TaskQueueView) loadMockQueues() {
+	now := time.Now()
 	tq.queues = []taskQueueEntry{
-		{Name: "order-tasks", Type: "Combined", PollerCount: 5, Backlog: 12},
-		{Name: "payment-tasks", Type: "Combined", PollerCount: 3, Backlog: 0},
-		{Name: "shipment-tasks", Type: "Combined", PollerCount: 2, Backlog: 5},
-		{Name: "notification-tasks", Type: "Combined", PollerCount: 2, Backlog: 0},
+		{Name: "order-tasks", Type: "Combined", PollerCount: 5, Backlog: 12, BacklogAge: 8 * time.Second, AddRate: 4.2, DispatchRate: 3.9, LastPollTime: now.Add(-2 * time.Second)},
+		{Name: "payment-tasks", Type: "Combined", PollerCount: 3, Backlog: 0, AddRate: 1.1, DispatchRate: 1.1, LastPollTime: now.Add(-5 * time.Second)},
+		{Name: "shipment-tasks", Type: "Combined", PollerCount: 2, Backlog: 5, BacklogAge: 3 * time.Minute, AddRate: 0.4, LastPollTime: now.Add(-6 * time.Minute)},
+		{Name: "notification-tasks", Type: "Combined", PollerCount: 0, Backlog: 0},
 	}
+	tq.recordSamples()
 	tq.populateQueueTable()
 }
 
@@ -194,8 +344,10 @@ func (tq *TaskQueueView) populateQueueTable() {
 	currentRow := tq.queueTable.SelectedRow()
 
 	tq.queueTable.ClearRows()
-	tq.queueTable.SetHeaders("NAME", "TYPE", "POLLERS", "BACKLOG")
+	tq.queueTable.SetHeaders(taskQueueColumns...)
 
+	now := time.Now()
+	stuckCount := 0
 	for _, q := range tq.queues {
 		backlogIcon := theme.IconCompleted
 		backlogColor := theme.StatusColor("Completed")
@@ -212,19 +364,46 @@ func (tq *TaskQueueView) populateQueueTable() {
 			typeIcon = theme.IconActivity
 		}
 
+		backlog := fmt.Sprintf("%s %d", backlogIcon, q.Backlog)
+		if q.Backlog > 0 && q.BacklogAge > 0 {
+			backlog += fmt.Sprintf(" (%s)", temporal.FormatDuration(q.BacklogAge))
+		}
+		lastPoll, lastPollColor := taskQueueStaleness(now, q)
+
+		name := theme.IconTaskQueue + " " + q.Name
+		stuck := q.stuck(now)
+		if stuck {
+			stuckCount++
+			name = theme.IconWarning + " " + q.Name + " (stuck)"
+		}
+
 		// Track row position before adding
 		tableRow := tq.queueTable.Table.GetRowCount()
 		tq.queueTable.AddRow(
-			theme.IconTaskQueue+" "+q.Name,
+			name,
 			typeIcon+" "+q.Type,
 			fmt.Sprintf("%d", q.PollerCount),
-			fmt.Sprintf("%s %d", backlogIcon, q.Backlog),
+			backlog,
+			formatRate(q.AddRate),
+			formatRate(q.DispatchRate),
+			sparkline(tq.history[q.Name]),
+			lastPoll,
 		)
-		// Color the backlog cell
-		cell := tq.queueTable.GetCell(tableRow, 3)
-		cell.SetTextColor(backlogColor)
+		// Color the backlog, trend and staleness cells
+		tq.queueTable.GetCell(tableRow, 3).SetTextColor(backlogColor)
+		tq.queueTable.GetCell(tableRow, 6).SetTextColor(theme.Accent())
+		tq.queueTable.GetCell(tableRow, 7).SetTextColor(lastPollColor)
+		if stuck {
+			tq.queueTable.GetCell(tableRow, 0).SetTextColor(theme.Warning())
+		}
 	}
 
+	title := fmt.Sprintf("%s Task Queues", theme.IconTaskQueue)
+	if stuckCount > 0 {
+		title += fmt.Sprintf(" [%s]%s %d stuck[-]", theme.TagWarning(), theme.IconWarning, stuckCount)
+	}
+	tq.queuePanel.SetTitle(title)
+
 	if tq.queueTable.RowCount() > 0 {
 		// Only manage suppressSelect if it's not already being managed by caller
 		wasSuppress := tq.suppressSelect
@@ -243,6 +422,23 @@ func (tq *TaskQueueView) populateQueueTable() {
 	}
 }
 
+// taskQueueStaleness describes how recently a queue was polled, colored by health:
+// no pollers or only stale pollers are flagged.
+func taskQueueStaleness(now time.Time, q taskQueueEntry) (string, tcell.Color) {
+	switch {
+	case q.Err != nil:
+		return theme.IconError + " error", theme.Error()
+	case q.Name == "(no task queues found)":
+		return "-", theme.FgDim()
+	case q.LastPollTime.IsZero():
+		return theme.IconWarning + " no pollers", theme.Warning()
+	case now.Sub(q.LastPollTime) > pollerStaleThreshold:
+		return theme.IconWarning + " " + formatTime(now, q.LastPollTime), theme.Warning()
+	default:
+		return formatTime(now, q.LastPollTime), theme.Fg()
+	}
+}
+
 func (tq *TaskQueueView) loadPollers(queueIndex int) {
 	if queueIndex < 0 || queueIndex >= len(tq.queues) {
 		return
@@ -262,7 +458,7 @@ func (tq *TaskQueueView) loadPollers(queueIndex int) {
 	tq.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS")
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		info, pollers, err := provider.DescribeTaskQueue(ctx, tq.app.CurrentNamespace(), queue.Name)
@@ -289,8 +485,7 @@ func (tq *TaskQueueView) updateQueueInfo(queueIndex int, info *temporal.TaskQueu
 		return
 	}
 	// Update the queue entry with real data
-	tq.queues[queueIndex].PollerCount = info.PollerCount
-	tq.queues[queueIndex].Backlog = info.Backlog
+	tq.queues[queueIndex].apply(info)
 	// Suppress selection events during table refresh to avoid recursive loop
 	tq.suppressSelect = true
 	// Refresh the queue table display
@@ -328,7 +523,15 @@ func (tq *TaskQueueView) populatePollerTable(queueType string) {
 			typeIcon = theme.IconActivity
 		}
 
-		lastAccess := formatRelativeTime(now, p.LastAccessTime)
+		lastAccess := formatTime(now, p.LastAccessTime)
+		if pollerStale(now, p) {
+			tq.pollerTable.AddRowWithColor(theme.Warning(),
+				theme.IconWarning+" "+p.Identity,
+				typeIcon+" "+p.TaskQueueType,
+				lastAccess,
+			)
+			continue
+		}
 		tq.pollerTable.AddRow(
 			theme.IconConnected+" "+p.Identity,
 			typeIcon+" "+p.TaskQueueType,
@@ -354,6 +557,29 @@ func (tq *TaskQueueView) refreshCurrentQueue() {
 	}
 }
 
+// openSelectedQueue drills into the selected queue's pollers.
+func (tq *TaskQueueView) openSelectedQueue() {
+	row := tq.queueTable.SelectedRow()
+	if row < 0 || row >= len(tq.queues) || tq.queues[row].Name == "(no task queues found)" {
+		return
+	}
+	tq.app.NavigateToTaskQueueDetail(tq.queues[row].Name)
+}
+
+// openSelectedQueueWorkflows lists the workflows on the selected queue.
+func (tq *TaskQueueView) openSelectedQueueWorkflows() {
+	row := tq.queueTable.SelectedRow()
+	if row < 0 || row >= len(tq.queues) || tq.queues[row].Name == "(no task queues found)" {
+		return
+	}
+	tq.app.NavigateToWorkflowsWithQuery(taskQueueQuery(tq.queues[row].Name))
+}
+
+// taskQueueQuery returns the visibility query matching workflows on a task queue.
+func taskQueueQuery(name string) string {
+	return fmt.Sprintf(`TaskQueue = "%s"`, strings.ReplaceAll(name, `"`, `\"`))
+}
+
 // Name returns the view name.
 func (tq *TaskQueueView) Name() string {
 	return "task-queues"
@@ -366,9 +592,18 @@ func (tq *TaskQueueView) Start() {
 		case event.Key() == tcell.KeyTab:
 			tq.app.JigApp().SetFocus(tq.pollerTable)
 			return nil
+		case event.Key() == tcell.KeyEnter:
+			tq.openSelectedQueue()
+			return nil
 		case event.Rune() == 'r':
 			tq.refreshCurrentQueue()
 			return nil
+		case event.Rune() == 'd':
+			tq.app.NavigateToWorkerDeployments()
+			return nil
+		case event.Rune() == 'w':
+			tq.openSelectedQueueWorkflows()
+			return nil
 		}
 		return event
 	})
@@ -387,17 +622,22 @@ func (tq *TaskQueueView) Start() {
 
 	// Load data when view becomes active
 	tq.loadData()
+	tq.startSampling()
 }
 
 // Stop is called when the view is deactivated.
 func (tq *TaskQueueView) Stop() {
 	tq.queueTable.SetInputCapture(nil)
 	tq.pollerTable.SetInputCapture(nil)
+	tq.stopSamplingStats()
 }
 
 // Hints returns keybinding hints for this view.
 func (tq *TaskQueueView) Hints() []KeyHint {
 	return []KeyHint{
+		{Key: "enter", Description: "Pollers"},
+		{Key: "w", Description: "Workflows"},
+		{Key: "d", Description: "Deployments"},
 		{Key: "r", Description: "Refresh"},
 		{Key: "tab", Description: "Switch Panel"},
 		{Key: "j/k", Description: "Navigate"},
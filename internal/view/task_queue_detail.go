@@ -0,0 +1,445 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// TaskQueueDetail drills into a single task queue, listing each poller with its
+// versioning capabilities and highlighting pollers that stopped polling.
+type TaskQueueDetail struct {
+	*tview.Flex
+	app         *App
+	taskQueue   string
+	info        *temporal.TaskQueueInfo
+	pollers     []temporal.Poller
+	loading     bool
+	loadedAt    time.Time
+	history     statsHistory
+	pollerTable *components.Table
+	summaryView *tview.TextView
+	statsView   *tview.TextView
+	pollerView  *tview.TextView
+	tablePanel  *components.Panel
+	summary     *components.Panel
+	statsPanel  *components.Panel
+	pollerPanel *components.Panel
+
+	// Stats sampling while the view is open
+	sampleTicker *time.Ticker
+	stopSampling chan struct{}
+}
+
+// NewTaskQueueDetail creates a new task queue detail view.
+func NewTaskQueueDetail(app *App, taskQueue string) *TaskQueueDetail {
+	td := &TaskQueueDetail{
+		Flex:         tview.NewFlex().SetDirection(tview.FlexRow),
+		app:          app,
+		taskQueue:    taskQueue,
+		pollerTable:  components.NewTable(),
+		summaryView:  tview.NewTextView(),
+		statsView:    tview.NewTextView(),
+		pollerView:   tview.NewTextView(),
+		history:      statsHistory{},
+		stopSampling: make(chan struct{}),
+	}
+	td.setup()
+	return td
+}
+
+func (td *TaskQueueDetail) setup() {
+	td.SetBackgroundColor(theme.Bg())
+
+	td.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS", "RATE", "BUILD ID", "VERSIONING")
+	td.pollerTable.SetBorder(false)
+	td.pollerTable.SetBackgroundColor(theme.Bg())
+
+	for _, tv := range []*tview.TextView{td.summaryView, td.statsView, td.pollerView} {
+		tv.SetDynamicColors(true)
+		tv.SetBackgroundColor(theme.Bg())
+		tv.SetTextColor(theme.Fg())
+	}
+	td.pollerView.SetWordWrap(true)
+
+	td.summary = components.NewPanel().SetTitle(fmt.Sprintf("%s %s", theme.IconTaskQueue, td.taskQueue))
+	td.summary.SetContent(td.summaryView)
+
+	td.statsPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Backlog & Rates", theme.IconInfo))
+	td.statsPanel.SetContent(td.statsView)
+
+	td.tablePanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Pollers", theme.IconActivity))
+	td.tablePanel.SetContent(td.pollerTable)
+
+	td.pollerPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Poller", theme.IconInfo))
+	td.pollerPanel.SetContent(td.pollerView)
+
+	td.pollerTable.SetSelectionChangedFunc(func(row, col int) {
+		td.renderPoller()
+	})
+
+	body := tview.NewFlex().SetDirection(tview.FlexColumn)
+	body.SetBackgroundColor(theme.Bg())
+	body.AddItem(td.tablePanel, 0, 2, true)
+	body.AddItem(td.pollerPanel, 0, 1, false)
+
+	top := tview.NewFlex().SetDirection(tview.FlexColumn)
+	top.SetBackgroundColor(theme.Bg())
+	top.AddItem(td.summary, 0, 1, false)
+	top.AddItem(td.statsPanel, 0, 2, false)
+
+	td.AddItem(top, 16, 0, false)
+	td.AddItem(body, 0, 1, true)
+}
+
+func (td *TaskQueueDetail) loadData() {
+	provider := td.app.Provider()
+	if provider == nil {
+		td.loadMockData()
+		return
+	}
+	if td.loading {
+		return
+	}
+
+	td.loading = true
+	ctx, cancel := context.WithCancel(context.Background())
+	done := td.app.startLoading(td.tablePanel, "Loading pollers", cancel)
+	go func() {
+		defer cancel()
+
+		info, pollers, err := provider.DescribeTaskQueue(ctx, td.app.CurrentNamespace(), td.taskQueue)
+
+		td.app.JigApp().QueueUpdateDraw(func() {
+			done()
+			td.loading = false
+			if err != nil {
+				td.showError(err)
+				return
+			}
+			td.setData(info, pollers)
+		})
+	}()
+}
+
+func (td *TaskQueueDetail) loadMockData() {
+	now := time.Now()
+	pollers := []temporal.Poller{
+		{Identity: "worker-1@host-001", LastAccessTime: now.Add(-5 * time.Second), TaskQueueType: "Workflow", RatePerSecond: 100000, BuildID: "1.4.0", Versioning: "Versioned", DeploymentName: "orders"},
+		{Identity: "worker-1@host-001", LastAccessTime: now.Add(-3 * time.Second), TaskQueueType: "Activity", RatePerSecond: 100000, BuildID: "1.4.0", Versioning: "Versioned", DeploymentName: "orders"},
+		{Identity: "worker-2@host-002", LastAccessTime: now.Add(-4 * time.Minute), TaskQueueType: "Workflow", RatePerSecond: 100000, BuildID: "1.3.2", Versioning: "Versioned", DeploymentName: "orders"},
+		{Identity: "worker-3@host-003", LastAccessTime: now.Add(-1 * time.Second), TaskQueueType: "Activity", RatePerSecond: 50},
+	}
+	backlog := len(td.history["Activity/backlog"]) % 7
+	info := &temporal.TaskQueueInfo{
+		Name:         td.taskQueue,
+		Type:         "Combined",
+		PollerCount:  len(pollers),
+		Backlog:      backlog,
+		BacklogAge:   12 * time.Second,
+		AddRate:      3.5,
+		DispatchRate: 3.2,
+		LastPollTime: now.Add(-1 * time.Second),
+		Stats: []temporal.TaskQueueStats{
+			{TaskQueueType: "Workflow", AddRate: 1.5, DispatchRate: 1.5},
+			{TaskQueueType: "Activity", Backlog: backlog, BacklogAge: 12 * time.Second, AddRate: 2, DispatchRate: 1.7},
+		},
+	}
+	td.setData(info, pollers)
+}
+
+func (td *TaskQueueDetail) setData(info *temporal.TaskQueueInfo, pollers []temporal.Poller) {
+	td.info = info
+	td.loadedAt = time.Now()
+	td.history.addStats(info.Stats)
+
+	// Stale pollers first, then by type and identity, so problems are at the top
+	sort.SliceStable(pollers, func(i, j int) bool {
+		si, sj := pollerStale(td.loadedAt, pollers[i]), pollerStale(td.loadedAt, pollers[j])
+		if si != sj {
+			return si
+		}
+		if pollers[i].TaskQueueType != pollers[j].TaskQueueType {
+			return pollers[i].TaskQueueType > pollers[j].TaskQueueType // Workflow before Activity
+		}
+		return pollers[i].Identity < pollers[j].Identity
+	})
+	td.pollers = pollers
+
+	td.render()
+}
+
+// pollerStale reports whether a poller hasn't polled within pollerStaleThreshold.
+func pollerStale(now time.Time, p temporal.Poller) bool {
+	return now.Sub(p.LastAccessTime) > pollerStaleThreshold
+}
+
+func (td *TaskQueueDetail) render() {
+	td.renderSummary()
+	if td.info != nil {
+		td.statsView.SetText(renderTaskQueueStats(td.info.Stats, td.history))
+	}
+	td.renderTable()
+	td.renderPoller()
+}
+
+func (td *TaskQueueDetail) renderSummary() {
+	if td.info == nil {
+		return
+	}
+
+	stale := 0
+	for _, p := range td.pollers {
+		if pollerStale(td.loadedAt, p) {
+			stale++
+		}
+	}
+
+	pollers := fmt.Sprintf("[%s]%d[-]", theme.TagFg(), td.info.PollerCount)
+	if stale > 0 {
+		pollers += fmt.Sprintf(" [%s]%s %d stale[-]", theme.TagWarning(), theme.IconWarning, stale)
+	}
+
+	backlog := fmt.Sprintf("[%s]%d[-]", theme.TagFg(), td.info.Backlog)
+	if td.info.Backlog > 0 && td.info.BacklogAge > 0 {
+		backlog += fmt.Sprintf(" [%s](oldest %s)[-]", theme.TagFgDim(), temporal.FormatDuration(td.info.BacklogAge))
+	}
+
+	lastPoll, lastPollColor := taskQueueStaleness(td.loadedAt, taskQueueEntry{Name: td.taskQueue, LastPollTime: td.info.LastPollTime})
+
+	td.summaryView.SetText(fmt.Sprintf(`
+[%s::b]Pollers[-:-:-]     %s
+[%s::b]Backlog[-:-:-]     %s
+[%s::b]Rates[-:-:-]       [%s]add %s/s, dispatch %s/s[-]
+[%s::b]Last Poll[-:-:-]   [%s]%s[-]`,
+		theme.TagFgDim(), pollers,
+		theme.TagFgDim(), backlog,
+		theme.TagFgDim(), theme.TagFg(), formatRate(td.info.AddRate), formatRate(td.info.DispatchRate),
+		theme.TagFgDim(), theme.ColorToHex(lastPollColor), lastPoll,
+	))
+}
+
+func (td *TaskQueueDetail) renderTable() {
+	currentRow := td.pollerTable.SelectedRow()
+
+	td.pollerTable.ClearRows()
+	td.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS", "RATE", "BUILD ID", "VERSIONING")
+
+	for _, p := range td.pollers {
+		typeIcon := theme.IconWorkflow
+		if p.TaskQueueType == "Activity" {
+			typeIcon = theme.IconActivity
+		}
+
+		identity := theme.IconConnected + " " + p.Identity
+		if pollerStale(td.loadedAt, p) {
+			identity = theme.IconWarning + " " + p.Identity
+		}
+
+		cells := []string{
+			identity,
+			typeIcon + " " + p.TaskQueueType,
+			formatTime(td.loadedAt, p.LastAccessTime),
+			formatPollerRate(p.RatePerSecond),
+			valueOrDash(p.BuildID),
+			valueOrDash(p.Versioning),
+		}
+		if pollerStale(td.loadedAt, p) {
+			td.pollerTable.AddRowWithColor(theme.Warning(), cells...)
+		} else {
+			td.pollerTable.AddRow(cells...)
+		}
+	}
+
+	if len(td.pollers) == 0 {
+		td.pollerTable.AddRowWithColor(theme.FgDim(), "(no pollers)", "", "", "", "", "")
+		return
+	}
+
+	if currentRow >= 0 && currentRow < len(td.pollers) {
+		td.pollerTable.SelectRow(currentRow)
+	} else {
+		td.pollerTable.SelectRow(0)
+	}
+}
+
+func (td *TaskQueueDetail) renderPoller() {
+	row := td.pollerTable.SelectedRow()
+	if row < 0 || row >= len(td.pollers) {
+		td.pollerView.SetText("")
+		return
+	}
+	p := td.pollers[row]
+
+	lastAccess := fmt.Sprintf("[%s]%s[-]", theme.TagFg(), formatTime(td.loadedAt, p.LastAccessTime))
+	if pollerStale(td.loadedAt, p) {
+		lastAccess = fmt.Sprintf("[%s]%s %s (stale)[-]", theme.TagWarning(), theme.IconWarning,
+			formatTime(td.loadedAt, p.LastAccessTime))
+	}
+
+	td.pollerView.SetText(fmt.Sprintf(`
+[%s::b]Identity[-:-:-]
+  [%s]%s[-]
+
+[%s::b]Task Queue Type[-:-:-]
+  [%s]%s[-]
+
+[%s::b]Last Access[-:-:-]
+  %s
+  [%s]%s[-]
+
+[%s::b]Rate Limit[-:-:-]
+  [%s]%s[-]
+
+[%s::b]Worker Versioning[-:-:-]
+  [%s]Mode[-]        [%s]%s[-]
+  [%s]Build ID[-]    [%s]%s[-]
+  [%s]Deployment[-]  [%s]%s[-]`,
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(p.Identity),
+		theme.TagFgDim(), theme.TagFg(), p.TaskQueueType,
+		theme.TagFgDim(), lastAccess, theme.TagFgDim(), inTimezone(p.LastAccessTime).Format(time.RFC3339),
+		theme.TagFgDim(), theme.TagFg(), formatPollerRate(p.RatePerSecond),
+		theme.TagFgDim(),
+		theme.TagFgDim(), theme.TagFg(), valueOrDash(p.Versioning),
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(valueOrDash(p.BuildID)),
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(valueOrDash(p.DeploymentName)),
+	))
+}
+
+// formatPollerRate formats a poller's configured rate limit. Workers report a very
+// large value when no limit is set.
+func formatPollerRate(rate float64) string {
+	if rate <= 0 || rate >= 100000 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%g/s", rate)
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func (td *TaskQueueDetail) showError(err error) {
+	td.summaryView.SetText(fmt.Sprintf("\n [%s]Error: %s[-]", theme.TagError(), tview.Escape(err.Error())))
+	td.statsView.SetText("")
+	td.pollerTable.ClearRows()
+	td.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS", "RATE", "BUILD ID", "VERSIONING")
+	td.pollerView.SetText("")
+}
+
+// RefreshTheme updates all component colors after a theme change.
+func (td *TaskQueueDetail) RefreshTheme() {
+	bg := theme.Bg()
+	td.SetBackgroundColor(bg)
+	td.pollerTable.SetBackgroundColor(bg)
+	for _, tv := range []*tview.TextView{td.summaryView, td.statsView, td.pollerView} {
+		tv.SetBackgroundColor(bg)
+		tv.SetTextColor(theme.Fg())
+	}
+	td.render()
+}
+
+// Name returns the view name.
+func (td *TaskQueueDetail) Name() string {
+	return "task-queue-detail"
+}
+
+// Start is called when the view becomes active.
+func (td *TaskQueueDetail) Start() {
+	td.pollerTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 'r':
+			td.loadData()
+			return nil
+		case 'v':
+			td.app.NavigateToVersioningRules(td.taskQueue)
+			return nil
+		case 'w':
+			td.app.NavigateToWorkflowsWithQuery(taskQueueQuery(td.taskQueue))
+			return nil
+		case 'b':
+			buildID := ""
+			if row := td.pollerTable.SelectedRow(); row >= 0 && row < len(td.pollers) {
+				buildID = td.pollers[row].BuildID
+			}
+			showReachabilityForm(td.app, td.taskQueue, buildID)
+			return nil
+		}
+		return event
+	})
+	td.loadData()
+	td.startSampling()
+}
+
+// Stop is called when the view is deactivated.
+func (td *TaskQueueDetail) Stop() {
+	td.pollerTable.SetInputCapture(nil)
+	td.stopSamplingStats()
+}
+
+// startSampling reloads the queue periodically so the trend sparklines fill in
+// while the view is open.
+func (td *TaskQueueDetail) startSampling() {
+	if td.sampleTicker != nil {
+		return
+	}
+	td.sampleTicker = time.NewTicker(taskQueueSampleInterval)
+	ticker := td.sampleTicker
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				td.app.JigApp().QueueUpdateDraw(func() {
+					td.loadData()
+				})
+			case <-td.stopSampling:
+				return
+			}
+		}
+	}()
+}
+
+func (td *TaskQueueDetail) stopSamplingStats() {
+	if td.sampleTicker != nil {
+		td.sampleTicker.Stop()
+		td.sampleTicker = nil
+	}
+	select {
+	case td.stopSampling <- struct{}{}:
+	default:
+	}
+}
+
+// Hints returns keybinding hints for this view.
+func (td *TaskQueueDetail) Hints() []KeyHint {
+	return []KeyHint{
+		{Key: "r", Description: "Refresh"},
+		{Key: "w", Description: "Workflows"},
+		{Key: "v", Description: "Versioning Rules"},
+		{Key: "b", Description: "Reachability"},
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "T", Description: "Theme"},
+		{Key: "esc", Description: "Back"},
+	}
+}
+
+// Focus sets focus to the poller table.
+func (td *TaskQueueDetail) Focus(delegate func(p tview.Primitive)) {
+	delegate(td.pollerTable)
+}
+
+// Draw applies theme colors dynamically and draws the view.
+func (td *TaskQueueDetail) Draw(screen tcell.Screen) {
+	td.SetBackgroundColor(theme.Bg())
+	td.Flex.Draw(screen)
+}
@@ -0,0 +1,108 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// How often task queue stats are sampled while a task queue view is open, and
+// how many samples the trend sparklines keep.
+const (
+	taskQueueSampleInterval = 10 * time.Second
+	sparklineSamples        = 30
+)
+
+// statsHistory keeps recent samples of task queue metrics, keyed by metric name.
+type statsHistory map[string][]float64
+
+// add appends a sample, dropping the oldest beyond sparklineSamples.
+func (h statsHistory) add(key string, value float64) {
+	values := append(h[key], value)
+	if len(values) > sparklineSamples {
+		values = values[len(values)-sparklineSamples:]
+	}
+	h[key] = values
+}
+
+// addStats records the backlog and rates of each task queue type.
+func (h statsHistory) addStats(stats []temporal.TaskQueueStats) {
+	for _, s := range stats {
+		h.add(s.TaskQueueType+"/backlog", float64(s.Backlog))
+		h.add(s.TaskQueueType+"/add", s.AddRate)
+		h.add(s.TaskQueueType+"/dispatch", s.DispatchRate)
+	}
+}
+
+// sparkline renders values as a row of block characters scaled to the largest
+// value, so a flat series of zeros renders as a baseline.
+func sparkline(values []float64) string {
+	blocks := []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+	var maxVal float64
+	for _, v := range values {
+		if v > maxVal {
+			maxVal = v
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		i := 0
+		if maxVal > 0 && v > 0 {
+			i = int(v / maxVal * float64(len(blocks)-1))
+		}
+		b.WriteRune(blocks[i])
+	}
+	return b.String()
+}
+
+// formatRate formats a tasks-per-second rate.
+func formatRate(rate float64) string {
+	switch {
+	case rate == 0:
+		return "0"
+	case rate < 10:
+		return fmt.Sprintf("%.2f", rate)
+	default:
+		return fmt.Sprintf("%.0f", rate)
+	}
+}
+
+// renderTaskQueueStats renders per-type stats with backlog and rate trends.
+func renderTaskQueueStats(stats []temporal.TaskQueueStats, history statsHistory) string {
+	if len(stats) == 0 {
+		return fmt.Sprintf("\n [%s]No stats reported by the server[-]", theme.TagFgDim())
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n[%s::b]%-10s %8s %8s %9s %11s[-:-:-]\n",
+		theme.TagFgDim(), "TYPE", "BACKLOG", "AGE", "ADD/S", "DISPATCH/S")
+	for _, s := range stats {
+		age := "-"
+		if s.Backlog > 0 && s.BacklogAge > 0 {
+			age = temporal.FormatDuration(s.BacklogAge)
+		}
+		backlogTag := theme.TagFg()
+		if s.DispatchRate < s.AddRate && s.Backlog > 0 {
+			// Backlog is growing
+			backlogTag = theme.TagWarning()
+		}
+		fmt.Fprintf(&b, "[%s]%-10s[-] [%s]%8d[-] [%s]%8s %9s %11s[-]\n",
+			theme.TagFg(), s.TaskQueueType,
+			backlogTag, s.Backlog,
+			theme.TagFg(), age, formatRate(s.AddRate), formatRate(s.DispatchRate))
+	}
+
+	for _, s := range stats {
+		fmt.Fprintf(&b, "\n[%s::b]%s trends[-:-:-]\n", theme.TagFgDim(), s.TaskQueueType)
+		for _, metric := range []string{"backlog", "add", "dispatch"} {
+			fmt.Fprintf(&b, "  [%s]%-8s[-] [%s]%s[-]\n", theme.TagFgDim(), metric, theme.TagAccent(),
+				sparkline(history[s.TaskQueueType+"/"+metric]))
+		}
+	}
+	return b.String()
+}
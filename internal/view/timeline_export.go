@@ -0,0 +1,219 @@
+package view
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+)
+
+// Layout of exported timelines, in pixels.
+const (
+	svgLabelWidth = 280
+	svgBarWidth   = 900
+	svgRowHeight  = 22
+	svgHeaderY    = 30
+	svgPadding    = 10
+)
+
+// cssColor returns a theme color as a CSS hex color, or fallback for the default color.
+func cssColor(c tcell.Color, fallback string) string {
+	if hex := c.Hex(); hex >= 0 {
+		return fmt.Sprintf("#%06x", hex)
+	}
+	return fallback
+}
+
+// RenderSVG renders the timeline as a standalone SVG Gantt chart covering the
+// full time range, with critical path lanes outlined.
+func (tv *TimelineView) RenderSVG(title string) string {
+	timeRange := tv.endTime.Sub(tv.startTime)
+	if timeRange <= 0 {
+		timeRange = time.Minute
+	}
+
+	width := svgPadding*2 + svgLabelWidth + svgBarWidth
+	height := svgHeaderY + svgRowHeight*(len(tv.lanes)+1) + svgPadding*2
+	bg := cssColor(theme.Bg(), "#ffffff")
+	fg := cssColor(theme.Fg(), "#000000")
+	dim := cssColor(theme.FgDim(), "#888888")
+	border := cssColor(theme.Border(), "#cccccc")
+	accent := cssColor(theme.Accent(), "#3b82f6")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="monospace" font-size="12">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", bg)
+	fmt.Fprintf(&b, `<text x="%d" y="%d" fill="%s" font-weight="bold">%s</text>`+"\n",
+		svgPadding, svgPadding+12, fg, html.EscapeString(title))
+
+	// Time scale
+	barX := svgPadding + svgLabelWidth
+	axisY := svgPadding + svgHeaderY
+	const markers = 5
+	for i := 0; i <= markers; i++ {
+		x := barX + svgBarWidth*i/markers
+		offset := roundDuration(timeRange * time.Duration(i) / markers)
+		fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="%s"/>`+"\n",
+			x, axisY-4, x, height-svgPadding, border)
+		fmt.Fprintf(&b, `<text x="%d" y="%d" fill="%s" text-anchor="middle">%s</text>`+"\n",
+			x, axisY-8, dim, formatRelativeDuration(offset))
+	}
+
+	// Lanes
+	for i, lane := range tv.lanes {
+		y := axisY + svgRowHeight*i
+		_, color := tv.barStyle(lane.Status)
+
+		start := svgBarWidth * float64(lane.StartTime.Sub(tv.startTime)) / float64(timeRange)
+		end := float64(svgBarWidth)
+		if lane.EndTime != nil {
+			end = svgBarWidth * float64(lane.EndTime.Sub(tv.startTime)) / float64(timeRange)
+		}
+		if end-start < 2 {
+			end = start + 2
+		}
+
+		stroke := ""
+		if tv.criticalPath.Contains(lane.Node) {
+			stroke = fmt.Sprintf(` stroke="%s" stroke-width="2"`, accent)
+		}
+
+		duration := "running"
+		if lane.EndTime != nil {
+			duration = formatRelativeDuration(lane.EndTime.Sub(lane.StartTime))
+		}
+		tooltip := fmt.Sprintf("%s\n%s, started +%s, %s", strings.TrimSpace(lane.Name), lane.Status,
+			formatRelativeDuration(lane.StartTime.Sub(tv.startTime)), duration)
+
+		fmt.Fprintf(&b, `<text x="%d" y="%d" fill="%s" xml:space="preserve">%s</text>`+"\n",
+			svgPadding, y+15, cssColor(tv.statusColor(lane.Status), fg), html.EscapeString(truncate(lane.Name, 38)))
+		fmt.Fprintf(&b, `<rect x="%.1f" y="%d" width="%.1f" height="%d" rx="2" fill="%s"%s><title>%s</title></rect>`+"\n",
+			float64(barX)+start, y+4, end-start, svgRowHeight-8, cssColor(color, fg), stroke, html.EscapeString(tooltip))
+	}
+
+	b.WriteString("</svg>\n")
+	return b.String()
+}
+
+// RenderHTML renders the timeline as a standalone HTML page embedding the SVG
+// chart, followed by the critical path summary.
+func (tv *TimelineView) RenderHTML(title string) string {
+	bg := cssColor(theme.Bg(), "#ffffff")
+	fg := cssColor(theme.Fg(), "#000000")
+	dim := cssColor(theme.FgDim(), "#888888")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+<style>
+body { background: %s; color: %s; font-family: monospace; margin: 2em; }
+.dim { color: %s; }
+table { border-collapse: collapse; margin-top: 1em; }
+td, th { padding: 2px 12px 2px 0; text-align: left; }
+</style>
+</head>
+<body>
+<h2>%s</h2>
+<p class="dim">%s &ndash; %s (%s)</p>
+`, html.EscapeString(title), bg, fg, dim, html.EscapeString(title),
+		tv.startTime.Format(time.RFC3339), tv.endTime.Format(time.RFC3339),
+		formatRelativeDuration(tv.endTime.Sub(tv.startTime)))
+
+	b.WriteString(tv.RenderSVG(title))
+
+	if cp := tv.criticalPath; cp != nil && len(cp.Nodes) > 0 {
+		b.WriteString("<h3>Critical Path</h3>\n<table>\n<tr><th>Lane</th><th>Time</th><th>Share</th></tr>\n")
+		for _, lane := range cp.Lanes {
+			name := lane.Name
+			if lane.Count > 1 {
+				name = fmt.Sprintf("%s ×%d", name, lane.Count)
+			}
+			fmt.Fprintf(&b, "<tr><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+				html.EscapeString(name), temporal.FormatDuration(lane.Duration), percentOf(lane.Duration, cp.Total))
+		}
+		if cp.Other > 0 {
+			fmt.Fprintf(&b, "<tr><td class=\"dim\">workflow tasks and idle</td><td>%s</td><td>%s</td></tr>\n",
+				temporal.FormatDuration(cp.Other), percentOf(cp.Other, cp.Total))
+		}
+		b.WriteString("</table>\n")
+	}
+
+	b.WriteString("</body>\n</html>\n")
+	return b.String()
+}
+
+// showTimelineExport asks for a file path and writes the timeline to it, as SVG
+// for a .svg extension and as a standalone HTML page otherwise.
+func (eh *EventHistory) showTimelineExport() {
+	if eh.timelineView.LaneCount() == 0 {
+		eh.app.ShowToastWarning("Nothing to export: timeline is empty")
+		return
+	}
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Export Timeline", theme.IconEvent),
+		Width:    80,
+		Height:   12,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("path", "File Path (.html or .svg)", "")
+	_ = form.SetValues(map[string]any{
+		"path": strings.TrimSuffix(defaultExportPath(eh.workflowID, eh.runID), ".json") + "_timeline.html",
+	})
+
+	closeForm := func() {
+		eh.app.Modals().Close("timeline-export-form")
+	}
+	submit := func(values map[string]any) {
+		path := strings.TrimSpace(values["path"].(string))
+		if path == "" {
+			return
+		}
+		closeForm()
+		eh.executeTimelineExport(expandHome(path))
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(closeForm)
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Export"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(closeForm)
+
+	eh.app.Modals().Show("timeline-export-form", modal, form)
+}
+
+func (eh *EventHistory) executeTimelineExport(path string) {
+	title := "Timeline: " + eh.workflowID
+	var data string
+	if strings.EqualFold(filepath.Ext(path), ".svg") {
+		data = eh.timelineView.RenderSVG(title)
+	} else {
+		data = eh.timelineView.RenderHTML(title)
+	}
+
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		ShowErrorModal(eh.app.Modals(), "Export Failed", err.Error())
+		return
+	}
+	ShowInfoModal(eh.app.Modals(), "Timeline Exported",
+		fmt.Sprintf("Wrote %s to %s", temporal.FormatBytes(len(data)), path))
+}
@@ -25,9 +25,31 @@ type TimelineLane struct {
 	Node      *temporal.EventTreeNode
 }
 
+// WorkflowTaskLaneMode controls how workflow task lanes appear in the timeline.
+type WorkflowTaskLaneMode int
+
+const (
+	WorkflowTasksHidden WorkflowTaskLaneMode = iota // No workflow task lanes
+	WorkflowTasksMerged                             // Consecutive workflow tasks share one lane
+	WorkflowTasksAll                                // One lane per workflow task
+)
+
+// String returns a display name for the mode.
+func (m WorkflowTaskLaneMode) String() string {
+	switch m {
+	case WorkflowTasksMerged:
+		return "merged"
+	case WorkflowTasksAll:
+		return "all"
+	default:
+		return "hidden"
+	}
+}
+
 // TimelineView displays workflow events as a horizontal Gantt-style timeline.
 type TimelineView struct {
 	*tview.Box
+	nodes             []*temporal.EventTreeNode
 	lanes             []TimelineLane
 	startTime         time.Time
 	endTime           time.Time
@@ -37,6 +59,22 @@ type TimelineView struct {
 	selectedLane      int
 	onSelect          func(lane *TimelineLane)
 	onSelectionChange func(lane *TimelineLane)
+	criticalPath      *temporal.CriticalPath
+	showCritical      bool
+	workflowTasks     WorkflowTaskLaneMode
+	laneFilter        []temporal.EventGroupType // Only these lane types are shown; nil shows all
+	laneFilterIndex   int                       // Position in timelineLaneFilters
+	hideCompleted     bool
+
+	// Time cursor: a movable vertical line for correlating with external logs
+	cursorOn       bool
+	cursorTime     time.Time
+	onCursorChange func(t time.Time, active []TimelineLane)
+
+	// Mouse drag over the bar area, in columns relative to the bar start
+	dragging  bool
+	dragStart int
+	dragEnd   int
 }
 
 // NewTimelineView creates a new timeline/Gantt chart view.
@@ -46,6 +84,7 @@ func NewTimelineView() *TimelineView {
 		lanes:        []TimelineLane{},
 		zoomLevel:    1.0,
 		selectedLane: 0,
+		showCritical: true,
 	}
 
 	tv.SetBackgroundColor(tcell.ColorDefault)
@@ -58,10 +97,182 @@ func NewTimelineView() *TimelineView {
 func (tv *TimelineView) Destroy() {}
 
 // SetNodes populates the timeline from event tree nodes.
+// The selected lane is kept across refreshes of the same history.
 func (tv *TimelineView) SetNodes(nodes []*temporal.EventTreeNode) {
-	tv.lanes = nil
-	tv.selectedLane = 0
+	tv.nodes = nodes
+	tv.criticalPath = nil
+	if len(nodes) > 0 {
+		tv.criticalPath = temporal.ComputeCriticalPath(nodes, time.Now())
+	}
+	tv.buildLanes()
+	if tv.selectedLane >= len(tv.lanes) {
+		tv.selectedLane = 0
+	}
+}
+
+// WorkflowTasks returns how workflow task lanes are shown.
+func (tv *TimelineView) WorkflowTasks() WorkflowTaskLaneMode {
+	return tv.workflowTasks
+}
+
+// CycleWorkflowTasks switches workflow task lanes between hidden, merged and
+// all, and returns the new mode.
+func (tv *TimelineView) CycleWorkflowTasks() WorkflowTaskLaneMode {
+	tv.workflowTasks = (tv.workflowTasks + 1) % 3
+	tv.rebuildLanes()
+	return tv.workflowTasks
+}
+
+// timelineLaneFilters are the lane type filters cycled through, starting with all lanes.
+var timelineLaneFilters = [][]temporal.EventGroupType{
+	nil,
+	{temporal.GroupActivity},
+	{temporal.GroupTimer},
+	{temporal.GroupChildWorkflow},
+}
+
+// CycleLaneFilter switches between showing all lanes and only activities,
+// timers or child workflows, and returns a description of the new filter.
+func (tv *TimelineView) CycleLaneFilter() string {
+	tv.laneFilterIndex = (tv.laneFilterIndex + 1) % len(timelineLaneFilters)
+	tv.laneFilter = timelineLaneFilters[tv.laneFilterIndex]
+	tv.rebuildLanes()
+	return tv.LaneFilter()
+}
+
+// LaneFilter describes the lane type filter, or returns "" when all lanes are shown.
+func (tv *TimelineView) LaneFilter() string {
+	if len(tv.laneFilter) == 0 {
+		return ""
+	}
+	switch tv.laneFilter[0] {
+	case temporal.GroupActivity:
+		return "activities"
+	case temporal.GroupTimer:
+		return "timers"
+	case temporal.GroupChildWorkflow:
+		return "child workflows"
+	default:
+		return tv.laneFilter[0].String()
+	}
+}
+
+// ToggleHideCompleted hides or shows lanes that completed successfully and
+// reports whether they are now hidden.
+func (tv *TimelineView) ToggleHideCompleted() bool {
+	tv.hideCompleted = !tv.hideCompleted
+	tv.rebuildLanes()
+	return tv.hideCompleted
+}
+
+// HideCompleted reports whether successfully completed lanes are hidden.
+func (tv *TimelineView) HideCompleted() bool {
+	return tv.hideCompleted
+}
+
+// rebuildLanes lays out lanes again after a display option changed.
+func (tv *TimelineView) rebuildLanes() {
+	tv.buildLanes()
+	if tv.selectedLane >= len(tv.lanes) {
+		tv.selectedLane = 0
+	}
+	tv.scrollY = 0
+}
+
+// showLane reports whether a node passes the lane type and completion filters.
+func (tv *TimelineView) showLane(node *temporal.EventTreeNode) bool {
+	if tv.hideCompleted && (node.Status == "Completed" || node.Status == "Fired") {
+		return false
+	}
+	if len(tv.laneFilter) == 0 {
+		return true
+	}
+	for _, t := range tv.laneFilter {
+		if node.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+// laneNodes returns the nodes shown as lanes, applying the workflow task mode
+// and lane filters.
+func (tv *TimelineView) laneNodes() []*temporal.EventTreeNode {
+	var result []*temporal.EventTreeNode
+	var run []*temporal.EventTreeNode
+
+	flush := func() {
+		if len(run) == 1 {
+			result = append(result, run[0])
+		} else if len(run) > 1 {
+			result = append(result, mergeWorkflowTasks(run))
+		}
+		run = nil
+	}
+
+	for _, node := range tv.nodes {
+		if node.Type != temporal.GroupWorkflowTask {
+			// Workflow-level events have no lane and do not break a run of tasks
+			if node.Type != temporal.GroupWorkflow {
+				flush()
+			}
+			if node.Type == temporal.GroupWorkflow || tv.showLane(node) {
+				result = append(result, node)
+			}
+			continue
+		}
+		if len(tv.laneFilter) > 0 {
+			continue
+		}
+		switch tv.workflowTasks {
+		case WorkflowTasksAll:
+			result = append(result, node)
+		case WorkflowTasksMerged:
+			run = append(run, node)
+		}
+	}
+	flush()
+
+	// Merged workflow tasks are filtered as a whole
+	if tv.hideCompleted {
+		filtered := result[:0]
+		for _, node := range result {
+			if node.Type != temporal.GroupWorkflowTask || tv.showLane(node) {
+				filtered = append(filtered, node)
+			}
+		}
+		result = filtered
+	}
+	return result
+}
+
+// mergeWorkflowTasks combines consecutive workflow tasks into one node spanning
+// all of them. The merged node fails if any task failed.
+func mergeWorkflowTasks(tasks []*temporal.EventTreeNode) *temporal.EventTreeNode {
+	first, last := tasks[0], tasks[len(tasks)-1]
+	merged := &temporal.EventTreeNode{
+		Name:      fmt.Sprintf("WorkflowTasks ×%d", len(tasks)),
+		Type:      temporal.GroupWorkflowTask,
+		Status:    last.Status,
+		StartTime: first.StartTime,
+		EndTime:   last.EndTime,
+	}
+	for _, t := range tasks {
+		merged.Events = append(merged.Events, t.Events...)
+		if t.Status == "Failed" || t.Status == "TimedOut" {
+			merged.Status = t.Status
+		}
+	}
+	if merged.EndTime != nil {
+		merged.Duration = merged.EndTime.Sub(merged.StartTime)
+	}
+	return merged
+}
 
+// buildLanes lays out lanes for the current nodes and workflow task mode.
+func (tv *TimelineView) buildLanes() {
+	tv.lanes = nil
+	nodes := tv.laneNodes()
 	if len(nodes) == 0 {
 		return
 	}
@@ -73,7 +284,8 @@ func (tv *TimelineView) SetNodes(nodes []*temporal.EventTreeNode) {
 
 	for _, node := range nodes {
 		// Skip workflow-level events, only show activities/timers/child workflows
-		if node.Type == temporal.GroupWorkflow || node.Type == temporal.GroupWorkflowTask {
+		// and the workflow tasks kept by laneNodes
+		if node.Type == temporal.GroupWorkflow {
 			continue
 		}
 
@@ -92,6 +304,18 @@ func (tv *TimelineView) SetNodes(nodes []*temporal.EventTreeNode) {
 		}
 		validLanes = append(validLanes, lane)
 
+		// Retried activities get a sub-lane per attempt
+		for _, child := range node.Children {
+			validLanes = append(validLanes, TimelineLane{
+				Name:      "  " + child.Name,
+				Type:      child.Type,
+				Status:    child.Status,
+				StartTime: child.StartTime,
+				EndTime:   child.EndTime,
+				Node:      child,
+			})
+		}
+
 		// Update time range
 		if firstValid || node.StartTime.Before(minStart) {
 			minStart = node.StartTime
@@ -109,8 +333,9 @@ func (tv *TimelineView) SetNodes(nodes []*temporal.EventTreeNode) {
 	tv.lanes = validLanes
 	tv.startTime = minStart
 
-	// Set end time: use max end time, or now for running items
-	if maxEnd.IsZero() || maxEnd.Before(minStart) {
+	// Set end time: use max end time, or now for running items and workflows
+	// so the right edge stays pinned to the present while the workflow runs
+	if maxEnd.IsZero() || maxEnd.Before(minStart) || workflowRunning(tv.nodes) {
 		tv.endTime = time.Now()
 	} else {
 		tv.endTime = maxEnd
@@ -175,6 +400,24 @@ func (tv *TimelineView) Draw(screen tcell.Screen) {
 		tv.drawCursor(screen, barStartX, y, barAreaWidth, height, timeRange)
 	}
 
+	if tv.cursorOn {
+		tv.drawTimeCursor(screen, barStartX, y, barAreaWidth, height, timeRange)
+	}
+
+	// Highlight the range being dragged out for zooming
+	if tv.dragging && tv.dragEnd != tv.dragStart {
+		from, to := tv.dragStart, tv.dragEnd
+		if from > to {
+			from, to = to, from
+		}
+		style := tcell.StyleDefault.Foreground(theme.Bg()).Background(theme.Accent())
+		for col := from; col <= to && col < barAreaWidth; col++ {
+			if col >= 0 {
+				screen.SetContent(barStartX+col, y+1, '━', nil, style)
+			}
+		}
+	}
+
 	// Draw legend at bottom if space
 	if height > len(tv.lanes)+4 {
 		tv.drawLegend(screen, x, y+height-1, width)
@@ -251,7 +494,7 @@ func (tv *TimelineView) drawHeader(screen tcell.Screen, x, y, width int) {
 // drawLaneLabel draws the label for a lane.
 func (tv *TimelineView) drawLaneLabel(screen tcell.Screen, x, y int, lane TimelineLane, selected bool) {
 	// Truncate name if needed
-	name := lane.Name
+	name := statusLabel(lane.Status, lane.Name)
 	maxLen := timelineLabelWidth - 2
 	if len(name) > maxLen {
 		name = name[:maxLen-1] + "…"
@@ -278,9 +521,14 @@ func (tv *TimelineView) drawLaneLabel(screen tcell.Screen, x, y int, lane Timeli
 		screen.SetContent(x+i, y, r, nil, style)
 	}
 
-	// Draw separator
+	// Draw separator, marking lanes on the critical path
 	sepStyle := tcell.StyleDefault.Foreground(theme.Border()).Background(theme.Bg())
-	screen.SetContent(x+timelineLabelWidth, y, '│', nil, sepStyle)
+	sep := '│'
+	if tv.isCritical(lane) {
+		sepStyle = tcell.StyleDefault.Foreground(theme.Accent()).Background(theme.Bg())
+		sep = '▌'
+	}
+	screen.SetContent(x+timelineLabelWidth, y, sep, nil, sepStyle)
 }
 
 // drawLaneBar draws the timeline bar for a lane.
@@ -323,6 +571,9 @@ func (tv *TimelineView) drawLaneBar(screen tcell.Screen, x, y, width int, lane T
 	barChar, barColor := tv.barStyle(lane.Status)
 	barStyle := tcell.StyleDefault.Foreground(barColor).Background(theme.Bg())
 
+	if tv.isCritical(lane) {
+		barStyle = barStyle.Foreground(theme.Accent())
+	}
 	if selected {
 		barStyle = barStyle.Bold(true)
 	}
@@ -471,6 +722,117 @@ func (tv *TimelineView) drawCursor(screen tcell.Screen, x, y, width, height int,
 	}
 }
 
+// timeColumn returns the bar-area column of t at the current zoom and scroll.
+func (tv *TimelineView) timeColumn(t time.Time, width int, timeRange time.Duration) int {
+	pos := int(float64(width) * float64(t.Sub(tv.startTime)) / float64(timeRange))
+	return int(float64(pos)*tv.zoomLevel) - tv.scrollX
+}
+
+// drawTimeCursor draws the time cursor line with its timestamp in the header.
+func (tv *TimelineView) drawTimeCursor(screen tcell.Screen, x, y, width, height int, timeRange time.Duration) {
+	col := tv.timeColumn(tv.cursorTime, width, timeRange)
+	if col < 0 || col >= width {
+		return
+	}
+
+	lanesEnd := y + 2 + (len(tv.lanes) - tv.scrollY)
+	if lanesEnd > y+height-1 {
+		lanesEnd = y + height - 1
+	}
+	lineStyle := tcell.StyleDefault.Foreground(theme.Warning()).Background(theme.Bg())
+	for row := y + 1; row < lanesEnd; row++ {
+		screen.SetContent(x+col, row, '┊', nil, lineStyle)
+	}
+
+	label := fmt.Sprintf(" %s +%s ", inTimezone(tv.cursorTime).Format("15:04:05.000"), formatRelativeDuration(tv.cursorTime.Sub(tv.startTime)))
+	labelX := x + col
+	if labelX+len(label) > x+width {
+		labelX = x + width - len(label)
+	}
+	if labelX < x {
+		labelX = x
+	}
+	labelStyle := tcell.StyleDefault.Foreground(theme.Bg()).Background(theme.Warning())
+	for i, r := range label {
+		if labelX+i < x+width {
+			screen.SetContent(labelX+i, y, r, nil, labelStyle)
+		}
+	}
+}
+
+// ToggleTimeCursor shows or hides the time cursor and reports the new state. The
+// cursor starts at the selected lane's start time.
+func (tv *TimelineView) ToggleTimeCursor() bool {
+	tv.cursorOn = !tv.cursorOn
+	if tv.cursorOn {
+		tv.cursorTime = tv.startTime
+		if lane := tv.SelectedLane(); lane != nil {
+			tv.cursorTime = lane.StartTime
+		}
+		tv.notifyCursor()
+	}
+	return tv.cursorOn
+}
+
+// moveTimeCursor moves the time cursor by a number of screen columns.
+func (tv *TimelineView) moveTimeCursor(columns int) {
+	if !tv.cursorOn {
+		return
+	}
+	_, _, width, _ := tv.GetInnerRect()
+	barAreaWidth := width - timelineLabelWidth - 1
+	if barAreaWidth < timelineMinWidth {
+		barAreaWidth = timelineMinWidth
+	}
+
+	perColumn := time.Duration(float64(tv.endTime.Sub(tv.startTime)) / (float64(barAreaWidth) * tv.zoomLevel))
+	if perColumn <= 0 {
+		return
+	}
+	t := tv.cursorTime.Add(perColumn * time.Duration(columns))
+	if t.Before(tv.startTime) {
+		t = tv.startTime
+	}
+	if t.After(tv.endTime) {
+		t = tv.endTime
+	}
+	tv.cursorTime = t
+
+	// Keep the cursor on screen
+	col := tv.timeColumn(t, barAreaWidth, tv.endTime.Sub(tv.startTime))
+	if col < 0 {
+		tv.scroll(col)
+	} else if col >= barAreaWidth {
+		tv.scroll(col - barAreaWidth + 1)
+	}
+	tv.notifyCursor()
+}
+
+// ActiveLanes returns the lanes running at t.
+func (tv *TimelineView) ActiveLanes(t time.Time) []TimelineLane {
+	var active []TimelineLane
+	for _, lane := range tv.lanes {
+		if lane.StartTime.After(t) {
+			continue
+		}
+		if lane.EndTime == nil || !lane.EndTime.Before(t) {
+			active = append(active, lane)
+		}
+	}
+	return active
+}
+
+func (tv *TimelineView) notifyCursor() {
+	if tv.onCursorChange != nil {
+		tv.onCursorChange(tv.cursorTime, tv.ActiveLanes(tv.cursorTime))
+	}
+}
+
+// SetOnCursorChange sets the callback for when the time cursor moves.
+func (tv *TimelineView) SetOnCursorChange(fn func(t time.Time, active []TimelineLane)) {
+	tv.onCursorChange = fn
+}
+
 // drawLegend draws the status legend and selected lane stats at the bottom.
 func (tv *TimelineView) drawLegend(screen tcell.Screen, x, y, width int) {
 	legend := []struct {
@@ -502,6 +864,18 @@ func (tv *TimelineView) drawLegend(screen tcell.Screen, x, y, width int) {
 		pos += 1 // spacing
 	}
 
+	if tv.showCritical && tv.criticalPath != nil && len(tv.criticalPath.Nodes) > 0 && pos+10 <= x+width/2 {
+		style := tcell.StyleDefault.Foreground(theme.Accent()).Background(theme.Bg())
+		screen.SetContent(pos, y, '▌', nil, style)
+		pos++
+		labelStyle := tcell.StyleDefault.Foreground(theme.FgDim()).Background(theme.Bg())
+		for _, r := range "Critical" {
+			screen.SetContent(pos, y, r, nil, labelStyle)
+			pos++
+		}
+		pos++
+	}
+
 	// Draw selected lane stats on the right side
 	if tv.selectedLane >= 0 && tv.selectedLane < len(tv.lanes) {
 		lane := tv.lanes[tv.selectedLane]
@@ -576,17 +950,33 @@ func (tv *TimelineView) drawLegend(screen tcell.Screen, x, y, width int) {
 	}
 }
 
+// isCritical reports whether a lane is highlighted as part of the critical path.
+func (tv *TimelineView) isCritical(lane TimelineLane) bool {
+	return tv.showCritical && tv.criticalPath.Contains(lane.Node)
+}
+
+// ToggleCriticalPath turns critical path highlighting on or off and reports the new state.
+func (tv *TimelineView) ToggleCriticalPath() bool {
+	tv.showCritical = !tv.showCritical
+	return tv.showCritical
+}
+
+// CriticalPath returns the critical path of the displayed nodes, or nil if none.
+func (tv *TimelineView) CriticalPath() *temporal.CriticalPath {
+	return tv.criticalPath
+}
+
 // barStyle returns the bar character and color for a status.
 func (tv *TimelineView) barStyle(status string) (rune, tcell.Color) {
 	switch status {
 	case "Running":
 		return '▓', theme.Warning()
 	case "Completed", "Fired":
-		return '█', theme.Success()
+		return '█', theme.StatusColor("Completed")
 	case "Failed", "TimedOut":
-		return '░', theme.Error()
+		return '░', theme.StatusColor("Failed")
 	case "Canceled", "Terminated":
-		return '▒', theme.Warning()
+		return '▒', theme.StatusColor("Canceled")
 	case "Scheduled", "Initiated", "Pending":
 		return '▒', theme.FgDim()
 	default:
@@ -631,11 +1021,114 @@ func (tv *TimelineView) InputHandler() func(event *tcell.EventKey, setFocus func
 				tv.zoom(0.8)
 			case '0':
 				tv.resetView()
+			case '[':
+				tv.moveTimeCursor(-1)
+			case ']':
+				tv.moveTimeCursor(1)
+			case '{':
+				tv.moveTimeCursor(-10)
+			case '}':
+				tv.moveTimeCursor(10)
 			}
 		}
 	})
 }
 
+// MouseHandler selects lanes on click, scrolls with the wheel and zooms to a
+// time range dragged out over the bar area.
+func (tv *TimelineView) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+	return tv.WrapMouseHandler(func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+		mx, my := event.Position()
+		if !tv.InRect(mx, my) && !tv.dragging {
+			return false, nil
+		}
+
+		x, y, width, _ := tv.GetInnerRect()
+		barStartX := x + timelineLabelWidth + 1
+		barAreaWidth := width - timelineLabelWidth - 1
+		if barAreaWidth < timelineMinWidth {
+			barAreaWidth = timelineMinWidth
+		}
+
+		switch action {
+		case tview.MouseLeftDown:
+			setFocus(tv)
+			if mx >= barStartX {
+				tv.dragging = true
+				tv.dragStart = mx - barStartX
+				tv.dragEnd = tv.dragStart
+				return true, tv
+			}
+			return true, nil
+		case tview.MouseMove:
+			if tv.dragging {
+				tv.dragEnd = mx - barStartX
+				return true, tv
+			}
+		case tview.MouseLeftUp:
+			if tv.dragging {
+				tv.dragging = false
+				if diff := tv.dragEnd - tv.dragStart; diff > 1 || diff < -1 {
+					tv.zoomToColumns(tv.dragStart, tv.dragEnd, barAreaWidth)
+					tv.dragEnd = tv.dragStart
+					return true, nil
+				}
+			}
+		case tview.MouseLeftClick:
+			if lane := my - y - 2 + tv.scrollY; my >= y+2 && lane < len(tv.lanes) {
+				tv.moveSelection(lane - tv.selectedLane)
+			}
+			// With the time cursor on, clicking the bar area moves it there
+			if tv.cursorOn && mx >= barStartX {
+				raw := float64(mx-barStartX+tv.scrollX) / tv.zoomLevel
+				timeRange := tv.endTime.Sub(tv.startTime)
+				tv.cursorTime = tv.startTime.Add(time.Duration(float64(timeRange) * raw / float64(barAreaWidth)))
+				if tv.cursorTime.After(tv.endTime) {
+					tv.cursorTime = tv.endTime
+				}
+				tv.notifyCursor()
+			}
+			return true, nil
+		case tview.MouseLeftDoubleClick:
+			if tv.onSelect != nil && tv.selectedLane >= 0 && tv.selectedLane < len(tv.lanes) {
+				tv.onSelect(&tv.lanes[tv.selectedLane])
+			}
+			return true, nil
+		case tview.MouseScrollUp:
+			tv.moveSelection(-1)
+			return true, nil
+		case tview.MouseScrollDown:
+			tv.moveSelection(1)
+			return true, nil
+		case tview.MouseScrollLeft:
+			tv.scroll(-5)
+			return true, nil
+		case tview.MouseScrollRight:
+			tv.scroll(5)
+			return true, nil
+		}
+		return false, nil
+	})
+}
+
+// zoomToColumns zooms so the bar-area columns from..to fill the visible width.
+func (tv *TimelineView) zoomToColumns(from, to, width int) {
+	if from > to {
+		from, to = to, from
+	}
+	if from < 0 {
+		from = 0
+	}
+	if to <= from || width <= 0 {
+		return
+	}
+
+	// Columns map to unzoomed positions as (col + scrollX) / zoomLevel
+	rawFrom := float64(from+tv.scrollX) / tv.zoomLevel
+	tv.zoom(float64(width) / float64(to-from))
+	tv.scrollX = int(rawFrom * tv.zoomLevel)
+}
+
 // moveSelection moves the lane selection up or down.
 func (tv *TimelineView) moveSelection(delta int) {
 	if len(tv.lanes) == 0 {
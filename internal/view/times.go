@@ -0,0 +1,84 @@
+package view
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/config"
+)
+
+// timeSettings is how timestamps are shown, set from the config at startup and
+// by the time display toggle.
+var timeSettings config.TimeSettings
+
+// formatTime renders t for a list column or summary: relative to now ("5m
+// ago"), or in the configured format and timezone.
+func formatTime(now, t time.Time) string {
+	if timeSettings.Relative() {
+		return formatRelativeTime(now, t)
+	}
+	return formatTimestamp(t)
+}
+
+// formatTimestamp renders t in the configured format and timezone.
+func formatTimestamp(t time.Time) string {
+	return config.Strftime(inTimezone(t), timeSettings.GetFormat())
+}
+
+// inTimezone returns t in the configured timezone, for times shown with a
+// fixed layout such as event times.
+func inTimezone(t time.Time) time.Time {
+	return t.In(timeSettings.Location())
+}
+
+// timezoneLabel names the configured timezone, e.g. "local time (CET)" or "UTC".
+func timezoneLabel(now time.Time) string {
+	if timeSettings.UTC() {
+		return "UTC"
+	}
+	return fmt.Sprintf("local time (%s)", now.Local().Format("MST"))
+}
+
+// applyTimeSettings shows times as the config says.
+func (a *App) applyTimeSettings() {
+	if a.config != nil {
+		timeSettings = a.config.Times
+	}
+}
+
+// cycleTimeDisplay switches times between relative, absolute in the local
+// timezone and absolute in UTC, refreshes the current view and saves the
+// choice.
+func (a *App) cycleTimeDisplay() {
+	settings := timeSettings
+	switch {
+	case settings.Relative():
+		settings.Display = config.TimeAbsolute
+		a.toasts.Info("Absolute times in " + timezoneLabel(time.Now()))
+	case !settings.UTC():
+		settings.Timezone = config.TimezoneUTC
+		a.toasts.Info("Absolute times in UTC")
+	default:
+		settings.Display, settings.Timezone = config.TimeRelative, config.TimezoneLocal
+		a.toasts.Info("Relative times")
+	}
+	timeSettings = settings
+	a.refreshCurrentView()
+
+	if a.config != nil {
+		a.config.Times = settings
+		if err := a.config.Save(); err != nil {
+			a.ShowToastError(fmt.Sprintf("Failed to save config: %s", err))
+		}
+	}
+}
+
+// warnInvalidTimeSettings reports time settings with unknown values.
+func (a *App) warnInvalidTimeSettings() {
+	if a.config == nil {
+		return
+	}
+	for _, field := range a.config.Times.InvalidFields() {
+		a.toasts.Warning(fmt.Sprintf("Unknown times.%s setting ignored", field))
+	}
+}
@@ -2,6 +2,8 @@ package view
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/atterpac/jig/theme"
 	"github.com/galaxy-io/tempo/internal/temporal"
@@ -17,6 +19,9 @@ type EventTreeView struct {
 	onSelect     func(node *temporal.EventTreeNode)
 	onSelChange  func(node *temporal.EventTreeNode)
 	selectedNode *temporal.EventTreeNode
+
+	// Schedule-to-start latencies above this are flagged; zero disables flagging
+	latencyThreshold time.Duration
 }
 
 // NewEventTreeView creates a new tree view for displaying workflow events.
@@ -80,6 +85,12 @@ func (etv *EventTreeView) Draw(screen tcell.Screen) {
 	etv.TreeView.Draw(screen)
 }
 
+// SetLatencyThreshold sets the schedule-to-start latency above which nodes are
+// flagged. Call before SetNodes.
+func (etv *EventTreeView) SetLatencyThreshold(d time.Duration) {
+	etv.latencyThreshold = d
+}
+
 // SetNodes populates the tree with event nodes.
 func (etv *EventTreeView) SetNodes(nodes []*temporal.EventTreeNode) {
 	etv.nodes = nodes
@@ -136,7 +147,17 @@ func (etv *EventTreeView) formatNodeText(node *temporal.EventTreeNode) string {
 
 	// Add attempt count if multiple attempts
 	if node.Attempts > 1 {
-		suffix = fmt.Sprintf(" %d attempts", node.Attempts)
+		suffix += fmt.Sprintf(" %d attempts", node.Attempts)
+	}
+
+	suffix += etv.formatLatency(node)
+
+	// Attempt rows show who ran them and why they failed
+	if node.Identity != "" {
+		suffix += fmt.Sprintf(" on %s", node.Identity)
+	}
+	if node.Failure != "" && node.Status != "Completed" {
+		suffix += fmt.Sprintf(" - %s", truncateStr(node.Failure, 40))
 	}
 
 	// Add status tag
@@ -145,6 +166,31 @@ func (etv *EventTreeView) formatNodeText(node *temporal.EventTreeNode) string {
 	return fmt.Sprintf("%s %s %s%s", icon, name, statusTag, suffix)
 }
 
+// formatLatency returns the schedule-to-start and start-to-close annotation for
+// activities and workflow tasks, flagging slow pickups as possible queue starvation.
+func (etv *EventTreeView) formatLatency(node *temporal.EventTreeNode) string {
+	var parts []string
+	if node.ScheduleToStart > 0 {
+		queued := "queued " + temporal.FormatDuration(node.ScheduleToStart)
+		if etv.isLatencyOutlier(node) {
+			queued = fmt.Sprintf("[%s]%s %s[-]", theme.TagWarning(), theme.IconWarning, queued)
+		}
+		parts = append(parts, queued)
+	}
+	if node.StartToClose > 0 && node.StartToClose != node.Duration {
+		parts = append(parts, "ran "+temporal.FormatDuration(node.StartToClose))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, ", ") + ")"
+}
+
+// isLatencyOutlier reports whether a node waited in the task queue longer than the threshold.
+func (etv *EventTreeView) isLatencyOutlier(node *temporal.EventTreeNode) bool {
+	return etv.latencyThreshold > 0 && node.ScheduleToStart > etv.latencyThreshold
+}
+
 // statusIcon returns the icon for a node status.
 func (etv *EventTreeView) statusIcon(status string) string {
 	switch status {
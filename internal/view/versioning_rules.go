@@ -0,0 +1,560 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// VersioningRulesView lists and edits a task queue's Build ID assignment and
+// redirect rules. Every change is previewed with its CLI equivalent before it is sent.
+type VersioningRulesView struct {
+	*tview.Flex
+	app             *App
+	taskQueue       string
+	rules           *temporal.VersioningRules
+	loading         bool
+	assignmentTable *components.Table
+	redirectTable   *components.Table
+	assignmentPanel *components.Panel
+	redirectPanel   *components.Panel
+}
+
+// NewVersioningRulesView creates a new versioning rules view.
+func NewVersioningRulesView(app *App, taskQueue string) *VersioningRulesView {
+	vr := &VersioningRulesView{
+		Flex:            tview.NewFlex().SetDirection(tview.FlexColumn),
+		app:             app,
+		taskQueue:       taskQueue,
+		assignmentTable: components.NewTable(),
+		redirectTable:   components.NewTable(),
+	}
+	vr.setup()
+	return vr
+}
+
+func (vr *VersioningRulesView) setup() {
+	vr.SetBackgroundColor(theme.Bg())
+
+	vr.assignmentTable.SetHeaders("#", "BUILD ID", "RAMP", "CREATED")
+	vr.assignmentTable.SetBorder(false)
+	vr.assignmentTable.SetBackgroundColor(theme.Bg())
+
+	vr.redirectTable.SetHeaders("SOURCE", "TARGET", "CREATED")
+	vr.redirectTable.SetBorder(false)
+	vr.redirectTable.SetBackgroundColor(theme.Bg())
+
+	vr.assignmentPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Assignment Rules: %s", theme.IconTaskQueue, vr.taskQueue))
+	vr.assignmentPanel.SetContent(vr.assignmentTable)
+
+	vr.redirectPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Redirect Rules", theme.IconInfo))
+	vr.redirectPanel.SetContent(vr.redirectTable)
+
+	vr.AddItem(vr.assignmentPanel, 0, 3, true)
+	vr.AddItem(vr.redirectPanel, 0, 2, false)
+}
+
+func (vr *VersioningRulesView) loadData() {
+	provider := vr.app.Provider()
+	if provider == nil {
+		vr.loadMockData()
+		return
+	}
+
+	vr.loading = true
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		rules, err := provider.GetVersioningRules(ctx, vr.app.CurrentNamespace(), vr.taskQueue)
+
+		vr.app.JigApp().QueueUpdateDraw(func() {
+			vr.loading = false
+			if err != nil {
+				vr.showError(err)
+				return
+			}
+			vr.rules = rules
+			vr.render()
+		})
+	}()
+}
+
+func (vr *VersioningRulesView) loadMockData() {
+	now := time.Now()
+	vr.rules = &temporal.VersioningRules{
+		AssignmentRules: []temporal.AssignmentRule{
+			{TargetBuildID: "1.5.0", Ramped: true, Percentage: 10, CreateTime: now.Add(-2 * time.Hour)},
+			{TargetBuildID: "1.4.0", CreateTime: now.Add(-72 * time.Hour)},
+		},
+		RedirectRules: []temporal.RedirectRule{
+			{SourceBuildID: "1.3.2", TargetBuildID: "1.4.0", CreateTime: now.Add(-48 * time.Hour)},
+		},
+	}
+	vr.render()
+}
+
+func (vr *VersioningRulesView) showError(err error) {
+	vr.assignmentTable.ClearRows()
+	vr.assignmentTable.SetHeaders("#", "BUILD ID", "RAMP", "CREATED")
+	vr.assignmentTable.AddRowWithColor(theme.Error(), "", theme.IconError+" Error loading rules", err.Error(), "")
+	vr.redirectTable.ClearRows()
+	vr.redirectTable.SetHeaders("SOURCE", "TARGET", "CREATED")
+}
+
+func (vr *VersioningRulesView) render() {
+	if vr.rules == nil {
+		return
+	}
+	now := time.Now()
+
+	assignmentRow := vr.assignmentTable.SelectedRow()
+	vr.assignmentTable.ClearRows()
+	vr.assignmentTable.SetHeaders("#", "BUILD ID", "RAMP", "CREATED")
+	pastDefault := false
+	for i, rule := range vr.rules.AssignmentRules {
+		ramp := "all new workflows"
+		if rule.Ramped {
+			ramp = fmt.Sprintf("%g%%", rule.Percentage)
+		}
+		buildID := rule.TargetBuildID
+		if !rule.Ramped && !pastDefault {
+			buildID += " (default)"
+		}
+		row := []string{strconv.Itoa(i), buildID, ramp, formatTime(now, rule.CreateTime)}
+		if pastDefault {
+			// Rules after the default are never reached
+			vr.assignmentTable.AddRowWithColor(theme.FgDim(), row...)
+			continue
+		}
+		pastDefault = !rule.Ramped
+		vr.assignmentTable.AddRow(row...)
+	}
+	if len(vr.rules.AssignmentRules) == 0 {
+		vr.assignmentTable.AddRowWithColor(theme.FgDim(), "", "(no rules: new workflows go to unversioned workers)", "", "")
+	} else if assignmentRow >= 0 && assignmentRow < len(vr.rules.AssignmentRules) {
+		vr.assignmentTable.SelectRow(assignmentRow)
+	} else {
+		vr.assignmentTable.SelectRow(0)
+	}
+
+	redirectRow := vr.redirectTable.SelectedRow()
+	vr.redirectTable.ClearRows()
+	vr.redirectTable.SetHeaders("SOURCE", "TARGET", "CREATED")
+	for _, rule := range vr.rules.RedirectRules {
+		vr.redirectTable.AddRow(rule.SourceBuildID, "→ "+rule.TargetBuildID, formatTime(now, rule.CreateTime))
+	}
+	if len(vr.rules.RedirectRules) == 0 {
+		vr.redirectTable.AddRowWithColor(theme.FgDim(), "(no redirect rules)", "", "")
+	} else if redirectRow >= 0 && redirectRow < len(vr.rules.RedirectRules) {
+		vr.redirectTable.SelectRow(redirectRow)
+	} else {
+		vr.redirectTable.SelectRow(0)
+	}
+}
+
+// selectedAssignment returns the selected assignment rule index, or -1.
+func (vr *VersioningRulesView) selectedAssignment() int {
+	row := vr.assignmentTable.SelectedRow()
+	if vr.rules == nil || row < 0 || row >= len(vr.rules.AssignmentRules) {
+		return -1
+	}
+	return row
+}
+
+// selectedRedirect returns the selected redirect rule index, or -1.
+func (vr *VersioningRulesView) selectedRedirect() int {
+	row := vr.redirectTable.SelectedRow()
+	if vr.rules == nil || row < 0 || row >= len(vr.rules.RedirectRules) {
+		return -1
+	}
+	return row
+}
+
+// showAddRuleForm adds an assignment rule at the top. Without a ramp the build
+// becomes the new default for new workflows.
+func (vr *VersioningRulesView) showAddRuleForm() {
+	vr.showRuleForm("Add Assignment Rule", "", "", func(buildID string, ramp *float32) {
+		vr.confirmChange(temporal.VersioningRuleChange{
+			Op:      temporal.VersioningInsertAssignmentRule,
+			BuildID: buildID,
+			Ramp:    ramp,
+		})
+	})
+}
+
+// showRampForm changes the ramp of the selected assignment rule.
+func (vr *VersioningRulesView) showRampForm() {
+	i := vr.selectedAssignment()
+	if i < 0 {
+		return
+	}
+	rule := vr.rules.AssignmentRules[i]
+	ramp := ""
+	if rule.Ramped {
+		ramp = fmt.Sprintf("%g", rule.Percentage)
+	}
+	vr.showRuleForm("Set Ramp", rule.TargetBuildID, ramp, func(buildID string, ramp *float32) {
+		vr.confirmChange(temporal.VersioningRuleChange{
+			Op:        temporal.VersioningReplaceAssignmentRule,
+			RuleIndex: int32(i),
+			BuildID:   buildID,
+			Ramp:      ramp,
+		})
+	})
+}
+
+func (vr *VersioningRulesView) showRuleForm(title, buildID, ramp string, onSubmit func(buildID string, ramp *float32)) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s %s", theme.IconTaskQueue, title),
+		Width:    70,
+		Height:   13,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("buildId", "Build ID", "")
+	form.AddTextField("ramp", "Ramp % (empty for all new workflows)", "")
+	_ = form.SetValues(map[string]any{
+		"buildId": buildID,
+		"ramp":    ramp,
+	})
+
+	submit := func(values map[string]any) {
+		buildID := strings.TrimSpace(values["buildId"].(string))
+		if buildID == "" {
+			return
+		}
+		var rampPtr *float32
+		if r := strings.TrimSuffix(strings.TrimSpace(values["ramp"].(string)), "%"); r != "" {
+			pct, err := strconv.ParseFloat(r, 32)
+			if err != nil || pct < 0 || pct > 100 {
+				vr.app.ShowToastError("Ramp must be a percentage between 0 and 100")
+				return
+			}
+			p := float32(pct)
+			rampPtr = &p
+		}
+		vr.closeModal("versioning-rule-form")
+		onSubmit(buildID, rampPtr)
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		vr.closeModal("versioning-rule-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Preview"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		vr.closeModal("versioning-rule-form")
+	})
+
+	vr.app.Modals().Show("versioning-rule-form", modal, form)
+}
+
+// showRedirectForm adds a redirect rule, defaulting the target to the selected build.
+func (vr *VersioningRulesView) showRedirectForm() {
+	target := ""
+	if i := vr.selectedAssignment(); i >= 0 {
+		target = vr.rules.AssignmentRules[i].TargetBuildID
+	}
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Add Redirect Rule", theme.IconTaskQueue),
+		Width:    70,
+		Height:   13,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("source", "Source Build ID", "")
+	form.AddTextField("target", "Target Build ID", "")
+	_ = form.SetValues(map[string]any{
+		"target": target,
+	})
+
+	submit := func(values map[string]any) {
+		source := strings.TrimSpace(values["source"].(string))
+		target := strings.TrimSpace(values["target"].(string))
+		if source == "" || target == "" {
+			return
+		}
+		vr.closeModal("versioning-redirect-form")
+		vr.confirmChange(temporal.VersioningRuleChange{
+			Op:            temporal.VersioningAddRedirectRule,
+			SourceBuildID: source,
+			BuildID:       target,
+		})
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		vr.closeModal("versioning-redirect-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Preview"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		vr.closeModal("versioning-redirect-form")
+	})
+
+	vr.app.Modals().Show("versioning-redirect-form", modal, form)
+}
+
+// commitSelected commits the selected build: it becomes the unconditional default
+// and all other assignment rules and unreachable redirects are removed.
+func (vr *VersioningRulesView) commitSelected() {
+	i := vr.selectedAssignment()
+	if i < 0 {
+		return
+	}
+	vr.confirmChange(temporal.VersioningRuleChange{
+		Op:      temporal.VersioningCommitBuildID,
+		BuildID: vr.rules.AssignmentRules[i].TargetBuildID,
+	})
+}
+
+// deleteSelected deletes the selected rule in the focused table.
+func (vr *VersioningRulesView) deleteSelected() {
+	if vr.redirectTable.HasFocus() {
+		i := vr.selectedRedirect()
+		if i < 0 {
+			return
+		}
+		vr.confirmChange(temporal.VersioningRuleChange{
+			Op:            temporal.VersioningDeleteRedirectRule,
+			SourceBuildID: vr.rules.RedirectRules[i].SourceBuildID,
+		})
+		return
+	}
+
+	i := vr.selectedAssignment()
+	if i < 0 {
+		return
+	}
+	vr.confirmChange(temporal.VersioningRuleChange{
+		Op:        temporal.VersioningDeleteAssignmentRule,
+		RuleIndex: int32(i),
+		BuildID:   vr.rules.AssignmentRules[i].TargetBuildID,
+	})
+}
+
+// checkSelectedReachability checks reachability of a Build ID, defaulting to the
+// selected rule's (the redirect source when the redirect table has focus).
+func (vr *VersioningRulesView) checkSelectedReachability() {
+	buildID := ""
+	if vr.redirectTable.HasFocus() {
+		if i := vr.selectedRedirect(); i >= 0 {
+			buildID = vr.rules.RedirectRules[i].SourceBuildID
+		}
+	} else if i := vr.selectedAssignment(); i >= 0 {
+		buildID = vr.rules.AssignmentRules[i].TargetBuildID
+	}
+	showReachabilityForm(vr.app, vr.taskQueue, buildID)
+}
+
+// describeChange summarizes a change for the confirm modal.
+func describeChange(change temporal.VersioningRuleChange) string {
+	ramp := "all new workflows"
+	if change.Ramp != nil {
+		ramp = fmt.Sprintf("%g%% of new workflows", *change.Ramp)
+	}
+	switch change.Op {
+	case temporal.VersioningInsertAssignmentRule:
+		if change.Ramp == nil {
+			return fmt.Sprintf("Make %s the default build for new workflows", change.BuildID)
+		}
+		return fmt.Sprintf("Assign %s to build %s", ramp, change.BuildID)
+	case temporal.VersioningReplaceAssignmentRule:
+		return fmt.Sprintf("Change rule %d to assign %s to build %s", change.RuleIndex, ramp, change.BuildID)
+	case temporal.VersioningDeleteAssignmentRule:
+		return fmt.Sprintf("Delete assignment rule %d (%s)", change.RuleIndex, change.BuildID)
+	case temporal.VersioningAddRedirectRule:
+		return fmt.Sprintf("Redirect workflows on %s to %s", change.SourceBuildID, change.BuildID)
+	case temporal.VersioningDeleteRedirectRule:
+		return fmt.Sprintf("Delete the redirect rule from %s", change.SourceBuildID)
+	case temporal.VersioningCommitBuildID:
+		return fmt.Sprintf("Commit %s: make it the only assignment rule and drop unreachable redirects", change.BuildID)
+	}
+	return string(change.Op)
+}
+
+// confirmChange previews a change and its CLI equivalent before applying it.
+func (vr *VersioningRulesView) confirmChange(change temporal.VersioningRuleChange) {
+	if vr.rules != nil {
+		change.ConflictToken = vr.rules.ConflictToken
+	}
+	command := change.Command(vr.app.CurrentNamespace(), vr.taskQueue)
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Update Versioning Rules", theme.IconWarning),
+		Width:    90,
+		Height:   14,
+		Backdrop: true,
+	})
+
+	text := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWordWrap(true)
+	text.SetBackgroundColor(theme.Bg())
+	text.SetText(fmt.Sprintf(`[%s]%s?[-]
+
+[%s]Task Queue:[-] [%s]%s[-]
+
+[%s]CLI equivalent:[-]
+[%s]%s[-]`,
+		theme.TagAccent(), tview.Escape(describeChange(change)),
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(vr.taskQueue),
+		theme.TagFgDim(),
+		theme.TagFg(), tview.Escape(command)))
+
+	modal.SetContent(text)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Apply"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		vr.closeModal("versioning-confirm")
+		vr.executeChange(change)
+	})
+	modal.SetOnCancel(func() {
+		vr.closeModal("versioning-confirm")
+	})
+
+	vr.app.Modals().Show("versioning-confirm", modal, nil)
+}
+
+func (vr *VersioningRulesView) executeChange(change temporal.VersioningRuleChange) {
+	provider := vr.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		rules, err := provider.UpdateVersioningRules(ctx, vr.app.CurrentNamespace(), vr.taskQueue, change)
+
+		vr.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(vr.app.Modals(), "Versioning Update Failed", err.Error())
+				vr.loadData() // The rules may have changed underneath us
+				return
+			}
+			vr.rules = rules
+			vr.render()
+		})
+	}()
+}
+
+func (vr *VersioningRulesView) closeModal(name string) {
+	vr.app.Modals().Close(name)
+}
+
+// RefreshTheme updates all component colors after a theme change.
+func (vr *VersioningRulesView) RefreshTheme() {
+	bg := theme.Bg()
+	vr.SetBackgroundColor(bg)
+	vr.assignmentTable.SetBackgroundColor(bg)
+	vr.redirectTable.SetBackgroundColor(bg)
+	vr.render()
+}
+
+// Name returns the view name.
+func (vr *VersioningRulesView) Name() string {
+	return "versioning-rules"
+}
+
+// Start is called when the view becomes active.
+func (vr *VersioningRulesView) Start() {
+	handler := func(event *tcell.EventKey) *tcell.EventKey {
+		switch {
+		case event.Key() == tcell.KeyTab:
+			if vr.assignmentTable.HasFocus() {
+				vr.app.JigApp().SetFocus(vr.redirectTable)
+			} else {
+				vr.app.JigApp().SetFocus(vr.assignmentTable)
+			}
+			return nil
+		case event.Rune() == 'r':
+			vr.loadData()
+			return nil
+		case event.Rune() == 'n':
+			vr.showAddRuleForm()
+			return nil
+		case event.Rune() == 'p':
+			vr.showRampForm()
+			return nil
+		case event.Rune() == 'R':
+			vr.showRedirectForm()
+			return nil
+		case event.Rune() == 'c':
+			vr.commitSelected()
+			return nil
+		case event.Rune() == 'D':
+			vr.deleteSelected()
+			return nil
+		case event.Rune() == 'b':
+			vr.checkSelectedReachability()
+			return nil
+		}
+		return event
+	}
+	vr.assignmentTable.SetInputCapture(handler)
+	vr.redirectTable.SetInputCapture(handler)
+	vr.loadData()
+}
+
+// Stop is called when the view is deactivated.
+func (vr *VersioningRulesView) Stop() {
+	vr.assignmentTable.SetInputCapture(nil)
+	vr.redirectTable.SetInputCapture(nil)
+}
+
+// Hints returns keybinding hints for this view.
+func (vr *VersioningRulesView) Hints() []KeyHint {
+	return []KeyHint{
+		{Key: "n", Description: "New Rule"},
+		{Key: "p", Description: "Set Ramp"},
+		{Key: "c", Description: "Commit Build"},
+		{Key: "R", Description: "Redirect"},
+		{Key: "D", Description: "Delete"},
+		{Key: "b", Description: "Reachability"},
+		{Key: "r", Description: "Refresh"},
+		{Key: "tab", Description: "Switch Panel"},
+		{Key: "esc", Description: "Back"},
+	}
+}
+
+// Focus sets focus to the assignment rules table.
+func (vr *VersioningRulesView) Focus(delegate func(p tview.Primitive)) {
+	delegate(vr.assignmentTable)
+}
+
+// Draw applies theme colors dynamically and draws the view.
+func (vr *VersioningRulesView) Draw(screen tcell.Screen) {
+	vr.SetBackgroundColor(theme.Bg())
+	vr.Flex.Draw(screen)
+}
@@ -0,0 +1,436 @@
+package view
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// VirtualRowFunc returns the color and cells of data row i.
+type VirtualRowFunc func(i int) (tcell.Color, []string)
+
+// VirtualRowKeyFunc returns a key identifying data row i across refreshes.
+type VirtualRowKeyFunc func(i int) string
+
+// VirtualTable is a table that keeps no cells of its own: it asks a row func
+// for the rows in view each time it draws, so scrolling and refreshing cost the
+// same with a hundred rows or a hundred thousand. Rows are addressed by data
+// index (0-based, not counting the header), like components.Table.
+//
+// In multi-select mode, space marks the selected row and Ctrl+A marks every
+// row, or none once all are marked. Marks follow the row key, so they survive
+// a refresh that reorders or drops rows.
+type VirtualTable struct {
+	*tview.Box
+	headers  []string
+	widths   []int // Widest cell seen per column, so columns don't jump while scrolling
+	rowCount int
+	row      VirtualRowFunc
+	selected int // -1 when there are no rows
+	offset   int // First row in view
+	height   int // Rows in view at the last draw
+
+	multiSelect bool
+	rowKey      VirtualRowKeyFunc
+	marked      map[string]bool
+	markCount   int // Rows in the table that are marked, kept so drawing doesn't scan every row
+
+	onChange  func(row int)
+	onSelect  func(row int)
+	onMarks   func(rows []int)
+	onContext func(row int)
+}
+
+// NewVirtualTable creates an empty virtual table.
+func NewVirtualTable() *VirtualTable {
+	vt := &VirtualTable{
+		Box:      tview.NewBox(),
+		selected: -1,
+		marked:   make(map[string]bool),
+	}
+	vt.SetBackgroundColor(theme.Bg())
+	return vt
+}
+
+// SetHeaders sets the column headers.
+func (vt *VirtualTable) SetHeaders(headers ...string) *VirtualTable {
+	vt.headers = headers
+	vt.widths = make([]int, len(headers))
+	for col, header := range headers {
+		vt.widths[col] = tview.TaggedStringWidth(header)
+	}
+	return vt
+}
+
+// SetRowFunc sets the func that renders a data row.
+func (vt *VirtualTable) SetRowFunc(fn VirtualRowFunc) *VirtualTable {
+	vt.row = fn
+	return vt
+}
+
+// SetRowCount sets the number of data rows, keeping the selection on the same
+// index when it is still in range. Growing from empty selects the first row.
+// Call it whenever the rows change, even if their number doesn't, so marks are
+// counted against the new rows.
+func (vt *VirtualTable) SetRowCount(n int) *VirtualTable {
+	vt.rowCount = max(n, 0)
+	vt.countMarks()
+	switch {
+	case vt.rowCount == 0:
+		vt.selected = -1
+		vt.offset = 0
+	case vt.selected < 0:
+		vt.selected = 0
+	case vt.selected >= vt.rowCount:
+		vt.selected = vt.rowCount - 1
+	}
+	return vt
+}
+
+// ClearRows removes every data row.
+func (vt *VirtualTable) ClearRows() {
+	vt.SetRowCount(0)
+	vt.SetHeaders(vt.headers...)
+}
+
+// RowCount returns the number of data rows.
+func (vt *VirtualTable) RowCount() int {
+	return vt.rowCount
+}
+
+// SelectedRow returns the selected data row, or -1 when there are no rows.
+func (vt *VirtualTable) SelectedRow() int {
+	return vt.selected
+}
+
+// SelectRow selects a data row, scrolling it into view.
+func (vt *VirtualTable) SelectRow(index int) {
+	if vt.rowCount == 0 {
+		return
+	}
+	index = min(max(index, 0), vt.rowCount-1)
+	if index == vt.selected {
+		return
+	}
+	vt.selected = index
+	vt.scrollToSelection()
+	if vt.onChange != nil {
+		vt.onChange(index)
+	}
+}
+
+// SetSelectionChangedFunc sets the callback for when the selected row changes.
+func (vt *VirtualTable) SetSelectionChangedFunc(fn func(row int)) *VirtualTable {
+	vt.onChange = fn
+	return vt
+}
+
+// SetSelectedFunc sets the callback for when a row is chosen with Enter.
+func (vt *VirtualTable) SetSelectedFunc(fn func(row int)) *VirtualTable {
+	vt.onSelect = fn
+	return vt
+}
+
+// SetContextMenuFunc sets the callback for when a row is right-clicked.
+func (vt *VirtualTable) SetContextMenuFunc(fn func(row int)) *VirtualTable {
+	vt.onContext = fn
+	return vt
+}
+
+// SetMultiSelect turns multi-select mode on or off. Turning it off clears the
+// marks.
+func (vt *VirtualTable) SetMultiSelect(enabled bool) *VirtualTable {
+	vt.multiSelect = enabled
+	if !enabled && len(vt.marked) > 0 {
+		vt.ClearSelection()
+	}
+	return vt
+}
+
+// SetRowKeyFunc sets the func that identifies rows for marking. Without one,
+// rows are identified by index.
+func (vt *VirtualTable) SetRowKeyFunc(fn VirtualRowKeyFunc) *VirtualTable {
+	vt.rowKey = fn
+	vt.countMarks()
+	return vt
+}
+
+// SetOnSelectionChange sets the callback for when the marked rows change.
+func (vt *VirtualTable) SetOnSelectionChange(fn func(rows []int)) *VirtualTable {
+	vt.onMarks = fn
+	return vt
+}
+
+// key returns the marking key of data row i.
+func (vt *VirtualTable) key(i int) string {
+	if vt.rowKey != nil {
+		return vt.rowKey(i)
+	}
+	return strconv.Itoa(i)
+}
+
+// ToggleSelection marks or unmarks the selected row.
+func (vt *VirtualTable) ToggleSelection() {
+	if vt.selected < 0 {
+		return
+	}
+	key := vt.key(vt.selected)
+	if vt.marked[key] {
+		delete(vt.marked, key)
+		vt.markCount--
+	} else {
+		vt.marked[key] = true
+		vt.markCount++
+	}
+	vt.marksChanged()
+}
+
+// SelectAll marks every row.
+func (vt *VirtualTable) SelectAll() {
+	for i := 0; i < vt.rowCount; i++ {
+		vt.marked[vt.key(i)] = true
+	}
+	vt.markCount = vt.rowCount
+	vt.marksChanged()
+}
+
+// ClearSelection unmarks every row.
+func (vt *VirtualTable) ClearSelection() {
+	vt.marked = make(map[string]bool)
+	vt.markCount = 0
+	vt.marksChanged()
+}
+
+// countMarks recounts the marked rows after the rows change.
+func (vt *VirtualTable) countMarks() {
+	vt.markCount = 0
+	if len(vt.marked) == 0 {
+		return
+	}
+	for i := 0; i < vt.rowCount; i++ {
+		if vt.marked[vt.key(i)] {
+			vt.markCount++
+		}
+	}
+}
+
+// IsRowSelected reports whether data row i is marked.
+func (vt *VirtualTable) IsRowSelected(i int) bool {
+	return len(vt.marked) > 0 && vt.marked[vt.key(i)]
+}
+
+// GetSelectedRows returns the marked data rows in order. Marks on keys no
+// longer in the table are not included.
+func (vt *VirtualTable) GetSelectedRows() []int {
+	var rows []int
+	if len(vt.marked) == 0 {
+		return rows
+	}
+	for i := 0; i < vt.rowCount; i++ {
+		if vt.marked[vt.key(i)] {
+			rows = append(rows, i)
+		}
+	}
+	return rows
+}
+
+// marksChanged notifies the selection change callback.
+func (vt *VirtualTable) marksChanged() {
+	if vt.onMarks != nil {
+		vt.onMarks(vt.GetSelectedRows())
+	}
+}
+
+// scrollToSelection moves the window so the selected row is in view.
+func (vt *VirtualTable) scrollToSelection() {
+	if vt.selected < vt.offset {
+		vt.offset = vt.selected
+	} else if vt.height > 0 && vt.selected >= vt.offset+vt.height {
+		vt.offset = vt.selected - vt.height + 1
+	}
+}
+
+// Draw renders the header and the rows in view.
+func (vt *VirtualTable) Draw(screen tcell.Screen) {
+	vt.SetBackgroundColor(theme.Bg())
+	vt.Box.DrawForSubclass(screen, vt)
+	x, y, width, height := vt.GetInnerRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	if len(vt.headers) > 0 {
+		vt.drawRow(screen, x, y, width, vt.headers, tcell.StyleDefault.Background(theme.Bg()).Foreground(theme.Accent()))
+		if vt.multiSelect {
+			// Marked count, over the end of the header
+			count := fmt.Sprintf(" %d selected ", vt.markCount)
+			tview.Print(screen, count, x, y, width, tview.AlignRight, theme.Accent())
+		}
+		y++
+		height--
+	}
+	vt.height = max(height, 0)
+	if vt.rowCount == 0 || vt.row == nil || vt.height == 0 {
+		return
+	}
+
+	// Clamp the window after a resize or a shrinking row count
+	vt.offset = min(vt.offset, max(vt.rowCount-vt.height, 0))
+	vt.scrollToSelection()
+
+	end := min(vt.offset+vt.height, vt.rowCount)
+	for i := vt.offset; i < end; i++ {
+		color, cells := vt.row(i)
+		style := tcell.StyleDefault.Background(theme.Bg()).Foreground(color)
+		if i == vt.selected {
+			style = theme.SelectionStyle()
+		} else if vt.IsRowSelected(i) {
+			style = tcell.StyleDefault.Background(theme.Accent()).Foreground(theme.Bg())
+		}
+		vt.drawRow(screen, x, y+i-vt.offset, width, cells, style)
+	}
+}
+
+// drawRow draws one line of cells, spreading the width left over after the
+// widest cells evenly across the columns.
+func (vt *VirtualTable) drawRow(screen tcell.Screen, x, y, width int, cells []string, style tcell.Style) {
+	for len(vt.widths) < len(cells) {
+		vt.widths = append(vt.widths, 0)
+	}
+	for col, text := range cells {
+		vt.widths[col] = max(vt.widths[col], tview.TaggedStringWidth(text))
+	}
+
+	columns := len(vt.widths)
+	if columns == 0 {
+		return
+	}
+	total := columns - 1 // Separators
+	for _, w := range vt.widths {
+		total += w
+	}
+	extra := max(width-total, 0) / columns
+
+	for col := 0; col < width; col++ {
+		screen.SetContent(x+col, y, ' ', nil, style)
+	}
+	pos := x
+	for col, w := range vt.widths {
+		w += extra
+		if pos+w > x+width {
+			w = x + width - pos
+		}
+		if w <= 0 {
+			break
+		}
+		if col < len(cells) {
+			fg, _, _ := style.Decompose()
+			tview.Print(screen, cells[col], pos, y, w, tview.AlignLeft, fg)
+		}
+		pos += w + 1
+	}
+}
+
+// InputHandler moves the selection with the arrow, j/k, page and g/G keys,
+// chooses the selected row with Enter and, in multi-select mode, marks rows with
+// space and Ctrl+A.
+func (vt *VirtualTable) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return vt.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		if vt.rowCount == 0 {
+			return
+		}
+		page := max(vt.height, 1)
+		switch event.Key() {
+		case tcell.KeyUp:
+			vt.SelectRow(vt.selected - 1)
+		case tcell.KeyDown:
+			vt.SelectRow(vt.selected + 1)
+		case tcell.KeyPgUp, tcell.KeyCtrlB:
+			vt.SelectRow(vt.selected - page)
+		case tcell.KeyPgDn, tcell.KeyCtrlF:
+			vt.SelectRow(vt.selected + page)
+		case tcell.KeyHome:
+			vt.SelectRow(0)
+		case tcell.KeyEnd:
+			vt.SelectRow(vt.rowCount - 1)
+		case tcell.KeyEnter:
+			if vt.onSelect != nil {
+				vt.onSelect(vt.selected)
+			}
+		case tcell.KeyCtrlA:
+			if !vt.multiSelect {
+				return
+			}
+			if vt.markCount == vt.rowCount {
+				vt.ClearSelection()
+			} else {
+				vt.SelectAll()
+			}
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case ' ':
+				if vt.multiSelect {
+					vt.ToggleSelection()
+				}
+			case 'k':
+				vt.SelectRow(vt.selected - 1)
+			case 'j':
+				vt.SelectRow(vt.selected + 1)
+			case 'g':
+				vt.SelectRow(0)
+			case 'G':
+				vt.SelectRow(vt.rowCount - 1)
+			}
+		}
+	})
+}
+
+// MouseHandler selects a row on click, opens its context menu on right-click
+// and scrolls with the wheel.
+func (vt *VirtualTable) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+	return vt.WrapMouseHandler(func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+		if !vt.InRect(event.Position()) {
+			return false, nil
+		}
+		switch action {
+		case tview.MouseLeftClick, tview.MouseRightClick:
+			setFocus(vt)
+			_, top, _, _ := vt.GetInnerRect()
+			if len(vt.headers) > 0 {
+				top++
+			}
+			_, my := event.Position()
+			if row := vt.offset + my - top; my >= top && row < vt.rowCount {
+				vt.SelectRow(row)
+				if action == tview.MouseRightClick && vt.onContext != nil {
+					vt.onContext(row)
+				}
+			}
+			return true, nil
+		case tview.MouseScrollUp:
+			vt.scroll(-1)
+			return true, nil
+		case tview.MouseScrollDown:
+			vt.scroll(1)
+			return true, nil
+		}
+		return false, nil
+	})
+}
+
+// scroll moves the window by delta rows, dragging the selection along when it
+// would leave the view.
+func (vt *VirtualTable) scroll(delta int) {
+	if vt.rowCount == 0 {
+		return
+	}
+	vt.offset = min(max(vt.offset+delta, 0), max(vt.rowCount-vt.height, 0))
+	if vt.selected < vt.offset {
+		vt.SelectRow(vt.offset)
+	} else if vt.height > 0 && vt.selected >= vt.offset+vt.height {
+		vt.SelectRow(vt.offset + vt.height - 1)
+	}
+}
@@ -0,0 +1,391 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// WorkerDeploymentsView lists worker deployments with their current and ramping
+// versions, and the versions of the selected deployment.
+type WorkerDeploymentsView struct {
+	*tview.Flex
+	app             *App
+	deployments     []temporal.WorkerDeployment
+	selected        *temporal.WorkerDeployment // Described deployment, with versions
+	loading         bool
+	deploymentTable *components.Table
+	versionTable    *components.Table
+	deploymentPanel *components.Panel
+	versionPanel    *components.Panel
+}
+
+// NewWorkerDeploymentsView creates a new worker deployments view.
+func NewWorkerDeploymentsView(app *App) *WorkerDeploymentsView {
+	wd := &WorkerDeploymentsView{
+		Flex:            tview.NewFlex().SetDirection(tview.FlexColumn),
+		app:             app,
+		deploymentTable: components.NewTable(),
+		versionTable:    components.NewTable(),
+	}
+	wd.setup()
+	return wd
+}
+
+func (wd *WorkerDeploymentsView) setup() {
+	wd.SetBackgroundColor(theme.Bg())
+
+	wd.deploymentTable.SetHeaders("NAME", "CURRENT", "RAMPING", "LATEST", "CREATED")
+	wd.deploymentTable.SetBorder(false)
+	wd.deploymentTable.SetBackgroundColor(theme.Bg())
+
+	wd.versionTable.SetHeaders("BUILD ID", "STATUS", "CREATED", "SINCE")
+	wd.versionTable.SetBorder(false)
+	wd.versionTable.SetBackgroundColor(theme.Bg())
+
+	wd.deploymentPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Worker Deployments", theme.IconTaskQueue))
+	wd.deploymentPanel.SetContent(wd.deploymentTable)
+
+	wd.versionPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Versions", theme.IconInfo))
+	wd.versionPanel.SetContent(wd.versionTable)
+
+	wd.deploymentTable.SetSelectionChangedFunc(func(row, col int) {
+		if row > 0 && row-1 < len(wd.deployments) {
+			wd.loadVersions(wd.deployments[row-1].Name)
+		}
+	})
+
+	wd.AddItem(wd.deploymentPanel, 0, 3, true)
+	wd.AddItem(wd.versionPanel, 0, 2, false)
+}
+
+func (wd *WorkerDeploymentsView) loadData() {
+	provider := wd.app.Provider()
+	if provider == nil {
+		wd.loadMockData()
+		return
+	}
+	if wd.loading {
+		return
+	}
+
+	wd.loading = true
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		deployments, err := provider.ListWorkerDeployments(ctx, wd.app.CurrentNamespace())
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			wd.loading = false
+			if err != nil {
+				wd.showError(err)
+				return
+			}
+			wd.deployments = deployments
+			wd.renderDeployments()
+			if row := wd.deploymentTable.SelectedRow(); row >= 0 && row < len(wd.deployments) {
+				wd.loadVersions(wd.deployments[row].Name)
+			}
+		})
+	}()
+}
+
+// loadVersions describes a deployment to list its versions.
+func (wd *WorkerDeploymentsView) loadVersions(name string) {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		deployment, err := provider.DescribeWorkerDeployment(ctx, wd.app.CurrentNamespace(), name)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			// Ignore responses for a deployment that is no longer selected
+			if row := wd.deploymentTable.SelectedRow(); row < 0 || row >= len(wd.deployments) || wd.deployments[row].Name != name {
+				return
+			}
+			if err != nil {
+				wd.selected = nil
+				wd.versionTable.ClearRows()
+				wd.versionTable.SetHeaders("BUILD ID", "STATUS", "CREATED", "SINCE")
+				wd.versionTable.AddRowWithColor(theme.Error(), theme.IconError+" Error loading versions", err.Error(), "", "")
+				return
+			}
+			wd.selected = deployment
+			wd.renderVersions()
+		})
+	}()
+}
+
+func (wd *WorkerDeploymentsView) loadMockData() {
+	now := time.Now()
+	wd.deployments = []temporal.WorkerDeployment{
+		{Name: "order-service", CurrentBuildID: "v42", RampingBuildID: "v43", RampPercentage: 25, LatestBuildID: "v43", CreateTime: now.Add(-30 * 24 * time.Hour)},
+		{Name: "payment-service", CurrentBuildID: "2025.06.1", LatestBuildID: "2025.06.1", CreateTime: now.Add(-90 * 24 * time.Hour)},
+	}
+	wd.selected = &temporal.WorkerDeployment{
+		Name:           "order-service",
+		CurrentBuildID: "v42",
+		RampingBuildID: "v43",
+		RampPercentage: 25,
+		Versions: []temporal.WorkerDeploymentVersion{
+			{BuildID: "v43", Status: temporal.DeploymentVersionRamping, CreateTime: now.Add(-2 * time.Hour), RampingSince: now.Add(-90 * time.Minute)},
+			{BuildID: "v42", Status: temporal.DeploymentVersionCurrent, CreateTime: now.Add(-3 * 24 * time.Hour), CurrentSince: now.Add(-3 * 24 * time.Hour)},
+			{BuildID: "v41", Status: temporal.DeploymentVersionDraining, CreateTime: now.Add(-10 * 24 * time.Hour)},
+			{BuildID: "v40", Status: temporal.DeploymentVersionDrained, CreateTime: now.Add(-20 * 24 * time.Hour)},
+		},
+	}
+	wd.renderDeployments()
+	wd.renderVersions()
+}
+
+func (wd *WorkerDeploymentsView) showError(err error) {
+	wd.deploymentTable.ClearRows()
+	wd.deploymentTable.SetHeaders("NAME", "CURRENT", "RAMPING", "LATEST", "CREATED")
+	wd.deploymentTable.AddRowWithColor(theme.Error(), theme.IconError+" Error loading deployments", err.Error(), "", "", "")
+}
+
+func (wd *WorkerDeploymentsView) renderDeployments() {
+	now := time.Now()
+	currentRow := wd.deploymentTable.SelectedRow()
+
+	wd.deploymentTable.ClearRows()
+	wd.deploymentTable.SetHeaders("NAME", "CURRENT", "RAMPING", "LATEST", "CREATED")
+	for _, d := range wd.deployments {
+		current := d.CurrentBuildID
+		if current == "" {
+			current = "(unversioned)"
+		}
+		ramping := "-"
+		if d.RampingBuildID != "" {
+			ramping = fmt.Sprintf("%s (%g%%)", d.RampingBuildID, d.RampPercentage)
+		}
+		tableRow := wd.deploymentTable.Table.GetRowCount()
+		wd.deploymentTable.AddRow(d.Name, current, ramping, valueOrDash(d.LatestBuildID), formatTime(now, d.CreateTime))
+		if d.LatestBuildID != "" && d.LatestBuildID != d.CurrentBuildID && d.LatestBuildID != d.RampingBuildID {
+			// A newer version is deployed but gets no new workflows
+			wd.deploymentTable.GetCell(tableRow, 3).SetTextColor(theme.Warning())
+		}
+	}
+
+	if len(wd.deployments) == 0 {
+		wd.deploymentTable.AddRowWithColor(theme.FgDim(), "(no worker deployments)", "", "", "", "")
+	} else if currentRow >= 0 && currentRow < len(wd.deployments) {
+		wd.deploymentTable.SelectRow(currentRow)
+	} else {
+		wd.deploymentTable.SelectRow(0)
+	}
+}
+
+func (wd *WorkerDeploymentsView) renderVersions() {
+	wd.versionTable.ClearRows()
+	wd.versionTable.SetHeaders("BUILD ID", "STATUS", "CREATED", "SINCE")
+	if wd.selected == nil {
+		return
+	}
+	wd.versionPanel.SetTitle(fmt.Sprintf("%s Versions: %s", theme.IconInfo, wd.selected.Name))
+
+	now := time.Now()
+	for _, v := range wd.selected.Versions {
+		since := "-"
+		switch {
+		case !v.CurrentSince.IsZero():
+			since = formatTime(now, v.CurrentSince)
+		case !v.RampingSince.IsZero():
+			since = formatTime(now, v.RampingSince)
+		}
+		status := v.Status
+		if v.Status == temporal.DeploymentVersionRamping {
+			status = fmt.Sprintf("%s %g%%", v.Status, wd.selected.RampPercentage)
+		}
+		tableRow := wd.versionTable.Table.GetRowCount()
+		wd.versionTable.AddRow(v.BuildID, status, formatTime(now, v.CreateTime), since)
+		wd.versionTable.GetCell(tableRow, 1).SetTextColor(deploymentVersionColor(v.Status))
+	}
+	if len(wd.selected.Versions) == 0 {
+		wd.versionTable.AddRowWithColor(theme.FgDim(), "(no versions)", "", "", "")
+	} else {
+		wd.versionTable.SelectRow(0)
+	}
+}
+
+func deploymentVersionColor(status string) tcell.Color {
+	switch status {
+	case temporal.DeploymentVersionCurrent:
+		return theme.StatusColor("Completed")
+	case temporal.DeploymentVersionRamping:
+		return theme.StatusColor("Running")
+	case temporal.DeploymentVersionDraining:
+		return theme.Warning()
+	default:
+		return theme.FgDim()
+	}
+}
+
+// setSelectedCurrent makes the selected version current.
+func (wd *WorkerDeploymentsView) setSelectedCurrent() {
+	row := wd.versionTable.SelectedRow()
+	if wd.selected == nil || row < 0 || row >= len(wd.selected.Versions) {
+		return
+	}
+	version := wd.selected.Versions[row]
+	if version.BuildID == wd.selected.CurrentBuildID {
+		wd.app.ShowToastWarning(fmt.Sprintf("%s is already the current version", version.BuildID))
+		return
+	}
+	wd.confirmSetCurrent(version.BuildID)
+}
+
+// confirmSetCurrent previews the change and its CLI equivalent before applying it.
+// An empty buildID routes new workflows to unversioned workers.
+func (wd *WorkerDeploymentsView) confirmSetCurrent(buildID string) {
+	if wd.selected == nil {
+		return
+	}
+	deployment := *wd.selected
+	command := temporal.SetCurrentVersionCommand(wd.app.CurrentNamespace(), deployment.Name, buildID)
+
+	target := buildID
+	if target == "" {
+		target = "unversioned workers"
+	}
+	from := deployment.CurrentBuildID
+	if from == "" {
+		from = "unversioned workers"
+	}
+
+	wd.app.confirmDestructive(destructiveConfirm{
+		Op:    config.OpSetCurrentVersion,
+		Page:  "deployment-confirm",
+		Title: fmt.Sprintf("%s Set Current Version", theme.IconWarning),
+		Width: 90,
+		Warning: fmt.Sprintf(`[%s]Send new workflows of %s to %s?[-]
+
+[%s]Currently:[-] [%s]%s[-]
+
+[%s]CLI equivalent:[-]
+[%s]%s[-]`,
+			theme.TagAccent(), tview.Escape(deployment.Name), tview.Escape(target),
+			theme.TagFgDim(), theme.TagFg(), tview.Escape(from),
+			theme.TagFgDim(),
+			theme.TagFg(), tview.Escape(command)),
+		WarningHeight: 8,
+		Action:        "Apply",
+		TypedLabel:    "Type deployment name to confirm",
+		Expected:      deployment.Name,
+	}, func() {
+		wd.executeSetCurrent(deployment, buildID)
+	})
+}
+
+func (wd *WorkerDeploymentsView) executeSetCurrent(deployment temporal.WorkerDeployment, buildID string) {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err := provider.SetWorkerDeploymentCurrentVersion(ctx, wd.app.CurrentNamespace(), deployment.Name, buildID, deployment.ConflictToken)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(wd.app.Modals(), "Set Current Version Failed", err.Error())
+			}
+			// Reload either way: the deployment may have changed underneath us
+			wd.loadData()
+		})
+	}()
+}
+
+// RefreshTheme updates all component colors after a theme change.
+func (wd *WorkerDeploymentsView) RefreshTheme() {
+	bg := theme.Bg()
+	wd.SetBackgroundColor(bg)
+	wd.deploymentTable.SetBackgroundColor(bg)
+	wd.versionTable.SetBackgroundColor(bg)
+	wd.renderDeployments()
+	wd.renderVersions()
+}
+
+// Name returns the view name.
+func (wd *WorkerDeploymentsView) Name() string {
+	return "worker-deployments"
+}
+
+// Start is called when the view becomes active.
+func (wd *WorkerDeploymentsView) Start() {
+	handler := func(event *tcell.EventKey) *tcell.EventKey {
+		switch {
+		case event.Key() == tcell.KeyTab:
+			if wd.deploymentTable.HasFocus() {
+				wd.app.JigApp().SetFocus(wd.versionTable)
+			} else {
+				wd.app.JigApp().SetFocus(wd.deploymentTable)
+			}
+			return nil
+		case event.Rune() == 'r':
+			wd.loadData()
+			return nil
+		case event.Rune() == 'c':
+			if wd.versionTable.HasFocus() {
+				wd.setSelectedCurrent()
+			} else {
+				wd.app.JigApp().SetFocus(wd.versionTable)
+			}
+			return nil
+		case event.Rune() == 'U':
+			if wd.selected != nil && wd.selected.CurrentBuildID != "" {
+				wd.confirmSetCurrent("")
+			}
+			return nil
+		}
+		return event
+	}
+	wd.deploymentTable.SetInputCapture(handler)
+	wd.versionTable.SetInputCapture(handler)
+	wd.loadData()
+}
+
+// Stop is called when the view is deactivated.
+func (wd *WorkerDeploymentsView) Stop() {
+	wd.deploymentTable.SetInputCapture(nil)
+	wd.versionTable.SetInputCapture(nil)
+}
+
+// Hints returns keybinding hints for this view.
+func (wd *WorkerDeploymentsView) Hints() []KeyHint {
+	return []KeyHint{
+		{Key: "c", Description: "Set Current"},
+		{Key: "U", Description: "Unversioned"},
+		{Key: "r", Description: "Refresh"},
+		{Key: "tab", Description: "Switch Panel"},
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "esc", Description: "Back"},
+	}
+}
+
+// Focus sets focus to the deployment table.
+func (wd *WorkerDeploymentsView) Focus(delegate func(p tview.Primitive)) {
+	delegate(wd.deploymentTable)
+}
+
+// Draw applies theme colors dynamically and draws the view.
+func (wd *WorkerDeploymentsView) Draw(screen tcell.Screen) {
+	wd.SetBackgroundColor(theme.Bg())
+	wd.Flex.Draw(screen)
+}
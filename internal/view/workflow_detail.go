@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -21,15 +23,33 @@ type WorkflowDetail struct {
 	workflowID       string
 	runID            string
 	workflow         *temporal.Workflow
+	ancestors        []temporal.Workflow
+	allEvents        []temporal.EnhancedHistoryEvent // Full history; events holds the filtered view
+	hiddenGroups     map[temporal.EventGroupType]bool
 	events           []temporal.EnhancedHistoryEvent
 	leftFlex         *tview.Flex
 	workflowPanel    *components.Panel
+	detailsPanel     *components.Panel
+	failurePanel     *components.Panel
 	eventDetailPanel *components.Panel
 	eventsPanel      *components.Panel
 	workflowView     *tview.TextView
+	detailsView      *tview.TextView
+	failureView      *tview.TextView
+	failure          *temporal.FailureSummary // Close event summary for failed workflows
 	eventDetailView  *tview.TextView
-	eventTable       *components.Table
+	eventTable       *VirtualTable
 	loading          bool
+	searchText       string             // Active in-history search, highlighted in the event detail
+	tailCancel       context.CancelFunc // Non-nil while following new events
+	historyCancel    context.CancelFunc // Non-nil while history pages are still streaming in
+	historyLoaded    int                // Events received so far by the current history load
+	onLoad           []func()           // Run once the workflow's details have loaded
+
+	// A reload stops following until the history is back, then resumes the
+	// tail from where it stopped
+	tailCursor      temporal.TailCursor
+	followAfterLoad bool
 }
 
 // NewWorkflowDetail creates a new workflow detail view.
@@ -39,7 +59,7 @@ func NewWorkflowDetail(app *App, workflowID, runID string) *WorkflowDetail {
 		app:        app,
 		workflowID: workflowID,
 		runID:      runID,
-		eventTable: components.NewTable(),
+		eventTable: NewVirtualTable(),
 	}
 	wd.setup()
 	return wd
@@ -54,14 +74,31 @@ func (wd *WorkflowDetail) setup() {
 		SetTextAlign(tview.AlignLeft)
 	wd.workflowView.SetBackgroundColor(theme.Bg())
 
+	// Current details published through the metadata query
+	wd.detailsView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetWrap(true).
+		SetTextAlign(tview.AlignLeft)
+	wd.detailsView.SetBackgroundColor(theme.Bg())
+
+	// Terminal failure summary for failed workflows
+	wd.failureView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWrap(true).
+		SetTextAlign(tview.AlignLeft)
+	wd.failureView.SetBackgroundColor(theme.Bg())
+
 	// Event detail view
 	wd.eventDetailView = tview.NewTextView().
 		SetDynamicColors(true).
+		SetRegions(true).
 		SetTextAlign(tview.AlignLeft)
 	wd.eventDetailView.SetBackgroundColor(theme.Bg())
 
 	// Event table
 	wd.eventTable.SetHeaders("ID", "TIME", "TYPE", "NAME")
+	wd.eventTable.SetRowFunc(wd.eventRow)
 	wd.eventTable.SetBorder(false)
 	wd.eventTable.SetBackgroundColor(theme.Bg())
 
@@ -69,6 +106,12 @@ func (wd *WorkflowDetail) setup() {
 	wd.workflowPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Workflow", theme.IconWorkflow))
 	wd.workflowPanel.SetContent(wd.workflowView)
 
+	wd.detailsPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Current Details", theme.IconInfo))
+	wd.detailsPanel.SetContent(wd.detailsView)
+
+	wd.failurePanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Failure", theme.IconError))
+	wd.failurePanel.SetContent(wd.failureView)
+
 	wd.eventDetailPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Event Detail", theme.IconInfo))
 	wd.eventDetailPanel.SetContent(wd.eventDetailView)
 
@@ -79,21 +122,23 @@ func (wd *WorkflowDetail) setup() {
 	wd.leftFlex = tview.NewFlex().SetDirection(tview.FlexRow)
 	wd.leftFlex.SetBackgroundColor(theme.Bg())
 	wd.leftFlex.AddItem(wd.workflowPanel, 0, 1, false)
+	wd.leftFlex.AddItem(wd.detailsPanel, 0, 0, false) // Shown once the workflow reports details
+	wd.leftFlex.AddItem(wd.failurePanel, 0, 0, false) // Shown for failed, timed out and terminated workflows
 	wd.leftFlex.AddItem(wd.eventDetailPanel, 0, 1, false)
 
 	// Main layout: left stack + right events
-	wd.AddItem(wd.leftFlex, 0, 2, false)
-	wd.AddItem(wd.eventsPanel, 0, 3, true)
+	ratio := wd.app.panelRatio(wd.Name(), workflowDetailRatio)
+	wd.AddItem(wd.leftFlex, 0, ratio, false)
+	wd.AddItem(wd.eventsPanel, 0, 100-ratio, true)
 
 	// Update event detail when selection changes
-	wd.eventTable.SetSelectionChangedFunc(func(row, col int) {
-		if row > 0 && row-1 < len(wd.events) {
-			wd.updateEventDetail(wd.events[row-1])
+	wd.eventTable.SetSelectionChangedFunc(func(row int) {
+		if row >= 0 && row < len(wd.events) {
+			wd.updateEventDetail(wd.events[row])
+			// Child workflow hint depends on the selected event
+			wd.app.JigApp().Menu().SetHints(wd.Hints())
 		}
 	})
-
-	// Show loading state initially
-	wd.workflowView.SetText(fmt.Sprintf("\n [%s]Loading...[-]", theme.TagFgDim()))
 }
 
 func (wd *WorkflowDetail) setLoading(loading bool) {
@@ -111,6 +156,10 @@ func (wd *WorkflowDetail) RefreshTheme() {
 	// Update text views
 	wd.workflowView.SetBackgroundColor(bg)
 	wd.workflowView.SetTextColor(fg)
+	wd.detailsView.SetBackgroundColor(bg)
+	wd.detailsView.SetTextColor(fg)
+	wd.failureView.SetBackgroundColor(bg)
+	wd.failureView.SetTextColor(fg)
 	wd.eventDetailView.SetBackgroundColor(bg)
 	wd.eventDetailView.SetTextColor(fg)
 
@@ -122,6 +171,7 @@ func (wd *WorkflowDetail) RefreshTheme() {
 
 	// Re-render content with new theme colors
 	wd.render()
+	wd.renderFailureSummary()
 	wd.populateEventTable()
 }
 
@@ -132,16 +182,32 @@ func (wd *WorkflowDetail) loadData() {
 		return
 	}
 
+	// Show prefetched details right away, refreshed quietly by the load below
+	prefetched := false
+	if workflow, ok := wd.app.prefetcher().Get(wd.app.CurrentNamespace(), wd.workflowID, wd.runID); ok && wd.workflow == nil {
+		wd.workflow = workflow
+		wd.render()
+		wd.app.JigApp().Menu().SetHints(wd.Hints())
+		prefetched = true
+		wd.runOnLoad()
+	}
+
 	wd.setLoading(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := func() {}
+	if !prefetched {
+		done = wd.app.startLoading(wd.workflowPanel, "Loading workflow", cancel)
+	}
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
 		workflow, err := provider.GetWorkflow(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID)
 
 		wd.app.JigApp().QueueUpdateDraw(func() {
+			done()
 			wd.setLoading(false)
 			if err != nil {
+				wd.onLoad = nil
 				wd.showError(err)
 				return
 			}
@@ -149,26 +215,189 @@ func (wd *WorkflowDetail) loadData() {
 			wd.render()
 			// Update hints now that we have workflow status
 			wd.app.JigApp().Menu().SetHints(wd.Hints())
+			wd.runOnLoad()
+			if workflow.ParentID != nil {
+				wd.loadAncestry()
+			}
+			if workflow.Status == "Running" {
+				wd.loadCurrentDetails()
+			} else {
+				wd.setCurrentDetails("")
+			}
+			switch workflow.Status {
+			case "Failed", "TimedOut", "Terminated":
+				wd.loadFailureSummary()
+			default:
+				wd.setFailureSummary(nil)
+			}
+		})
+	}()
+
+	// Stream events in parallel so large histories can be browsed while loading
+	wd.loadHistory(provider)
+}
+
+// loadHistory streams the event history page by page, replacing the current events
+// on the first page and appending the rest as they arrive.
+func (wd *WorkflowDetail) loadHistory(provider temporal.Provider) {
+	wd.stopHistoryLoad()
+	if wd.tailCancel != nil {
+		wd.stopFollow()
+		wd.followAfterLoad = true
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	wd.historyCancel = cancel
+	wd.historyLoaded = 0
+	wd.updateEventsTitle()
+
+	first := true
+	go func() {
+		defer cancel()
+
+		err := provider.StreamWorkflowHistory(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID,
+			func(page []temporal.EnhancedHistoryEvent) {
+				wd.app.JigApp().QueueUpdateDraw(func() {
+					if ctx.Err() != nil {
+						return // Superseded by a newer load
+					}
+					wd.historyLoaded += len(page)
+					if first {
+						first = false
+						wd.allEvents = page
+						wd.applyEventFilter()
+						return
+					}
+					wd.appendEvents(page)
+				})
+			})
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if ctx.Err() != nil {
+				return
+			}
+			wd.historyCancel = nil
+			if err != nil {
+				wd.app.ShowToastError(fmt.Sprintf("Failed to load history: %v", err))
+			}
+			wd.updateEventsTitle()
+			// Worker info and the last failed activity come from the full history
+			if wd.workflow != nil {
+				wd.render()
+			}
+			wd.renderFailureSummary()
+			if wd.followAfterLoad {
+				wd.followAfterLoad = false
+				wd.resumeFollow()
+			}
+		})
+	}()
+}
+
+// stopHistoryLoad cancels an in-progress history stream.
+func (wd *WorkflowDetail) stopHistoryLoad() {
+	if wd.historyCancel != nil {
+		wd.historyCancel()
+		wd.historyCancel = nil
+	}
+}
+
+// appendEvents adds a page of events to the end of the table without rebuilding it.
+func (wd *WorkflowDetail) appendEvents(page []temporal.EnhancedHistoryEvent) {
+	wasEmpty := len(wd.events) == 0
+	wd.allEvents = append(wd.allEvents, page...)
+
+	if len(wd.hiddenGroups) == 0 {
+		// events aliases allEvents when unfiltered
+		wd.events = wd.allEvents
+	} else {
+		for i := range page {
+			if !wd.hiddenGroups[temporal.ClassifyEvent(page[i].Type)] {
+				wd.events = append(wd.events, page[i])
+			}
+		}
+	}
+	wd.eventTable.SetRowCount(len(wd.events))
+
+	if wasEmpty && len(wd.events) > 0 {
+		wd.eventTable.SelectRow(0)
+		wd.updateEventDetail(wd.events[0])
+	}
+	wd.updateEventsTitle()
+}
+
+// loadAncestry fetches the parent chain for the breadcrumb shown in the workflow panel.
+func (wd *WorkflowDetail) loadAncestry() {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ancestors, err := provider.GetWorkflowAncestry(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				return
+			}
+			wd.ancestors = ancestors
+			wd.render()
 		})
 	}()
+}
+
+// loadCurrentDetails fetches the status the workflow publishes through the metadata
+// query. Workflows on older SDKs, or without a worker, simply show no panel.
+func (wd *WorkflowDetail) loadCurrentDetails() {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
 
-	// Load events in parallel
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
-		events, err := provider.GetEnhancedWorkflowHistory(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID)
+		md, err := provider.GetWorkflowMetadata(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID)
 
 		wd.app.JigApp().QueueUpdateDraw(func() {
 			if err != nil {
+				wd.setCurrentDetails("")
 				return
 			}
-			wd.events = events
-			wd.populateEventTable()
+			wd.setCurrentDetails(md.CurrentDetails)
 		})
 	}()
 }
 
+// setCurrentDetails shows the current details panel, or hides it when details is empty.
+func (wd *WorkflowDetail) setCurrentDetails(details string) {
+	details = strings.TrimSpace(details)
+	if details == "" {
+		wd.detailsView.SetText("")
+		wd.leftFlex.ResizeItem(wd.detailsPanel, 0, 0)
+		return
+	}
+
+	var b strings.Builder
+	for i, line := range strings.Split(details, "\n") {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		// Markdown headings are common in current details; render them as bold labels
+		if heading := strings.TrimLeft(line, "#"); heading != line {
+			b.WriteString(fmt.Sprintf("[%s::b]%s[-:-:-]", theme.TagAccent(), tview.Escape(strings.TrimSpace(heading))))
+			continue
+		}
+		b.WriteString(tview.Escape(line))
+	}
+	wd.detailsView.SetText(b.String())
+	wd.detailsView.ScrollToBeginning()
+	wd.leftFlex.ResizeItem(wd.detailsPanel, 0, 1)
+}
+
 func (wd *WorkflowDetail) loadMockData() {
 	now := time.Now()
 	wd.workflow = &temporal.Workflow{
@@ -180,7 +409,7 @@ func (wd *WorkflowDetail) loadMockData() {
 		TaskQueue: "mock-tasks",
 		StartTime: now.Add(-5 * time.Minute),
 	}
-	wd.events = []temporal.EnhancedHistoryEvent{
+	wd.allEvents = []temporal.EnhancedHistoryEvent{
 		{ID: 1, Type: "WorkflowExecutionStarted", Time: now.Add(-5 * time.Minute), Details: "WorkflowType: MockWorkflow, TaskQueue: mock-tasks"},
 		{ID: 2, Type: "WorkflowTaskScheduled", Time: now.Add(-5 * time.Minute), Details: "TaskQueue: mock-tasks"},
 		{ID: 3, Type: "WorkflowTaskStarted", Time: now.Add(-5 * time.Minute), Details: "Identity: worker-1@host"},
@@ -190,7 +419,26 @@ func (wd *WorkflowDetail) loadMockData() {
 		{ID: 7, Type: "ActivityTaskCompleted", Time: now.Add(-3 * time.Minute), Details: "ScheduledEventId: 5, Result: {success: true}", ActivityType: "MockActivity", ScheduledEventID: 5},
 	}
 	wd.render()
-	wd.populateEventTable()
+	wd.applyEventFilter()
+}
+
+// whenLoaded runs fn once the workflow's details have loaded, right away if
+// they have. It is dropped if they fail to load or the view is left first.
+func (wd *WorkflowDetail) whenLoaded(fn func()) {
+	if wd.workflow != nil {
+		fn()
+		return
+	}
+	wd.onLoad = append(wd.onLoad, fn)
+}
+
+// runOnLoad runs the funcs waiting for the workflow's details.
+func (wd *WorkflowDetail) runOnLoad() {
+	fns := wd.onLoad
+	wd.onLoad = nil
+	for _, fn := range fns {
+		fn()
+	}
 }
 
 func (wd *WorkflowDetail) showError(err error) {
@@ -228,14 +476,208 @@ func (wd *WorkflowDetail) render() {
 		theme.TagFgDim(), theme.TagFg(), w.ID,
 		theme.TagFgDim(), theme.TagFg(), w.Type,
 		theme.TagFgDim(), statusColor, statusIcon, w.Status,
-		theme.TagFgDim(), theme.TagFg(), formatRelativeTime(now, w.StartTime),
+		theme.TagFgDim(), theme.TagFg(), formatTime(now, w.StartTime),
 		theme.TagFgDim(), theme.TagFg(), durationStr,
 		theme.TagFgDim(), theme.TagFg(), w.TaskQueue,
 		theme.TagFgDim(), theme.TagFgDim(), truncateStr(w.RunID, 25),
 	)
+
+	if w.ParentID != nil {
+		workflowText += fmt.Sprintf(`
+[%s::b]Parent[-:-:-]       [%s]%s[-]
+[%s::b]Parent Run[-:-:-]   [%s]%s[-]`,
+			theme.TagFgDim(), theme.TagAccent(), *w.ParentID,
+			theme.TagFgDim(), theme.TagFgDim(), truncateStr(w.ParentRunID, 25),
+		)
+		if len(wd.ancestors) > 0 {
+			workflowText += fmt.Sprintf("\n[%s::b]Ancestry[-:-:-]     %s", theme.TagFgDim(), formatAncestry(wd.ancestors, w))
+		}
+	}
+
+	workflowText += formatRetrySection(w, now)
+	workflowText += formatWorkerSection(wd.allEvents)
 	wd.workflowView.SetText(workflowText)
 }
 
+// formatRetrySection renders the workflow's attempt and retry policy, plus any
+// pending activities that are retrying, with their next attempt times.
+func formatRetrySection(w *temporal.Workflow, now time.Time) string {
+	var b strings.Builder
+
+	if w.Attempt > 1 || w.RetryPolicy != nil {
+		maxAttempts := int32(0)
+		if w.RetryPolicy != nil {
+			maxAttempts = w.RetryPolicy.MaximumAttempts
+		}
+		b.WriteString(fmt.Sprintf("\n[%s::b]Attempt[-:-:-]      [%s]%s[-]",
+			theme.TagFgDim(), theme.TagFg(), formatAttempt(w.Attempt, maxAttempts)))
+	}
+	if w.RetryPolicy != nil {
+		b.WriteString(fmt.Sprintf("\n[%s::b]Retry Policy[-:-:-] [%s]%s[-]",
+			theme.TagFgDim(), theme.TagFg(), formatRetryPolicy(w.RetryPolicy)))
+	}
+	// A retried run waits for its backoff before the first workflow task is
+	// scheduled; a first attempt may also wait, for a start delay or cron
+	// schedule, which is not a retry
+	if w.Attempt > 1 && w.ExecutionTime != nil && w.ExecutionTime.After(now) {
+		b.WriteString(fmt.Sprintf("\n[%s::b]Next Retry[-:-:-]   [%s]%s[-]",
+			theme.TagFgDim(), theme.TagAccent(), formatNextAttempt(now, *w.ExecutionTime)))
+	}
+
+	var retrying []temporal.PendingActivity
+	for _, pa := range w.PendingActivities {
+		if pa.Attempt > 1 || pa.NextAttemptTime != nil {
+			retrying = append(retrying, pa)
+		}
+	}
+	if len(retrying) > 0 {
+		b.WriteString(fmt.Sprintf("\n\n[%s::b]Retrying Activities[-:-:-]", theme.TagAccent()))
+		for _, pa := range retrying {
+			b.WriteString(fmt.Sprintf("\n [%s]%s[-] [%s]attempt %s[-]",
+				theme.TagFg(), pa.ActivityType, theme.TagFgDim(), formatAttempt(pa.Attempt, pa.MaximumAttempts)))
+			if pa.NextAttemptTime != nil {
+				b.WriteString(fmt.Sprintf(" [%s]next %s[-]", theme.TagAccent(), formatNextAttempt(now, *pa.NextAttemptTime)))
+			}
+			if pa.RetryPolicy != nil {
+				b.WriteString(fmt.Sprintf("\n   [%s]%s[-]", theme.TagFgDim(), formatRetryPolicy(pa.RetryPolicy)))
+			}
+			if pa.LastFailure != "" {
+				b.WriteString(fmt.Sprintf("\n   [%s]%s[-]", theme.TagError(), truncateStr(pa.LastFailure, 60)))
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// formatWorkerSection renders the worker that completed the last workflow task and
+// the build IDs that processed tasks across the history.
+func formatWorkerSection(events []temporal.EnhancedHistoryEvent) string {
+	var last *temporal.EnhancedHistoryEvent
+	var buildIDs []string
+	counts := make(map[string]int)
+	for i := range events {
+		ev := &events[i]
+		if ev.Type == "WorkflowTaskCompleted" {
+			last = ev
+		}
+		if ev.BuildID != "" {
+			if counts[ev.BuildID] == 0 {
+				buildIDs = append(buildIDs, ev.BuildID)
+			}
+			counts[ev.BuildID]++
+		}
+	}
+	if last == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("\n\n[%s::b]Workers[-:-:-]", theme.TagAccent()))
+	if last.Identity != "" {
+		b.WriteString(fmt.Sprintf("\n[%s::b]Last Task[-:-:-]    [%s]%s[-]",
+			theme.TagFgDim(), theme.TagFg(), tview.Escape(last.Identity)))
+	}
+	buildID := last.BuildID
+	if buildID == "" {
+		buildID = "unversioned"
+	}
+	b.WriteString(fmt.Sprintf("\n[%s::b]Build ID[-:-:-]     [%s]%s[-]",
+		theme.TagFgDim(), theme.TagFg(), tview.Escape(buildID)))
+
+	// List every build ID when more than one worker version touched the run
+	if len(buildIDs) > 1 {
+		b.WriteString(fmt.Sprintf("\n[%s::b]All Builds[-:-:-]", theme.TagFgDim()))
+		for _, id := range buildIDs {
+			b.WriteString(fmt.Sprintf("\n [%s]%s[-] [%s]%d tasks[-]",
+				theme.TagFg(), tview.Escape(id), theme.TagFgDim(), counts[id]))
+		}
+	}
+	return b.String()
+}
+
+// formatAttempt renders "n/max", or "n/∞" when attempts are unlimited.
+func formatAttempt(attempt, maxAttempts int32) string {
+	if attempt < 1 {
+		attempt = 1
+	}
+	if maxAttempts <= 0 {
+		return fmt.Sprintf("%d/∞", attempt)
+	}
+	return fmt.Sprintf("%d/%d", attempt, maxAttempts)
+}
+
+// formatRetryPolicy summarizes a retry policy as "initial ×coefficient ≤ max".
+func formatRetryPolicy(rp *temporal.RetryPolicy) string {
+	text := fmt.Sprintf("%s ×%.1f", rp.InitialInterval, rp.BackoffCoefficient)
+	if rp.MaximumInterval > 0 {
+		text += fmt.Sprintf(" ≤ %s", rp.MaximumInterval)
+	}
+	if len(rp.NonRetryableErrors) > 0 {
+		text += fmt.Sprintf(", non-retryable: %s", strings.Join(rp.NonRetryableErrors, ", "))
+	}
+	return text
+}
+
+// formatNextAttempt renders a future attempt time as "in 12s (15:04:05)".
+func formatNextAttempt(now, t time.Time) string {
+	if !t.After(now) {
+		return "due now"
+	}
+	return fmt.Sprintf("in %s (%s)", t.Sub(now).Round(time.Second), inTimezone(t).Format("15:04:05"))
+}
+
+// formatAncestry renders the parent chain as a breadcrumb ending at the current workflow.
+func formatAncestry(ancestors []temporal.Workflow, current *temporal.Workflow) string {
+	parts := make([]string, 0, len(ancestors)+1)
+	for _, a := range ancestors {
+		parts = append(parts, fmt.Sprintf("[%s]%s[-]", theme.TagFg(), truncateStr(a.Type, 20)))
+	}
+	parts = append(parts, fmt.Sprintf("[%s::b]%s[-:-:-]", theme.TagAccent(), truncateStr(current.Type, 20)))
+	return strings.Join(parts, fmt.Sprintf(" [%s]›[-] ", theme.TagFgDim()))
+}
+
+// navigateToParent opens the parent workflow's detail view.
+func (wd *WorkflowDetail) navigateToParent() {
+	if wd.workflow == nil || wd.workflow.ParentID == nil {
+		return
+	}
+	wd.app.NavigateToWorkflowDetail(*wd.workflow.ParentID, wd.workflow.ParentRunID)
+}
+
+// selectedChildExecution returns the workflow execution referenced by the selected event.
+// Initiated events carry no run ID, so it is resolved from the matching started event when present.
+func (wd *WorkflowDetail) selectedChildExecution() (workflowID, runID string, ok bool) {
+	row := wd.eventTable.SelectedRow()
+	if row < 0 || row >= len(wd.events) {
+		return "", "", false
+	}
+	ev := wd.events[row]
+	if ev.ChildWorkflowID == "" {
+		return "", "", false
+	}
+
+	runID = ev.ChildRunID
+	if runID == "" {
+		for _, other := range wd.allEvents {
+			if other.InitiatedEventID == ev.ID && other.ChildRunID != "" {
+				runID = other.ChildRunID
+				break
+			}
+		}
+	}
+	return ev.ChildWorkflowID, runID, true
+}
+
+// navigateToChild opens the detail view of the workflow referenced by the selected event.
+func (wd *WorkflowDetail) navigateToChild() {
+	workflowID, runID, ok := wd.selectedChildExecution()
+	if !ok {
+		return
+	}
+	wd.app.NavigateToWorkflowDetail(workflowID, runID)
+}
+
 func (wd *WorkflowDetail) updateEventDetail(ev temporal.EnhancedHistoryEvent) {
 	icon := eventIcon(ev.Type)
 	colorTag := eventColorTag(ev.Type)
@@ -249,6 +691,9 @@ func (wd *WorkflowDetail) updateEventDetail(ev temporal.EnhancedHistoryEvent) {
 	if name != "" {
 		nameLine = fmt.Sprintf("\n[%s::b]Name[-:-:-]         [%s]%s[-]", theme.TagFgDim(), theme.TagFg(), name)
 	}
+	if ev.ChildWorkflowID != "" {
+		nameLine += fmt.Sprintf("\n[%s::b]Child ID[-:-:-]     [%s]%s[-]", theme.TagFgDim(), theme.TagAccent(), ev.ChildWorkflowID)
+	}
 
 	detailText := fmt.Sprintf(`
 [%s::b]Event ID[-:-:-]     [%s]%d[-]
@@ -258,10 +703,260 @@ func (wd *WorkflowDetail) updateEventDetail(ev temporal.EnhancedHistoryEvent) {
 %s`,
 		theme.TagFgDim(), theme.TagFg(), ev.ID,
 		theme.TagFgDim(), colorTag, icon, ev.Type, nameLine,
-		theme.TagFgDim(), theme.TagFg(), ev.Time.Format("2006-01-02 15:04:05.000"),
+		theme.TagFgDim(), theme.TagFg(), inTimezone(ev.Time).Format("2006-01-02 15:04:05.000"),
 		formattedDetails,
 	)
+	if len(ev.Payloads) > 0 {
+		detailText += "\n\n" + formatPayloadSection(ev.Payloads)
+	}
+
+	detailText, regions := highlightSearchMatches(detailText, wd.searchText)
 	wd.eventDetailView.SetText(detailText)
+	wd.eventDetailView.Highlight(regions...)
+	if len(regions) > 0 {
+		wd.eventDetailView.ScrollToHighlight()
+	} else {
+		wd.eventDetailView.ScrollToBeginning()
+	}
+}
+
+// showSearch prompts for an in-history search across event types and payload contents.
+func (wd *WorkflowDetail) showSearch() {
+	wd.app.ShowFilterMode(wd.searchText, FilterModeCallbacks{
+		Placeholder: "Search events and payloads...",
+		OnSubmit: func(text string) {
+			wd.applySearch(text)
+		},
+	})
+}
+
+// applySearch sets the search text and jumps to the first match at or after the selection.
+func (wd *WorkflowDetail) applySearch(text string) {
+	wd.searchText = strings.TrimSpace(text)
+	wd.updateEventsTitle()
+	wd.app.JigApp().Menu().SetHints(wd.Hints())
+
+	if wd.searchText == "" {
+		wd.refreshSelectedEventDetail()
+		return
+	}
+	if !wd.jumpToMatch(wd.eventTable.SelectedRow(), true) {
+		wd.app.ShowToastWarning(fmt.Sprintf("No events match %q", wd.searchText))
+		wd.refreshSelectedEventDetail()
+	}
+}
+
+// jumpToMatch selects the next (or previous) matching event starting from row, wrapping around.
+func (wd *WorkflowDetail) jumpToMatch(from int, forward bool) bool {
+	n := len(wd.events)
+	if wd.searchText == "" || n == 0 {
+		return false
+	}
+	for step := 0; step < n; step++ {
+		var i int
+		if forward {
+			i = (from + step + n) % n
+		} else {
+			i = (from - step + n) % n
+		}
+		if eventMatchesSearch(&wd.events[i], wd.searchText) {
+			wd.eventTable.SelectRow(i)
+			wd.updateEventDetail(wd.events[i])
+			return true
+		}
+	}
+	return false
+}
+
+// nextMatch moves to the next or previous search match after the current selection.
+func (wd *WorkflowDetail) nextMatch(forward bool) {
+	row := wd.eventTable.SelectedRow()
+	if forward {
+		wd.jumpToMatch(row+1, true)
+	} else {
+		wd.jumpToMatch(row-1, false)
+	}
+}
+
+// refreshSelectedEventDetail re-renders the detail pane for the selected event.
+func (wd *WorkflowDetail) refreshSelectedEventDetail() {
+	row := wd.eventTable.SelectedRow()
+	if row >= 0 && row < len(wd.events) {
+		wd.updateEventDetail(wd.events[row])
+	}
+}
+
+// toggleFollow starts or stops live tailing of a running workflow's history.
+func (wd *WorkflowDetail) toggleFollow() {
+	if wd.tailCancel != nil {
+		wd.stopFollow()
+		wd.updateEventsTitle()
+		wd.app.JigApp().Menu().SetHints(wd.Hints())
+		return
+	}
+	if wd.workflow == nil || wd.workflow.Status != "Running" {
+		return
+	}
+	if wd.historyCancel != nil {
+		wd.app.ShowToastWarning("History is still loading")
+		return
+	}
+	wd.startFollow()
+}
+
+// startFollow long-polls for new events and appends them as they are written.
+// When the workflow closes, follow mode ends and the view reloads.
+func (wd *WorkflowDetail) startFollow() {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wd.tailCancel = cancel
+
+	var lastEventID int64
+	if n := len(wd.allEvents); n > 0 {
+		lastEventID = wd.allEvents[n-1].ID
+	}
+
+	wd.updateEventsTitle()
+	wd.app.JigApp().Menu().SetHints(wd.Hints())
+	wd.selectLastEvent()
+
+	go func() {
+		err := provider.TailWorkflowHistory(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.currentRunID(), lastEventID, &wd.tailCursor,
+			func(events []temporal.EnhancedHistoryEvent) {
+				wd.app.JigApp().QueueUpdateDraw(func() {
+					if ctx.Err() != nil {
+						return
+					}
+					// A reload may already have loaded some of these
+					for _, ev := range events {
+						if n := len(wd.allEvents); n == 0 || ev.ID > wd.allEvents[n-1].ID {
+							wd.allEvents = append(wd.allEvents, ev)
+						}
+					}
+					wd.applyEventFilter()
+					wd.selectLastEvent()
+				})
+			})
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if ctx.Err() != nil {
+				return // Stopped by the user or view
+			}
+			wd.stopFollow()
+			if err != nil {
+				wd.app.ShowToastError(fmt.Sprintf("Follow stopped: %v", err))
+			}
+			// Workflow closed (or polling failed); refresh status and history
+			wd.loadData()
+		})
+	}()
+}
+
+// resumeFollow follows again after a reload, if the workflow is still running.
+func (wd *WorkflowDetail) resumeFollow() {
+	if wd.workflow != nil && wd.workflow.Status == "Running" {
+		wd.startFollow()
+		return
+	}
+	wd.app.JigApp().Menu().SetHints(wd.Hints())
+}
+
+// stopFollow cancels live tailing if it is active.
+func (wd *WorkflowDetail) stopFollow() {
+	if wd.tailCancel != nil {
+		wd.tailCancel()
+		wd.tailCancel = nil
+	}
+}
+
+// selectLastEvent moves the selection to the newest visible event.
+func (wd *WorkflowDetail) selectLastEvent() {
+	if n := len(wd.events); n > 0 {
+		wd.eventTable.SelectRow(n - 1)
+		wd.updateEventDetail(wd.events[n-1])
+	}
+}
+
+// updateEventsTitle shows the follow state, active filter and search in the events panel title.
+func (wd *WorkflowDetail) updateEventsTitle() {
+	title := fmt.Sprintf("%s Events", theme.IconEvent)
+	if wd.tailCancel != nil {
+		title += fmt.Sprintf(" [%s]● LIVE[-]", theme.TagSuccess())
+	}
+	if wd.historyCancel != nil {
+		progress := fmt.Sprintf("%d", wd.historyLoaded)
+		if wd.workflow != nil && wd.workflow.HistoryLength > 0 {
+			progress = fmt.Sprintf("%d/%d", wd.historyLoaded, wd.workflow.HistoryLength)
+		}
+		title += fmt.Sprintf(" [%s]%s Loading %s[-]", theme.TagFgDim(), theme.IconPending, progress)
+	}
+	if len(wd.events) != len(wd.allEvents) {
+		title += fmt.Sprintf(" [%s](%d/%d)[-]", theme.TagFgDim(), len(wd.events), len(wd.allEvents))
+	}
+	if wd.searchText != "" {
+		matches := 0
+		for i := range wd.events {
+			if eventMatchesSearch(&wd.events[i], wd.searchText) {
+				matches++
+			}
+		}
+		title += fmt.Sprintf(" [%s]/%s (%d)[-]", theme.TagAccent(), wd.searchText, matches)
+	}
+	wd.eventsPanel.SetTitle(title)
+}
+
+// applyEventFilter rebuilds the visible events from the full history, hiding
+// any categories toggled off in the event filter.
+func (wd *WorkflowDetail) applyEventFilter() {
+	if len(wd.hiddenGroups) == 0 {
+		wd.events = wd.allEvents
+	} else {
+		wd.events = make([]temporal.EnhancedHistoryEvent, 0, len(wd.allEvents))
+		for _, ev := range wd.allEvents {
+			if !wd.hiddenGroups[temporal.ClassifyEvent(ev.Type)] {
+				wd.events = append(wd.events, ev)
+			}
+		}
+	}
+	wd.populateEventTable()
+	if len(wd.events) == 0 {
+		wd.eventDetailView.SetText(fmt.Sprintf("\n [%s]No events match the current filter[-]", theme.TagFgDim()))
+	}
+}
+
+// formatPayloadSection renders decoded event payloads with their encoding and size.
+func formatPayloadSection(payloads []temporal.Payload) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("[%s::b]Payloads[-:-:-]", theme.TagAccent()))
+	for _, p := range payloads {
+		encoding := p.Encoding
+		if encoding == "" {
+			encoding = "unknown"
+		}
+		meta := fmt.Sprintf("%s, %s", encoding, temporal.FormatBytes(p.Size))
+		if p.MessageType != "" {
+			meta += ", " + p.MessageType
+		}
+		b.WriteString(fmt.Sprintf("\n\n[%s::b]%s[-:-:-]  [%s]%s[-]\n",
+			theme.TagFgDim(), p.Field, theme.TagFgMuted(), meta))
+
+		if p.CodecError != "" {
+			b.WriteString(fmt.Sprintf("[%s]Codec failed, showing the encoded payload: %s[-]\n", theme.TagError(), tview.Escape(p.CodecError)))
+		}
+		if p.Truncated {
+			b.WriteString(fmt.Sprintf("[%s]Preview only, payload exceeds the inline size limit (open with d)[-]\n", theme.TagWarning()))
+		}
+		if p.Decoded {
+			b.WriteString(highlightJSONText(p.Data))
+		} else {
+			b.WriteString(fmt.Sprintf("[%s]%s[-]", theme.TagFgDim(), tview.Escape(p.Data)))
+		}
+	}
+	return b.String()
 }
 
 // formatEventDetails parses event details and formats them with pretty JSON.
@@ -273,8 +968,7 @@ func formatEventDetails(details string) string {
 	// First check if the whole thing is JSON
 	trimmed := strings.TrimSpace(details)
 	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
-		formatted := formatJSONPretty(details)
-		return highlightFormattedJSONWorkflow(formatted)
+		return highlightJSONText(details)
 	}
 
 	// Handle key-value format with embedded JSON
@@ -328,19 +1022,13 @@ func formatKeyValueDetailsWorkflow(details string) string {
 
 			// Check if value is JSON
 			value := strings.TrimSpace(kv.value)
-			if strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[") {
-				formatted := formatJSONPretty(value)
-				if formatted != value {
-					// JSON was successfully formatted - put it on next line at left margin
-					result.WriteString(fmt.Sprintf("[%s::b]%s[-:-:-]\n", theme.TagFgDim(), paddedKey))
-					result.WriteString(highlightFormattedJSONWorkflow(formatted))
-				} else {
-					result.WriteString(fmt.Sprintf("[%s::b]%s[-:-:-]  ", theme.TagFgDim(), paddedKey))
-					result.WriteString(highlightJSONLineWorkflow(value))
-				}
+			if _, err := parseJSONNodes(value); err == nil && (strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[")) {
+				// Put objects and arrays on the next line at the left margin
+				result.WriteString(fmt.Sprintf("[%s::b]%s[-:-:-]\n", theme.TagFgDim(), paddedKey))
+				result.WriteString(highlightJSONText(value))
 			} else {
 				result.WriteString(fmt.Sprintf("[%s::b]%s[-:-:-]  ", theme.TagFgDim(), paddedKey))
-				result.WriteString(fmt.Sprintf("[%s]%s[-]", theme.TagFg(), highlightValuesWorkflow(value)))
+				result.WriteString(highlightDetail(value))
 			}
 		} else {
 			result.WriteString(fmt.Sprintf("[%s]%s[-]", theme.TagFg(), kv.value))
@@ -429,40 +1117,16 @@ func formatJSONPretty(s string) string {
 	return string(pretty)
 }
 
-// highlightFormattedJSONWorkflow applies syntax highlighting to formatted JSON.
-func highlightFormattedJSONWorkflow(formatted string) string {
-	lines := strings.Split(formatted, "\n")
-	var result []string
-	for _, line := range lines {
-		result = append(result, highlightJSONLineWorkflow(line))
-	}
-	return strings.Join(result, "\n")
-}
-
-// highlightJSONLineWorkflow highlights a single line of JSON content.
-func highlightJSONLineWorkflow(line string) string {
-	// Check for key: value pattern
-	if colonIdx := strings.Index(line, ":"); colonIdx > 0 {
-		prefix := line[:colonIdx]
-		suffix := line[colonIdx+1:]
-
-		trimmed := strings.TrimSpace(prefix)
-		if strings.HasPrefix(trimmed, "\"") && strings.HasSuffix(trimmed, "\"") {
-			// JSON key with quotes - use accent color
-			return fmt.Sprintf("[%s]%s[-]:[%s]%s[-]", theme.TagAccent(), prefix, theme.TagFg(), highlightValuesWorkflow(suffix))
-		}
+// eventRow renders event i for the events table, which only asks for the rows
+// in view.
+func (wd *WorkflowDetail) eventRow(i int) (tcell.Color, []string) {
+	ev := &wd.events[i]
+	return eventColor(ev.Type), []string{
+		fmt.Sprintf("%d", ev.ID),
+		inTimezone(ev.Time).Format("15:04:05"),
+		eventIcon(ev.Type) + " " + truncateStr(ev.Type, 30),
+		getEventNameDetail(ev),
 	}
-
-	return highlightValuesWorkflow(line)
-}
-
-// highlightValuesWorkflow highlights JSON values (booleans, null).
-func highlightValuesWorkflow(s string) string {
-	result := s
-	result = strings.ReplaceAll(result, "true", fmt.Sprintf("[%s]true[-]", theme.StatusColorTag("Completed")))
-	result = strings.ReplaceAll(result, "false", fmt.Sprintf("[%s]false[-]", theme.StatusColorTag("Failed")))
-	result = strings.ReplaceAll(result, "null", fmt.Sprintf("[%s]null[-]", theme.TagFgDim()))
-	return result
 }
 
 func (wd *WorkflowDetail) populateEventTable() {
@@ -470,19 +1134,9 @@ func (wd *WorkflowDetail) populateEventTable() {
 	currentRow := wd.eventTable.SelectedRow()
 
 	wd.eventTable.ClearRows()
-	wd.eventTable.SetHeaders("ID", "TIME", "TYPE", "NAME")
+	wd.eventTable.SetRowCount(len(wd.events))
 
-	for _, ev := range wd.events {
-		icon := eventIcon(ev.Type)
-		color := eventColor(ev.Type)
-		name := getEventNameDetail(&ev)
-		wd.eventTable.AddRowWithColor(color,
-			fmt.Sprintf("%d", ev.ID),
-			ev.Time.Format("15:04:05"),
-			icon+" "+truncateStr(ev.Type, 30),
-			name,
-		)
-	}
+	wd.updateEventsTitle()
 
 	if wd.eventTable.RowCount() > 0 {
 		// Restore previous selection if valid, otherwise select first row
@@ -549,12 +1203,75 @@ func (wd *WorkflowDetail) Start() {
 		case 'R':
 			wd.showResetSelector()
 			return nil
+		case 'H':
+			wd.resetToSelectedEvent()
+			return nil
+		case 'S':
+			wd.showRerunForm()
+			return nil
 		case 'Q':
 			wd.showQueryInput()
 			return nil
+		case 't':
+			wd.executeStackTraceQuery()
+			return nil
+		case 'U':
+			wd.showUpdateInput()
+			return nil
+		case 'u':
+			wd.showUpdates()
+			return nil
 		case 'i':
 			wd.showIOModal()
 			return nil
+		case 'p':
+			wd.navigateToParent()
+			return nil
+		case 'o':
+			wd.navigateToChild()
+			return nil
+		case 'a':
+			wd.showRuns()
+			return nil
+		case 'E':
+			wd.showExportHistory()
+			return nil
+		case 'F':
+			wd.showEventFilter()
+			return nil
+		case 'f':
+			wd.toggleFollow()
+			return nil
+		case '/':
+			wd.showSearch()
+			return nil
+		case 'n':
+			wd.nextMatch(true)
+			return nil
+		case 'N':
+			wd.nextMatch(false)
+			return nil
+		case 'x':
+			wd.app.ShowCrossReference(wd.app.CurrentNamespace(), wd.workflowID)
+			return nil
+		case '<', '>', '=':
+			wd.resizePanels(event.Rune())
+			return nil
+		case wrapKey:
+			wd.app.toggleDetailWrap()
+			wd.app.JigApp().Menu().SetHints(wd.Hints())
+			return nil
+		case 'z':
+			wd.app.toggleZen(wd.eventDetailPanel, wd.eventDetailView, wd.app.zenTextHints()...)
+			return nil
+		case 'h':
+			if wd.app.scrollDetail(wd.eventDetailView, -detailScrollStep) {
+				return nil
+			}
+		case 'l':
+			if wd.app.scrollDetail(wd.eventDetailView, detailScrollStep) {
+				return nil
+			}
 		}
 		return event
 	})
@@ -564,33 +1281,105 @@ func (wd *WorkflowDetail) Start() {
 // Stop is called when the view is deactivated.
 func (wd *WorkflowDetail) Stop() {
 	wd.eventTable.SetInputCapture(nil)
+	wd.onLoad = nil
+	wd.stopFollow()
+	wd.followAfterLoad = false
+	wd.stopHistoryLoad()
 }
 
 // Hints returns keybinding hints for this view.
 func (wd *WorkflowDetail) Hints() []KeyHint {
+	state := workflowHintState{
+		searching:  wd.searchText != "",
+		following:  wd.tailCancel != nil,
+		otherConns: wd.app.HasOtherConnections(),
+	}
+	if wd.workflow != nil {
+		state.status = wd.workflow.Status
+		state.hasParent = wd.workflow.ParentID != nil
+	}
+	_, _, state.childSelected = wd.selectedChildExecution()
+	if row := wd.eventTable.SelectedRow(); row >= 0 && row < len(wd.events) && wd.events[row].Type == "WorkflowTaskCompleted" {
+		state.resetPoint = true
+	}
+	return workflowDetailHints(wd.app, state)
+}
+
+// workflowHintState is what the workflow detail's bindings depend on.
+type workflowHintState struct {
+	status        string // Workflow status, empty until loaded
+	hasParent     bool
+	searching     bool
+	following     bool
+	otherConns    bool
+	childSelected bool // The selected event started a child workflow
+	resetPoint    bool // The selected event is a workflow task to reset to
+}
+
+// workflowDetailHints lists the workflow detail's bindings in a given state.
+func workflowDetailHints(app *App, state workflowHintState) []KeyHint {
 	hints := []KeyHint{
 		{Key: "i", Description: "Input/Output"},
 		{Key: "e", Description: "Event Graph"},
 		{Key: "d", Description: "Detail"},
+		{Key: "u", Description: "Updates"},
+		{Key: "a", Description: "All Runs"},
+		{Key: "E", Description: "Export"},
+		{Key: "F", Description: "Filter"},
+		{Key: "/", Description: "Search"},
 		{Key: "y", Description: "Yank"},
 		{Key: "r", Description: "Refresh"},
+		{Key: "</>", Description: "Resize"},
+		app.wrapHint(),
+		{Key: "z", Description: "Maximize Detail"},
 		{Key: "j/k", Description: "Navigate"},
 	}
 
+	if !app.detailWrap() {
+		hints = append(hints, KeyHint{Key: "h/l", Description: "Scroll Detail"})
+	}
+
+	if state.hasParent {
+		hints = append(hints, KeyHint{Key: "p", Description: "Parent"})
+	}
+	if state.searching {
+		hints = append(hints, KeyHint{Key: "n/N", Description: "Next/Prev Match"})
+	}
+	if state.otherConns {
+		hints = append(hints, KeyHint{Key: "x", Description: "Other Clusters"})
+	}
+	if state.childSelected {
+		hints = append(hints, KeyHint{Key: "o", Description: "Open Child"})
+	}
+	if state.resetPoint {
+		hints = append(hints, KeyHint{Key: "H", Description: "Reset Here"})
+	}
+
 	// Only show mutation hints if workflow is running
-	if wd.workflow != nil && wd.workflow.Status == "Running" {
+	if state.status == "Running" {
+		followHint := "Follow"
+		if state.following {
+			followHint = "Unfollow"
+		}
 		hints = append(hints,
+			KeyHint{Key: "f", Description: followHint},
 			KeyHint{Key: "c", Description: "Cancel"},
 			KeyHint{Key: "X", Description: "Terminate"},
 			KeyHint{Key: "s", Description: "Signal"},
 			KeyHint{Key: "Q", Description: "Query"},
+			KeyHint{Key: "t", Description: "Stack Trace"},
+			KeyHint{Key: "U", Description: "Update"},
 		)
 	}
 
 	// Reset is available for completed/failed workflows
-	if wd.workflow != nil && (wd.workflow.Status == "Completed" || wd.workflow.Status == "Failed" || wd.workflow.Status == "Terminated" || wd.workflow.Status == "Canceled") {
+	switch state.status {
+	case "Completed", "Failed", "Terminated", "Canceled":
 		hints = append(hints, KeyHint{Key: "R", Description: "Reset"})
 	}
+	if state.status != "" && state.status != "Running" {
+		hints = append(hints, KeyHint{Key: "S", Description: "Re-run"})
+	}
 
 	hints = append(hints,
 		KeyHint{Key: "D", Description: "Delete"},
@@ -601,6 +1390,26 @@ func (wd *WorkflowDetail) Hints() []KeyHint {
 	return hints
 }
 
+// helpHints lists the bindings offered for running and closed workflows, with
+// those that depend on the selected event or other connections.
+func (wd *WorkflowDetail) helpHints() []KeyHint {
+	return mergeHints(
+		workflowDetailHints(wd.app, workflowHintState{status: "Running", hasParent: true, searching: true}),
+		workflowDetailHints(wd.app, workflowHintState{status: "Completed"}),
+		[]KeyHint{
+			{Key: "x", Description: "Other Clusters"},
+			{Key: "o", Description: "Open Child"},
+			{Key: "H", Description: "Reset Here"},
+		})
+}
+
+// resizePanels moves the divider between the workflow and the events.
+func (wd *WorkflowDetail) resizePanels(key rune) {
+	ratio := wd.app.resizePanels(wd.Name(), workflowDetailRatio, panelResizeDelta(key))
+	wd.ResizeItem(wd.leftFlex, 0, ratio)
+	wd.ResizeItem(wd.eventsPanel, 0, 100-ratio)
+}
+
 // Focus sets focus to the event table.
 func (wd *WorkflowDetail) Focus(delegate func(p tview.Primitive)) {
 	delegate(wd.eventTable)
@@ -613,6 +1422,7 @@ func (wd *WorkflowDetail) Draw(screen tcell.Screen) {
 	wd.leftFlex.SetBackgroundColor(bg)
 	wd.workflowView.SetBackgroundColor(bg)
 	wd.eventDetailView.SetBackgroundColor(bg)
+	wd.eventDetailView.SetWrap(wd.app.detailWrap())
 	wd.Flex.Draw(screen)
 }
 
@@ -627,16 +1437,30 @@ func truncateStr(s string, maxLen int) string {
 // TODO: Implement full modal functionality using jig components
 
 func (wd *WorkflowDetail) showCancelConfirm() {
+	mode := wd.app.ConfirmMode(config.OpCancel)
+	if mode == config.ConfirmSkip {
+		wd.executeCancelWorkflow("Cancelled via tempo")
+		return
+	}
+
+	height := 12
+	if mode == config.ConfirmTyped {
+		height = 14
+	}
 	modal := components.NewModal(components.ModalConfig{
 		Title:    fmt.Sprintf("%s Cancel Workflow", theme.IconWarning),
 		Width:    60,
-		Height:   12,
+		Height:   height,
 		Backdrop: true,
 	})
 
 	form := components.NewForm()
 	form.AddTextField("reason", "Reason (optional)", "Cancelled via tempo")
+	addTypedConfirmField(form, mode, "Type workflow ID to confirm")
 	form.SetOnSubmit(func(values map[string]any) {
+		if !typedConfirmMatches(mode, values, wd.workflowID) {
+			return // Must match workflow ID
+		}
 		reason := values["reason"].(string)
 		wd.closeModal("cancel-confirm")
 		wd.executeCancelWorkflow(reason)
@@ -652,6 +1476,9 @@ func (wd *WorkflowDetail) showCancelConfirm() {
 	})
 	modal.SetOnSubmit(func() {
 		values := form.GetValues()
+		if !typedConfirmMatches(mode, values, wd.workflowID) {
+			return
+		}
 		reason := values["reason"].(string)
 		wd.closeModal("cancel-confirm")
 		wd.executeCancelWorkflow(reason)
@@ -660,8 +1487,7 @@ func (wd *WorkflowDetail) showCancelConfirm() {
 		wd.closeModal("cancel-confirm")
 	})
 
-	wd.app.JigApp().Pages().AddPage("cancel-confirm", modal, true, true)
-	wd.app.JigApp().SetFocus(form)
+	wd.app.Modals().Show("cancel-confirm", modal, form)
 }
 
 func (wd *WorkflowDetail) executeCancelWorkflow(reason string) {
@@ -671,7 +1497,7 @@ func (wd *WorkflowDetail) executeCancelWorkflow(reason string) {
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		err := provider.CancelWorkflow(
@@ -693,10 +1519,20 @@ func (wd *WorkflowDetail) executeCancelWorkflow(reason string) {
 }
 
 func (wd *WorkflowDetail) showTerminateConfirm() {
+	mode := wd.app.ConfirmMode(config.OpTerminate)
+	if mode == config.ConfirmSkip {
+		wd.executeTerminateWorkflow("Terminated via tempo")
+		return
+	}
+
+	height := 14
+	if mode == config.ConfirmTyped {
+		height = 16
+	}
 	modal := components.NewModal(components.ModalConfig{
 		Title:    fmt.Sprintf("%s Terminate Workflow", theme.IconError),
 		Width:    65,
-		Height:   14,
+		Height:   height,
 		Backdrop: true,
 	})
 
@@ -712,10 +1548,11 @@ func (wd *WorkflowDetail) showTerminateConfirm() {
 
 	form := components.NewForm()
 	form.AddTextField("reason", "Reason (required)", "Terminated via tempo")
+	addTypedConfirmField(form, mode, "Type workflow ID to confirm")
 	form.SetOnSubmit(func(values map[string]any) {
 		reason := values["reason"].(string)
-		if reason == "" {
-			return // Require a reason
+		if reason == "" || !typedConfirmMatches(mode, values, wd.workflowID) {
+			return // Require a reason and, when typed, the workflow ID
 		}
 		wd.closeModal("terminate-confirm")
 		wd.executeTerminateWorkflow(reason)
@@ -735,7 +1572,7 @@ func (wd *WorkflowDetail) showTerminateConfirm() {
 	modal.SetOnSubmit(func() {
 		values := form.GetValues()
 		reason := values["reason"].(string)
-		if reason == "" {
+		if reason == "" || !typedConfirmMatches(mode, values, wd.workflowID) {
 			return
 		}
 		wd.closeModal("terminate-confirm")
@@ -745,8 +1582,7 @@ func (wd *WorkflowDetail) showTerminateConfirm() {
 		wd.closeModal("terminate-confirm")
 	})
 
-	wd.app.JigApp().Pages().AddPage("terminate-confirm", modal, true, true)
-	wd.app.JigApp().SetFocus(form)
+	wd.app.Modals().Show("terminate-confirm", modal, form)
 }
 
 func (wd *WorkflowDetail) executeTerminateWorkflow(reason string) {
@@ -756,7 +1592,7 @@ func (wd *WorkflowDetail) executeTerminateWorkflow(reason string) {
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		err := provider.TerminateWorkflow(
@@ -778,65 +1614,22 @@ func (wd *WorkflowDetail) executeTerminateWorkflow(reason string) {
 }
 
 func (wd *WorkflowDetail) showDeleteConfirm() {
-	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Delete Workflow", theme.IconError),
-		Width:    70,
-		Height:   16,
-		Backdrop: true,
-	})
-
-	// Create content with warning message
-	contentFlex := tview.NewFlex().SetDirection(tview.FlexRow)
-	contentFlex.SetBackgroundColor(theme.Bg())
-
-	warningText := tview.NewTextView().
-		SetDynamicColors(true).
-		SetTextAlign(tview.AlignLeft)
-	warningText.SetBackgroundColor(theme.Bg())
-	warningText.SetText(fmt.Sprintf(`[%s]Warning: This will permanently delete the workflow and its history.
+	wd.app.confirmDestructive(destructiveConfirm{
+		Op:    config.OpDelete,
+		Page:  "delete-confirm",
+		Title: fmt.Sprintf("%s Delete Workflow", theme.IconError),
+		Width: 70,
+		Warning: fmt.Sprintf(`[%s]Warning: This will permanently delete the workflow and its history.
 This action cannot be undone.[-]
 
 [%s]Workflow ID:[-] [%s]%s[-]`,
-		theme.TagError(),
-		theme.TagFgDim(), theme.TagFg(), wd.workflowID))
-
-	form := components.NewForm()
-	form.AddTextField("confirm", "Type workflow ID to confirm", "")
-	form.SetOnSubmit(func(values map[string]any) {
-		confirm := values["confirm"].(string)
-		if confirm != wd.workflowID {
-			return // Must match workflow ID
-		}
-		wd.closeModal("delete-confirm")
-		wd.executeDeleteWorkflow()
-	})
-	form.SetOnCancel(func() {
-		wd.closeModal("delete-confirm")
-	})
-
-	contentFlex.AddItem(warningText, 5, 0, false)
-	contentFlex.AddItem(form, 0, 1, true)
-
-	modal.SetContent(contentFlex)
-	modal.SetHints([]components.KeyHint{
-		{Key: "Enter", Description: "Delete"},
-		{Key: "Esc", Description: "Cancel"},
-	})
-	modal.SetOnSubmit(func() {
-		values := form.GetValues()
-		confirm := values["confirm"].(string)
-		if confirm != wd.workflowID {
-			return
-		}
-		wd.closeModal("delete-confirm")
-		wd.executeDeleteWorkflow()
-	})
-	modal.SetOnCancel(func() {
-		wd.closeModal("delete-confirm")
-	})
-
-	wd.app.JigApp().Pages().AddPage("delete-confirm", modal, true, true)
-	wd.app.JigApp().SetFocus(form)
+			theme.TagError(),
+			theme.TagFgDim(), theme.TagFg(), wd.workflowID),
+		WarningHeight: 5,
+		Action:        "Delete",
+		TypedLabel:    "Type workflow ID to confirm",
+		Expected:      wd.workflowID,
+	}, wd.executeDeleteWorkflow)
 }
 
 func (wd *WorkflowDetail) executeDeleteWorkflow() {
@@ -846,7 +1639,7 @@ func (wd *WorkflowDetail) executeDeleteWorkflow() {
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		err := provider.DeleteWorkflow(
@@ -862,57 +1655,133 @@ func (wd *WorkflowDetail) executeDeleteWorkflow() {
 				return
 			}
 			// Navigate back to workflow list after deletion
-			wd.app.JigApp().Pages().Pop()
+			wd.app.popView()
 		})
 	}()
 }
 
 func (wd *WorkflowDetail) showSignalInput() {
+	presets := wd.signalPresets()
+	height := 16 + jsonPreviewHeight
+	if len(presets) > 0 {
+		height += 3
+	}
+
 	modal := components.NewModal(components.ModalConfig{
 		Title:    fmt.Sprintf("%s Signal Workflow", theme.IconSignal),
 		Width:    70,
-		Height:   16,
+		Height:   height,
 		Backdrop: true,
 	})
 
 	form := components.NewForm()
+	var input *jsonInput
+	if len(presets) > 0 {
+		options := make([]components.SelectOption, len(presets))
+		for i, p := range presets {
+			options[i] = components.SelectOption{Label: p.label, Value: strconv.Itoa(i)}
+		}
+		templates := components.NewSelect("template").
+			SetLabel("Template").
+			SetPlaceholder("Choose a template or recent signal").
+			SetOptionsWithValues(options)
+		templates.SetOnChange(func(index int, _ components.SelectOption) {
+			if index < 0 || index >= len(presets) {
+				return
+			}
+			_ = form.SetValues(map[string]any{
+				"signalName": presets[index].signal,
+				"input":      presets[index].input,
+			})
+			input.render()
+		})
+		form.AddField(templates)
+	}
 	form.AddTextField("signalName", "Signal Name", "")
 	form.AddTextField("input", "Input (JSON, optional)", "")
-	form.SetOnSubmit(func(values map[string]any) {
+
+	submit := func(force bool) {
+		values := form.GetValues()
 		signalName := values["signalName"].(string)
 		if signalName == "" {
 			return // Require signal name
 		}
-		input := values["input"].(string)
+		if !input.valid(force) {
+			return
+		}
 		wd.closeModal("signal-input")
-		wd.executeSignalWorkflow(signalName, input)
+		wd.executeSignalWorkflow(signalName, values["input"].(string))
+	}
+	input = newJSONInput(wd.app, form, "input", submit)
+	form.SetOnSubmit(func(map[string]any) {
+		submit(false)
 	})
 	form.SetOnCancel(func() {
 		wd.closeModal("signal-input")
 	})
 
-	modal.SetContent(form)
+	modal.SetContent(input.layout)
 	modal.SetHints([]components.KeyHint{
 		{Key: "Tab", Description: "Next field"},
 		{Key: "Enter", Description: "Send signal"},
+		{Key: "Ctrl+F", Description: "Send invalid JSON"},
 		{Key: "Esc", Description: "Cancel"},
 	})
 	modal.SetOnSubmit(func() {
-		values := form.GetValues()
-		signalName := values["signalName"].(string)
-		if signalName == "" {
-			return
-		}
-		input := values["input"].(string)
-		wd.closeModal("signal-input")
-		wd.executeSignalWorkflow(signalName, input)
+		submit(false)
 	})
 	modal.SetOnCancel(func() {
 		wd.closeModal("signal-input")
 	})
 
-	wd.app.JigApp().Pages().AddPage("signal-input", modal, true, true)
-	wd.app.JigApp().SetFocus(form)
+	wd.app.Modals().Show("signal-input", modal, form)
+}
+
+// signalPreset is a signal name and payload that can prefill the Signal modal.
+type signalPreset struct {
+	label  string
+	signal string
+	input  string
+}
+
+// signalPresets returns configured templates followed by recently sent signals
+// for the current workflow type.
+func (wd *WorkflowDetail) signalPresets() []signalPreset {
+	cfg := wd.app.Config()
+	if cfg == nil || wd.workflow == nil {
+		return nil
+	}
+
+	var presets []signalPreset
+	for _, t := range cfg.GetSignalTemplates(wd.workflow.Type) {
+		presets = append(presets, signalPreset{
+			label:  fmt.Sprintf("%s (%s)", t.Name, t.Signal),
+			signal: t.Signal,
+			input:  t.Input,
+		})
+	}
+	if history := wd.app.SignalHistory(); history != nil {
+		for _, r := range history.Get(wd.workflow.Type) {
+			label := "Recent: " + r.Signal
+			if r.Input != "" {
+				label += " " + truncateStr(r.Input, 30)
+			}
+			presets = append(presets, signalPreset{label: label, signal: r.Signal, input: r.Input})
+		}
+	}
+	return presets
+}
+
+// rememberSignal records a sent signal so it is offered the next time the Signal modal opens.
+func (wd *WorkflowDetail) rememberSignal(signalName, input string) {
+	history := wd.app.SignalHistory()
+	if history == nil || wd.workflow == nil {
+		return
+	}
+	history.Add(wd.workflow.Type, signalName, input)
+	if err := history.Save(); err != nil {
+		wd.app.ShowToastError(fmt.Sprintf("Failed to save signal history: %s", err))
+	}
 }
 
 func (wd *WorkflowDetail) executeSignalWorkflow(signalName, input string) {
@@ -922,7 +1791,7 @@ func (wd *WorkflowDetail) executeSignalWorkflow(signalName, input string) {
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		var inputBytes []byte
@@ -944,6 +1813,7 @@ func (wd *WorkflowDetail) executeSignalWorkflow(signalName, input string) {
 				wd.showError(err)
 				return
 			}
+			wd.rememberSignal(signalName, input)
 			wd.loadData() // Refresh to show signal event
 		})
 	}()
@@ -968,10 +1838,10 @@ func (wd *WorkflowDetail) showResetSelector() {
 	loadingText.SetBackgroundColor(theme.Bg())
 	loadingText.SetText(fmt.Sprintf("[%s]Fetching reset points...[-]", theme.TagFgDim()))
 	loadingModal.SetContent(loadingText)
-	wd.app.JigApp().Pages().AddPage("reset-loading", loadingModal, true, true)
+	wd.app.Modals().Show("reset-loading", loadingModal, nil)
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		resetPoints, err := provider.GetResetPoints(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID)
@@ -995,11 +1865,65 @@ func (wd *WorkflowDetail) showResetSelector() {
 	}()
 }
 
+// resetToSelectedEvent offers a reset to the workflow task selected in the events table.
+func (wd *WorkflowDetail) resetToSelectedEvent() {
+	row := wd.eventTable.SelectedRow()
+	if row < 0 || row >= len(wd.events) {
+		return
+	}
+	ev := wd.events[row]
+	if problem := wd.validateResetEvent(ev); problem != "" {
+		wd.showResetError(problem)
+		return
+	}
+	wd.showResetConfirm(temporal.ResetPoint{
+		EventID:     ev.ID,
+		EventType:   ev.Type,
+		Timestamp:   ev.Time,
+		Description: wd.describeResetEvent(ev),
+		Reason:      "Reset to selected workflow task",
+	})
+}
+
+// validateResetEvent returns why ev cannot be used as a reset point, or "" if it can.
+// The server accepts the ID of a completed workflow task from this run's history.
+func (wd *WorkflowDetail) validateResetEvent(ev temporal.EnhancedHistoryEvent) string {
+	if ev.Type != "WorkflowTaskCompleted" {
+		return fmt.Sprintf("Event %d is %s. Select a WorkflowTaskCompleted event.", ev.ID, ev.Type)
+	}
+	for _, e := range wd.allEvents {
+		if e.ID == ev.ID {
+			return ""
+		}
+	}
+	return fmt.Sprintf("Event %d is not part of this run's history.", ev.ID)
+}
+
+// describeResetEvent summarizes the work that precedes a workflow task, which is
+// what the new run keeps after the reset.
+func (wd *WorkflowDetail) describeResetEvent(ev temporal.EnhancedHistoryEvent) string {
+	for i := len(wd.allEvents) - 1; i >= 0; i-- {
+		prev := wd.allEvents[i]
+		if prev.ID >= ev.ID || temporal.ClassifyEvent(prev.Type) == temporal.GroupWorkflowTask {
+			continue
+		}
+		if name := getEventNameDetail(&prev); name != "" {
+			return fmt.Sprintf("After %s (%s) at event %d", prev.Type, name, prev.ID)
+		}
+		return fmt.Sprintf("After %s at event %d", prev.Type, prev.ID)
+	}
+	return fmt.Sprintf("Workflow task completed at event %d", ev.ID)
+}
+
 func (wd *WorkflowDetail) showQuickResetModal(failurePoint temporal.ResetPoint, allPoints []temporal.ResetPoint) {
+	height := 26
+	if wd.app.ConfirmMode(config.OpReset) == config.ConfirmTyped {
+		height = 28
+	}
 	modal := components.NewModal(components.ModalConfig{
 		Title:    fmt.Sprintf("%s Quick Reset", theme.IconWarning),
 		Width:    70,
-		Height:   14,
+		Height:   height,
 		Backdrop: true,
 	})
 
@@ -1020,15 +1944,7 @@ func (wd *WorkflowDetail) showQuickResetModal(failurePoint temporal.ResetPoint,
 		theme.TagFgDim(), theme.TagFg(), failurePoint.EventType,
 		theme.TagFgDim(), theme.TagFg(), failurePoint.Description))
 
-	form := components.NewForm()
-	form.AddTextField("reason", "Reason", "Reset via tempo")
-	form.SetOnSubmit(func(values map[string]any) {
-		wd.closeModal("quick-reset")
-		wd.executeResetWorkflow(failurePoint.EventID, values["reason"].(string))
-	})
-	form.SetOnCancel(func() {
-		wd.closeModal("quick-reset")
-	})
+	form, _ := wd.newResetForm("quick-reset", failurePoint.EventID)
 
 	contentFlex.AddItem(infoText, 6, 0, false)
 	contentFlex.AddItem(form, 0, 1, true)
@@ -1043,8 +1959,7 @@ func (wd *WorkflowDetail) showQuickResetModal(failurePoint temporal.ResetPoint,
 		wd.closeModal("quick-reset")
 	})
 
-	wd.app.JigApp().Pages().AddPage("quick-reset", modal, true, true)
-	wd.app.JigApp().SetFocus(form)
+	wd.app.Modals().Show("quick-reset", modal, form)
 }
 
 func (wd *WorkflowDetail) showResetPicker(resetPoints []temporal.ResetPoint) {
@@ -1065,7 +1980,7 @@ func (wd *WorkflowDetail) showResetPicker(resetPoints []temporal.ResetPoint) {
 		table.AddRow(
 			fmt.Sprintf("%d", rp.EventID),
 			truncateStr(rp.EventType, 25),
-			rp.Timestamp.Format("15:04:05"),
+			inTimezone(rp.Timestamp).Format("15:04:05"),
 			truncateStr(rp.Description, 35),
 		)
 	}
@@ -1101,15 +2016,18 @@ func (wd *WorkflowDetail) showResetPicker(resetPoints []temporal.ResetPoint) {
 		wd.closeModal("reset-picker")
 	})
 
-	wd.app.JigApp().Pages().AddPage("reset-picker", modal, true, true)
-	wd.app.JigApp().SetFocus(table)
+	wd.app.Modals().Show("reset-picker", modal, table)
 }
 
 func (wd *WorkflowDetail) showResetConfirm(resetPoint temporal.ResetPoint) {
+	height := 28
+	if wd.app.ConfirmMode(config.OpReset) == config.ConfirmTyped {
+		height = 30
+	}
 	modal := components.NewModal(components.ModalConfig{
 		Title:    fmt.Sprintf("%s Confirm Reset", theme.IconWarning),
 		Width:    70,
-		Height:   16,
+		Height:   height,
 		Backdrop: true,
 	})
 
@@ -1129,18 +2047,10 @@ func (wd *WorkflowDetail) showResetConfirm(resetPoint temporal.ResetPoint) {
 		theme.TagAccent(),
 		theme.TagFgDim(), theme.TagFg(), resetPoint.EventID,
 		theme.TagFgDim(), theme.TagFg(), resetPoint.EventType,
-		theme.TagFgDim(), theme.TagFg(), resetPoint.Timestamp.Format("2006-01-02 15:04:05"),
+		theme.TagFgDim(), theme.TagFg(), formatTimestamp(resetPoint.Timestamp),
 		theme.TagFgDim(), theme.TagFg(), resetPoint.Description))
 
-	form := components.NewForm()
-	form.AddTextField("reason", "Reason", "Reset via tempo")
-	form.SetOnSubmit(func(values map[string]any) {
-		wd.closeModal("reset-confirm")
-		wd.executeResetWorkflow(resetPoint.EventID, values["reason"].(string))
-	})
-	form.SetOnCancel(func() {
-		wd.closeModal("reset-confirm")
-	})
+	form, submit := wd.newResetForm("reset-confirm", resetPoint.EventID)
 
 	contentFlex.AddItem(infoText, 7, 0, false)
 	contentFlex.AddItem(form, 0, 1, true)
@@ -1151,26 +2061,93 @@ func (wd *WorkflowDetail) showResetConfirm(resetPoint temporal.ResetPoint) {
 		{Key: "Esc", Description: "Cancel"},
 	})
 	modal.SetOnSubmit(func() {
-		values := form.GetValues()
-		wd.closeModal("reset-confirm")
-		wd.executeResetWorkflow(resetPoint.EventID, values["reason"].(string))
+		submit(form.GetValues())
 	})
 	modal.SetOnCancel(func() {
 		wd.closeModal("reset-confirm")
 	})
 
-	wd.app.JigApp().Pages().AddPage("reset-confirm", modal, true, true)
-	wd.app.JigApp().SetFocus(form)
+	wd.app.Modals().Show("reset-confirm", modal, form)
+}
+
+// newResetForm builds the form of a reset to eventID shown in the modal page,
+// asking for the workflow ID to be typed when the profile's confirmation
+// policy requires it. Reset always shows its form to pick options; skip mode
+// only drops the typed check. submit runs the reset from the form's values,
+// unless the typed ID doesn't match.
+func (wd *WorkflowDetail) newResetForm(page string, eventID int64) (*components.Form, func(values map[string]any)) {
+	mode := wd.app.ConfirmMode(config.OpReset)
+	form := components.NewForm()
+	form.AddTextField("reason", "Reason", "Reset via tempo")
+	addResetOptionFields(form)
+	addTypedConfirmField(form, mode, "Type workflow ID to confirm")
+
+	submit := func(values map[string]any) {
+		if !typedConfirmMatches(mode, values, wd.workflowID) {
+			return // Must match workflow ID
+		}
+		wd.closeModal(page)
+		wd.executeResetWorkflow(resetOptionsFromValues(eventID, values))
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		wd.closeModal(page)
+	})
+	return form, submit
+}
+
+// resetReapplyLabels are the reapply choices shown in reset forms, in the same
+// order as resetReapplyTypes.
+var (
+	resetReapplyLabels = []string{"Signals and updates", "Signals only", "None"}
+	resetReapplyTypes  = []string{temporal.ResetReapplyAll, temporal.ResetReapplySignal, temporal.ResetReapplyNone}
+)
+
+// addResetOptionFields adds the reapply and exclusion fields to a reset form.
+func addResetOptionFields(form *components.Form) {
+	form.AddSelect("reapply", "Reapply", resetReapplyLabels)
+	form.AddSelect("excludeSignals", "Exclude Signals", []string{"No", "Yes"})
+	form.AddSelect("excludeUpdates", "Exclude Updates", []string{"No", "Yes"})
+	form.AddSelect("excludeNexus", "Exclude Nexus", []string{"No", "Yes"})
+	form.AddSelect("excludeCancels", "Exclude Cancel Requests", []string{"No", "Yes"})
 }
 
-func (wd *WorkflowDetail) executeResetWorkflow(eventID int64, reason string) {
+// resetOptionsFromValues builds reset options from a form set up by addResetOptionFields.
+func resetOptionsFromValues(eventID int64, values map[string]any) temporal.ResetOptions {
+	opts := temporal.ResetOptions{
+		EventID: eventID,
+		Reason:  values["reason"].(string),
+	}
+	for i, label := range resetReapplyLabels {
+		if values["reapply"] == label {
+			opts.ReapplyType = resetReapplyTypes[i]
+		}
+	}
+	excludes := []struct {
+		field string
+		kind  string
+	}{
+		{"excludeSignals", temporal.ResetExcludeSignal},
+		{"excludeUpdates", temporal.ResetExcludeUpdate},
+		{"excludeNexus", temporal.ResetExcludeNexus},
+		{"excludeCancels", temporal.ResetExcludeCancelRequest},
+	}
+	for _, e := range excludes {
+		if values[e.field] == "Yes" {
+			opts.ExcludeTypes = append(opts.ExcludeTypes, e.kind)
+		}
+	}
+	return opts
+}
+
+func (wd *WorkflowDetail) executeResetWorkflow(opts temporal.ResetOptions) {
 	provider := wd.app.Provider()
 	if provider == nil {
 		return
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		newRunID, err := provider.ResetWorkflow(
@@ -1178,8 +2155,7 @@ func (wd *WorkflowDetail) executeResetWorkflow(eventID int64, reason string) {
 			wd.app.CurrentNamespace(),
 			wd.workflowID,
 			wd.runID,
-			eventID,
-			reason,
+			opts,
 		)
 
 		wd.app.JigApp().QueueUpdateDraw(func() {
@@ -1219,22 +2195,18 @@ func (wd *WorkflowDetail) showResetError(message string) {
 		wd.closeModal("reset-error")
 	})
 
-	wd.app.JigApp().Pages().AddPage("reset-error", modal, true, true)
+	wd.app.Modals().Show("reset-error", modal, nil)
 }
 
 func (wd *WorkflowDetail) closeModal(name string) {
-	wd.app.JigApp().Pages().RemovePage(name)
-	// Restore focus to current view
-	if current := wd.app.JigApp().Pages().Current(); current != nil {
-		wd.app.JigApp().SetFocus(current)
-	}
+	wd.app.Modals().Close(name)
 }
 
 func (wd *WorkflowDetail) showQueryInput() {
 	modal := components.NewModal(components.ModalConfig{
 		Title:    fmt.Sprintf("%s Query Workflow", theme.IconInfo),
 		Width:    70,
-		Height:   18,
+		Height:   18 + jsonPreviewHeight,
 		Backdrop: true,
 	})
 
@@ -1243,7 +2215,9 @@ func (wd *WorkflowDetail) showQueryInput() {
 	form.AddTextField("customQuery", "Custom Query Name", "")
 	form.AddTextField("args", "Arguments (JSON, optional)", "")
 
-	form.SetOnSubmit(func(values map[string]any) {
+	var args *jsonInput
+	submit := func(force bool) {
+		values := form.GetValues()
 		queryType := values["queryType"].(string)
 		if queryType == "custom" {
 			queryType = values["customQuery"].(string)
@@ -1251,39 +2225,35 @@ func (wd *WorkflowDetail) showQueryInput() {
 		if queryType == "" {
 			return
 		}
-		args := values["args"].(string)
+		if !args.valid(force) {
+			return
+		}
 		wd.closeModal("query-input")
-		wd.executeQuery(queryType, args)
+		wd.executeQuery(queryType, values["args"].(string))
+	}
+	args = newJSONInput(wd.app, form, "args", submit)
+	form.SetOnSubmit(func(map[string]any) {
+		submit(false)
 	})
 	form.SetOnCancel(func() {
 		wd.closeModal("query-input")
 	})
 
-	modal.SetContent(form)
+	modal.SetContent(args.layout)
 	modal.SetHints([]components.KeyHint{
 		{Key: "Tab", Description: "Next field"},
-		{Key: "Enter", Description: "Execute query"},
-		{Key: "Esc", Description: "Cancel"},
-	})
-	modal.SetOnSubmit(func() {
-		values := form.GetValues()
-		queryType := values["queryType"].(string)
-		if queryType == "custom" {
-			queryType = values["customQuery"].(string)
-		}
-		if queryType == "" {
-			return
-		}
-		args := values["args"].(string)
-		wd.closeModal("query-input")
-		wd.executeQuery(queryType, args)
+		{Key: "Enter", Description: "Execute query"},
+		{Key: "Ctrl+F", Description: "Send invalid JSON"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(false)
 	})
 	modal.SetOnCancel(func() {
 		wd.closeModal("query-input")
 	})
 
-	wd.app.JigApp().Pages().AddPage("query-input", modal, true, true)
-	wd.app.JigApp().SetFocus(form)
+	wd.app.Modals().Show("query-input", modal, form)
 }
 
 func (wd *WorkflowDetail) executeQuery(queryType, args string) {
@@ -1293,7 +2263,7 @@ func (wd *WorkflowDetail) executeQuery(queryType, args string) {
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		var argsBytes []byte
@@ -1321,8 +2291,14 @@ func (wd *WorkflowDetail) executeQuery(queryType, args string) {
 }
 
 func (wd *WorkflowDetail) showQueryResult(queryType, result string) {
+	wd.showResultModal(fmt.Sprintf("%s Query Result: %s", theme.IconInfo, queryType), "query-result", result)
+}
+
+// showResultModal displays a result in a modal page, as a foldable tree when
+// it's JSON.
+func (wd *WorkflowDetail) showResultModal(title, pageName, result string) {
 	modal := components.NewModal(components.ModalConfig{
-		Title:     fmt.Sprintf("%s Query Result: %s", theme.IconInfo, queryType),
+		Title:     title,
 		Width:     0,
 		Height:    0,
 		MinWidth:  80,
@@ -1330,70 +2306,30 @@ func (wd *WorkflowDetail) showQueryResult(queryType, result string) {
 		Backdrop:  true,
 	})
 
-	// Create scrollable text view for result
-	resultView := tview.NewTextView().
-		SetDynamicColors(true).
-		SetScrollable(true).
-		SetWrap(true)
-	resultView.SetBackgroundColor(theme.Bg())
-	resultView.SetTextColor(theme.Fg())
-
-	// Format the result (attempt to pretty-print JSON)
-	formatted := formatJSONPretty(result)
-	highlighted := highlightFormattedJSONWorkflow(formatted)
-	resultView.SetText(highlighted)
+	// Show the result as a foldable tree when it's JSON
+	resultTree := NewJSONTree(func(p tview.Primitive) { wd.app.JigApp().SetFocus(p) }).SetText(result)
 
 	panel := components.NewPanel().SetTitle("Result")
-	panel.SetContent(resultView)
+	panel.SetContent(resultTree)
 
-	resultView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+	resultTree.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
 		case tcell.KeyEscape:
-			wd.closeModal("query-result")
-			return nil
-		case tcell.KeyDown:
-			row, col := resultView.GetScrollOffset()
-			resultView.ScrollTo(row+1, col)
-			return nil
-		case tcell.KeyUp:
-			row, col := resultView.GetScrollOffset()
-			if row > 0 {
-				resultView.ScrollTo(row-1, col)
-			}
+			wd.closeModal(pageName)
 			return nil
 		case tcell.KeyRune:
 			switch event.Rune() {
-			case 'j':
-				row, col := resultView.GetScrollOffset()
-				resultView.ScrollTo(row+1, col)
+			case 'y':
+				value, _ := resultTree.Selected()
+				wd.app.CopyToClipboard(value, "Result")
 				return nil
-			case 'k':
-				row, col := resultView.GetScrollOffset()
-				if row > 0 {
-					resultView.ScrollTo(row-1, col)
+			case 'p':
+				if _, path := resultTree.Selected(); path != "" {
+					wd.app.CopyToClipboard(path, "Path")
 				}
 				return nil
-			case 'g':
-				resultView.ScrollTo(0, 0)
-				return nil
-			case 'G':
-				resultView.ScrollToEnd()
-				return nil
-			case 'y':
-				copyToClipboard(result)
-				// Show "Copied!" feedback
-				panel.SetTitle(fmt.Sprintf("%s Copied!", theme.IconCompleted))
-				panel.SetTitleColor(theme.StatusColor("Completed"))
-				go func() {
-					time.Sleep(1 * time.Second)
-					wd.app.JigApp().QueueUpdateDraw(func() {
-						panel.SetTitle("Result")
-						panel.SetTitleColor(0)
-					})
-				}()
-				return nil
 			case 'q':
-				wd.closeModal("query-result")
+				wd.closeModal(pageName)
 				return nil
 			}
 		}
@@ -1402,21 +2338,38 @@ func (wd *WorkflowDetail) showQueryResult(queryType, result string) {
 
 	modal.SetContent(panel)
 	modal.SetHints([]components.KeyHint{
-		{Key: "j/k", Description: "Scroll"},
+		{Key: "j/k", Description: "Move"},
+		{Key: "space", Description: "Fold"},
+		{Key: "+/-", Description: "Expand/Collapse all"},
+		{Key: "f", Description: "Filter path"},
 		{Key: "y", Description: "Copy"},
+		{Key: "p", Description: "Copy path"},
 		{Key: "Esc", Description: "Close"},
 	})
 	modal.SetOnCancel(func() {
-		wd.closeModal("query-result")
+		if resultTree.IsFiltering() {
+			resultTree.CloseFilter()
+			return
+		}
+		wd.closeModal(pageName)
 	})
 
-	wd.app.JigApp().Pages().AddPage("query-result", modal, true, true)
-	wd.app.JigApp().SetFocus(resultView)
+	wd.app.Modals().Show(pageName, modal, resultTree)
 }
 
 func (wd *WorkflowDetail) showQueryError(queryType, errMsg string) {
+	wd.showErrorResultModal(
+		fmt.Sprintf("%s Query Failed: %s", theme.IconError, queryType),
+		"query-error",
+		"Error executing query:",
+		errMsg,
+	)
+}
+
+// showErrorResultModal displays an operation failure in a modal page.
+func (wd *WorkflowDetail) showErrorResultModal(title, pageName, heading, errMsg string) {
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Query Failed: %s", theme.IconError, queryType),
+		Title:    title,
 		Width:    60,
 		Height:   10,
 		Backdrop: true,
@@ -1426,21 +2379,149 @@ func (wd *WorkflowDetail) showQueryError(queryType, errMsg string) {
 		SetDynamicColors(true).
 		SetTextAlign(tview.AlignLeft)
 	errorText.SetBackgroundColor(theme.Bg())
-	errorText.SetText(fmt.Sprintf("[%s]Error executing query:[-]\n\n[%s]%s[-]",
-		theme.TagError(), theme.TagFg(), errMsg))
+	errorText.SetText(fmt.Sprintf("[%s]%s[-]\n\n[%s]%s[-]",
+		theme.TagError(), heading, theme.TagFg(), errMsg))
 
 	modal.SetContent(errorText)
 	modal.SetHints([]components.KeyHint{
 		{Key: "Enter/Esc", Description: "Close"},
 	})
 	modal.SetOnSubmit(func() {
-		wd.closeModal("query-error")
+		wd.closeModal(pageName)
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal(pageName)
+	})
+
+	wd.app.Modals().Show(pageName, modal, nil)
+}
+
+// executeStackTraceQuery runs the built-in __stack_trace query and shows the dump.
+func (wd *WorkflowDetail) executeStackTraceQuery() {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		result, err := provider.QueryWorkflow(
+			ctx,
+			wd.app.CurrentNamespace(),
+			wd.workflowID,
+			wd.runID,
+			"__stack_trace",
+			nil,
+		)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.showQueryError("__stack_trace", err.Error())
+				return
+			}
+			if result.Error != "" {
+				wd.showQueryError("__stack_trace", result.Error)
+				return
+			}
+			wd.showStackTrace(unquoteStackTrace(result.Result))
+		})
+	}()
+}
+
+// unquoteStackTrace decodes the JSON string returned by the __stack_trace query.
+// Falls back to the raw result if it is not a JSON string.
+func unquoteStackTrace(result string) string {
+	var dump string
+	if err := json.Unmarshal([]byte(result), &dump); err != nil {
+		return result
+	}
+	return dump
+}
+
+func (wd *WorkflowDetail) showStackTrace(dump string) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:     fmt.Sprintf("%s Stack Trace", theme.IconInfo),
+		Width:     0,
+		Height:    0,
+		MinWidth:  90,
+		MinHeight: 25,
+		Backdrop:  true,
+	})
+
+	traceView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWrap(false)
+	traceView.SetBackgroundColor(theme.Bg())
+	traceView.SetTextColor(theme.Fg())
+	traceView.SetText(highlightCode(stackTraceLexer, strings.TrimRight(dump, "\n")))
+
+	panel := components.NewPanel().SetTitle(wd.workflowID)
+	panel.SetContent(traceView)
+
+	traceView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			wd.closeModal("stack-trace-modal")
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'j':
+				row, col := traceView.GetScrollOffset()
+				traceView.ScrollTo(row+1, col)
+				return nil
+			case 'k':
+				row, col := traceView.GetScrollOffset()
+				if row > 0 {
+					traceView.ScrollTo(row-1, col)
+				}
+				return nil
+			case 'h':
+				row, col := traceView.GetScrollOffset()
+				if col > 0 {
+					traceView.ScrollTo(row, col-4)
+				}
+				return nil
+			case 'l':
+				row, col := traceView.GetScrollOffset()
+				traceView.ScrollTo(row, col+4)
+				return nil
+			case 'g':
+				traceView.ScrollTo(0, 0)
+				return nil
+			case 'G':
+				traceView.ScrollToEnd()
+				return nil
+			case 'r':
+				wd.closeModal("stack-trace-modal")
+				wd.executeStackTraceQuery()
+				return nil
+			case 'y':
+				wd.app.CopyToClipboard(dump, "Stack trace")
+				return nil
+			case 'q':
+				wd.closeModal("stack-trace-modal")
+				return nil
+			}
+		}
+		return event
+	})
+
+	modal.SetContent(panel)
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Scroll"},
+		{Key: "h/l", Description: "Pan"},
+		{Key: "r", Description: "Refresh"},
+		{Key: "y", Description: "Copy"},
+		{Key: "Esc", Description: "Close"},
 	})
 	modal.SetOnCancel(func() {
-		wd.closeModal("query-error")
+		wd.closeModal("stack-trace-modal")
 	})
 
-	wd.app.JigApp().Pages().AddPage("query-error", modal, true, true)
+	wd.app.Modals().Show("stack-trace-modal", modal, traceView)
 }
 
 // getSelectedEventDetails returns the details for the currently selected event.
@@ -1459,34 +2540,7 @@ func (wd *WorkflowDetail) yankEventData() {
 	if data == "" {
 		return
 	}
-
-	if err := copyToClipboard(data); err != nil {
-		wd.eventDetailView.SetText(fmt.Sprintf("[%s]%s Failed to copy: %s[-]",
-			theme.TagError(), theme.IconError, err.Error()))
-		return
-	}
-
-	// Show success feedback
-	wd.eventDetailView.SetText(fmt.Sprintf(`
-[%s::b]Copied to clipboard[-:-:-]
-
-[%s]%s[-]
-
-[%s]%s[-]`,
-		theme.TagAccent(),
-		theme.TagAccent(), eventType,
-		theme.StatusColorTag("Completed"), "Event data copied!"))
-
-	// Restore detail after a brief delay
-	go func() {
-		time.Sleep(1500 * time.Millisecond)
-		wd.app.JigApp().QueueUpdateDraw(func() {
-			row := wd.eventTable.SelectedRow()
-			if row >= 0 && row < len(wd.events) {
-				wd.updateEventDetail(wd.events[row])
-			}
-		})
-	}()
+	wd.app.CopyToClipboard(data, eventType+" data")
 }
 
 // showEventDetailModal shows a full-screen modal with the event details.
@@ -1510,8 +2564,9 @@ func (wd *WorkflowDetail) showEventDetailModal() {
 	// Create scrollable text view for details
 	detailView := tview.NewTextView().
 		SetDynamicColors(true).
+		SetRegions(true).
 		SetScrollable(true).
-		SetWrap(true)
+		SetWrap(wd.app.detailWrap())
 	detailView.SetBackgroundColor(theme.Bg())
 	detailView.SetTextColor(theme.Fg())
 
@@ -1519,35 +2574,177 @@ func (wd *WorkflowDetail) showEventDetailModal() {
 	icon := eventIcon(ev.Type)
 	colorTag := eventColorTag(ev.Type)
 
-	headerText := fmt.Sprintf(`[%s::b]Event ID[-:-:-]     [%s]%d[-]
+	header := func(section string) string {
+		return fmt.Sprintf(`[%s::b]Event ID[-:-:-]     [%s]%d[-]
 [%s::b]Type[-:-:-]         [%s]%s %s[-]
 [%s::b]Time[-:-:-]         [%s]%s[-]
 
-[%s::b]Details[-:-:-]`,
-		theme.TagFgDim(), theme.TagFg(), ev.ID,
-		theme.TagFgDim(), colorTag, icon, ev.Type,
-		theme.TagFgDim(), theme.TagFg(), ev.Time.Format("2006-01-02 15:04:05.000"),
-		theme.TagAccent(),
-	)
+[%s::b]%s[-:-:-]`,
+			theme.TagFgDim(), theme.TagFg(), ev.ID,
+			theme.TagFgDim(), colorTag, icon, ev.Type,
+			theme.TagFgDim(), theme.TagFg(), inTimezone(ev.Time).Format("2006-01-02 15:04:05.000"),
+			theme.TagAccent(), section,
+		)
+	}
 
-	// Format the details with syntax highlighting
-	formattedDetails := formatEventDetails(ev.Details)
-	fullText := headerText + "\n" + formattedDetails
+	// Format the details and payloads with syntax highlighting
+	buildSummary := func(ev temporal.EnhancedHistoryEvent) string {
+		text := header("Details") + "\n" + formatEventDetails(ev.Details)
+		if len(ev.Payloads) > 0 {
+			text += "\n\n" + formatPayloadSection(ev.Payloads)
+		}
+		return text
+	}
+	summaryText := buildSummary(ev)
+	fullText := summaryText
 
-	detailView.SetText(fullText)
+	// Raw attributes are fetched on first toggle
+	rawMode := false
+	rawJSON := ""
 
 	// Create panel
 	panel := components.NewPanel().SetTitle(fmt.Sprintf("%s Details", theme.IconInfo))
 	panel.SetContent(detailView)
 
-	modal.SetContent(panel)
-	modal.SetHints([]components.KeyHint{
-		{Key: "j/k", Description: "Scroll"},
-		{Key: "g/G", Description: "Top/Bottom"},
-		{Key: "y", Description: "Copy"},
-		{Key: "esc", Description: "Close"},
+	// Search within the details, starting from the view's active search
+	searchText := wd.searchText
+	var regions []string
+	current := 0
+	applySearch := func() {
+		var text string
+		text, regions = highlightSearchMatches(fullText, searchText)
+		current = 0
+		detailView.SetText(text)
+		if len(regions) > 0 {
+			detailView.Highlight(regions[0])
+			detailView.ScrollToHighlight()
+			panel.SetTitle(fmt.Sprintf("%s Details [%s]/%s (1/%d)[-]", theme.IconInfo, theme.TagAccent(), searchText, len(regions)))
+		} else {
+			detailView.Highlight()
+			panel.SetTitle(fmt.Sprintf("%s Details", theme.IconInfo))
+		}
+	}
+	cycleMatch := func(delta int) {
+		if len(regions) == 0 {
+			return
+		}
+		current = (current + delta + len(regions)) % len(regions)
+		detailView.Highlight(regions[current])
+		detailView.ScrollToHighlight()
+		panel.SetTitle(fmt.Sprintf("%s Details [%s]/%s (%d/%d)[-]", theme.IconInfo, theme.TagAccent(), searchText, current+1, len(regions)))
+	}
+	applySearch()
+
+	// Large payloads were truncated when the history loaded; fetch the full event
+	if provider := wd.app.Provider(); ev.Truncated && provider != nil {
+		go func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			detail, err := provider.GetHistoryEvent(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.currentRunID(), ev.ID)
+
+			wd.app.JigApp().QueueUpdateDraw(func() {
+				if err != nil {
+					wd.app.ShowToastError(fmt.Sprintf("Failed to load full payloads: %v", err))
+					return
+				}
+				ev = detail.Event
+				rawJSON = detail.RawJSON
+				summaryText = buildSummary(ev)
+				if !rawMode {
+					fullText = summaryText
+					applySearch()
+				}
+			})
+		}()
+	}
+
+	showRaw := func() {
+		fullText = header("Raw Attributes") + "\n" + highlightJSONText(rawJSON)
+		applySearch()
+	}
+	toggleRaw := func() {
+		rawMode = !rawMode
+		if !rawMode {
+			fullText = summaryText
+			applySearch()
+			return
+		}
+		if rawJSON != "" {
+			showRaw()
+			return
+		}
+
+		provider := wd.app.Provider()
+		if provider == nil {
+			rawMode = false
+			return
+		}
+		detailView.SetText(fmt.Sprintf("[%s]Loading raw event...[-]", theme.TagFgDim()))
+		go func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			detail, err := provider.GetHistoryEvent(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.currentRunID(), ev.ID)
+
+			wd.app.JigApp().QueueUpdateDraw(func() {
+				if !rawMode {
+					return
+				}
+				if err != nil {
+					detailView.SetText(fmt.Sprintf("[%s]Failed to load raw event: %s[-]", theme.TagError(), tview.Escape(err.Error())))
+					return
+				}
+				rawJSON = detail.RawJSON
+				showRaw()
+			})
+		}()
+	}
+
+	searchInput := tview.NewInputField().SetLabel("/ ")
+	searchInput.SetFieldBackgroundColor(theme.Bg())
+	searchInput.SetBackgroundColor(theme.Bg())
+	searchInput.SetLabelColor(theme.Accent())
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow)
+	content.SetBackgroundColor(theme.Bg())
+	content.AddItem(panel, 0, 1, true)
+	content.AddItem(searchInput, 0, 0, false)
+
+	closeSearch := func() {
+		content.ResizeItem(searchInput, 0, 0)
+		wd.app.JigApp().SetFocus(detailView)
+	}
+	searchInput.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEnter {
+			searchText = strings.TrimSpace(searchInput.GetText())
+			applySearch()
+		}
+		closeSearch()
 	})
+
+	modal.SetContent(content)
+	hints := func() []components.KeyHint {
+		hints := []components.KeyHint{
+			{Key: "j/k", Description: "Scroll"},
+			{Key: "g/G", Description: "Top/Bottom"},
+			{Key: "/", Description: "Search"},
+			{Key: "n/N", Description: "Next/Prev"},
+			{Key: "r", Description: "Raw/Summary"},
+		}
+		hints = append(hints, wd.app.wrapModalHints()...)
+		return append(hints,
+			components.KeyHint{Key: "y", Description: "Copy"},
+			components.KeyHint{Key: "esc", Description: "Close"},
+		)
+	}
+	modal.SetHints(hints())
 	modal.SetOnCancel(func() {
+		// Esc while typing a search only dismisses the search prompt
+		if searchInput.HasFocus() {
+			closeSearch()
+			return
+		}
 		wd.closeEventDetailModal()
 	})
 
@@ -1597,20 +2794,39 @@ func (wd *WorkflowDetail) showEventDetailModal() {
 			case 'G':
 				detailView.ScrollToEnd()
 				return nil
+			case '/':
+				searchInput.SetText(searchText)
+				content.ResizeItem(searchInput, 1, 0)
+				wd.app.JigApp().SetFocus(searchInput)
+				return nil
+			case 'n':
+				cycleMatch(1)
+				return nil
+			case 'N':
+				cycleMatch(-1)
+				return nil
+			case 'r':
+				toggleRaw()
+				return nil
+			case wrapKey:
+				wd.app.toggleDetailWrap()
+				detailView.SetWrap(wd.app.detailWrap())
+				modal.SetHints(hints())
+				return nil
+			case 'h':
+				wd.app.scrollDetail(detailView, -detailScrollStep)
+				return nil
+			case 'l':
+				wd.app.scrollDetail(detailView, detailScrollStep)
+				return nil
 			case 'y':
 				// Copy the raw details
-				if ev.Details != "" {
-					copyToClipboard(prettyPrintJSONDetail(ev.Details))
-					// Show "Copied!" feedback
-					panel.SetTitle(fmt.Sprintf("%s Copied!", theme.IconCompleted))
-					panel.SetTitleColor(theme.StatusColor("Completed"))
-					go func() {
-						time.Sleep(1 * time.Second)
-						wd.app.JigApp().QueueUpdateDraw(func() {
-							panel.SetTitle(fmt.Sprintf("%s Details", theme.IconInfo))
-							panel.SetTitleColor(0)
-						})
-					}()
+				copyText := prettyPrintJSONDetail(ev.Details)
+				if rawMode && rawJSON != "" {
+					copyText = formatJSONPretty(rawJSON)
+				}
+				if copyText != "" {
+					wd.app.CopyToClipboard(copyText, "Event details")
 				}
 				return nil
 			case 'q':
@@ -1621,14 +2837,12 @@ func (wd *WorkflowDetail) showEventDetailModal() {
 		return event
 	})
 
-	wd.app.JigApp().Pages().AddPage("event-detail-modal", modal, true, true)
-	wd.app.JigApp().SetFocus(detailView)
+	wd.app.Modals().Show("event-detail-modal", modal, detailView)
 }
 
 // closeEventDetailModal closes the event detail modal.
 func (wd *WorkflowDetail) closeEventDetailModal() {
-	wd.app.JigApp().Pages().RemovePage("event-detail-modal")
-	wd.app.JigApp().SetFocus(wd.eventTable)
+	wd.app.Modals().Close("event-detail-modal")
 }
 
 // truncateEventTypeStr shortens long event type names for the title.
@@ -1691,46 +2905,6 @@ func prettyPrintJSONDetail(s string) string {
 	return result.String()
 }
 
-// formatDetailViewWithHighlighting adds color tags for syntax highlighting.
-func formatDetailViewWithHighlighting(data string) string {
-	lines := strings.Split(data, "\n")
-	var result []string
-
-	for _, line := range lines {
-		highlighted := highlightDetailLine(line)
-		result = append(result, highlighted)
-	}
-
-	return strings.Join(result, "\n")
-}
-
-// highlightDetailLine adds tview color tags to a single line.
-func highlightDetailLine(line string) string {
-	// If line contains a colon that looks like a JSON key
-	if idx := strings.Index(line, ":"); idx > 0 {
-		prefix := line[:idx]
-		suffix := line[idx:]
-
-		trimmed := strings.TrimSpace(prefix)
-		if strings.HasPrefix(trimmed, "\"") || strings.HasPrefix(trimmed, "'") {
-			return fmt.Sprintf("[%s]%s[-]%s", theme.TagAccent(), prefix, highlightDetailValue(suffix))
-		} else if !strings.Contains(trimmed, " ") && len(trimmed) > 0 {
-			return fmt.Sprintf("[%s::b]%s[-:-:-]%s", theme.TagAccent(), prefix, highlightDetailValue(suffix))
-		}
-	}
-
-	return highlightDetailValue(line)
-}
-
-// highlightDetailValue highlights JSON values.
-func highlightDetailValue(s string) string {
-	result := s
-	result = strings.ReplaceAll(result, "true", fmt.Sprintf("[%s]true[-]", theme.StatusColorTag("Completed")))
-	result = strings.ReplaceAll(result, "false", fmt.Sprintf("[%s]false[-]", theme.StatusColorTag("Failed")))
-	result = strings.ReplaceAll(result, "null", fmt.Sprintf("[%s]null[-]", theme.TagFgDim()))
-	return result
-}
-
 // showIOModal displays a modal with workflow input and output side by side.
 func (wd *WorkflowDetail) showIOModal() {
 	if wd.workflow == nil {
@@ -1746,35 +2920,17 @@ func (wd *WorkflowDetail) showIOModal() {
 		MinHeight: 35,
 	})
 
-	// Create two side-by-side text views for input and output
-	inputView := tview.NewTextView().
-		SetDynamicColors(true).
-		SetScrollable(true).
-		SetWrap(true)
-	inputView.SetBackgroundColor(theme.Bg())
-	inputView.SetTextColor(theme.Fg())
-
-	outputView := tview.NewTextView().
-		SetDynamicColors(true).
-		SetScrollable(true).
-		SetWrap(true)
-	outputView.SetBackgroundColor(theme.Bg())
-	outputView.SetTextColor(theme.Fg())
-
-	// Format input
-	inputText := formatIOContent("Input", wd.workflow.Input)
-	inputView.SetText(inputText)
-
-	// Format output
-	outputText := formatIOContent("Output", wd.workflow.Output)
-	outputView.SetText(outputText)
+	// Create two side-by-side trees for input and output
+	setFocus := func(p tview.Primitive) { wd.app.JigApp().SetFocus(p) }
+	inputTree := NewJSONTree(setFocus).SetText(ioContent("Input", wd.workflow.Input))
+	outputTree := NewJSONTree(setFocus).SetText(ioContent("Output", wd.workflow.Output))
 
 	// Create panels for each side with visual indicator for focus
 	inputPanel := components.NewPanel().SetTitle(fmt.Sprintf("%s Input", theme.IconArrowRight))
-	inputPanel.SetContent(inputView)
+	inputPanel.SetContent(inputTree)
 
 	outputPanel := components.NewPanel().SetTitle(fmt.Sprintf("%s Output", theme.IconArrowLeft))
-	outputPanel.SetContent(outputView)
+	outputPanel.SetContent(outputTree)
 
 	// Layout: side by side
 	flex := tview.NewFlex().SetDirection(tview.FlexColumn).
@@ -1782,20 +2938,33 @@ func (wd *WorkflowDetail) showIOModal() {
 		AddItem(outputPanel, 0, 1, false)
 	flex.SetBackgroundColor(theme.Bg())
 
+	// Track which pane is focused and store references for the handler
+	focusedInput := true
+	focusedTree := func() *JSONTree {
+		if focusedInput {
+			return inputTree
+		}
+		return outputTree
+	}
+
 	modal.SetContent(flex)
 	modal.SetHints([]components.KeyHint{
-		{Key: "tab/h/l", Description: "Switch"},
-		{Key: "j/k", Description: "Scroll"},
+		{Key: "tab", Description: "Switch"},
+		{Key: "j/k", Description: "Move"},
+		{Key: "space", Description: "Fold"},
+		{Key: "f", Description: "Filter path"},
 		{Key: "y", Description: "Copy"},
+		{Key: "p", Description: "Copy path"},
 		{Key: "esc", Description: "Close"},
 	})
 	modal.SetOnCancel(func() {
+		if tree := focusedTree(); tree.IsFiltering() {
+			tree.CloseFilter()
+			return
+		}
 		wd.closeIOModal()
 	})
 
-	// Track which pane is focused and store references for the handler
-	focusedInput := true
-
 	// Update panel titles and colors to show focus
 	updatePanelTitles := func() {
 		if focusedInput {
@@ -1812,110 +2981,33 @@ func (wd *WorkflowDetail) showIOModal() {
 	}
 	updatePanelTitles()
 
-	// Switch focus helper
-	switchFocus := func() {
-		focusedInput = !focusedInput
-		updatePanelTitles()
-		if focusedInput {
-			wd.app.JigApp().SetFocus(inputView)
-		} else {
-			wd.app.JigApp().SetFocus(outputView)
-		}
-	}
-
-	// Scroll helper
-	scrollView := func(delta int) {
-		var view *tview.TextView
-		if focusedInput {
-			view = inputView
-		} else {
-			view = outputView
-		}
-		row, col := view.GetScrollOffset()
-		newRow := row + delta
-		if newRow < 0 {
-			newRow = 0
-		}
-		view.ScrollTo(newRow, col)
-	}
-
-	// Handle input - shared handler for both views
+	// Handle input - shared handler for both trees; h/l fold, so only tab
+	// switches panes
 	inputHandler := func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
 		case tcell.KeyEscape:
 			wd.closeIOModal()
 			return nil
 		case tcell.KeyTab, tcell.KeyBacktab:
-			switchFocus()
-			return nil
-		case tcell.KeyDown:
-			scrollView(1)
-			return nil
-		case tcell.KeyUp:
-			scrollView(-1)
-			return nil
-		case tcell.KeyPgDn:
-			scrollView(10)
-			return nil
-		case tcell.KeyPgUp:
-			scrollView(-10)
+			focusedInput = !focusedInput
+			updatePanelTitles()
+			wd.app.JigApp().SetFocus(focusedTree())
 			return nil
 		case tcell.KeyRune:
 			switch event.Rune() {
-			case 'h':
-				if !focusedInput {
-					switchFocus()
-				}
-				return nil
-			case 'l':
-				if focusedInput {
-					switchFocus()
-				}
-				return nil
-			case 'j':
-				scrollView(1)
-				return nil
-			case 'k':
-				scrollView(-1)
-				return nil
-			case 'g':
-				// Go to top
+			case 'y':
+				// Copy the selected value of the focused pane
+				what := "Output"
 				if focusedInput {
-					inputView.ScrollTo(0, 0)
-				} else {
-					outputView.ScrollTo(0, 0)
+					what = "Input"
 				}
-				return nil
-			case 'G':
-				// Go to bottom - scroll to a large number
-				if focusedInput {
-					inputView.ScrollToEnd()
-				} else {
-					outputView.ScrollToEnd()
+				if value, _ := focusedTree().Selected(); value != "" {
+					wd.app.CopyToClipboard(value, what)
 				}
 				return nil
-			case 'y':
-				// Copy the content of the focused pane
-				var content string
-				var panel *components.Panel
-				if focusedInput {
-					content = wd.workflow.Input
-					panel = inputPanel
-				} else {
-					content = wd.workflow.Output
-					panel = outputPanel
-				}
-				if content != "" {
-					copyToClipboard(content)
-					// Show "Copied!" feedback
-					panel.SetTitle(fmt.Sprintf("%s Copied!", theme.IconCompleted))
-					panel.SetTitleColor(theme.StatusColor("Completed"))
-					go func() {
-						time.Sleep(1 * time.Second)
-						wd.app.JigApp().QueueUpdateDraw(func() {
-							updatePanelTitles()
-						})
-					}()
+			case 'p':
+				if _, path := focusedTree().Selected(); path != "" {
+					wd.app.CopyToClipboard(path, "Path")
 				}
 				return nil
 			case 'q':
@@ -1926,28 +3018,22 @@ func (wd *WorkflowDetail) showIOModal() {
 		return event
 	}
 
-	inputView.SetInputCapture(inputHandler)
-	outputView.SetInputCapture(inputHandler)
+	inputTree.SetInputCapture(inputHandler)
+	outputTree.SetInputCapture(inputHandler)
 
-	wd.app.JigApp().Pages().AddPage("io-modal", modal, true, true)
-	wd.app.JigApp().SetFocus(inputView)
+	wd.app.Modals().Show("io-modal", modal, inputTree)
 }
 
-// formatIOContent formats input or output content for display.
-func formatIOContent(label, content string) string {
+// ioContent returns workflow input or output for display, or a note when
+// there is none.
+func ioContent(label, content string) string {
 	if content == "" {
-		return fmt.Sprintf("[%s]No %s[-]", theme.TagFgDim(), strings.ToLower(label))
+		return fmt.Sprintf("No %s", strings.ToLower(label))
 	}
-
-	// Pretty print if it's JSON
-	formatted := formatJSONPretty(content)
-	highlighted := highlightFormattedJSONWorkflow(formatted)
-
-	return highlighted
+	return content
 }
 
 // closeIOModal closes the IO modal.
 func (wd *WorkflowDetail) closeIOModal() {
-	wd.app.JigApp().Pages().RemovePage("io-modal")
-	wd.app.JigApp().SetFocus(wd.eventTable)
+	wd.app.Modals().Close("io-modal")
 }
@@ -23,6 +23,7 @@ type WorkflowDiff struct {
 	workflowB *temporal.Workflow
 	eventsA   []temporal.HistoryEvent
 	eventsB   []temporal.HistoryEvent
+	aligned   []diffRow // Aligned event rows, set once both histories are loaded
 
 	// UI components
 	leftPanel   *components.Panel
@@ -35,8 +36,29 @@ type WorkflowDiff struct {
 	// State
 	focusLeft bool
 	loading   bool
+	syncing   bool // Guards against recursive selection sync between tables
 }
 
+// diffKind classifies an aligned row in the event comparison.
+type diffKind int
+
+const (
+	diffSame      diffKind = iota // Same event type on both sides
+	diffChanged                   // Different event types at the same position
+	diffLeftOnly                  // Event only present in workflow A
+	diffRightOnly                 // Event only present in workflow B
+)
+
+// diffRow pairs an event index from each side; -1 marks a gap.
+type diffRow struct {
+	left  int
+	right int
+	kind  diffKind
+}
+
+// maxAlignCells bounds the LCS table size; larger histories fall back to positional alignment.
+const maxAlignCells = 4_000_000
+
 // NewWorkflowDiff creates a new workflow diff view.
 func NewWorkflowDiff(app *App, namespace string) *WorkflowDiff {
 	wd := &WorkflowDiff{
@@ -88,11 +110,29 @@ func (wd *WorkflowDiff) setup() {
 	wd.rightPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Workflow B", theme.IconWorkflow))
 	wd.rightPanel.SetContent(rightContent)
 
+	// Keep aligned rows in step while scrolling either side
+	wd.leftEvents.SetSelectionChangedFunc(func(row, col int) {
+		wd.syncSelection(wd.leftEvents, wd.rightEvents)
+	})
+	wd.rightEvents.SetSelectionChangedFunc(func(row, col int) {
+		wd.syncSelection(wd.rightEvents, wd.leftEvents)
+	})
+
 	// Build layout
 	wd.AddItem(wd.leftPanel, 0, 1, true)
 	wd.AddItem(wd.rightPanel, 0, 1, false)
 }
 
+// syncSelection mirrors the selected row onto the other table when histories are aligned.
+func (wd *WorkflowDiff) syncSelection(from, to *components.Table) {
+	if wd.aligned == nil || wd.syncing {
+		return
+	}
+	wd.syncing = true
+	to.SelectRow(from.SelectedRow())
+	wd.syncing = false
+}
+
 // Name returns the view name.
 func (wd *WorkflowDiff) Name() string {
 	return "workflow-diff"
@@ -145,6 +185,7 @@ func (wd *WorkflowDiff) Hints() []KeyHint {
 		{Key: "Tab", Description: "Switch Panel"},
 		{Key: "a", Description: "Set Left"},
 		{Key: "b", Description: "Set Right"},
+		{Key: "n/N", Description: "Next/Prev Diff"},
 		{Key: "r", Description: "Refresh"},
 		{Key: "esc", Description: "Back"},
 	}
@@ -185,11 +226,54 @@ func (wd *WorkflowDiff) inputHandler(event *tcell.EventKey) *tcell.EventKey {
 	case 'r':
 		wd.loadData()
 		return nil
+	case 'n':
+		wd.jumpToDivergence(true)
+		return nil
+	case 'N':
+		wd.jumpToDivergence(false)
+		return nil
 	}
 
 	return event
 }
 
+// jumpToDivergence moves the selection to the next (or previous) block of diverging rows.
+func (wd *WorkflowDiff) jumpToDivergence(forward bool) {
+	if len(wd.aligned) == 0 {
+		return
+	}
+	current := wd.leftEvents.SelectedRow()
+	if !wd.focusLeft {
+		current = wd.rightEvents.SelectedRow()
+	}
+
+	isBlockStart := func(i int) bool {
+		return wd.aligned[i].kind != diffSame && (i == 0 || wd.aligned[i-1].kind == diffSame)
+	}
+
+	target := -1
+	if forward {
+		for i := current + 1; i < len(wd.aligned); i++ {
+			if isBlockStart(i) {
+				target = i
+				break
+			}
+		}
+	} else {
+		for i := current - 1; i >= 0; i-- {
+			if isBlockStart(i) {
+				target = i
+				break
+			}
+		}
+	}
+	if target < 0 {
+		return
+	}
+	wd.leftEvents.SelectRow(target)
+	wd.rightEvents.SelectRow(target)
+}
+
 func (wd *WorkflowDiff) toggleFocus() {
 	wd.focusLeft = !wd.focusLeft
 	if wd.focusLeft {
@@ -270,17 +354,11 @@ func (wd *WorkflowDiff) promptWorkflowInput(isLeft bool) {
 		wd.closeModal("workflow-input")
 	})
 
-	wd.app.JigApp().Pages().AddPage("workflow-input", modal, true, true)
-	wd.app.JigApp().SetFocus(form)
+	wd.app.Modals().Show("workflow-input", modal, form)
 }
 
 func (wd *WorkflowDiff) closeModal(name string) {
-	wd.app.JigApp().Pages().RemovePage(name)
-	if wd.focusLeft {
-		wd.app.JigApp().SetFocus(wd.leftEvents)
-	} else {
-		wd.app.JigApp().SetFocus(wd.rightEvents)
-	}
+	wd.app.Modals().Close(name)
 }
 
 func (wd *WorkflowDiff) loadWorkflow(isLeft bool, workflowID, runID string) {
@@ -290,7 +368,7 @@ func (wd *WorkflowDiff) loadWorkflow(isLeft bool, workflowID, runID string) {
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		workflow, err := provider.GetWorkflow(ctx, wd.namespace, workflowID, runID)
@@ -340,7 +418,7 @@ func (wd *WorkflowDiff) updateLeftInfo() {
 		wd.leftInfo.SetText("")
 		return
 	}
-	wd.leftInfo.SetText(wd.formatWorkflowInfo(wd.workflowA, len(wd.eventsA)))
+	wd.leftInfo.SetText(wd.formatWorkflowInfo(wd.workflowA, len(wd.eventsA)) + wd.formatDivergence(true))
 }
 
 func (wd *WorkflowDiff) updateRightInfo() {
@@ -348,7 +426,39 @@ func (wd *WorkflowDiff) updateRightInfo() {
 		wd.rightInfo.SetText("")
 		return
 	}
-	wd.rightInfo.SetText(wd.formatWorkflowInfo(wd.workflowB, len(wd.eventsB)))
+	wd.rightInfo.SetText(wd.formatWorkflowInfo(wd.workflowB, len(wd.eventsB)) + wd.formatDivergence(false))
+}
+
+// formatDivergence summarizes where the aligned histories first diverge.
+func (wd *WorkflowDiff) formatDivergence(isLeft bool) string {
+	if wd.aligned == nil {
+		return ""
+	}
+
+	diverging := 0
+	first := -1
+	for i, row := range wd.aligned {
+		if row.kind != diffSame {
+			diverging++
+			if first < 0 {
+				first = i
+			}
+		}
+	}
+	if first < 0 {
+		return fmt.Sprintf("\n[%s]Diff:[-] [%s]histories match[-]", theme.TagFgDim(), theme.TagFg())
+	}
+
+	row := wd.aligned[first]
+	idx, events := row.left, wd.eventsA
+	if !isLeft {
+		idx, events = row.right, wd.eventsB
+	}
+	at := "gap"
+	if idx >= 0 {
+		at = fmt.Sprintf("event %d", events[idx].ID)
+	}
+	return fmt.Sprintf("\n[%s]Diff:[-] [%s]%d rows differ, first at %s[-]", theme.TagFgDim(), theme.TagError(), diverging, at)
 }
 
 func (wd *WorkflowDiff) formatWorkflowInfo(w *temporal.Workflow, eventCount int) string {
@@ -370,19 +480,22 @@ func (wd *WorkflowDiff) formatWorkflowInfo(w *temporal.Workflow, eventCount int)
 [%s]Task Queue:[-] [%s]%s[-]`,
 		theme.TagFgDim(), theme.TagFg(), w.Type,
 		theme.TagFgDim(), statusColor, statusIcon, w.Status,
-		theme.TagFgDim(), theme.TagFg(), w.StartTime.Format("2006-01-02 15:04:05"),
+		theme.TagFgDim(), theme.TagFg(), formatTimestamp(w.StartTime),
 		theme.TagFgDim(), theme.TagFg(), duration,
 		theme.TagFgDim(), theme.TagAccent(), eventCount,
 		theme.TagFgDim(), theme.TagFg(), w.TaskQueue)
 }
 
 func (wd *WorkflowDiff) updateLeftEvents() {
+	if wd.updateAlignedEvents() {
+		return
+	}
 	wd.leftEvents.ClearRows()
 	for _, e := range wd.eventsA {
 		wd.leftEvents.AddRow(
 			fmt.Sprintf("%d", e.ID),
 			e.Type,
-			e.Time.Format("15:04:05"),
+			inTimezone(e.Time).Format("15:04:05"),
 		)
 	}
 	if wd.leftEvents.RowCount() > 0 {
@@ -391,12 +504,15 @@ func (wd *WorkflowDiff) updateLeftEvents() {
 }
 
 func (wd *WorkflowDiff) updateRightEvents() {
+	if wd.updateAlignedEvents() {
+		return
+	}
 	wd.rightEvents.ClearRows()
 	for _, e := range wd.eventsB {
 		wd.rightEvents.AddRow(
 			fmt.Sprintf("%d", e.ID),
 			e.Type,
-			e.Time.Format("15:04:05"),
+			inTimezone(e.Time).Format("15:04:05"),
 		)
 	}
 	if wd.rightEvents.RowCount() > 0 {
@@ -404,6 +520,143 @@ func (wd *WorkflowDiff) updateRightEvents() {
 	}
 }
 
+// updateAlignedEvents renders both event tables row-aligned when both histories are
+// loaded, highlighting where they diverge. It returns false if alignment isn't possible yet.
+func (wd *WorkflowDiff) updateAlignedEvents() bool {
+	if wd.workflowA == nil || wd.workflowB == nil || len(wd.eventsA) == 0 || len(wd.eventsB) == 0 {
+		wd.aligned = nil
+		return false
+	}
+
+	wd.aligned = alignEvents(wd.eventsA, wd.eventsB)
+	wd.leftEvents.ClearRows()
+	wd.rightEvents.ClearRows()
+
+	addCells := func(table *components.Table, events []temporal.HistoryEvent, idx int, color tcell.Color) {
+		if idx < 0 {
+			table.AddRowWithColor(theme.FgMuted(), "", "·", "")
+			return
+		}
+		e := events[idx]
+		table.AddRowWithColor(color, fmt.Sprintf("%d", e.ID), e.Type, inTimezone(e.Time).Format("15:04:05"))
+	}
+
+	for _, row := range wd.aligned {
+		color := theme.Fg()
+		switch row.kind {
+		case diffChanged:
+			color = theme.Warning()
+		case diffLeftOnly, diffRightOnly:
+			color = theme.Error()
+		}
+		addCells(wd.leftEvents, wd.eventsA, row.left, color)
+		addCells(wd.rightEvents, wd.eventsB, row.right, color)
+	}
+
+	wd.leftEvents.SelectRow(0)
+	wd.rightEvents.SelectRow(0)
+	wd.updateLeftInfo()
+	wd.updateRightInfo()
+	return true
+}
+
+// alignEvents aligns two histories by event type. The common prefix and suffix are
+// matched directly and the middle is aligned with a longest common subsequence, so
+// runs that share a prefix (e.g. after a reset) line up up to the divergence point.
+func alignEvents(a, b []temporal.HistoryEvent) []diffRow {
+	n, m := len(a), len(b)
+
+	prefix := 0
+	for prefix < n && prefix < m && a[prefix].Type == b[prefix].Type {
+		prefix++
+	}
+	suffix := 0
+	for suffix < n-prefix && suffix < m-prefix && a[n-1-suffix].Type == b[m-1-suffix].Type {
+		suffix++
+	}
+
+	rows := make([]diffRow, 0, n+m)
+	for i := 0; i < prefix; i++ {
+		rows = append(rows, diffRow{left: i, right: i, kind: diffSame})
+	}
+
+	midA, midB := n-prefix-suffix, m-prefix-suffix
+	var leftOnly, rightOnly []int
+	flush := func() {
+		paired := min(len(leftOnly), len(rightOnly))
+		for i := 0; i < paired; i++ {
+			rows = append(rows, diffRow{left: leftOnly[i], right: rightOnly[i], kind: diffChanged})
+		}
+		for _, i := range leftOnly[paired:] {
+			rows = append(rows, diffRow{left: i, right: -1, kind: diffLeftOnly})
+		}
+		for _, j := range rightOnly[paired:] {
+			rows = append(rows, diffRow{left: -1, right: j, kind: diffRightOnly})
+		}
+		leftOnly, rightOnly = leftOnly[:0], rightOnly[:0]
+	}
+
+	if midA*midB <= maxAlignCells {
+		// lcs[i][j] is the LCS length of a[prefix+i:] and b[prefix+j:] within the middle
+		width := midB + 1
+		lcs := make([]int32, (midA+1)*width)
+		for i := midA - 1; i >= 0; i-- {
+			for j := midB - 1; j >= 0; j-- {
+				if a[prefix+i].Type == b[prefix+j].Type {
+					lcs[i*width+j] = lcs[(i+1)*width+j+1] + 1
+				} else {
+					lcs[i*width+j] = max(lcs[(i+1)*width+j], lcs[i*width+j+1])
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < midA && j < midB {
+			switch {
+			case a[prefix+i].Type == b[prefix+j].Type:
+				flush()
+				rows = append(rows, diffRow{left: prefix + i, right: prefix + j, kind: diffSame})
+				i++
+				j++
+			case lcs[(i+1)*width+j] >= lcs[i*width+j+1]:
+				leftOnly = append(leftOnly, prefix+i)
+				i++
+			default:
+				rightOnly = append(rightOnly, prefix+j)
+				j++
+			}
+		}
+		for ; i < midA; i++ {
+			leftOnly = append(leftOnly, prefix+i)
+		}
+		for ; j < midB; j++ {
+			rightOnly = append(rightOnly, prefix+j)
+		}
+	} else {
+		// Too large to align exactly; compare positionally
+		for i := 0; i < max(midA, midB); i++ {
+			switch {
+			case i >= midA:
+				rightOnly = append(rightOnly, prefix+i)
+			case i >= midB:
+				leftOnly = append(leftOnly, prefix+i)
+			case a[prefix+i].Type == b[prefix+i].Type:
+				flush()
+				rows = append(rows, diffRow{left: prefix + i, right: prefix + i, kind: diffSame})
+			default:
+				leftOnly = append(leftOnly, prefix+i)
+				rightOnly = append(rightOnly, prefix+i)
+			}
+		}
+	}
+	flush()
+
+	for k := suffix; k > 0; k-- {
+		rows = append(rows, diffRow{left: n - k, right: m - k, kind: diffSame})
+	}
+	return rows
+}
+
 // SetWorkflowA sets the left workflow for comparison.
 func (wd *WorkflowDiff) SetWorkflowA(w *temporal.Workflow) {
 	wd.workflowA = w
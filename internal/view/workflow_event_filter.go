@@ -0,0 +1,116 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+)
+
+// showEventFilter opens a toggle menu for hiding event categories in the events panel.
+func (wd *WorkflowDetail) showEventFilter() {
+	if wd.hiddenGroups == nil {
+		wd.hiddenGroups = make(map[temporal.EventGroupType]bool)
+	}
+
+	groups := temporal.EventGroupTypes()
+	counts := make(map[temporal.EventGroupType]int)
+	for _, ev := range wd.allEvents {
+		counts[temporal.ClassifyEvent(ev.Type)]++
+	}
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Filter Events", theme.IconEvent),
+		Width:    50,
+		Height:   len(groups) + 8,
+		Backdrop: true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders(" ", "CATEGORY", "EVENTS")
+	table.SetBackgroundColor(theme.Bg())
+
+	render := func() {
+		row := table.SelectedRow()
+		table.ClearRows()
+		for _, g := range groups {
+			mark, color := "✓", theme.Fg()
+			if wd.hiddenGroups[g] {
+				mark, color = " ", theme.FgDim()
+			}
+			table.AddRowWithColor(color, mark, g.String(), fmt.Sprintf("%d", counts[g]))
+		}
+		if row < 0 {
+			row = 0
+		}
+		table.SelectRow(row)
+	}
+
+	update := func() {
+		render()
+		wd.applyEventFilter()
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		row := table.SelectedRow()
+		switch event.Key() {
+		case tcell.KeyEnter:
+			if row >= 0 && row < len(groups) {
+				wd.hiddenGroups[groups[row]] = !wd.hiddenGroups[groups[row]]
+				update()
+			}
+			return nil
+		case tcell.KeyEscape:
+			wd.closeModal("event-filter-modal")
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case ' ':
+				if row >= 0 && row < len(groups) {
+					wd.hiddenGroups[groups[row]] = !wd.hiddenGroups[groups[row]]
+					update()
+				}
+				return nil
+			case 'o':
+				// Show only the selected category
+				if row >= 0 && row < len(groups) {
+					for _, g := range groups {
+						wd.hiddenGroups[g] = g != groups[row]
+					}
+					update()
+				}
+				return nil
+			case 'w':
+				// Hide workflow task bookkeeping, which dominates most histories
+				wd.hiddenGroups[temporal.GroupWorkflowTask] = !wd.hiddenGroups[temporal.GroupWorkflowTask]
+				update()
+				return nil
+			case 'a':
+				wd.hiddenGroups = make(map[temporal.EventGroupType]bool)
+				update()
+				return nil
+			case 'q':
+				wd.closeModal("event-filter-modal")
+				return nil
+			}
+		}
+		return event
+	})
+
+	render()
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Space", Description: "Toggle"},
+		{Key: "o", Description: "Only"},
+		{Key: "w", Description: "WF Tasks"},
+		{Key: "a", Description: "All"},
+		{Key: "Esc", Description: "Close"},
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal("event-filter-modal")
+	})
+
+	wd.app.Modals().Show("event-filter-modal", modal, table)
+}
@@ -0,0 +1,109 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// defaultExportPath returns a file name for an exported history, safe for use as a path.
+func defaultExportPath(workflowID, runID string) string {
+	name := workflowID
+	if runID != "" {
+		name += "_" + runID
+	}
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', ' ':
+			return '_'
+		}
+		return r
+	}, name)
+	return name + ".json"
+}
+
+// expandHome replaces a leading "~" with the user's home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+func (wd *WorkflowDetail) showExportHistory() {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Export History", theme.IconEvent),
+		Width:    80,
+		Height:   12,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("path", "File Path", "")
+	_ = form.SetValues(map[string]any{
+		"path": defaultExportPath(wd.workflowID, wd.currentRunID()),
+	})
+
+	submit := func(values map[string]any) {
+		path := strings.TrimSpace(values["path"].(string))
+		if path == "" {
+			return
+		}
+		wd.closeModal("export-form")
+		wd.executeExportHistory(expandHome(path))
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		wd.closeModal("export-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Export"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal("export-form")
+	})
+
+	wd.app.Modals().Show("export-form", modal, form)
+}
+
+func (wd *WorkflowDetail) executeExportHistory(path string) {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		data, err := provider.ExportWorkflowHistory(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.currentRunID())
+		if err == nil {
+			err = os.WriteFile(path, data, 0644)
+		}
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(wd.app.Modals(), "Export Failed", err.Error())
+				return
+			}
+			ShowInfoModal(wd.app.Modals(), "History Exported",
+				fmt.Sprintf("Wrote %s to %s", temporal.FormatBytes(len(data)), path))
+		})
+	}()
+}
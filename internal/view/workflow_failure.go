@@ -0,0 +1,156 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// failedActivity identifies the activity failure most likely behind a workflow failure.
+type failedActivity struct {
+	activityType string
+	activityID   string
+	identity     string
+	failure      string
+	eventID      int64 // Zero when taken from the failure chain
+}
+
+// loadFailureSummary fetches the close event of a failed workflow for the failure panel.
+func (wd *WorkflowDetail) loadFailureSummary() {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		summary, err := provider.GetWorkflowFailure(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.setFailureSummary(nil)
+				return
+			}
+			wd.setFailureSummary(summary)
+		})
+	}()
+}
+
+// setFailureSummary shows the failure panel for summary, or hides it when nil.
+func (wd *WorkflowDetail) setFailureSummary(summary *temporal.FailureSummary) {
+	wd.failure = summary
+	wd.renderFailureSummary()
+}
+
+func (wd *WorkflowDetail) renderFailureSummary() {
+	if wd.failure == nil {
+		wd.failureView.SetText("")
+		wd.leftFlex.ResizeItem(wd.failurePanel, 0, 0)
+		return
+	}
+	wd.failureView.SetText(formatFailureSummary(wd.failure, wd.lastFailedActivity()))
+	wd.leftFlex.ResizeItem(wd.failurePanel, 0, 1)
+}
+
+// lastFailedActivity returns the activity failure in the failure chain, falling back
+// to the last failed or timed out activity in the loaded history.
+func (wd *WorkflowDetail) lastFailedActivity() *failedActivity {
+	if wd.failure != nil {
+		for i, c := range wd.failure.Causes {
+			if c.ActivityType != "" {
+				fa := &failedActivity{
+					activityType: c.ActivityType,
+					activityID:   c.ActivityID,
+					identity:     c.Identity,
+				}
+				// The activity's own error is the next cause in the chain
+				if i+1 < len(wd.failure.Causes) {
+					fa.failure = wd.failure.Causes[i+1].Message
+				}
+				return fa
+			}
+		}
+	}
+
+	for i := len(wd.allEvents) - 1; i >= 0; i-- {
+		ev := wd.allEvents[i]
+		if ev.Type != "ActivityTaskFailed" && ev.Type != "ActivityTaskTimedOut" {
+			continue
+		}
+		fa := &failedActivity{failure: ev.Failure, eventID: ev.ID, identity: ev.Identity}
+		for _, scheduled := range wd.allEvents {
+			if scheduled.ID == ev.ScheduledEventID {
+				fa.activityType = scheduled.ActivityType
+				fa.activityID = scheduled.ActivityID
+				break
+			}
+		}
+		return fa
+	}
+	return nil
+}
+
+// formatFailureSummary renders a failure summary with its cause chain and stack traces.
+func formatFailureSummary(summary *temporal.FailureSummary, activity *failedActivity) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("[%s::b]%s[-:-:-] [%s]at %s (event %d)[-]",
+		theme.TagError(), summary.Status,
+		theme.TagFgDim(), formatTimestamp(summary.Time), summary.EventID))
+	if summary.RetryState != "" {
+		b.WriteString(fmt.Sprintf("\n[%s::b]Retry State[-:-:-]  [%s]%s[-]", theme.TagFgDim(), theme.TagFg(), summary.RetryState))
+	}
+	if summary.Identity != "" {
+		b.WriteString(fmt.Sprintf("\n[%s::b]Identity[-:-:-]     [%s]%s[-]", theme.TagFgDim(), theme.TagFg(), tview.Escape(summary.Identity)))
+	}
+
+	for i, c := range summary.Causes {
+		if i == 0 {
+			b.WriteString(fmt.Sprintf("\n\n[%s::b]%s[-:-:-]", theme.TagAccent(), tview.Escape(c.Type)))
+		} else {
+			b.WriteString(fmt.Sprintf("\n[%s]caused by[-] [%s::b]%s[-:-:-]", theme.TagFgDim(), theme.TagAccent(), tview.Escape(c.Type)))
+		}
+		if c.NonRetryable {
+			b.WriteString(fmt.Sprintf(" [%s](non-retryable)[-]", theme.TagWarning()))
+		}
+		if c.Message != "" {
+			b.WriteString(fmt.Sprintf("\n  [%s]%s[-]", theme.TagError(), tview.Escape(c.Message)))
+		}
+	}
+
+	if activity != nil && activity.activityType != "" {
+		b.WriteString(fmt.Sprintf("\n\n[%s::b]Last Failed Activity[-:-:-]\n[%s]%s[-]", theme.TagAccent(), theme.TagFg(), tview.Escape(activity.activityType)))
+		if activity.activityID != "" {
+			b.WriteString(fmt.Sprintf(" [%s](id %s)[-]", theme.TagFgDim(), tview.Escape(activity.activityID)))
+		}
+		if activity.eventID > 0 {
+			b.WriteString(fmt.Sprintf(" [%s]event %d[-]", theme.TagFgDim(), activity.eventID))
+		}
+		if activity.identity != "" {
+			b.WriteString(fmt.Sprintf("\n[%s]on %s[-]", theme.TagFgDim(), tview.Escape(activity.identity)))
+		}
+		if activity.failure != "" {
+			b.WriteString(fmt.Sprintf("\n[%s]%s[-]", theme.TagError(), tview.Escape(activity.failure)))
+		}
+	}
+
+	for _, c := range summary.Causes {
+		if c.StackTrace == "" {
+			continue
+		}
+		label := c.Type
+		if c.Source != "" {
+			label += ", " + c.Source
+		}
+		b.WriteString(fmt.Sprintf("\n\n[%s::b]Stack Trace[-:-:-] [%s](%s)[-]\n%s",
+			theme.TagAccent(), theme.TagFgDim(), tview.Escape(label),
+			highlightCode(stackTraceLexer, strings.TrimRight(c.StackTrace, "\n"))))
+	}
+
+	return b.String()
+}
@@ -3,14 +3,13 @@ package view
 import (
 	"context"
 	"fmt"
-	"os/exec"
-	"runtime"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -21,7 +20,7 @@ type WorkflowList struct {
 	*tview.Flex
 	app              *App
 	namespace        string
-	table            *components.Table
+	table            *VirtualTable
 	leftPanel        *components.Panel
 	rightPanel       *components.Panel
 	preview          *tview.TextView
@@ -37,6 +36,9 @@ type WorkflowList struct {
 	refreshTicker    *time.Ticker
 	stopRefresh      chan struct{}
 	selectionMode    bool     // Multi-select mode active
+	idWidth          int      // ID column truncation, 0 for none
+	typeWidth        int      // Type column truncation, 0 for none
+	populatedAt      time.Time
 	searchHistory    []string // History of visibility queries
 	historyIndex     int      // Current position in history (-1 = not browsing)
 	maxHistorySize   int      // Maximum number of history entries
@@ -52,7 +54,7 @@ func NewWorkflowList(app *App, namespace string) *WorkflowList {
 		Flex:           tview.NewFlex().SetDirection(tview.FlexColumn),
 		app:            app,
 		namespace:      namespace,
-		table:          components.NewTable(),
+		table:          NewVirtualTable(),
 		preview:        tview.NewTextView(),
 		workflows:      []temporal.Workflow{},
 		showPreview:    true,
@@ -65,8 +67,24 @@ func NewWorkflowList(app *App, namespace string) *WorkflowList {
 	return wl
 }
 
+// NewWorkflowListWithQuery creates a workflow list view filtered by a visibility query.
+func NewWorkflowListWithQuery(app *App, namespace, query string) *WorkflowList {
+	wl := NewWorkflowList(app, namespace)
+	wl.visibilityQuery = query
+	wl.addToHistory(query)
+	wl.updatePanelTitle()
+	return wl
+}
+
 func (wl *WorkflowList) setup() {
 	wl.table.SetHeaders("WORKFLOW ID", "STATUS", "TYPE", "START TIME")
+	wl.table.SetRowFunc(wl.workflowRow)
+	wl.table.SetRowKeyFunc(func(i int) string {
+		if i >= len(wl.workflows) {
+			return "" // Error row
+		}
+		return wl.workflows[i].ID + "/" + wl.workflows[i].RunID
+	})
 	wl.table.SetBorder(false)
 	wl.table.SetBackgroundColor(theme.Bg())
 	wl.SetBackgroundColor(theme.Bg())
@@ -83,6 +101,9 @@ func (wl *WorkflowList) setup() {
 		case 'W':
 			wl.showSignalWithStart()
 			return nil
+		case 'U':
+			wl.showUpdateWithStart()
+			return nil
 		case 'r':
 			wl.loadData()
 			return nil
@@ -98,6 +119,11 @@ func (wl *WorkflowList) setup() {
 		case 'p':
 			wl.togglePreview()
 			return nil
+		case '<', '>', '=':
+			if wl.showPreview {
+				wl.resizePanels(event.Rune())
+			}
+			return nil
 		}
 		return event
 	}
@@ -122,28 +148,38 @@ func (wl *WorkflowList) setup() {
 	wl.rightPanel.SetContent(wl.preview)
 
 	// Selection change handler to update preview
-	wl.table.SetSelectionChangedFunc(func(row, col int) {
-		if row > 0 && row-1 < len(wl.workflows) {
-			wl.updatePreview(wl.workflows[row-1])
+	wl.table.SetSelectionChangedFunc(func(row int) {
+		if row >= 0 && row < len(wl.workflows) && len(wl.table.GetSelectedRows()) == 0 {
+			wl.updatePreview(wl.workflows[row])
 		}
 	})
 
+	// Marked rows replace the preview with a summary
+	wl.table.SetOnSelectionChange(func([]int) {
+		wl.updateSelectionPreview()
+	})
+
 	// Selection handler for drill-down
-	wl.table.SetOnSelect(func(row int) {
+	wl.table.SetSelectedFunc(func(row int) {
 		if row >= 0 && row < len(wl.workflows) {
 			wf := wl.workflows[row]
 			wl.app.NavigateToWorkflowDetail(wf.ID, wf.RunID)
 		}
 	})
 
+	wl.table.SetContextMenuFunc(func(int) {
+		wl.showActionMenu()
+	})
+
 	wl.buildLayout()
 }
 
 func (wl *WorkflowList) buildLayout() {
 	wl.Clear()
 	if wl.showPreview {
-		wl.AddItem(wl.leftPanel, 0, 3, true)
-		wl.AddItem(wl.rightPanel, 0, 2, false)
+		ratio := wl.app.panelRatio(wl.Name(), workflowListRatio)
+		wl.AddItem(wl.leftPanel, 0, ratio, true)
+		wl.AddItem(wl.rightPanel, 0, 100-ratio, false)
 	} else {
 		wl.AddItem(wl.leftPanel, 0, 1, true)
 	}
@@ -156,6 +192,13 @@ func (wl *WorkflowList) togglePreview() {
 	wl.populateTable()
 }
 
+// resizePanels moves the divider between the list and the preview.
+func (wl *WorkflowList) resizePanels(key rune) {
+	wl.app.resizePanels(wl.Name(), workflowListRatio, panelResizeDelta(key))
+	wl.buildLayout()
+	wl.populateTable()
+}
+
 // RefreshTheme updates all component colors after a theme change.
 func (wl *WorkflowList) RefreshTheme() {
 	bg := theme.Bg()
@@ -182,7 +225,7 @@ func (wl *WorkflowList) updatePreview(w temporal.Workflow) {
 	endTimeStr := "-"
 	durationStr := "-"
 	if w.EndTime != nil {
-		endTimeStr = formatRelativeTime(now, *w.EndTime)
+		endTimeStr = formatTime(now, *w.EndTime)
 		durationStr = w.EndTime.Sub(w.StartTime).Round(time.Second).String()
 	} else if w.Status == "Running" {
 		durationStr = time.Since(w.StartTime).Round(time.Second).String()
@@ -218,7 +261,7 @@ func (wl *WorkflowList) updatePreview(w temporal.Workflow) {
 		theme.TagFgDim(),
 		theme.TagFg(), w.Type,
 		theme.TagFgDim(),
-		theme.TagFg(), formatRelativeTime(now, w.StartTime),
+		theme.TagFg(), formatTime(now, w.StartTime),
 		theme.TagFgDim(),
 		theme.TagFg(), endTimeStr,
 		theme.TagFgDim(),
@@ -228,9 +271,38 @@ func (wl *WorkflowList) updatePreview(w temporal.Workflow) {
 		theme.TagFgDim(),
 		theme.TagFgDim(), truncate(w.RunID, 30),
 	)
+
+	// Details the list doesn't carry are shown once prefetched, which also
+	// makes opening the workflow instant
+	if details, ok := wl.app.prefetcher().Get(wl.namespace, w.ID, w.RunID); ok {
+		text += previewDetails(details)
+	} else if wl.app.Provider() != nil {
+		wl.app.prefetcher().Prefetch(wl.namespace, w.ID, w.RunID, func(*temporal.Workflow) {
+			wl.updateSelectionPreview()
+		})
+	}
 	wl.preview.SetText(text)
 }
 
+// previewDetails formats the prefetched details of a workflow for the preview.
+func previewDetails(w *temporal.Workflow) string {
+	field := func(label, value string) string {
+		return fmt.Sprintf("\n\n[%s]%s[-]\n[%s]%s[-]", theme.TagFgDim(), label, theme.TagFg(), value)
+	}
+
+	text := field("History Events", fmt.Sprintf("%d", w.HistoryLength))
+	if w.Attempt > 1 {
+		text += field("Attempt", fmt.Sprintf("%d", w.Attempt))
+	}
+	if len(w.PendingActivities) > 0 {
+		text += field("Pending Activities", fmt.Sprintf("%d", len(w.PendingActivities)))
+	}
+	if w.ParentID != nil {
+		text += field("Parent", truncate(*w.ParentID, 35))
+	}
+	return text
+}
+
 func (wl *WorkflowList) setLoading(loading bool) {
 	wl.loading = loading
 }
@@ -243,8 +315,10 @@ func (wl *WorkflowList) loadData() {
 	}
 
 	wl.setLoading(true)
+	settings := wl.app.ListSettings()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := wl.app.startLoading(wl.leftPanel, "Loading workflows", cancel)
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
 		// Resolve time placeholders in the query
@@ -252,17 +326,15 @@ func (wl *WorkflowList) loadData() {
 		if err != nil {
 			wl.app.ShowToastError(fmt.Sprintf("Invalid query: %v", err))
 			wl.app.JigApp().QueueUpdateDraw(func() {
+				done()
 				wl.setLoading(false)
 			})
 			return
 		}
-		opts := temporal.ListOptions{
-			PageSize: 100,
-			Query:    resolvedQuery,
-		}
-		workflows, _, err := provider.ListWorkflows(ctx, wl.namespace, opts)
+		workflows, err := listWorkflowPages(ctx, provider, wl.namespace, resolvedQuery, settings)
 
 		wl.app.JigApp().QueueUpdateDraw(func() {
+			done()
 			wl.setLoading(false)
 			if err != nil {
 				wl.showError(err)
@@ -278,6 +350,39 @@ func (wl *WorkflowList) loadData() {
 	}()
 }
 
+// listWorkflowPages fetches workflows page by page until the results are
+// exhausted or the configured max results cap is reached.
+func listWorkflowPages(ctx context.Context, provider temporal.Provider, namespace, query string, settings config.ListSettings) ([]temporal.Workflow, error) {
+	// Apply the default ordering unless the query specifies its own
+	if clause := settings.OrderClause(); clause != "" && !strings.Contains(strings.ToUpper(query), "ORDER BY") {
+		query = strings.TrimSpace(query + " " + clause)
+	}
+
+	maxResults := settings.GetMaxResults()
+	opts := temporal.ListOptions{
+		PageSize: settings.GetPageSize(),
+		Query:    query,
+	}
+
+	var workflows []temporal.Workflow
+	for {
+		page, nextToken, err := provider.ListWorkflows(ctx, namespace, opts)
+		if err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, page...)
+		if nextToken == "" || len(workflows) >= maxResults {
+			break
+		}
+		opts.PageToken = nextToken
+	}
+
+	if len(workflows) > maxResults {
+		workflows = workflows[:maxResults]
+	}
+	return workflows, nil
+}
+
 // applyFilter filters allWorkflows based on filterText and updates the display.
 func (wl *WorkflowList) applyFilter() {
 	wl.applyFilterWithFallback(false)
@@ -362,7 +467,7 @@ func (wl *WorkflowList) populateTable() {
 	currentRow := wl.table.SelectedRow()
 
 	wl.table.ClearRows()
-	wl.table.SetHeaders("WORKFLOW ID", "STATUS", "TYPE", "START TIME")
+	wl.table.SetRowFunc(wl.workflowRow)
 
 	if len(wl.workflows) == 0 {
 		if len(wl.allWorkflows) == 0 {
@@ -377,17 +482,9 @@ func (wl *WorkflowList) populateTable() {
 	wl.leftPanel.SetContent(wl.table)
 
 	// Calculate dynamic column widths based on available space
-	idWidth, typeWidth := wl.calculateColumnWidths()
-
-	now := time.Now()
-	for _, w := range wl.workflows {
-		wl.table.AddStyledRowSimple(w.Status,
-			truncateIfNeeded(w.ID, idWidth),
-			w.Status,
-			truncateIfNeeded(w.Type, typeWidth),
-			formatRelativeTime(now, w.StartTime),
-		)
-	}
+	wl.idWidth, wl.typeWidth = wl.calculateColumnWidths()
+	wl.populatedAt = time.Now()
+	wl.table.SetRowCount(len(wl.workflows))
 
 	if wl.table.RowCount() > 0 {
 		if currentRow >= 0 && currentRow < len(wl.workflows) {
@@ -402,6 +499,22 @@ func (wl *WorkflowList) populateTable() {
 	}
 }
 
+// workflowRow renders workflow i for the table, which only asks for the rows in
+// view. The status cell carries its status color and icon.
+func (wl *WorkflowList) workflowRow(i int) (tcell.Color, []string) {
+	w := wl.workflows[i]
+	status := w.Status
+	if icon := theme.StatusIcon(status); icon != "" {
+		status = icon + " " + status
+	}
+	return theme.Fg(), []string{
+		truncateIfNeeded(w.ID, wl.idWidth),
+		fmt.Sprintf("[%s]%s[-]", theme.StatusColorTag(w.Status), status),
+		truncateIfNeeded(w.Type, wl.typeWidth),
+		formatTime(wl.populatedAt, w.StartTime),
+	}
+}
+
 func (wl *WorkflowList) updateStats() {
 	var running, completed, failed int
 	for _, w := range wl.workflows {
@@ -423,13 +536,10 @@ func (wl *WorkflowList) updateStats() {
 
 func (wl *WorkflowList) showError(err error) {
 	wl.table.ClearRows()
-	wl.table.SetHeaders("WORKFLOW ID", "STATUS", "TYPE", "START TIME")
-	wl.table.AddRowWithColor(theme.Error(),
-		theme.IconError+" Error loading workflows",
-		err.Error(),
-		"",
-		"",
-	)
+	wl.table.SetRowFunc(func(int) (tcell.Color, []string) {
+		return theme.Error(), []string{theme.IconError + " Error loading workflows", err.Error(), "", ""}
+	})
+	wl.table.SetRowCount(1)
 }
 
 func (wl *WorkflowList) toggleAutoRefresh() {
@@ -476,12 +586,6 @@ func (wl *WorkflowList) Name() string {
 // Start is called when the view becomes active.
 func (wl *WorkflowList) Start() {
 	wl.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Key() == tcell.KeyRune && event.Rune() == ' ' && wl.selectionMode {
-			wl.table.ToggleSelection()
-			wl.updateSelectionPreview()
-			return nil
-		}
-
 		switch event.Rune() {
 		case '/':
 			wl.showFilter()
@@ -542,14 +646,17 @@ func (wl *WorkflowList) Start() {
 		case 'W':
 			wl.showSignalWithStart()
 			return nil
+		case 'U':
+			wl.showUpdateWithStart()
+			return nil
 		case 'd':
 			wl.startDiff()
 			return nil
-		}
-
-		if event.Key() == tcell.KeyCtrlA && wl.selectionMode {
-			wl.table.SelectAll()
-			wl.updateSelectionPreview()
+		case 'm':
+			wl.showActionMenu()
+			return nil
+		case 'z':
+			wl.app.toggleZen(wl.rightPanel, wl.preview, wl.app.zenTextHints()...)
 			return nil
 		}
 
@@ -563,27 +670,39 @@ func (wl *WorkflowList) Start() {
 func (wl *WorkflowList) Stop() {
 	wl.table.SetInputCapture(nil)
 	wl.stopAutoRefresh()
+	wl.app.prefetcher().Stop()
 	wl.app.ClearWorkflowStats()
 }
 
 // Hints returns keybinding hints for this view.
 func (wl *WorkflowList) Hints() []KeyHint {
 	if wl.selectionMode {
-		hints := []KeyHint{
-			{Key: "space", Description: "Select"},
-			{Key: "Ctrl+A", Description: "Select All"},
-			{Key: "v", Description: "Exit Select"},
-		}
-		if len(wl.table.GetSelectedRows()) > 0 {
-			hints = append(hints,
-				KeyHint{Key: "c", Description: "Cancel"},
-				KeyHint{Key: "X", Description: "Terminate"},
-			)
-		}
-		hints = append(hints, KeyHint{Key: "esc", Description: "Back"})
-		return hints
+		return workflowSelectHints(len(wl.table.GetSelectedRows()) > 0)
+	}
+	return workflowListHints(wl.visibilityQuery != "")
+}
+
+// workflowSelectHints lists the bindings of select mode, with the batch actions
+// once rows are selected.
+func workflowSelectHints(selected bool) []KeyHint {
+	hints := []KeyHint{
+		{Key: "space", Description: "Select"},
+		{Key: "Ctrl+A", Description: "All/None"},
+		{Key: "v", Description: "Exit Select"},
+	}
+	if selected {
+		hints = append(hints,
+			KeyHint{Key: "c", Description: "Cancel"},
+			KeyHint{Key: "X", Description: "Terminate"},
+		)
 	}
+	hints = append(hints, KeyHint{Key: "esc", Description: "Back"})
+	return hints
+}
 
+// workflowListHints lists the bindings of the list, with those of an active
+// visibility query.
+func workflowListHints(querying bool) []KeyHint {
 	hints := []KeyHint{
 		{Key: "enter", Description: "Detail"},
 		{Key: "/", Description: "Filter"},
@@ -591,7 +710,7 @@ func (wl *WorkflowList) Hints() []KeyHint {
 		{Key: "f", Description: "Templates"},
 		{Key: "D", Description: "Date Range"},
 	}
-	if wl.visibilityQuery != "" {
+	if querying {
 		hints = append(hints,
 			KeyHint{Key: "C", Description: "Clear Query"},
 			KeyHint{Key: "S", Description: "Save Filter"},
@@ -602,9 +721,13 @@ func (wl *WorkflowList) Hints() []KeyHint {
 		KeyHint{Key: "d", Description: "Diff"},
 		KeyHint{Key: "v", Description: "Select Mode"},
 		KeyHint{Key: "W", Description: "Signal+Start"},
+		KeyHint{Key: "U", Description: "Update+Start"},
 		KeyHint{Key: "y", Description: "Copy ID"},
+		KeyHint{Key: "m", Description: "Menu"},
 		KeyHint{Key: "r", Description: "Refresh"},
 		KeyHint{Key: "p", Description: "Preview"},
+		KeyHint{Key: "z", Description: "Maximize Preview"},
+		KeyHint{Key: "</>", Description: "Resize"},
 		KeyHint{Key: "a", Description: "Auto-refresh"},
 		KeyHint{Key: "t", Description: "Task Queues"},
 		KeyHint{Key: "s", Description: "Schedules"},
@@ -615,6 +738,14 @@ func (wl *WorkflowList) Hints() []KeyHint {
 	return hints
 }
 
+// helpHints lists the bindings of the list and of select mode.
+func (wl *WorkflowList) helpHints() []KeyHint {
+	return mergeHints(workflowListHints(true), workflowSelectHints(false), []KeyHint{
+		{Key: "c", Description: "Cancel Selected"},
+		{Key: "X", Description: "Terminate Selected"},
+	})
+}
+
 // HandleEscape implements EscapeHandler to clear filter state before navigation.
 func (wl *WorkflowList) HandleEscape() bool {
 	if wl.filterText != "" || wl.visibilityQuery != "" || wl.originalWorkflows != nil {
@@ -821,30 +952,47 @@ func (wl *WorkflowList) copyWorkflowID() {
 		return
 	}
 
-	wf := wl.workflows[row]
-	if err := copyToClipboard(wf.ID); err != nil {
-		wl.preview.SetText(fmt.Sprintf("[%s]%s Failed to copy: %s[-]",
-			theme.TagError(), theme.IconError, err.Error()))
+	wl.app.CopyToClipboard(wl.workflows[row].ID, "Workflow ID")
+}
+
+// showActionMenu opens the action menu of the selected workflow. Actions on a
+// single workflow open its detail view and start there once it has loaded.
+func (wl *WorkflowList) showActionMenu() {
+	row := wl.table.SelectedRow()
+	if row < 0 || row >= len(wl.workflows) {
 		return
 	}
+	wf := wl.workflows[row]
+	inDetail := func(action func(wd *WorkflowDetail)) func() {
+		return func() {
+			wd := wl.app.openWorkflowDetail(wf.ID, wf.RunID)
+			wd.whenLoaded(func() { action(wd) })
+		}
+	}
 
-	wl.preview.SetText(fmt.Sprintf(`[%s::b]Copied to clipboard[-:-:-]
-
-[%s]%s[-]
-
-[%s]Workflow ID copied![-]`,
-		theme.TagPanelTitle(),
-		theme.TagAccent(), wf.ID,
-		theme.TagSuccess()))
-
-	go func() {
-		time.Sleep(1500 * time.Millisecond)
-		wl.app.JigApp().QueueUpdateDraw(func() {
-			if row < len(wl.workflows) {
-				wl.updatePreview(wl.workflows[row])
-			}
-		})
-	}()
+	actions := []rowAction{
+		{Label: "Describe", Key: "enter", Run: func() { wl.app.NavigateToWorkflowDetail(wf.ID, wf.RunID) }},
+		{Label: "Event History", Run: func() { wl.app.NavigateToEvents(wf.ID, wf.RunID) }},
+	}
+	if wf.Status == "Running" {
+		actions = append(actions,
+			rowAction{Label: "Signal", Run: inDetail((*WorkflowDetail).showSignalInput)},
+			rowAction{Label: "Query", Run: inDetail((*WorkflowDetail).showQueryInput)},
+			rowAction{Label: "Cancel", Run: inDetail((*WorkflowDetail).showCancelConfirm)},
+			rowAction{Label: "Terminate", Run: inDetail((*WorkflowDetail).showTerminateConfirm)},
+		)
+	}
+	actions = append(actions,
+		rowAction{Label: "Reset", Run: inDetail((*WorkflowDetail).showResetSelector)},
+		rowAction{Label: "Delete", Run: inDetail((*WorkflowDetail).showDeleteConfirm)},
+		rowAction{Label: "Compare (Diff)", Key: "d", Run: wl.startDiff},
+		rowAction{Label: "Copy Workflow ID", Key: "y", Run: wl.copyWorkflowID},
+		rowAction{Label: "Copy Run ID", Run: func() { wl.app.CopyToClipboard(wf.RunID, "Run ID") }},
+		rowAction{Label: "Open in Web UI", Run: func() {
+			wl.app.OpenInWebUI(wl.app.webProfile().WorkflowWebURL(wl.namespace, wf.ID, wf.RunID))
+		}},
+	)
+	wl.app.showActionMenu("Workflow "+wf.ID, actions)
 }
 
 func formatRelativeTime(now time.Time, t time.Time) string {
@@ -1029,15 +1177,28 @@ func (wl *WorkflowList) showBatchCancelConfirm() {
 		}
 	}
 
+	mode := wl.app.ConfirmMode(config.OpBatchCancel)
+	if mode == config.ConfirmSkip {
+		wl.executeBatchCancel(selected, "Batch cancelled via tempo")
+		return
+	}
+	// Typed confirmations ask for the number of workflows affected
+	expected := strconv.Itoa(runningCount)
+
+	height := 14
+	if mode == config.ConfirmTyped {
+		height = 16
+	}
 	modal := components.NewModal(components.ModalConfig{
 		Title:    fmt.Sprintf("%s Cancel %d Workflow(s)", theme.IconWarning, len(selected)),
 		Width:    60,
-		Height:   14,
+		Height:   height,
 		Backdrop: true,
 	})
 
 	form := components.NewForm()
 	form.AddTextField("reason", "Reason (optional)", "Batch cancelled via tempo")
+	addTypedConfirmField(form, mode, fmt.Sprintf("Type %s to confirm", expected))
 
 	infoText := tview.NewTextView().SetDynamicColors(true)
 	infoText.SetBackgroundColor(theme.Bg())
@@ -1060,6 +1221,9 @@ func (wl *WorkflowList) showBatchCancelConfirm() {
 	})
 	modal.SetOnSubmit(func() {
 		values := form.GetValues()
+		if !typedConfirmMatches(mode, values, expected) {
+			return
+		}
 		reason := values["reason"].(string)
 		wl.closeModal("batch-cancel")
 		wl.executeBatchCancel(selected, reason)
@@ -1068,8 +1232,7 @@ func (wl *WorkflowList) showBatchCancelConfirm() {
 		wl.closeModal("batch-cancel")
 	})
 
-	wl.app.JigApp().Pages().AddPage("batch-cancel", modal, true, true)
-	wl.app.JigApp().SetFocus(form)
+	wl.app.Modals().Show("batch-cancel", modal, form)
 }
 
 func (wl *WorkflowList) executeBatchCancel(indices []int, reason string) {
@@ -1079,7 +1242,7 @@ func (wl *WorkflowList) executeBatchCancel(indices []int, reason string) {
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		var succeeded, failed int
@@ -1128,15 +1291,28 @@ func (wl *WorkflowList) showBatchTerminateConfirm() {
 		}
 	}
 
+	mode := wl.app.ConfirmMode(config.OpBatchTerminate)
+	if mode == config.ConfirmSkip {
+		wl.executeBatchTerminate(selected, "Batch terminated via tempo")
+		return
+	}
+	// Typed confirmations ask for the number of workflows affected
+	expected := strconv.Itoa(runningCount)
+
+	height := 16
+	if mode == config.ConfirmTyped {
+		height = 18
+	}
 	modal := components.NewModal(components.ModalConfig{
 		Title:    fmt.Sprintf("%s Terminate %d Workflow(s)", theme.IconError, len(selected)),
 		Width:    65,
-		Height:   16,
+		Height:   height,
 		Backdrop: true,
 	})
 
 	form := components.NewForm()
 	form.AddTextField("reason", "Reason (required)", "")
+	addTypedConfirmField(form, mode, fmt.Sprintf("Type %s to confirm", expected))
 
 	warningText := tview.NewTextView().SetDynamicColors(true)
 	warningText.SetBackgroundColor(theme.Bg())
@@ -1163,7 +1339,7 @@ func (wl *WorkflowList) showBatchTerminateConfirm() {
 	modal.SetOnSubmit(func() {
 		values := form.GetValues()
 		reason := values["reason"].(string)
-		if reason == "" {
+		if reason == "" || !typedConfirmMatches(mode, values, expected) {
 			return // Require reason for terminate
 		}
 		wl.closeModal("batch-terminate")
@@ -1173,8 +1349,7 @@ func (wl *WorkflowList) showBatchTerminateConfirm() {
 		wl.closeModal("batch-terminate")
 	})
 
-	wl.app.JigApp().Pages().AddPage("batch-terminate", modal, true, true)
-	wl.app.JigApp().SetFocus(form)
+	wl.app.Modals().Show("batch-terminate", modal, form)
 }
 
 func (wl *WorkflowList) executeBatchTerminate(indices []int, reason string) {
@@ -1184,7 +1359,7 @@ func (wl *WorkflowList) executeBatchTerminate(indices []int, reason string) {
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		var succeeded, failed int
@@ -1220,8 +1395,7 @@ func (wl *WorkflowList) executeBatchTerminate(indices []int, reason string) {
 }
 
 func (wl *WorkflowList) closeModal(name string) {
-	wl.app.JigApp().Pages().RemovePage(name)
-	wl.app.JigApp().SetFocus(wl.table)
+	wl.app.Modals().Close(name)
 }
 
 // Visibility query methods
@@ -1275,8 +1449,7 @@ func (wl *WorkflowList) showVisibilityQuery() {
 		wl.closeModal("visibility-query")
 	})
 
-	wl.app.JigApp().Pages().AddPage("visibility-query", modal, true, true)
-	wl.app.JigApp().SetFocus(form)
+	wl.app.Modals().Show("visibility-query", modal, form)
 }
 
 func (wl *WorkflowList) applyVisibilityQuery(query string) {
@@ -1357,8 +1530,7 @@ func (wl *WorkflowList) showQueryTemplates() {
 		wl.closeModal("query-templates")
 	})
 
-	wl.app.JigApp().Pages().AddPage("query-templates", modal, true, true)
-	wl.app.JigApp().SetFocus(table)
+	wl.app.Modals().Show("query-templates", modal, table)
 }
 
 func (wl *WorkflowList) showDateRangePicker() {
@@ -1405,8 +1577,7 @@ func (wl *WorkflowList) showDateRangePicker() {
 		wl.closeModal("date-range")
 	})
 
-	wl.app.JigApp().Pages().AddPage("date-range", modal, true, true)
-	wl.app.JigApp().SetFocus(form)
+	wl.app.Modals().Show("date-range", modal, form)
 }
 
 func (wl *WorkflowList) applyDatePreset(preset string) {
@@ -1480,8 +1651,7 @@ func (wl *WorkflowList) showSavedFilters() {
 		wl.closeModal("saved-filters")
 	})
 
-	wl.app.JigApp().Pages().AddPage("saved-filters", modal, true, true)
-	wl.app.JigApp().SetFocus(table)
+	wl.app.Modals().Show("saved-filters", modal, table)
 }
 
 func (wl *WorkflowList) showNoSavedFilters() {
@@ -1510,8 +1680,7 @@ Your queries will be saved here.[-]`,
 		wl.closeModal("saved-filters")
 	})
 
-	wl.app.JigApp().Pages().AddPage("saved-filters", modal, true, true)
-	wl.app.JigApp().SetFocus(modal)
+	wl.app.Modals().Show("saved-filters", modal, nil)
 }
 
 func (wl *WorkflowList) showSaveFilter() {
@@ -1560,8 +1729,7 @@ func (wl *WorkflowList) showSaveFilter() {
 		wl.closeModal("save-filter")
 	})
 
-	wl.app.JigApp().Pages().AddPage("save-filter", modal, true, true)
-	wl.app.JigApp().SetFocus(form)
+	wl.app.Modals().Show("save-filter", modal, form)
 }
 
 func (wl *WorkflowList) clearVisibilityQuery() {
@@ -1689,48 +1857,6 @@ func startOfWeek(t time.Time) time.Time {
 	return startOfDay(monday)
 }
 
-func copyToClipboard(text string) error {
-	// Use OS-specific clipboard commands
-	var cmd *exec.Cmd
-
-	switch runtime.GOOS {
-	case "darwin":
-		cmd = exec.Command("pbcopy")
-	case "linux":
-		// Try xclip first, fall back to xsel
-		if _, err := exec.LookPath("xclip"); err == nil {
-			cmd = exec.Command("xclip", "-selection", "clipboard")
-		} else if _, err := exec.LookPath("xsel"); err == nil {
-			cmd = exec.Command("xsel", "--clipboard", "--input")
-		} else {
-			return fmt.Errorf("clipboard not available: install xclip or xsel")
-		}
-	case "windows":
-		cmd = exec.Command("clip")
-	default:
-		return fmt.Errorf("clipboard not supported on %s", runtime.GOOS)
-	}
-
-	pipe, err := cmd.StdinPipe()
-	if err != nil {
-		return err
-	}
-
-	if err := cmd.Start(); err != nil {
-		return err
-	}
-
-	if _, err := pipe.Write([]byte(text)); err != nil {
-		return err
-	}
-
-	if err := pipe.Close(); err != nil {
-		return err
-	}
-
-	return cmd.Wait()
-}
-
 // showSignalWithStart displays a modal for SignalWithStart operation.
 func (wl *WorkflowList) showSignalWithStart() {
 	modal := components.NewModal(components.ModalConfig{
@@ -1793,8 +1919,7 @@ func (wl *WorkflowList) showSignalWithStart() {
 		wl.closeModal("signal-with-start")
 	})
 
-	wl.app.JigApp().Pages().AddPage("signal-with-start", modal, true, true)
-	wl.app.JigApp().SetFocus(form)
+	wl.app.Modals().Show("signal-with-start", modal, form)
 }
 
 // executeSignalWithStart performs the SignalWithStart operation asynchronously.
@@ -1805,7 +1930,7 @@ func (wl *WorkflowList) executeSignalWithStart(workflowID, workflowType, taskQue
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
 		req := temporal.SignalWithStartRequest{
@@ -1826,13 +1951,107 @@ func (wl *WorkflowList) executeSignalWithStart(workflowID, workflowType, taskQue
 
 		wl.app.JigApp().QueueUpdateDraw(func() {
 			if err != nil {
-				ShowErrorModal(wl.app.JigApp(), "SignalWithStart Failed", err.Error())
+				ShowErrorModal(wl.app.Modals(), "SignalWithStart Failed", err.Error())
 				return
 			}
 
-			ShowInfoModal(wl.app.JigApp(), "SignalWithStart Successful",
+			ShowInfoModal(wl.app.Modals(), "SignalWithStart Successful",
 				fmt.Sprintf("Workflow: %s\nRun ID: %s", workflowID, runID))
 			wl.loadData() // Refresh the workflow list
 		})
 	}()
 }
+
+// showUpdateWithStart displays a modal for the UpdateWithStart operation.
+func (wl *WorkflowList) showUpdateWithStart() {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Update With Start (%s)", theme.IconInfo, wl.namespace),
+		Width:    70,
+		Height:   20,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("workflowId", "Workflow ID", "")
+	form.AddTextField("workflowType", "Workflow Type", "")
+	form.AddTextField("taskQueue", "Task Queue", "")
+	form.AddTextField("updateName", "Update Name", "")
+	form.AddTextField("updateInput", "Update Input (JSON, optional)", "")
+	form.AddTextField("workflowInput", "Workflow Input (JSON, optional)", "")
+
+	submit := func(values map[string]any) {
+		req := temporal.UpdateWithStartRequest{
+			WorkflowID:   values["workflowId"].(string),
+			WorkflowType: values["workflowType"].(string),
+			TaskQueue:    values["taskQueue"].(string),
+			UpdateName:   values["updateName"].(string),
+		}
+
+		// Validate required fields
+		if req.WorkflowID == "" || req.WorkflowType == "" || req.TaskQueue == "" || req.UpdateName == "" {
+			return
+		}
+
+		if input := values["updateInput"].(string); input != "" {
+			req.UpdateInput = []byte(input)
+		}
+		if input := values["workflowInput"].(string); input != "" {
+			req.WorkflowInput = []byte(input)
+		}
+
+		wl.closeModal("update-with-start-form")
+		wl.executeUpdateWithStart(req)
+	}
+
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		wl.closeModal("update-with-start-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Execute"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		wl.closeModal("update-with-start-form")
+	})
+
+	wl.app.Modals().Show("update-with-start-form", modal, form)
+}
+
+// executeUpdateWithStart performs the UpdateWithStart operation asynchronously.
+func (wl *WorkflowList) executeUpdateWithStart(req temporal.UpdateWithStartRequest) {
+	provider := wl.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		result, err := provider.UpdateWithStartWorkflow(ctx, wl.namespace, req)
+
+		wl.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(wl.app.Modals(), "UpdateWithStart Failed", err.Error())
+				return
+			}
+			if result.Error != "" {
+				ShowErrorModal(wl.app.Modals(), "Update Rejected",
+					fmt.Sprintf("Workflow: %s\nRun ID: %s\n\n%s", req.WorkflowID, result.RunID, result.Error))
+				wl.loadData() // The workflow may still have been started
+				return
+			}
+
+			ShowInfoModal(wl.app.Modals(), "UpdateWithStart Successful",
+				fmt.Sprintf("Workflow: %s\nRun ID: %s\nResult: %s", req.WorkflowID, result.RunID, truncate(result.Result, 200)))
+			wl.loadData() // Refresh the workflow list
+		})
+	}()
+}
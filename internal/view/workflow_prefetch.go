@@ -0,0 +1,146 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+const (
+	// prefetchDelay is how long a row stays highlighted before its details are
+	// prefetched, so scrolling through a list doesn't send a request per row.
+	prefetchDelay = 300 * time.Millisecond
+
+	// prefetchTTL is how long prefetched details are shown before a view has
+	// loaded its own.
+	prefetchTTL = 30 * time.Second
+
+	// prefetchCacheSize caps how many prefetched workflows are kept.
+	prefetchCacheSize = 50
+)
+
+// prefetchedWorkflow is a workflow's details and where and when they were fetched.
+type prefetchedWorkflow struct {
+	provider temporal.Provider
+	workflow *temporal.Workflow
+	fetched  time.Time
+}
+
+// workflowPrefetcher loads the details of the highlighted workflow in the
+// background, so its detail view and the list preview have them without
+// waiting. It is only used on the UI thread.
+type workflowPrefetcher struct {
+	app     *App
+	key     string // Workflow waited for or being fetched
+	timer   *time.Timer
+	cancel  context.CancelFunc
+	entries map[string]prefetchedWorkflow
+	order   []string // Keys of entries, oldest first
+}
+
+// prefetcher returns the app's workflow prefetcher.
+func (a *App) prefetcher() *workflowPrefetcher {
+	if a.prefetch == nil {
+		a.prefetch = &workflowPrefetcher{
+			app:     a,
+			entries: make(map[string]prefetchedWorkflow),
+		}
+	}
+	return a.prefetch
+}
+
+// workflowKey identifies a workflow run across namespaces.
+func workflowKey(namespace, workflowID, runID string) string {
+	return namespace + "/" + workflowID + "/" + runID
+}
+
+// Prefetch loads a workflow's details once it has stayed highlighted for
+// prefetchDelay, replacing any prefetch not yet done. onFetched is called with
+// the details unless another workflow is prefetched meanwhile; it isn't called
+// for details already cached.
+func (p *workflowPrefetcher) Prefetch(namespace, workflowID, runID string, onFetched func(*temporal.Workflow)) {
+	key := workflowKey(namespace, workflowID, runID)
+	if key == p.key {
+		return
+	}
+	p.Stop()
+	if _, ok := p.Get(namespace, workflowID, runID); ok {
+		return
+	}
+
+	p.key = key
+	p.timer = time.AfterFunc(prefetchDelay, func() {
+		p.app.app.QueueUpdateDraw(func() {
+			if p.key == key {
+				p.fetch(key, namespace, workflowID, runID, onFetched)
+			}
+		})
+	})
+}
+
+// fetch loads a workflow's details and caches them.
+func (p *workflowPrefetcher) fetch(key, namespace, workflowID, runID string, onFetched func(*temporal.Workflow)) {
+	provider := p.app.Provider()
+	if provider == nil {
+		p.key = ""
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	go func() {
+		workflow, err := provider.GetWorkflow(ctx, namespace, workflowID, runID)
+		cancel()
+
+		p.app.app.QueueUpdateDraw(func() {
+			if p.key != key {
+				return // Superseded by another row
+			}
+			p.key = ""
+			p.cancel = nil
+			if err != nil {
+				return // Views load and report errors themselves
+			}
+			p.put(key, prefetchedWorkflow{provider: provider, workflow: workflow, fetched: time.Now()})
+			if onFetched != nil {
+				onFetched(workflow)
+			}
+		})
+	}()
+}
+
+// put caches a workflow's details, dropping the oldest beyond prefetchCacheSize.
+func (p *workflowPrefetcher) put(key string, entry prefetchedWorkflow) {
+	if _, ok := p.entries[key]; !ok {
+		p.order = append(p.order, key)
+	}
+	p.entries[key] = entry
+	for len(p.order) > prefetchCacheSize {
+		delete(p.entries, p.order[0])
+		p.order = p.order[1:]
+	}
+}
+
+// Get returns a workflow's prefetched details if they are recent and from the
+// active connection.
+func (p *workflowPrefetcher) Get(namespace, workflowID, runID string) (*temporal.Workflow, bool) {
+	entry, ok := p.entries[workflowKey(namespace, workflowID, runID)]
+	if !ok || entry.provider != p.app.Provider() || time.Since(entry.fetched) > prefetchTTL {
+		return nil, false
+	}
+	return entry.workflow, true
+}
+
+// Stop drops the pending prefetch, cancelling it if in flight.
+func (p *workflowPrefetcher) Stop() {
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+	if p.cancel != nil {
+		p.cancel()
+		p.cancel = nil
+	}
+	p.key = ""
+}
@@ -0,0 +1,203 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// rerunInput returns the original workflow input as editable JSON: the argument itself
+// for a single argument, or a JSON array for several. ok is false when any argument
+// was truncated or could not be decoded, in which case the input cannot be edited.
+func (wd *WorkflowDetail) rerunInput() (input string, ok bool) {
+	var started *temporal.EnhancedHistoryEvent
+	for i := range wd.allEvents {
+		if wd.allEvents[i].Type == "WorkflowExecutionStarted" {
+			started = &wd.allEvents[i]
+			break
+		}
+	}
+	if started == nil || started.Truncated {
+		return "", false
+	}
+
+	var args []string
+	for _, p := range started.Payloads {
+		if p.Field != "input" {
+			continue
+		}
+		if !p.Decoded || p.Truncated {
+			return "", false
+		}
+		args = append(args, p.Data)
+	}
+
+	switch len(args) {
+	case 0:
+		return "", true
+	case 1:
+		return args[0], true
+	default:
+		return "[" + strings.Join(args, ",") + "]", true
+	}
+}
+
+// rerunArgs converts edited input back into per-argument JSON. A JSON array is
+// split into arguments when the original run had more than one.
+func rerunArgs(input string, multiple bool) ([][]byte, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return [][]byte{}, nil
+	}
+	if !json.Valid([]byte(input)) {
+		return nil, fmt.Errorf("input is not valid JSON")
+	}
+	if !multiple {
+		return [][]byte{[]byte(input)}, nil
+	}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal([]byte(input), &raw); err != nil {
+		return nil, fmt.Errorf("input must be a JSON array of arguments")
+	}
+	args := make([][]byte, len(raw))
+	for i, r := range raw {
+		args[i] = r
+	}
+	return args, nil
+}
+
+// showRerunForm opens a form to start a new execution with the same type, options and
+// input as the current run. The workflow ID, task queue and input can be edited first.
+func (wd *WorkflowDetail) showRerunForm() {
+	if wd.workflow == nil {
+		return
+	}
+	if wd.workflow.Status == "Running" {
+		wd.app.ShowToastError("Workflow is still running")
+		return
+	}
+
+	original, editable := wd.rerunInput()
+	multiple := wd.inputArgCount() > 1
+
+	inputLabel := "Input (JSON)"
+	if !editable {
+		inputLabel = "Input (JSON, empty keeps original)"
+	}
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Re-run Workflow", theme.IconRunning),
+		Width:    80,
+		Height:   17,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("workflowId", "Workflow ID", "")
+	form.AddTextField("taskQueue", "Task Queue", "")
+	form.AddTextField("input", inputLabel, "")
+	_ = form.SetValues(map[string]any{
+		"workflowId": wd.workflowID,
+		"taskQueue":  wd.workflow.TaskQueue,
+		"input":      original,
+	})
+
+	submit := func(values map[string]any) {
+		workflowID := strings.TrimSpace(values["workflowId"].(string))
+		if workflowID == "" {
+			return // Require workflow ID
+		}
+		opts := temporal.RerunOptions{
+			WorkflowID: workflowID,
+			TaskQueue:  strings.TrimSpace(values["taskQueue"].(string)),
+		}
+
+		// Unchanged input reuses the original payloads, preserving their encoding
+		input := values["input"].(string)
+		if (editable && input != original) || (!editable && strings.TrimSpace(input) != "") {
+			args, err := rerunArgs(input, multiple)
+			if err != nil {
+				wd.app.ShowToastError(err.Error())
+				return
+			}
+			opts.Args = args
+		}
+
+		wd.closeModal("rerun-form")
+		wd.executeRerun(opts)
+	}
+
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		wd.closeModal("rerun-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Start"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal("rerun-form")
+	})
+
+	wd.app.Modals().Show("rerun-form", modal, form)
+}
+
+// inputArgCount returns the number of input arguments recorded in the start event.
+func (wd *WorkflowDetail) inputArgCount() int {
+	for _, ev := range wd.allEvents {
+		if ev.Type != "WorkflowExecutionStarted" {
+			continue
+		}
+		count := 0
+		for _, p := range ev.Payloads {
+			if p.Field == "input" {
+				count++
+			}
+		}
+		return count
+	}
+	return 0
+}
+
+func (wd *WorkflowDetail) executeRerun(opts temporal.RerunOptions) {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		workflowID, runID, err := provider.RerunWorkflow(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID, opts)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.showErrorResultModal(
+					fmt.Sprintf("%s Re-run Failed", theme.IconError),
+					"rerun-error",
+					"Error starting workflow:",
+					err.Error(),
+				)
+				return
+			}
+			if workflowID == wd.workflowID {
+				wd.switchRun(runID)
+				return
+			}
+			wd.app.NavigateToWorkflowDetail(workflowID, runID)
+		})
+	}()
+}
@@ -0,0 +1,177 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+func (wd *WorkflowDetail) showRuns() {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		runs, err := provider.ListWorkflowRuns(ctx, wd.app.CurrentNamespace(), wd.workflowID)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.showErrorResultModal(
+					fmt.Sprintf("%s Workflow Runs", theme.IconError),
+					"runs-error",
+					"Error listing runs:",
+					err.Error(),
+				)
+				return
+			}
+			wd.showRunsPicker(runs)
+		})
+	}()
+}
+
+func (wd *WorkflowDetail) showRunsPicker(runs []temporal.Workflow) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:     fmt.Sprintf("%s Runs of %s (%d)", theme.IconWorkflow, truncateStr(wd.workflowID, 40), len(runs)),
+		Width:     100,
+		Height:    22,
+		MinHeight: 12,
+		Backdrop:  true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders(" ", "RUN ID", "STATUS", "STARTED", "CLOSED", "DURATION")
+	table.SetBackgroundColor(theme.Bg())
+
+	currentIdx := 0
+	for i, run := range runs {
+		marker := " "
+		if run.RunID == wd.currentRunID() {
+			marker = "●"
+			currentIdx = i
+		}
+
+		closed := "-"
+		duration := time.Since(run.StartTime).Round(time.Second).String()
+		if run.EndTime != nil {
+			closed = formatTimestamp(*run.EndTime)
+			duration = run.EndTime.Sub(run.StartTime).Round(time.Second).String()
+		}
+
+		table.AddStyledRowSimple(run.Status,
+			marker,
+			run.RunID,
+			run.Status,
+			formatTimestamp(run.StartTime),
+			closed,
+			duration,
+		)
+	}
+
+	selectRun := func() {
+		row := table.SelectedRow()
+		if row < 0 || row >= len(runs) {
+			return
+		}
+		wd.closeModal("runs-picker")
+		if runs[row].RunID != wd.currentRunID() {
+			wd.switchRun(runs[row].RunID)
+		}
+	}
+
+	// Compare the selected run against the one currently displayed
+	compareRun := func() {
+		row := table.SelectedRow()
+		if row < 0 || row >= len(runs) || wd.workflow == nil || runs[row].RunID == wd.currentRunID() {
+			return
+		}
+		current := *wd.workflow
+		other := runs[row]
+		wd.closeModal("runs-picker")
+		wd.app.NavigateToWorkflowDiff(&current, &other)
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			selectRun()
+			return nil
+		case tcell.KeyEscape:
+			wd.closeModal("runs-picker")
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'r':
+				wd.closeModal("runs-picker")
+				wd.showRuns()
+				return nil
+			case 'c':
+				compareRun()
+				return nil
+			case 'q':
+				wd.closeModal("runs-picker")
+				return nil
+			}
+		}
+		return event
+	})
+
+	if len(runs) == 0 {
+		empty := tview.NewTextView().SetDynamicColors(true)
+		empty.SetBackgroundColor(theme.Bg())
+		empty.SetText(fmt.Sprintf("\n [%s]No runs found in visibility for this workflow ID[-]", theme.TagFgDim()))
+		modal.SetContent(empty)
+	} else {
+		modal.SetContent(table)
+		table.SelectRow(currentIdx)
+	}
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "Enter", Description: "Switch run"},
+		{Key: "c", Description: "Compare"},
+		{Key: "r", Description: "Refresh"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal("runs-picker")
+	})
+
+	wd.app.Modals().Show("runs-picker", modal, table)
+}
+
+// currentRunID returns the run being displayed, resolving an empty run ID
+// (latest run) once the workflow has loaded.
+func (wd *WorkflowDetail) currentRunID() string {
+	if wd.runID == "" && wd.workflow != nil {
+		return wd.workflow.RunID
+	}
+	return wd.runID
+}
+
+// switchRun reloads the detail view for another run of the same workflow ID.
+func (wd *WorkflowDetail) switchRun(runID string) {
+	// Following tails the old run; stop it before its events land in the new one
+	wd.stopFollow()
+	wd.runID = runID
+	wd.workflow = nil
+	wd.ancestors = nil
+	wd.allEvents = nil
+	wd.events = nil
+	wd.eventTable.ClearRows()
+	wd.eventDetailView.SetText("")
+	wd.setCurrentDetails("")
+	wd.setFailureSummary(nil)
+	wd.workflowView.SetText("")
+	wd.updateEventsTitle()
+	wd.app.JigApp().Menu().SetHints(wd.Hints())
+	wd.loadData()
+}
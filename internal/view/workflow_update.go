@@ -0,0 +1,258 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+func (wd *WorkflowDetail) showUpdateInput() {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Update Workflow", theme.IconSignal),
+		Width:    70,
+		Height:   16 + jsonPreviewHeight,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("updateName", "Update Name", "")
+	form.AddTextField("args", "Arguments (JSON, optional)", "")
+
+	var args *jsonInput
+	submit := func(force bool) {
+		values := form.GetValues()
+		updateName := values["updateName"].(string)
+		if updateName == "" {
+			return // Require update name
+		}
+		if !args.valid(force) {
+			return
+		}
+		wd.closeModal("update-input")
+		wd.executeUpdateWorkflow(updateName, values["args"].(string))
+	}
+	args = newJSONInput(wd.app, form, "args", submit)
+	form.SetOnSubmit(func(map[string]any) {
+		submit(false)
+	})
+	form.SetOnCancel(func() {
+		wd.closeModal("update-input")
+	})
+
+	modal.SetContent(args.layout)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Send update"},
+		{Key: "Ctrl+F", Description: "Send invalid JSON"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(false)
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal("update-input")
+	})
+
+	wd.app.Modals().Show("update-input", modal, form)
+}
+
+func (wd *WorkflowDetail) executeUpdateWorkflow(updateName, args string) {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var argsBytes []byte
+		if args != "" {
+			argsBytes = []byte(args)
+		}
+
+		result, err := provider.UpdateWorkflow(
+			ctx,
+			wd.app.CurrentNamespace(),
+			wd.workflowID,
+			wd.runID,
+			updateName,
+			argsBytes,
+		)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.showErrorResultModal(
+					fmt.Sprintf("%s Update Failed: %s", theme.IconError, updateName),
+					"update-error",
+					"Error sending update:",
+					err.Error(),
+				)
+				return
+			}
+			if result.Error != "" {
+				wd.showErrorResultModal(
+					fmt.Sprintf("%s Update Rejected: %s", theme.IconError, updateName),
+					"update-error",
+					"Update was rejected or failed:",
+					result.Error,
+				)
+				return
+			}
+			wd.showResultModal(
+				fmt.Sprintf("%s Update Result: %s", theme.IconCompleted, updateName),
+				"update-result",
+				result.Result,
+			)
+			wd.loadData() // Refresh to show update events
+		})
+	}()
+}
+
+func (wd *WorkflowDetail) showUpdates() {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		updates, err := provider.ListWorkflowUpdates(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.showErrorResultModal(
+					fmt.Sprintf("%s Updates", theme.IconError),
+					"updates-error",
+					"Error loading updates:",
+					err.Error(),
+				)
+				return
+			}
+			wd.showUpdatesModal(updates)
+		})
+	}()
+}
+
+func (wd *WorkflowDetail) showUpdatesModal(updates []temporal.WorkflowUpdate) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:     fmt.Sprintf("%s Workflow Updates (%d)", theme.IconSignal, len(updates)),
+		Width:     0,
+		Height:    0,
+		MinWidth:  90,
+		MinHeight: 24,
+		Backdrop:  true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders("UPDATE ID", "NAME", "STATUS", "ADMITTED")
+	table.SetBorder(false)
+	table.SetBackgroundColor(theme.Bg())
+
+	detailView := tview.NewTextView().SetDynamicColors(true).SetWrap(true)
+	detailView.SetBackgroundColor(theme.Bg())
+
+	now := time.Now()
+	for _, u := range updates {
+		table.AddStyledRowSimple(u.Status,
+			truncateStr(u.UpdateID, 30),
+			u.Name,
+			u.Status,
+			formatTime(now, u.AdmittedTime),
+		)
+	}
+
+	if len(updates) == 0 {
+		detailView.SetText(fmt.Sprintf("\n [%s]No updates recorded in this workflow's history[-]", theme.TagFgDim()))
+	}
+
+	table.SetSelectionChangedFunc(func(row, col int) {
+		if row > 0 && row-1 < len(updates) {
+			detailView.SetText(formatUpdateDetail(updates[row-1]))
+		}
+	})
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 'r':
+			wd.closeModal("updates-modal")
+			wd.showUpdates()
+			return nil
+		case 'q':
+			wd.closeModal("updates-modal")
+			return nil
+		}
+		return event
+	})
+
+	tablePanel := components.NewPanel().SetTitle("Updates")
+	tablePanel.SetContent(table)
+	detailPanel := components.NewPanel().SetTitle("Detail")
+	detailPanel.SetContent(detailView)
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow)
+	content.SetBackgroundColor(theme.Bg())
+	content.AddItem(tablePanel, 0, 1, true)
+	content.AddItem(detailPanel, 0, 1, false)
+
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "r", Description: "Refresh"},
+		{Key: "Esc", Description: "Close"},
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal("updates-modal")
+	})
+
+	wd.app.Modals().Show("updates-modal", modal, table)
+
+	if len(updates) > 0 {
+		table.SelectRow(0)
+		detailView.SetText(formatUpdateDetail(updates[0]))
+	}
+}
+
+// formatUpdateDetail renders a single update for the detail pane.
+func formatUpdateDetail(u temporal.WorkflowUpdate) string {
+	completed := "-"
+	if u.CompletedTime != nil {
+		completed = inTimezone(*u.CompletedTime).Format("2006-01-02 15:04:05.000")
+	}
+
+	text := fmt.Sprintf(`
+[%s::b]Update ID[-:-:-]    [%s]%s[-]
+[%s::b]Name[-:-:-]         [%s]%s[-]
+[%s::b]Status[-:-:-]       [%s]%s %s[-]
+[%s::b]Event ID[-:-:-]     [%s]%d[-]
+[%s::b]Admitted[-:-:-]     [%s]%s[-]
+[%s::b]Completed[-:-:-]    [%s]%s[-]
+[%s::b]Identity[-:-:-]     [%s]%s[-]`,
+		theme.TagFgDim(), theme.TagFg(), u.UpdateID,
+		theme.TagFgDim(), theme.TagFg(), u.Name,
+		theme.TagFgDim(), theme.StatusColorTag(u.Status), theme.StatusIcon(u.Status), u.Status,
+		theme.TagFgDim(), theme.TagFg(), u.EventID,
+		theme.TagFgDim(), theme.TagFg(), inTimezone(u.AdmittedTime).Format("2006-01-02 15:04:05.000"),
+		theme.TagFgDim(), theme.TagFg(), completed,
+		theme.TagFgDim(), theme.TagFg(), u.Identity,
+	)
+
+	if u.Input != "" {
+		text += fmt.Sprintf("\n\n[%s::b]Input[-:-:-]\n%s", theme.TagFgDim(), highlightJSONText(u.Input))
+	}
+	if u.Result != "" {
+		text += fmt.Sprintf("\n\n[%s::b]Result[-:-:-]\n%s", theme.TagFgDim(), highlightJSONText(u.Result))
+	}
+	if u.Failure != "" {
+		text += fmt.Sprintf("\n\n[%s::b]Failure[-:-:-]\n[%s]%s[-]", theme.TagFgDim(), theme.TagError(), u.Failure)
+	}
+	return text
+}
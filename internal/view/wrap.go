@@ -0,0 +1,66 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+)
+
+// detailScrollStep is how many columns h/l scroll an unwrapped detail pane.
+const detailScrollStep = 4
+
+// wrapKey toggles wrapping, in views and detail modals alike.
+const wrapKey = 'W'
+
+// detailWrap reports whether detail panes and modals wrap long lines, rather
+// than cutting them off at the edge and scrolling sideways.
+func (a *App) detailWrap() bool {
+	return a.config == nil || a.config.WrapDetailsEnabled()
+}
+
+// toggleDetailWrap switches detail panes between wrapping long lines and
+// scrolling them horizontally, and saves the choice.
+func (a *App) toggleDetailWrap() {
+	wrap := !a.detailWrap()
+	if wrap {
+		a.toasts.Info("Wrapping long lines")
+	} else {
+		a.toasts.Info("Long lines unwrapped, h/l scrolls sideways")
+	}
+
+	if a.config != nil {
+		a.config.WrapDetails = &wrap
+		if err := a.config.Save(); err != nil {
+			a.ShowToastError(fmt.Sprintf("Failed to save config: %s", err))
+		}
+	}
+}
+
+// scrollDetail scrolls an unwrapped detail pane sideways by delta columns. It
+// returns false when the pane wraps, so the key can do something else.
+func (a *App) scrollDetail(tv *tview.TextView, delta int) bool {
+	if a.detailWrap() {
+		return false
+	}
+	row, col := tv.GetScrollOffset()
+	tv.ScrollTo(row, max(col+delta, 0))
+	return true
+}
+
+// wrapHint returns the key hint of the wrap toggle, naming what it switches to.
+func (a *App) wrapHint() KeyHint {
+	if a.detailWrap() {
+		return KeyHint{Key: string(wrapKey), Description: "No Wrap"}
+	}
+	return KeyHint{Key: string(wrapKey), Description: "Wrap"}
+}
+
+// wrapModalHints returns the hints of a detail modal's wrap toggle, and of
+// sideways scrolling while lines are unwrapped. Set them again after toggling.
+func (a *App) wrapModalHints() []KeyHint {
+	hints := []KeyHint{a.wrapHint()}
+	if !a.detailWrap() {
+		hints = append(hints, KeyHint{Key: "h/l", Description: "Scroll Sideways"})
+	}
+	return hints
+}
@@ -0,0 +1,51 @@
+package view
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// zenPage is the page name of a maximized panel.
+const zenPage = "zen"
+
+// toggleZen maximizes panel over its view, focusing focus, for reading large
+// payloads; called again, or with Esc, it restores the layout. The panel stays
+// part of the view, so its scroll position and selection carry over both ways.
+// hints are the keys that work in the maximized panel.
+func (a *App) toggleZen(panel, focus tview.Primitive, hints ...KeyHint) {
+	if a.modals.IsOpen(zenPage) {
+		a.closeZen()
+		return
+	}
+
+	page := tview.NewFlex().AddItem(panel, 0, 1, true)
+	page.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || event.Rune() == 'z' {
+			a.closeZen()
+			return nil
+		}
+		return event
+	})
+	a.modals.Show(zenPage, page, focus)
+	a.menu.SetHints(append(hints, KeyHint{Key: "z/esc", Description: "Restore"}))
+}
+
+// closeZen puts a maximized panel back in its view.
+func (a *App) closeZen() {
+	a.modals.Close(zenPage)
+	if current := a.app.Pages().Current(); current != nil {
+		a.menu.SetHints(a.keys.hints(current.Hints()))
+	}
+}
+
+// zenTextHints returns the keys of a maximized text panel.
+func (a *App) zenTextHints() []KeyHint {
+	hints := []KeyHint{
+		{Key: "j/k", Description: "Scroll"},
+		{Key: "g/G", Description: "Top/Bottom"},
+	}
+	if !a.detailWrap() {
+		hints = append(hints, KeyHint{Key: "h/l", Description: "Scroll Sideways"})
+	}
+	return hints
+}